@@ -0,0 +1,97 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+)
+
+// OAuth2Scope https://discord.com/developers/docs/topics/oauth2#shared-resources-oauth2-scopes
+type OAuth2Scope = string
+
+const (
+	OAuth2ScopeBot                      OAuth2Scope = "bot"
+	OAuth2ScopeApplicationsCommands     OAuth2Scope = "applications.commands"
+	OAuth2ScopeIdentify                 OAuth2Scope = "identify"
+	OAuth2ScopeEmail                    OAuth2Scope = "email"
+	OAuth2ScopeConnections              OAuth2Scope = "connections"
+	OAuth2ScopeGuilds                   OAuth2Scope = "guilds"
+	OAuth2ScopeGuildsJoin               OAuth2Scope = "guilds.join"
+	OAuth2ScopeGuildsMembersRead        OAuth2Scope = "guilds.members.read"
+	OAuth2ScopeGDMJoin                  OAuth2Scope = "gdm.join"
+	OAuth2ScopeWebhookIncoming          OAuth2Scope = "webhook.incoming"
+	OAuth2ScopeRoleConnectionsWrite     OAuth2Scope = "role_connections.write"
+	OAuth2ScopeApplicationsEntitlements OAuth2Scope = "applications.entitlements"
+)
+
+// bearerAuthorization creates the Authorization header value for requests made on behalf of a user
+// that has authorized the application through OAuth2.
+func bearerAuthorization(accessToken string) (string, error) {
+	if accessToken == "" {
+		return "", errors.New("an OAuth2 access token is required")
+	}
+	return "Bearer " + accessToken, nil
+}
+
+//////////////////////////////////////////////////////
+//
+// REST Methods
+//
+//////////////////////////////////////////////////////
+
+// ApplicationQueryBuilder REST interface for all application endpoints
+type ApplicationQueryBuilder interface {
+	WithContext(ctx context.Context) ApplicationQueryBuilder
+
+	// GetRoleConnectionMetadata Returns the role connection metadata records of the application.
+	GetRoleConnectionMetadata(flags ...RequestOption) ([]*ApplicationRoleConnectionMetadata, error)
+
+	// UpdateRoleConnectionMetadata Replaces the role connection metadata records of the application.
+	// An application can have at most 5 records.
+	UpdateRoleConnectionMetadata(records []*ApplicationRoleConnectionMetadata, flags ...RequestOption) ([]*ApplicationRoleConnectionMetadata, error)
+
+	// GetEmojis Returns the emojis owned by the application.
+	GetEmojis(flags ...RequestOption) ([]*Emoji, error)
+
+	// CreateEmoji Creates a new emoji owned by the application. Returns the new emoji object on success.
+	CreateEmoji(params *CreateApplicationEmojiParams, flags ...RequestOption) (*Emoji, error)
+
+	Emoji(emojiID Snowflake) ApplicationEmojiQueryBuilder
+
+	// GetSKUs Returns all SKUs for the application.
+	GetSKUs(flags ...RequestOption) ([]*SKU, error)
+
+	// GetEntitlements Returns all entitlements for the application, active and expired.
+	GetEntitlements(params *GetEntitlementsParams, flags ...RequestOption) ([]*Entitlement, error)
+
+	// CreateTestEntitlement Creates a test entitlement to a given SKU for a given guild or user.
+	CreateTestEntitlement(params *CreateTestEntitlementParams, flags ...RequestOption) (*Entitlement, error)
+
+	// DeleteTestEntitlement Deletes a currently-active test entitlement.
+	DeleteTestEntitlement(entitlementID Snowflake, flags ...RequestOption) error
+}
+
+// Application is used to create an application query builder.
+func (c clientQueryBuilder) Application(id Snowflake) ApplicationQueryBuilder {
+	return &applicationQueryBuilder{client: c.client, appID: id}
+}
+
+// The default application query builder.
+type applicationQueryBuilder struct {
+	ctx    context.Context
+	client *Client
+	appID  Snowflake
+}
+
+var _ ApplicationQueryBuilder = (*applicationQueryBuilder)(nil)
+
+func (a applicationQueryBuilder) WithContext(ctx context.Context) ApplicationQueryBuilder {
+	a.ctx = ctx
+	return &a
+}
+
+func (a applicationQueryBuilder) validate() error {
+	if a.appID.IsZero() {
+		return errors.New("applicationID must be set to target the correct application")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrAttachmentTooLarge is returned when an attachment exceeds DownloadOptions.MaxSize.
+var ErrAttachmentTooLarge = errors.New("attachment exceeds the maximum size")
+
+// ErrAttachmentContentType is returned when the content type of an attachment is not accepted, see
+// DownloadOptions.ContentTypes.
+var ErrAttachmentContentType = errors.New("attachment content type is not accepted")
+
+// DownloadOptions restricts which attachments are downloaded.
+type DownloadOptions struct {
+	// MaxSize is the maximum number of bytes to download. Attachments larger than this are rejected
+	// before, or while, being downloaded. 0 means no limit.
+	MaxSize int64
+
+	// ContentTypes are the accepted media types, such as "image/png". A type ending with a slash, such
+	// as "image/", accepts every subtype. Empty accepts any content type.
+	ContentTypes []string
+
+	// HTTPClient is used to fetch the file from the CDN. Defaults to DefaultHttpClient.
+	HTTPClient *http.Client
+}
+
+func (opts *DownloadOptions) acceptsContentType(contentType string) bool {
+	if len(opts.ContentTypes) == 0 {
+		return true
+	}
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	for _, accepted := range opts.ContentTypes {
+		accepted = strings.ToLower(accepted)
+		if mediaType == accepted || (strings.HasSuffix(accepted, "/") && strings.HasPrefix(mediaType, accepted)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Download streams the attachment from the CDN to w, and returns the number of bytes written. The file is
+// never held in memory, so a bot can process large uploads. Use the options to not fetch unbounded data:
+//  var buf bytes.Buffer
+//  _, err := attachment.Download(ctx, &buf, &disgord.DownloadOptions{
+//      MaxSize:      8 << 20,
+//      ContentTypes: []string{"image/"},
+//  })
+//  if errors.Is(err, disgord.ErrAttachmentTooLarge) {
+//      // tell the user
+//  }
+//
+// When the size limit is exceeded during the download, the bytes received so far have been written to w.
+func (a *Attachment) Download(ctx context.Context, w io.Writer, opts *DownloadOptions) (written int64, err error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	if a.URL == "" {
+		return 0, errors.New("attachment has no url")
+	}
+	if opts.MaxSize > 0 && int64(a.Size) > opts.MaxSize {
+		return 0, fmt.Errorf("%w: %d bytes", ErrAttachmentTooLarge, a.Size)
+	}
+	if a.ContentType != "" && !opts.acceptsContentType(a.ContentType) {
+		return 0, fmt.Errorf("%w: %s", ErrAttachmentContentType, a.ContentType)
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = DefaultHttpClient
+	}
+
+	req, err := http.NewRequest(http.MethodGet, a.URL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unable to download attachment, got %s", resp.Status)
+	}
+	if contentType := resp.Header.Get("Content-Type"); !opts.acceptsContentType(contentType) {
+		return 0, fmt.Errorf("%w: %s", ErrAttachmentContentType, contentType)
+	}
+	if opts.MaxSize > 0 && resp.ContentLength > opts.MaxSize {
+		return 0, fmt.Errorf("%w: %d bytes", ErrAttachmentTooLarge, resp.ContentLength)
+	}
+
+	body := io.Reader(resp.Body)
+	if opts.MaxSize > 0 {
+		// read one byte past the limit to detect files larger than announced
+		body = io.LimitReader(resp.Body, opts.MaxSize+1)
+	}
+	written, err = io.Copy(w, body)
+	if err != nil {
+		return written, err
+	}
+	if opts.MaxSize > 0 && written > opts.MaxSize {
+		return written, ErrAttachmentTooLarge
+	}
+	return written, nil
+}
@@ -0,0 +1,63 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAttachment_Download(t *testing.T) {
+	content := strings.Repeat("a", 100)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		if r.URL.Path == "/chunked" {
+			// no content length
+			w.(http.Flusher).Flush()
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	attachment := &Attachment{URL: srv.URL + "/file.png", Size: 100}
+
+	var buf bytes.Buffer
+	written, err := attachment.Download(ctx, &buf, &DownloadOptions{MaxSize: 100, ContentTypes: []string{"image/"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != 100 || buf.String() != content {
+		t.Errorf("expected the file content. Got %d bytes", written)
+	}
+
+	if _, err = attachment.Download(ctx, &buf, &DownloadOptions{MaxSize: 99}); !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("expected the announced size to be rejected. Got %v", err)
+	}
+
+	// the size of the attachment object is not trusted
+	attachment = &Attachment{URL: srv.URL + "/chunked", Size: 10}
+	buf.Reset()
+	written, err = attachment.Download(ctx, &buf, &DownloadOptions{MaxSize: 50})
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("expected the download to stop at the max size. Got %v", err)
+	}
+	if written > 51 {
+		t.Errorf("expected at most the max size to be read. Got %d bytes", written)
+	}
+
+	if _, err = attachment.Download(ctx, &buf, &DownloadOptions{ContentTypes: []string{"text/plain"}}); !errors.Is(err, ErrAttachmentContentType) {
+		t.Errorf("expected the content type to be rejected. Got %v", err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = attachment.Download(cancelled, &buf, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the download to be cancelled. Got %v", err)
+	}
+}
@@ -46,7 +46,59 @@ const (
 	AuditLogEvtEmojiDelete
 )
 const (
-	AuditLogEvtMessageDelete AuditLogEvt = 72
+	AuditLogEvtMessageDelete AuditLogEvt = 72 + iota
+	AuditLogEvtMessageBulkDelete
+	AuditLogEvtMessagePin
+	AuditLogEvtMessageUnpin
+)
+const (
+	AuditLogEvtIntegrationCreate AuditLogEvt = 80 + iota
+	AuditLogEvtIntegrationUpdate
+	AuditLogEvtIntegrationDelete
+	AuditLogEvtStageInstanceCreate
+	AuditLogEvtStageInstanceUpdate
+	AuditLogEvtStageInstanceDelete
+)
+const (
+	AuditLogEvtStickerCreate AuditLogEvt = 90 + iota
+	AuditLogEvtStickerUpdate
+	AuditLogEvtStickerDelete
+)
+const (
+	AuditLogEvtGuildScheduledEventCreate AuditLogEvt = 100 + iota
+	AuditLogEvtGuildScheduledEventUpdate
+	AuditLogEvtGuildScheduledEventDelete
+)
+const (
+	AuditLogEvtThreadCreate AuditLogEvt = 110 + iota
+	AuditLogEvtThreadUpdate
+	AuditLogEvtThreadDelete
+)
+const (
+	AuditLogEvtApplicationCommandPermissionUpdate AuditLogEvt = 121
+)
+const (
+	AuditLogEvtAutoModerationRuleCreate AuditLogEvt = 140 + iota
+	AuditLogEvtAutoModerationRuleUpdate
+	AuditLogEvtAutoModerationRuleDelete
+	AuditLogEvtAutoModerationBlockMessage
+	AuditLogEvtAutoModerationFlagToChannel
+	AuditLogEvtAutoModerationUserCommunicationDisabled
+)
+const (
+	AuditLogEvtCreatorMonetizationRequestCreated AuditLogEvt = 150 + iota
+	AuditLogEvtCreatorMonetizationTermsAccepted
+)
+const (
+	AuditLogEvtOnboardingPromptCreate AuditLogEvt = 163 + iota
+	AuditLogEvtOnboardingPromptUpdate
+	AuditLogEvtOnboardingPromptDelete
+	AuditLogEvtOnboardingCreate
+	AuditLogEvtOnboardingUpdate
+)
+const (
+	AuditLogEvtHomeSettingsCreate AuditLogEvt = 190 + iota
+	AuditLogEvtHomeSettingsUpdate
 )
 
 type AuditLogChange string
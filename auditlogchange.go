@@ -0,0 +1,288 @@
+package disgord
+
+import (
+	"fmt"
+
+	"github.com/Vedza/disgord/json"
+)
+
+// auditLogChangeFields holds the change keys that differ from the json field of the changed object
+var auditLogChangeFields = map[AuditLogChange]string{
+	AuditLogChangeIconHash:   "icon",
+	AuditLogChangeSplashHash: "splash",
+	AuditLogChangeAvatarHash: "avatar",
+}
+
+// decodeChanges populates v with either the old or the new values of the changes, as if the changes
+// were a partial json object of v.
+func decodeChanges(changes []*AuditLogChanges, old bool, v interface{}) error {
+	fields := make(map[string]interface{}, len(changes))
+	for _, change := range changes {
+		key := AuditLogChange(change.Key)
+		if key == AuditLogChangeAdd || key == AuditLogChangeRemove {
+			continue
+		}
+
+		value := change.NewValue
+		if old {
+			value = change.OldValue
+		}
+		if value == nil {
+			continue
+		}
+
+		field := change.Key
+		if alias, ok := auditLogChangeFields[key]; ok {
+			field = alias
+		}
+		fields[field] = value
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// decodeChange populates before and after with the old and new values of the entry changes.
+func (e *AuditLogEntry) decodeChange(before, after interface{}, events ...AuditLogEvt) error {
+	var expected bool
+	for _, evt := range events {
+		expected = expected || e.Event == evt
+	}
+	if !expected {
+		return fmt.Errorf("audit log entry of action type %d does not hold these changes", e.Event)
+	}
+
+	if err := decodeChanges(e.Changes, true, before); err != nil {
+		return err
+	}
+	return decodeChanges(e.Changes, false, after)
+}
+
+// ChannelChange holds the changed fields of a channel or thread. Fields that did not change are left empty,
+// and Before is empty for created channels, while After is empty for deleted channels.
+type ChannelChange struct {
+	Before *Channel
+	After  *Channel
+}
+
+// ChannelChange returns the channel changes of channel and thread entries.
+func (e *AuditLogEntry) ChannelChange() (*ChannelChange, error) {
+	change := &ChannelChange{Before: &Channel{}, After: &Channel{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtChannelCreate, AuditLogEvtChannelUpdate, AuditLogEvtChannelDelete,
+		AuditLogEvtThreadCreate, AuditLogEvtThreadUpdate, AuditLogEvtThreadDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// GuildChange holds the changed fields of a guild, see ChannelChange.
+type GuildChange struct {
+	Before *Guild
+	After  *Guild
+}
+
+// GuildChange returns the guild changes of guild update entries.
+func (e *AuditLogEntry) GuildChange() (*GuildChange, error) {
+	change := &GuildChange{Before: &Guild{}, After: &Guild{}}
+	if err := e.decodeChange(change.Before, change.After, AuditLogEvtGuildUpdate); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// MemberChange holds the changed fields of a member, see ChannelChange. Roles given to or taken from the
+// member are listed separately.
+type MemberChange struct {
+	Before *Member
+	After  *Member
+
+	AddedRoles   []*Role
+	RemovedRoles []*Role
+}
+
+// MemberChange returns the member changes of member update and member role update entries.
+func (e *AuditLogEntry) MemberChange() (*MemberChange, error) {
+	change := &MemberChange{Before: &Member{}, After: &Member{}}
+	if err := e.decodeChange(change.Before, change.After, AuditLogEvtMemberUpdate, AuditLogEvtMemberRoleUpdate); err != nil {
+		return nil, err
+	}
+
+	for _, c := range e.Changes {
+		var roles *[]*Role
+		switch AuditLogChange(c.Key) {
+		case AuditLogChangeAdd:
+			roles = &change.AddedRoles
+		case AuditLogChangeRemove:
+			roles = &change.RemovedRoles
+		default:
+			continue
+		}
+
+		data, err := json.Marshal(c.NewValue)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, roles); err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
+
+// RoleChange holds the changed fields of a role, see ChannelChange.
+type RoleChange struct {
+	Before *Role
+	After  *Role
+}
+
+// RoleChange returns the role changes of role entries.
+func (e *AuditLogEntry) RoleChange() (*RoleChange, error) {
+	change := &RoleChange{Before: &Role{}, After: &Role{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtRoleCreate, AuditLogEvtRoleUpdate, AuditLogEvtRoleDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// InviteChange holds the changed fields of an invite, see ChannelChange.
+type InviteChange struct {
+	Before *Invite
+	After  *Invite
+}
+
+// InviteChange returns the invite changes of invite entries.
+func (e *AuditLogEntry) InviteChange() (*InviteChange, error) {
+	change := &InviteChange{Before: &Invite{}, After: &Invite{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtInviteCreate, AuditLogEvtInviteUpdate, AuditLogEvtInviteDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// WebhookChange holds the changed fields of a webhook, see ChannelChange.
+type WebhookChange struct {
+	Before *Webhook
+	After  *Webhook
+}
+
+// WebhookChange returns the webhook changes of webhook entries.
+func (e *AuditLogEntry) WebhookChange() (*WebhookChange, error) {
+	change := &WebhookChange{Before: &Webhook{}, After: &Webhook{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtWebhookCreate, AuditLogEvtWebhookUpdate, AuditLogEvtWebhookDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// EmojiChange holds the changed fields of an emoji, see ChannelChange.
+type EmojiChange struct {
+	Before *Emoji
+	After  *Emoji
+}
+
+// EmojiChange returns the emoji changes of emoji entries.
+func (e *AuditLogEntry) EmojiChange() (*EmojiChange, error) {
+	change := &EmojiChange{Before: &Emoji{}, After: &Emoji{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtEmojiCreate, AuditLogEvtEmojiUpdate, AuditLogEvtEmojiDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// StickerChange holds the changed fields of a sticker, see ChannelChange.
+type StickerChange struct {
+	Before *Sticker
+	After  *Sticker
+}
+
+// StickerChange returns the sticker changes of sticker entries.
+func (e *AuditLogEntry) StickerChange() (*StickerChange, error) {
+	change := &StickerChange{Before: &Sticker{}, After: &Sticker{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtStickerCreate, AuditLogEvtStickerUpdate, AuditLogEvtStickerDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// IntegrationChange holds the changed fields of an integration, see ChannelChange.
+type IntegrationChange struct {
+	Before *Integration
+	After  *Integration
+}
+
+// IntegrationChange returns the integration changes of integration entries.
+func (e *AuditLogEntry) IntegrationChange() (*IntegrationChange, error) {
+	change := &IntegrationChange{Before: &Integration{}, After: &Integration{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtIntegrationCreate, AuditLogEvtIntegrationUpdate, AuditLogEvtIntegrationDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// StageInstanceChange holds the changed fields of a stage instance, see ChannelChange.
+type StageInstanceChange struct {
+	Before *StageInstance
+	After  *StageInstance
+}
+
+// StageInstanceChange returns the stage instance changes of stage instance entries.
+func (e *AuditLogEntry) StageInstanceChange() (*StageInstanceChange, error) {
+	change := &StageInstanceChange{Before: &StageInstance{}, After: &StageInstance{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtStageInstanceCreate, AuditLogEvtStageInstanceUpdate, AuditLogEvtStageInstanceDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// ScheduledEventChange holds the changed fields of a scheduled event, see ChannelChange.
+type ScheduledEventChange struct {
+	Before *GuildScheduledEvent
+	After  *GuildScheduledEvent
+}
+
+// ScheduledEventChange returns the scheduled event changes of scheduled event entries.
+func (e *AuditLogEntry) ScheduledEventChange() (*ScheduledEventChange, error) {
+	change := &ScheduledEventChange{Before: &GuildScheduledEvent{}, After: &GuildScheduledEvent{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtGuildScheduledEventCreate, AuditLogEvtGuildScheduledEventUpdate, AuditLogEvtGuildScheduledEventDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// AutoModerationRuleChange holds the changed fields of an auto moderation rule, see ChannelChange.
+type AutoModerationRuleChange struct {
+	Before *AutoModerationRule
+	After  *AutoModerationRule
+}
+
+// AutoModerationRuleChange returns the rule changes of auto moderation rule entries.
+func (e *AuditLogEntry) AutoModerationRuleChange() (*AutoModerationRuleChange, error) {
+	change := &AutoModerationRuleChange{Before: &AutoModerationRule{}, After: &AutoModerationRule{}}
+	err := e.decodeChange(change.Before, change.After,
+		AuditLogEvtAutoModerationRuleCreate, AuditLogEvtAutoModerationRuleUpdate, AuditLogEvtAutoModerationRuleDelete)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
@@ -0,0 +1,66 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestAuditLogEntry_ChannelChange(t *testing.T) {
+	data := []byte(`{
+		"id": "3",
+		"target_id": "2",
+		"action_type": 11,
+		"changes": [
+			{"key": "name", "old_value": "general", "new_value": "chat"},
+			{"key": "nsfw", "old_value": false, "new_value": true},
+			{"key": "rate_limit_per_user", "new_value": 10}
+		]
+	}`)
+	entry := &AuditLogEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	change, err := entry.ChannelChange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Before.Name != "general" || change.Before.NSFW || change.Before.RateLimitPerUser != 0 {
+		t.Errorf("incorrect old values. Got %+v", change.Before)
+	}
+	if change.After.Name != "chat" || !change.After.NSFW || change.After.RateLimitPerUser != 10 {
+		t.Errorf("incorrect new values. Got %+v", change.After)
+	}
+
+	if _, err = entry.RoleChange(); err == nil {
+		t.Error("expected an error for changes of a different action type")
+	}
+}
+
+func TestAuditLogEntry_MemberChange(t *testing.T) {
+	data := []byte(`{
+		"action_type": 25,
+		"changes": [
+			{"key": "$add", "new_value": [{"id": "5", "name": "moderator"}]},
+			{"key": "nick", "old_value": "a", "new_value": "b"}
+		]
+	}`)
+	entry := &AuditLogEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	change, err := entry.MemberChange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(change.AddedRoles) != 1 || change.AddedRoles[0].ID != 5 || len(change.RemovedRoles) != 0 {
+		t.Errorf("incorrect role changes. Got %+v", change.AddedRoles)
+	}
+	if change.Before.Nick != "a" || change.After.Nick != "b" {
+		t.Errorf("incorrect member changes. Got %+v and %+v", change.Before, change.After)
+	}
+}
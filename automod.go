@@ -0,0 +1,417 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// AutoModerationEventType indicates in what event context a rule should be checked.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-event-types
+type AutoModerationEventType uint
+
+const (
+	_ AutoModerationEventType = iota
+	AutoModerationEventMessageSend
+	AutoModerationEventMemberUpdate
+)
+
+// AutoModerationTriggerType characterizes the type of content which can trigger the rule.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-trigger-types
+type AutoModerationTriggerType uint
+
+const (
+	AutoModerationTriggerKeyword       AutoModerationTriggerType = 1
+	AutoModerationTriggerSpam          AutoModerationTriggerType = 3
+	AutoModerationTriggerKeywordPreset AutoModerationTriggerType = 4
+	AutoModerationTriggerMentionSpam   AutoModerationTriggerType = 5
+	AutoModerationTriggerMemberProfile AutoModerationTriggerType = 6
+)
+
+// AutoModerationKeywordPresetType https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-keyword-preset-types
+type AutoModerationKeywordPresetType uint
+
+const (
+	_ AutoModerationKeywordPresetType = iota
+	AutoModerationPresetProfanity
+	AutoModerationPresetSexualContent
+	AutoModerationPresetSlurs
+)
+
+// AutoModerationActionType https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object-action-types
+type AutoModerationActionType uint
+
+const (
+	_ AutoModerationActionType = iota
+	AutoModerationActionBlockMessage
+	AutoModerationActionSendAlertMessage
+	AutoModerationActionTimeout
+	AutoModerationActionBlockMemberInteraction
+)
+
+// AutoModerationMaxTimeout is the longest timeout, in seconds, a timeout action can be configured with.
+const AutoModerationMaxTimeout = 2419200 // 4 weeks
+
+// AutoModerationTriggerMetadata holds additional data used to determine whether a rule should be
+// triggered. Which fields are relevant depends on the trigger type of the rule.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object-trigger-metadata
+type AutoModerationTriggerMetadata struct {
+	// KeywordFilter substrings which will be searched for in content. Keyword and MemberProfile triggers.
+	KeywordFilter []string `json:"keyword_filter,omitempty"`
+
+	// RegexPatterns regular expressions which will be matched against content. Keyword and MemberProfile triggers.
+	RegexPatterns []string `json:"regex_patterns,omitempty"`
+
+	// Presets the internally pre-defined word sets which will be searched for. KeywordPreset triggers.
+	Presets []AutoModerationKeywordPresetType `json:"presets,omitempty"`
+
+	// AllowList substrings which should not trigger the rule. Keyword, KeywordPreset and MemberProfile triggers.
+	AllowList []string `json:"allow_list,omitempty"`
+
+	// MentionTotalLimit total number of unique role and user mentions allowed per message. MentionSpam triggers.
+	MentionTotalLimit int `json:"mention_total_limit,omitempty"`
+
+	// MentionRaidProtection whether to automatically detect mention raids. MentionSpam triggers.
+	MentionRaidProtection bool `json:"mention_raid_protection_enabled,omitempty"`
+}
+
+func (m *AutoModerationTriggerMetadata) findErrors(trigger AutoModerationTriggerType) error {
+	if len(m.KeywordFilter) > 1000 {
+		return errors.New("a rule can have at most 1000 keywords")
+	}
+	for _, keyword := range m.KeywordFilter {
+		if len(keyword) > 60 {
+			return fmt.Errorf("keyword %q is longer than 60 characters", keyword)
+		}
+	}
+	if len(m.RegexPatterns) > 10 {
+		return errors.New("a rule can have at most 10 regex patterns")
+	}
+	for _, pattern := range m.RegexPatterns {
+		if len(pattern) > 260 {
+			return fmt.Errorf("regex pattern %q is longer than 260 characters", pattern)
+		}
+	}
+
+	maxAllowed := 100
+	if trigger == AutoModerationTriggerKeywordPreset {
+		maxAllowed = 1000
+	}
+	if len(m.AllowList) > maxAllowed {
+		return fmt.Errorf("the allow list can have at most %d entries", maxAllowed)
+	}
+	if m.MentionTotalLimit > 50 {
+		return errors.New("mention total limit can not be higher than 50")
+	}
+	return nil
+}
+
+// AutoModerationActionMetadata holds additional data used when an action is executed.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object-action-metadata
+type AutoModerationActionMetadata struct {
+	// ChannelID channel to which user content should be logged. SendAlertMessage actions.
+	ChannelID Snowflake `json:"channel_id,omitempty"`
+
+	// DurationSeconds timeout duration in seconds, at most 4 weeks. Timeout actions.
+	DurationSeconds int `json:"duration_seconds,omitempty"`
+
+	// CustomMessage shown to members whenever their message is blocked, at most 150 characters. BlockMessage actions.
+	CustomMessage string `json:"custom_message,omitempty"`
+}
+
+// AutoModerationAction an action which will execute whenever a rule is triggered.
+// https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-action-object
+type AutoModerationAction struct {
+	Type     AutoModerationActionType      `json:"type"`
+	Metadata *AutoModerationActionMetadata `json:"metadata,omitempty"`
+}
+
+var _ Copier = (*AutoModerationAction)(nil)
+var _ DeepCopier = (*AutoModerationAction)(nil)
+
+func (a *AutoModerationAction) findErrors(trigger AutoModerationTriggerType) error {
+	switch a.Type {
+	case AutoModerationActionSendAlertMessage:
+		if a.Metadata == nil || a.Metadata.ChannelID.IsZero() {
+			return errors.New("send alert message actions require a channel id")
+		}
+	case AutoModerationActionTimeout:
+		switch trigger {
+		case AutoModerationTriggerKeyword, AutoModerationTriggerMentionSpam, AutoModerationTriggerMemberProfile:
+		default:
+			return errors.New("timeout actions can only be set up for keyword, mention spam and member profile rules")
+		}
+		if a.Metadata == nil || a.Metadata.DurationSeconds <= 0 || a.Metadata.DurationSeconds > AutoModerationMaxTimeout {
+			return errors.New("timeout actions require a duration of at most 4 weeks")
+		}
+	case AutoModerationActionBlockMessage:
+		if a.Metadata != nil && len(a.Metadata.CustomMessage) > 150 {
+			return errors.New("custom message can not be longer than 150 characters")
+		}
+	}
+	return nil
+}
+
+// AutoModerationRule https://discord.com/developers/docs/resources/auto-moderation#auto-moderation-rule-object
+type AutoModerationRule struct {
+	ID              Snowflake                      `json:"id"`
+	GuildID         Snowflake                      `json:"guild_id"`
+	Name            string                         `json:"name"`
+	CreatorID       Snowflake                      `json:"creator_id"`
+	EventType       AutoModerationEventType        `json:"event_type"`
+	TriggerType     AutoModerationTriggerType      `json:"trigger_type"`
+	TriggerMetadata *AutoModerationTriggerMetadata `json:"trigger_metadata"`
+	Actions         []*AutoModerationAction        `json:"actions"`
+	Enabled         bool                           `json:"enabled"`
+	ExemptRoles     []Snowflake                    `json:"exempt_roles"`
+	ExemptChannels  []Snowflake                    `json:"exempt_channels"`
+}
+
+var _ Copier = (*AutoModerationRule)(nil)
+var _ DeepCopier = (*AutoModerationRule)(nil)
+
+// CreateAutoModerationRuleParams https://discord.com/developers/docs/resources/auto-moderation#create-auto-moderation-rule-json-params
+type CreateAutoModerationRuleParams struct {
+	Name            string                         `json:"name"`
+	EventType       AutoModerationEventType        `json:"event_type"`
+	TriggerType     AutoModerationTriggerType      `json:"trigger_type"`
+	TriggerMetadata *AutoModerationTriggerMetadata `json:"trigger_metadata,omitempty"`
+	Actions         []*AutoModerationAction        `json:"actions"`
+	Enabled         bool                           `json:"enabled,omitempty"`
+	ExemptRoles     []Snowflake                    `json:"exempt_roles,omitempty"`    // at most 20
+	ExemptChannels  []Snowflake                    `json:"exempt_channels,omitempty"` // at most 50
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *CreateAutoModerationRuleParams) FindErrors() error {
+	if p.Name == "" {
+		return errors.New("auto moderation rule must have a name")
+	}
+	if p.EventType == 0 {
+		return errors.New("auto moderation rule must have an event type")
+	}
+	if p.TriggerType == 0 {
+		return errors.New("auto moderation rule must have a trigger type")
+	}
+	if len(p.Actions) == 0 {
+		return errors.New("auto moderation rule must have at least one action")
+	}
+	return validateAutoModerationRule(p.TriggerType, p.TriggerMetadata, p.Actions, p.ExemptRoles, p.ExemptChannels)
+}
+
+// UpdateAutoModerationRuleParams https://discord.com/developers/docs/resources/auto-moderation#modify-auto-moderation-rule-json-params
+// All fields are optional. The trigger type of a rule can not be changed.
+type UpdateAutoModerationRuleParams struct {
+	Name            string                         `json:"name,omitempty"`
+	EventType       AutoModerationEventType        `json:"event_type,omitempty"`
+	TriggerMetadata *AutoModerationTriggerMetadata `json:"trigger_metadata,omitempty"`
+	Actions         []*AutoModerationAction        `json:"actions,omitempty"`
+	Enabled         *bool                          `json:"enabled,omitempty"`
+	ExemptRoles     []Snowflake                    `json:"exempt_roles,omitempty"`
+	ExemptChannels  []Snowflake                    `json:"exempt_channels,omitempty"`
+
+	// TriggerType is the trigger type of the rule being updated. It is not sent to Discord, but is
+	// used to validate the trigger metadata and actions.
+	TriggerType AutoModerationTriggerType `json:"-"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateAutoModerationRuleParams) FindErrors() error {
+	return validateAutoModerationRule(p.TriggerType, p.TriggerMetadata, p.Actions, p.ExemptRoles, p.ExemptChannels)
+}
+
+func validateAutoModerationRule(trigger AutoModerationTriggerType, metadata *AutoModerationTriggerMetadata, actions []*AutoModerationAction, roles, channels []Snowflake) error {
+	if metadata != nil {
+		if err := metadata.findErrors(trigger); err != nil {
+			return err
+		}
+	}
+	for _, action := range actions {
+		if err := action.findErrors(trigger); err != nil {
+			return err
+		}
+	}
+	if len(roles) > 20 {
+		return errors.New("at most 20 roles can be exempt from a rule")
+	}
+	if len(channels) > 50 {
+		return errors.New("at most 50 channels can be exempt from a rule")
+	}
+	return nil
+}
+
+// GetAutoModerationRules [REST] Get a list of all rules currently configured for the guild.
+// Returns a list of auto moderation rule objects for the given guild.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/auto-moderation/rules
+//  Discord documentation   https://discord.com/developers/docs/resources/auto-moderation#list-auto-moderation-rules-for-guild
+//  Reviewed                2022-06-18
+//  Comment                 Requires the MANAGE_GUILD permission.
+func (g guildQueryBuilder) GetAutoModerationRules(flags ...RequestOption) ([]*AutoModerationRule, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildAutoModerationRules(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*AutoModerationRule, 0)
+		return &tmp
+	}
+
+	return getAutoModerationRules(r.Execute)
+}
+
+// CreateAutoModerationRule [REST] Create a new rule. Returns an auto moderation rule on success.
+// Fires an Auto Moderation Rule Create Gateway event.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/auto-moderation/rules
+//  Discord documentation   https://discord.com/developers/docs/resources/auto-moderation#create-auto-moderation-rule
+//  Reviewed                2022-06-18
+//  Comment                 Requires the MANAGE_GUILD permission.
+func (g guildQueryBuilder) CreateAutoModerationRule(params *CreateAutoModerationRuleParams, flags ...RequestOption) (*AutoModerationRule, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildAutoModerationRules(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &AutoModerationRule{}
+	}
+
+	return getAutoModerationRule(r.Execute)
+}
+
+type AutoModerationRuleQueryBuilder interface {
+	WithContext(ctx context.Context) AutoModerationRuleQueryBuilder
+
+	Get(flags ...RequestOption) (*AutoModerationRule, error)
+	Update(params *UpdateAutoModerationRuleParams, flags ...RequestOption) (*AutoModerationRule, error)
+	Delete(flags ...RequestOption) error
+}
+
+func (g guildQueryBuilder) AutoModerationRule(ruleID Snowflake) AutoModerationRuleQueryBuilder {
+	return &autoModerationRuleQueryBuilder{client: g.client, gid: g.gid, ruleID: ruleID}
+}
+
+type autoModerationRuleQueryBuilder struct {
+	ctx    context.Context
+	client *Client
+	gid    Snowflake
+	ruleID Snowflake
+}
+
+var _ AutoModerationRuleQueryBuilder = (*autoModerationRuleQueryBuilder)(nil)
+
+func (a autoModerationRuleQueryBuilder) WithContext(ctx context.Context) AutoModerationRuleQueryBuilder {
+	a.ctx = ctx
+	return &a
+}
+
+func (a autoModerationRuleQueryBuilder) validate() error {
+	if a.gid.IsZero() {
+		return errors.New("guildID must be set, was " + a.gid.String())
+	}
+	if a.ruleID.IsZero() {
+		return errors.New("ruleID must be set to target the correct auto moderation rule")
+	}
+	return nil
+}
+
+// Get [REST] Get a single rule. Returns an auto moderation rule object.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/auto-moderation/rules/{auto_moderation_rule.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/auto-moderation#get-auto-moderation-rule
+//  Reviewed                2022-06-18
+//  Comment                 Requires the MANAGE_GUILD permission.
+func (a autoModerationRuleQueryBuilder) Get(flags ...RequestOption) (*AutoModerationRule, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildAutoModerationRule(a.gid, a.ruleID),
+		Ctx:      a.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &AutoModerationRule{}
+	}
+
+	return getAutoModerationRule(r.Execute)
+}
+
+// Update [REST] Modify an existing rule. Returns an auto moderation rule on success.
+// Fires an Auto Moderation Rule Update Gateway event.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/auto-moderation/rules/{auto_moderation_rule.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/auto-moderation#modify-auto-moderation-rule
+//  Reviewed                2022-06-18
+//  Comment                 Requires the MANAGE_GUILD permission.
+func (a autoModerationRuleQueryBuilder) Update(params *UpdateAutoModerationRuleParams, flags ...RequestOption) (*AutoModerationRule, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         a.ctx,
+		Endpoint:    endpoint.GuildAutoModerationRule(a.gid, a.ruleID),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &AutoModerationRule{}
+	}
+
+	return getAutoModerationRule(r.Execute)
+}
+
+// Delete [REST] Delete a rule. Returns a 204 on success. Fires an Auto Moderation Rule Delete Gateway event.
+//  Method                  DELETE
+//  Endpoint                /guilds/{guild.id}/auto-moderation/rules/{auto_moderation_rule.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/auto-moderation#delete-auto-moderation-rule
+//  Reviewed                2022-06-18
+//  Comment                 Requires the MANAGE_GUILD permission.
+func (a autoModerationRuleQueryBuilder) Delete(flags ...RequestOption) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      a.ctx,
+		Endpoint: endpoint.GuildAutoModerationRule(a.gid, a.ruleID),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
@@ -0,0 +1,102 @@
+package disgord
+
+import (
+	"context"
+	"sync"
+)
+
+// awaitCtrl keeps a temporary handler alive until it has received one event, or is killed.
+type awaitCtrl struct {
+	mu   sync.Mutex
+	dead bool
+}
+
+var _ HandlerCtrl = (*awaitCtrl)(nil)
+
+func (c *awaitCtrl) OnInsert(Session) error { return nil }
+func (c *awaitCtrl) OnRemove(Session) error { return nil }
+
+func (c *awaitCtrl) IsDead() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.dead
+}
+
+func (c *awaitCtrl) Update() {
+	c.kill()
+}
+
+func (c *awaitCtrl) kill() {
+	c.mu.Lock()
+	c.dead = true
+	c.mu.Unlock()
+}
+
+// await registers a temporary handler and returns the first event accepted by the filter. The handler
+// is removed once an event is received or the context is done.
+func (c *Client) await(ctx context.Context, evtName string, filter func(evt interface{}) bool) (interface{}, error) {
+	// the handler runs at most once, so the buffer makes sure the dispatcher never blocks
+	received := make(chan interface{}, 1)
+	ctrl := &awaitCtrl{}
+	mdlw := func(evt interface{}) interface{} {
+		if !filter(evt) {
+			return nil
+		}
+		return evt
+	}
+
+	if err := c.dispatcher.register(evtName, mdlw, received, ctrl); err != nil {
+		return nil, err
+	}
+
+	select {
+	case evt := <-received:
+		return evt, nil
+	case <-ctx.Done():
+		ctrl.kill()
+		c.dispatcher.deregister(evtName, ctrl)
+		return nil, ctx.Err()
+	}
+}
+
+// AwaitMessage waits for the first message that satisfies the filter. A nil filter accepts any message.
+// Use a context with a timeout or deadline to stop waiting.
+//  ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//  defer cancel()
+//
+//  reply, err := client.AwaitMessage(ctx, func(evt *disgord.MessageCreate) bool {
+//      return evt.Message.ChannelID == channelID && evt.Message.Author.ID == userID
+//  })
+func (c *Client) AwaitMessage(ctx context.Context, filter func(evt *MessageCreate) bool) (*MessageCreate, error) {
+	evt, err := c.await(ctx, EvtMessageCreate, func(evt interface{}) bool {
+		return filter == nil || filter(evt.(*MessageCreate))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evt.(*MessageCreate), nil
+}
+
+// AwaitReaction waits for the first added reaction that satisfies the filter. A nil filter accepts any reaction.
+func (c *Client) AwaitReaction(ctx context.Context, filter func(evt *MessageReactionAdd) bool) (*MessageReactionAdd, error) {
+	evt, err := c.await(ctx, EvtMessageReactionAdd, func(evt interface{}) bool {
+		return filter == nil || filter(evt.(*MessageReactionAdd))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evt.(*MessageReactionAdd), nil
+}
+
+// AwaitComponentInteraction waits for the first message component interaction, such as a button click, that
+// satisfies the filter. A nil filter accepts any component interaction. Remember to respond to the interaction.
+func (c *Client) AwaitComponentInteraction(ctx context.Context, filter func(evt *InteractionCreate) bool) (*InteractionCreate, error) {
+	evt, err := c.await(ctx, EvtInteractionCreate, func(evt interface{}) bool {
+		interaction := evt.(*InteractionCreate)
+		return interaction.Type == InteractionMessageComponent && (filter == nil || filter(interaction))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evt.(*InteractionCreate), nil
+}
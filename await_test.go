@@ -0,0 +1,58 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+func TestClient_AwaitMessage(t *testing.T) {
+	c := &Client{dispatcher: newDispatcher(), log: &logger.Empty{}}
+	c.dispatcher.addSessionInstance(c)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		c.dispatcher.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "no"}})
+		c.dispatcher.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "yes"}})
+		c.dispatcher.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "yes, again"}})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	evt, err := c.AwaitMessage(ctx, func(evt *MessageCreate) bool {
+		return evt.Message.Content != "no"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if evt.Message.Content != "yes" {
+		t.Errorf("expected the first matching message. Got %s", evt.Message.Content)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	c.dispatcher.RLock()
+	nrOfSpecs := len(c.dispatcher.handlerSpecs[EvtMessageCreate])
+	c.dispatcher.RUnlock()
+	if nrOfSpecs != 0 {
+		t.Errorf("expected the temporary handler to be removed. Got %d", nrOfSpecs)
+	}
+}
+
+func TestClient_AwaitReaction_Timeout(t *testing.T) {
+	c := &Client{dispatcher: newDispatcher(), log: &logger.Empty{}}
+	c.dispatcher.addSessionInstance(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.AwaitReaction(ctx, nil); err != context.DeadlineExceeded {
+		t.Errorf("expected the context deadline to be exceeded. Got %v", err)
+	}
+
+	if len(c.dispatcher.handlerSpecs[EvtMessageReactionAdd]) != 0 {
+		t.Error("expected the temporary handler to be removed once the context is done")
+	}
+}
@@ -33,13 +33,29 @@ type userHolder struct {
 }
 
 func NewBasicCache() *BasicCache {
+	return NewBasicCacheWithConfig(BasicCacheConfig{})
+}
+
+// NewBasicCacheWithConfig creates a basic cache where the number of entries, and how long they are
+// kept, can be limited per resource.
+func NewBasicCacheWithConfig(conf BasicCacheConfig) *BasicCache {
 	cache := &BasicCache{
 		CurrentUser: &User{},
 	}
 	cache.Users.Store = make(map[Snowflake]*User)
+	cache.Users.limits = newCacheTracker(conf.Users)
 	cache.Channels.Store = make(map[Snowflake]*Channel)
+	cache.Channels.limits = newCacheTracker(conf.Channels)
 	cache.Guilds.Store = make(map[Snowflake]*guildCacheContainer)
+	cache.Guilds.limits = newCacheTracker(conf.Guilds)
+	cache.Guilds.memberLimits = newCacheTracker(conf.Members)
+
+	cache.Users.disabled, cache.Users.strip = conf.Policy.DisableUsers, conf.Policy.StripUser
+	cache.Channels.disabled, cache.Channels.strip = conf.Policy.DisableChannels, conf.Policy.StripChannel
+	cache.Guilds.strip = conf.Policy.StripGuild
+	cache.Guilds.disableMembers, cache.Guilds.stripMember = conf.Policy.DisableMembers, conf.Policy.StripMember
 	cache.VoiceStates.Store = make(map[Snowflake]*voiceStateCacheEntry)
+	cache.Presences = newPresenceCache(conf.Presences)
 
 	return cache
 }
@@ -57,22 +73,38 @@ type voiceStateCacheEntry struct {
 
 type channelsCache struct {
 	sync.Mutex
-	Store map[Snowflake]*Channel
+	Store    map[Snowflake]*Channel
+	limits   *cacheTracker
+	disabled bool
+	strip    func(*Channel)
+	counters cacheCounters
 }
 
 type guildsCache struct {
 	sync.Mutex
-	Store map[Snowflake]*guildCacheContainer
+	Store          map[Snowflake]*guildCacheContainer
+	limits         *cacheTracker
+	memberLimits   *cacheTracker
+	strip          func(*Guild)
+	disableMembers bool
+	stripMember    func(*Member)
+	counters       cacheCounters
+	memberCounters cacheCounters
 }
 
 type usersCache struct {
 	sync.Mutex
-	Store map[Snowflake]*User
+	Store    map[Snowflake]*User
+	limits   *cacheTracker
+	disabled bool
+	strip    func(*User)
+	counters cacheCounters
 }
 
 type guildCacheContainer struct {
 	Guild      *Guild
 	ChannelIDs []Snowflake
+	ThreadIDs  []Snowflake
 	Members    map[Snowflake]*Member
 }
 
@@ -104,8 +136,9 @@ func constructMemberList(membersMap map[Snowflake]*Member) (members []*Member) {
 	return members
 }
 
-func buildGuildFromCacheContainer(guildCopy *Guild, ChannelIDs []Snowflake, members []*Member, users *usersCache, channels *channelsCache) *Guild {
+func buildGuildFromCacheContainer(guildCopy *Guild, ChannelIDs, threadIDs []Snowflake, members []*Member, users *usersCache, channels *channelsCache) *Guild {
 	guildCopy.Channels = retrieveChannels(ChannelIDs, channels)
+	guildCopy.Threads = retrieveChannels(threadIDs, channels)
 	guildCopy.Members = members
 
 	users.Lock()
@@ -139,6 +172,7 @@ type BasicCache struct {
 	VoiceStates voiceStateCache
 	Channels    channelsCache
 	Guilds      guildsCache
+	Presences   presenceCache
 }
 
 var _ Cache = (*BasicCache)(nil)
@@ -167,7 +201,7 @@ func (c *BasicCache) createDMChannel(msg *Message) {
 
 		c.Patch(channel)
 
-		c.Channels.Store[channelID] = channel
+		c.Channels.put(channel)
 	}
 }
 
@@ -220,7 +254,7 @@ func (c *BasicCache) saveChannel(channel *Channel) error {
 		return CacheEntryAlreadyExistsErr
 	}
 
-	c.Channels.Store[channel.ID] = channel
+	c.Channels.put(channel)
 	return nil
 }
 
@@ -254,6 +288,7 @@ func (c *BasicCache) ChannelUpdate(data []byte) (*ChannelUpdate, error) {
 		if channel, err = updateChannel(channelID, channelI); err != nil {
 			return nil, err
 		}
+		c.Channels.put(channelI)
 	} else {
 		// unlikely
 		tmp := &Channel{}
@@ -264,7 +299,7 @@ func (c *BasicCache) ChannelUpdate(data []byte) (*ChannelUpdate, error) {
 		channel = DeepCopy(tmp).(*Channel)
 
 		if storedChannel, exists := c.Channels.Store[channelID]; !exists {
-			c.Channels.Store[channelID] = tmp
+			c.Channels.put(tmp)
 		} else if channel, err = updateChannel(channelID, storedChannel); err != nil { // double lock
 			return nil, err
 		}
@@ -283,6 +318,7 @@ func (c *BasicCache) ChannelDelete(data []byte) (*ChannelDelete, error) {
 	c.Channels.Lock()
 	defer c.Channels.Unlock()
 	delete(c.Channels.Store, cd.Channel.ID)
+	c.Channels.forget(cd.Channel.ID)
 
 	return cd, nil
 }
@@ -311,6 +347,161 @@ func (c *BasicCache) ChannelPinsUpdate(data []byte) (*ChannelPinsUpdate, error)
 	return cpu, nil
 }
 
+// addThread stores the thread and links it to the guild, if the guild is cached.
+// Threads replace any existing entry as every thread event holds the complete channel object.
+func (c *BasicCache) addThread(thread *Channel) {
+	c.Guilds.Lock()
+	defer c.Guilds.Unlock()
+	if container, ok := c.Guilds.Store[thread.GuildID]; ok {
+		if !containsSnowflake(container.ThreadIDs, thread.ID) {
+			container.ThreadIDs = append(container.ThreadIDs, thread.ID)
+		}
+	}
+
+	c.Channels.Lock()
+	defer c.Channels.Unlock()
+	if existing, ok := c.Channels.Store[thread.ID]; ok && thread.Member == nil {
+		thread.Member = existing.Member
+	}
+	c.Channels.put(thread)
+}
+
+func (c *BasicCache) removeThreads(guildID Snowflake, ids ...Snowflake) {
+	c.Guilds.Lock()
+	defer c.Guilds.Unlock()
+	if container, ok := c.Guilds.Store[guildID]; ok {
+		threadIDs := container.ThreadIDs[:0]
+		for _, id := range container.ThreadIDs {
+			if !containsSnowflake(ids, id) {
+				threadIDs = append(threadIDs, id)
+			}
+		}
+		container.ThreadIDs = threadIDs
+	}
+
+	c.Channels.Lock()
+	defer c.Channels.Unlock()
+	for _, id := range ids {
+		delete(c.Channels.Store, id)
+		c.Channels.forget(id)
+	}
+}
+
+func containsSnowflake(ids []Snowflake, id Snowflake) bool {
+	for i := range ids {
+		if ids[i] == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *BasicCache) ThreadCreate(data []byte) (*ThreadCreate, error) {
+	evt, err := c.CacheNop.ThreadCreate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.addThread(DeepCopy(evt.Thread).(*Channel))
+	return evt, nil
+}
+
+func (c *BasicCache) ThreadUpdate(data []byte) (*ThreadUpdate, error) {
+	evt, err := c.CacheNop.ThreadUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.addThread(DeepCopy(evt.Thread).(*Channel))
+	return evt, nil
+}
+
+func (c *BasicCache) ThreadDelete(data []byte) (*ThreadDelete, error) {
+	evt, err := c.CacheNop.ThreadDelete(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.removeThreads(evt.Thread.GuildID, evt.Thread.ID)
+	return evt, nil
+}
+
+func (c *BasicCache) ThreadListSync(data []byte) (*ThreadListSync, error) {
+	evt, err := c.CacheNop.ThreadListSync(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// threads of the synced parent channels that are not part of the event, are no longer active
+	var outdated []Snowflake
+	c.Guilds.Lock()
+	if container, ok := c.Guilds.Store[evt.GuildID]; ok {
+		c.Channels.Lock()
+		for _, id := range container.ThreadIDs {
+			thread, ok := c.Channels.Store[id]
+			if ok && len(evt.ChannelIDs) > 0 && !containsSnowflake(evt.ChannelIDs, thread.ParentID) {
+				continue
+			}
+			outdated = append(outdated, id)
+		}
+		c.Channels.Unlock()
+	}
+	c.Guilds.Unlock()
+	c.removeThreads(evt.GuildID, outdated...)
+
+	for _, thread := range evt.Threads {
+		thread = DeepCopy(thread).(*Channel)
+		thread.GuildID = evt.GuildID
+		for _, member := range evt.Members {
+			if member.ID == thread.ID {
+				thread.Member = DeepCopy(member).(*ThreadMember)
+				break
+			}
+		}
+		c.addThread(thread)
+	}
+	return evt, nil
+}
+
+func (c *BasicCache) ThreadMemberUpdate(data []byte) (*ThreadMemberUpdate, error) {
+	evt, err := c.CacheNop.ThreadMemberUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Channels.Lock()
+	defer c.Channels.Unlock()
+	if thread, ok := c.Channels.Store[evt.Member.ID]; ok {
+		thread.Member = DeepCopy(evt.Member).(*ThreadMember)
+	}
+	return evt, nil
+}
+
+func (c *BasicCache) ThreadMembersUpdate(data []byte) (*ThreadMembersUpdate, error) {
+	evt, err := c.CacheNop.ThreadMembersUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Channels.Lock()
+	defer c.Channels.Unlock()
+	thread, ok := c.Channels.Store[evt.ID]
+	if !ok {
+		return evt, nil
+	}
+
+	thread.MemberCount = evt.MemberCount
+	for _, member := range evt.AddedMembers {
+		if member.UserID == c.currentUserID {
+			thread.Member = DeepCopy(member).(*ThreadMember)
+		}
+	}
+	if containsSnowflake(evt.RemovedMemberIDs, c.currentUserID) {
+		thread.Member = nil
+	}
+	return evt, nil
+}
+
 //func (c *BasicCache) VoiceStateUpdate(data []byte) (*VoiceStateUpdate, error) {
 //	// assumption#1: not sent on deleted pins
 //
@@ -352,12 +543,7 @@ func (c *BasicCache) saveUsers(users []*User) {
 	defer c.Users.Unlock()
 
 	for i := range users {
-		id := users[i].ID
-		if _, ok := c.Users.Store[id]; ok {
-			continue
-		}
-
-		c.Users.Store[id] = users[i]
+		c.Users.put(users[i])
 	}
 }
 
@@ -375,6 +561,9 @@ func (c *BasicCache) GuildMembersChunk(data []byte) (evt *GuildMembersChunk, err
 	if evt, err = c.CacheNop.GuildMembersChunk(data); err != nil {
 		return nil, err
 	}
+	if err = c.saveGuildPresences(evt.GuildID, data); err != nil {
+		return nil, err
+	}
 
 	users := make([]*User, 0, len(evt.Members))
 	for i := range evt.Members {
@@ -402,7 +591,7 @@ func (c *BasicCache) GuildMembersChunk(data []byte) (evt *GuildMembersChunk, err
 		for i := range evt.Members {
 			member := DeepCopy(evt.Members[i]).(*Member)
 			member.User = nil
-			container.Members[member.UserID] = member
+			c.Guilds.putMember(container, evt.GuildID, member)
 		}
 	}
 	wg.Wait()
@@ -424,8 +613,13 @@ func (c *BasicCache) GuildMemberRemove(data []byte) (*GuildMemberRemove, error)
 			container.Guild.MemberCount--
 		}
 		delete(container.Members, gmr.User.ID)
+		c.Guilds.forgetMember(gmr.GuildID, gmr.User.ID)
 	}
 
+	c.Presences.Lock()
+	c.Presences.remove(memberKey{guildID: gmr.GuildID, userID: gmr.User.ID})
+	c.Presences.Unlock()
+
 	return gmr, nil
 }
 
@@ -444,10 +638,14 @@ func (c *BasicCache) GuildMemberUpdate(data []byte) (evt *GuildMemberUpdate, err
 			}
 			c.Patch(evt)
 		} else {
-			container.Guild.MemberCount++
+			if !c.Guilds.disableMembers {
+				container.Guild.MemberCount++
+			}
 			container.Members[evt.User.ID] = DeepCopy(evt.Member).(*Member)
 		}
-		container.Members[evt.User.ID].User = nil
+		member := container.Members[evt.User.ID]
+		member.User = nil
+		c.Guilds.putMember(container, evt.GuildID, member)
 	}
 
 	return evt, nil
@@ -475,26 +673,36 @@ func (c *BasicCache) GuildMemberAdd(data []byte) (*GuildMemberAdd, error) {
 		if _, ok := container.Members[evt.Member.UserID]; !ok {
 			container.Guild.MemberCount++
 		}
-		container.Members[evt.Member.UserID] = DeepCopy(evt.Member).(*Member)
+		c.Guilds.putMember(container, evt.Member.GuildID, DeepCopy(evt.Member).(*Member))
 	}
 
 	wg.Wait()
 	return evt, nil
 }
 
-func (c *BasicCache) deconstructGuild(guild *Guild) (*Guild, []Snowflake, map[Snowflake]*Member) {
+func (c *BasicCache) deconstructGuild(guild *Guild) (*Guild, []Snowflake, []Snowflake, map[Snowflake]*Member) {
 	channelIDs := make([]Snowflake, 0, len(guild.Channels))
+	threadIDs := make([]Snowflake, 0, len(guild.Threads))
 	membersMap := make(map[Snowflake]*Member, len(guild.Members))
 	if !guild.Unavailable {
 		// cache channels
 		c.Channels.Lock()
 		for i := range guild.Channels {
+			// the channels of a guild create event do not hold the guild id
 			channel := DeepCopy(guild.Channels[i]).(*Channel)
+			channel.GuildID = guild.ID
 			_ = c.saveChannel(channel)
 			channelIDs = append(channelIDs, channel.ID)
 		}
+		for i := range guild.Threads {
+			thread := DeepCopy(guild.Threads[i]).(*Channel)
+			thread.GuildID = guild.ID
+			c.Channels.put(thread)
+			threadIDs = append(threadIDs, thread.ID)
+		}
 		c.Channels.Unlock()
 		guild.Channels = nil
+		guild.Threads = nil
 
 		// cache users
 		users := make([]*User, 0, len(guild.Members))
@@ -513,7 +721,7 @@ func (c *BasicCache) deconstructGuild(guild *Guild) (*Guild, []Snowflake, map[Sn
 		guild.Members = nil
 	}
 
-	return guild, channelIDs, membersMap
+	return guild, channelIDs, threadIDs, membersMap
 }
 
 func (c *BasicCache) GuildCreate(data []byte) (*GuildCreate, error) {
@@ -522,17 +730,17 @@ func (c *BasicCache) GuildCreate(data []byte) (*GuildCreate, error) {
 		return nil, err
 	}
 
+	if err = c.saveGuildPresences(evt.Guild.ID, data); err != nil {
+		return nil, err
+	}
+
 	guild := DeepCopy(evt.Guild).(*Guild)
-	_, channelIDs, membersMap := c.deconstructGuild(guild)
+	_, channelIDs, threadIDs, membersMap := c.deconstructGuild(guild)
 
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
 
-	c.Guilds.Store[guild.ID] = &guildCacheContainer{
-		Guild:      guild,
-		ChannelIDs: channelIDs,
-		Members:    membersMap,
-	} // discard any previous data
+	c.Guilds.put(guild, channelIDs, threadIDs, membersMap) // discard any previous data
 
 	return evt, nil
 }
@@ -550,15 +758,12 @@ func (c *BasicCache) GuildUpdate(data []byte) (*GuildUpdate, error) {
 	if !ok {
 		// unlikely - slow case
 		guild := DeepCopy(evt.Guild).(*Guild)
-		_, channelIDs, membersMap := c.deconstructGuild(guild)
+		_, channelIDs, threadIDs, membersMap := c.deconstructGuild(guild)
 
-		c.Guilds.Store[guild.ID] = &guildCacheContainer{
-			Guild:      guild,
-			ChannelIDs: channelIDs,
-			Members:    membersMap,
-		}
+		c.Guilds.put(guild, channelIDs, threadIDs, membersMap)
 		return evt, nil
 	}
+	c.Guilds.track(evt.Guild.ID)
 
 	// channels and members should not have been affected by this, so that's a lot of garbage.
 	if err = json.Unmarshal(data, container.Guild); err != nil {
@@ -566,6 +771,8 @@ func (c *BasicCache) GuildUpdate(data []byte) (*GuildUpdate, error) {
 	}
 	container.Guild.Members = nil
 	container.Guild.Channels = nil
+	container.Guild.Threads = nil
+	c.Guilds.stripGuild(container.Guild)
 	c.Patch(evt)
 
 	return evt, nil
@@ -578,9 +785,13 @@ func (c *BasicCache) GuildDelete(data []byte) (*GuildDelete, error) {
 	}
 	c.Patch(guildEvt)
 
+	c.Presences.Lock()
+	c.Presences.removeGuild(guildEvt.UnavailableGuild.ID)
+	c.Presences.Unlock()
+
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
-	delete(c.Guilds.Store, guildEvt.UnavailableGuild.ID)
+	c.Guilds.delete(guildEvt.UnavailableGuild.ID)
 
 	return guildEvt, nil
 }
@@ -668,6 +879,26 @@ func (c *BasicCache) GuildRoleDelete(data []byte) (evt *GuildRoleDelete, err err
 	return evt, nil
 }
 
+func (c *BasicCache) GuildStickersUpdate(data []byte) (evt *GuildStickersUpdate, err error) {
+	if evt, err = c.CacheNop.GuildStickersUpdate(data); err != nil {
+		return nil, err
+	}
+
+	stickers := make([]*Sticker, 0, len(evt.Stickers))
+	for i := range evt.Stickers {
+		stickers = append(stickers, DeepCopy(evt.Stickers[i]).(*Sticker))
+	}
+
+	c.Guilds.Lock()
+	defer c.Guilds.Unlock()
+
+	if container, ok := c.Guilds.Store[evt.GuildID]; ok {
+		container.Guild.Stickers = stickers
+	}
+
+	return evt, nil
+}
+
 // REST lookup
 // func (c *BasicCache) GetMessage(channelID, messageID Snowflake) (*Message, error) {
 // 	return nil, nil
@@ -686,7 +917,7 @@ func (c *BasicCache) GetChannel(id Snowflake) (*Channel, error) {
 	c.Channels.Lock()
 	defer c.Channels.Unlock()
 
-	if channel, ok := c.Channels.Store[id]; ok {
+	if channel, ok := c.Channels.get(id); ok {
 		return DeepCopy(channel).(*Channel), nil
 	}
 	return nil, CacheMissErr
@@ -696,7 +927,7 @@ func (c *BasicCache) GetGuildEmoji(guildID, emojiID Snowflake) (*Emoji, error) {
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
 
-	if container, ok := c.Guilds.Store[guildID]; ok {
+	if container, ok := c.Guilds.get(guildID); ok {
 		if emoji, err := container.Guild.Emoji(emojiID); emoji != nil && err == nil {
 			return DeepCopy(emoji).(*Emoji), nil
 		}
@@ -708,7 +939,7 @@ func (c *BasicCache) GetGuildEmojis(id Snowflake) ([]*Emoji, error) {
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
 
-	if container, ok := c.Guilds.Store[id]; ok {
+	if container, ok := c.Guilds.get(id); ok {
 		emojis := make([]*Emoji, 0, len(container.Guild.Emojis))
 		for _, emoji := range container.Guild.Emojis {
 			if emoji == nil { // shouldn't happen, but let's just be certain
@@ -723,15 +954,17 @@ func (c *BasicCache) GetGuildEmojis(id Snowflake) ([]*Emoji, error) {
 
 func (c *BasicCache) GetGuild(id Snowflake) (*Guild, error) {
 	var guildCopy *Guild
-	var channelIDs []Snowflake
+	var channelIDs, threadIDs []Snowflake
 	var members []*Member
 
 	c.Guilds.Lock()
-	if container, ok := c.Guilds.Store[id]; ok {
+	if container, ok := c.Guilds.get(id); ok {
 		guildCopy = DeepCopy(container.Guild).(*Guild)
 		members = constructMemberList(container.Members)
 		channelIDs = make([]Snowflake, len(container.ChannelIDs))
 		copy(channelIDs, container.ChannelIDs)
+		threadIDs = make([]Snowflake, len(container.ThreadIDs))
+		copy(threadIDs, container.ThreadIDs)
 	}
 	c.Guilds.Unlock()
 
@@ -739,7 +972,7 @@ func (c *BasicCache) GetGuild(id Snowflake) (*Guild, error) {
 		return nil, CacheMissErr
 	}
 
-	return buildGuildFromCacheContainer(guildCopy, channelIDs, members, &c.Users, &c.Channels), nil
+	return buildGuildFromCacheContainer(guildCopy, channelIDs, threadIDs, members, &c.Users, &c.Channels), nil
 }
 
 func (c *BasicCache) GetGuildChannels(id Snowflake) ([]*Channel, error) {
@@ -747,7 +980,7 @@ func (c *BasicCache) GetGuildChannels(id Snowflake) ([]*Channel, error) {
 	var guildFound bool
 
 	c.Guilds.Lock()
-	if container, ok := c.Guilds.Store[id]; ok {
+	if container, ok := c.Guilds.get(id); ok {
 		channelIDs = make([]Snowflake, len(container.ChannelIDs))
 		copy(channelIDs, container.ChannelIDs)
 		guildFound = true
@@ -776,9 +1009,9 @@ func (c *BasicCache) GetMember(guildID, userID Snowflake) (*Member, error) {
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
 
-	if container, ok := c.Guilds.Store[guildID]; ok {
-		if member, _ = container.Members[userID]; member != nil {
-			member = DeepCopy(member).(*Member)
+	if container, ok := c.Guilds.get(guildID); ok {
+		if stored, ok := c.Guilds.member(container, guildID, userID); ok {
+			member = DeepCopy(stored).(*Member)
 		}
 	}
 
@@ -794,7 +1027,7 @@ func (c *BasicCache) GetGuildRoles(id Snowflake) ([]*Role, error) {
 	c.Guilds.Lock()
 	defer c.Guilds.Unlock()
 
-	if container, ok := c.Guilds.Store[id]; ok {
+	if container, ok := c.Guilds.get(id); ok {
 		roles := make([]*Role, 0, len(container.Guild.Roles))
 		for _, role := range container.Guild.Roles {
 			if role == nil { // shouldn't happen, but let's just be certain
@@ -822,7 +1055,7 @@ func (c *BasicCache) GetUser(id Snowflake) (*User, error) {
 
 	c.Users.Lock()
 	defer c.Users.Unlock()
-	if user, ok := c.Users.Store[id]; ok {
+	if user, ok := c.Users.get(id); ok {
 		return DeepCopy(user).(*User), nil
 	}
 	return nil, CacheMissErr
@@ -56,10 +56,17 @@ type CacheGetter interface {
 
 type CacheUpdater interface {
 	// Gateway events
+	AutoModerationActionExecution(data []byte) (*AutoModerationActionExecution, error)
+	AutoModerationRuleCreate(data []byte) (*AutoModerationRuleCreate, error)
+	AutoModerationRuleDelete(data []byte) (*AutoModerationRuleDelete, error)
+	AutoModerationRuleUpdate(data []byte) (*AutoModerationRuleUpdate, error)
 	ChannelCreate(data []byte) (*ChannelCreate, error)
 	ChannelDelete(data []byte) (*ChannelDelete, error)
 	ChannelPinsUpdate(data []byte) (*ChannelPinsUpdate, error)
 	ChannelUpdate(data []byte) (*ChannelUpdate, error)
+	EntitlementCreate(data []byte) (*EntitlementCreate, error)
+	EntitlementDelete(data []byte) (*EntitlementDelete, error)
+	EntitlementUpdate(data []byte) (*EntitlementUpdate, error)
 	GuildBanAdd(data []byte) (*GuildBanAdd, error)
 	GuildBanRemove(data []byte) (*GuildBanRemove, error)
 	GuildCreate(data []byte) (*GuildCreate, error)
@@ -73,6 +80,12 @@ type CacheUpdater interface {
 	GuildRoleCreate(data []byte) (*GuildRoleCreate, error)
 	GuildRoleDelete(data []byte) (*GuildRoleDelete, error)
 	GuildRoleUpdate(data []byte) (*GuildRoleUpdate, error)
+	GuildScheduledEventCreate(data []byte) (*GuildScheduledEventCreate, error)
+	GuildScheduledEventDelete(data []byte) (*GuildScheduledEventDelete, error)
+	GuildScheduledEventUpdate(data []byte) (*GuildScheduledEventUpdate, error)
+	GuildScheduledEventUserAdd(data []byte) (*GuildScheduledEventUserAdd, error)
+	GuildScheduledEventUserRemove(data []byte) (*GuildScheduledEventUserRemove, error)
+	GuildStickersUpdate(data []byte) (*GuildStickersUpdate, error)
 	GuildUpdate(data []byte) (*GuildUpdate, error)
 	InteractionCreate(data []byte) (*InteractionCreate, error)
 	InviteCreate(data []byte) (*InviteCreate, error)
@@ -80,6 +93,8 @@ type CacheUpdater interface {
 	MessageCreate(data []byte) (*MessageCreate, error)
 	MessageDelete(data []byte) (*MessageDelete, error)
 	MessageDeleteBulk(data []byte) (*MessageDeleteBulk, error)
+	MessagePollVoteAdd(data []byte) (*MessagePollVoteAdd, error)
+	MessagePollVoteRemove(data []byte) (*MessagePollVoteRemove, error)
 	MessageReactionAdd(data []byte) (*MessageReactionAdd, error)
 	MessageReactionRemove(data []byte) (*MessageReactionRemove, error)
 	MessageReactionRemoveAll(data []byte) (*MessageReactionRemoveAll, error)
@@ -88,6 +103,18 @@ type CacheUpdater interface {
 	PresenceUpdate(data []byte) (*PresenceUpdate, error)
 	Ready(data []byte) (*Ready, error)
 	Resumed(data []byte) (*Resumed, error)
+	StageInstanceCreate(data []byte) (*StageInstanceCreate, error)
+	StageInstanceDelete(data []byte) (*StageInstanceDelete, error)
+	StageInstanceUpdate(data []byte) (*StageInstanceUpdate, error)
+	SubscriptionCreate(data []byte) (*SubscriptionCreate, error)
+	SubscriptionDelete(data []byte) (*SubscriptionDelete, error)
+	SubscriptionUpdate(data []byte) (*SubscriptionUpdate, error)
+	ThreadCreate(data []byte) (*ThreadCreate, error)
+	ThreadDelete(data []byte) (*ThreadDelete, error)
+	ThreadListSync(data []byte) (*ThreadListSync, error)
+	ThreadMemberUpdate(data []byte) (*ThreadMemberUpdate, error)
+	ThreadMembersUpdate(data []byte) (*ThreadMembersUpdate, error)
+	ThreadUpdate(data []byte) (*ThreadUpdate, error)
 	TypingStart(data []byte) (*TypingStart, error)
 	UserUpdate(data []byte) (*UserUpdate, error)
 	VoiceServerUpdate(data []byte) (*VoiceServerUpdate, error)
@@ -97,6 +124,14 @@ type CacheUpdater interface {
 
 func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err error) {
 	switch event {
+	case EvtAutoModerationActionExecution:
+		evt, err = c.AutoModerationActionExecution(data)
+	case EvtAutoModerationRuleCreate:
+		evt, err = c.AutoModerationRuleCreate(data)
+	case EvtAutoModerationRuleDelete:
+		evt, err = c.AutoModerationRuleDelete(data)
+	case EvtAutoModerationRuleUpdate:
+		evt, err = c.AutoModerationRuleUpdate(data)
 	case EvtChannelCreate:
 		evt, err = c.ChannelCreate(data)
 	case EvtChannelDelete:
@@ -105,6 +140,12 @@ func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err err
 		evt, err = c.ChannelPinsUpdate(data)
 	case EvtChannelUpdate:
 		evt, err = c.ChannelUpdate(data)
+	case EvtEntitlementCreate:
+		evt, err = c.EntitlementCreate(data)
+	case EvtEntitlementDelete:
+		evt, err = c.EntitlementDelete(data)
+	case EvtEntitlementUpdate:
+		evt, err = c.EntitlementUpdate(data)
 	case EvtGuildBanAdd:
 		evt, err = c.GuildBanAdd(data)
 	case EvtGuildBanRemove:
@@ -131,6 +172,18 @@ func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err err
 		evt, err = c.GuildRoleDelete(data)
 	case EvtGuildRoleUpdate:
 		evt, err = c.GuildRoleUpdate(data)
+	case EvtGuildScheduledEventCreate:
+		evt, err = c.GuildScheduledEventCreate(data)
+	case EvtGuildScheduledEventDelete:
+		evt, err = c.GuildScheduledEventDelete(data)
+	case EvtGuildScheduledEventUpdate:
+		evt, err = c.GuildScheduledEventUpdate(data)
+	case EvtGuildScheduledEventUserAdd:
+		evt, err = c.GuildScheduledEventUserAdd(data)
+	case EvtGuildScheduledEventUserRemove:
+		evt, err = c.GuildScheduledEventUserRemove(data)
+	case EvtGuildStickersUpdate:
+		evt, err = c.GuildStickersUpdate(data)
 	case EvtGuildUpdate:
 		evt, err = c.GuildUpdate(data)
 	case EvtInteractionCreate:
@@ -145,6 +198,10 @@ func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err err
 		evt, err = c.MessageDelete(data)
 	case EvtMessageDeleteBulk:
 		evt, err = c.MessageDeleteBulk(data)
+	case EvtMessagePollVoteAdd:
+		evt, err = c.MessagePollVoteAdd(data)
+	case EvtMessagePollVoteRemove:
+		evt, err = c.MessagePollVoteRemove(data)
 	case EvtMessageReactionAdd:
 		evt, err = c.MessageReactionAdd(data)
 	case EvtMessageReactionRemove:
@@ -161,6 +218,30 @@ func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err err
 		evt, err = c.Ready(data)
 	case EvtResumed:
 		evt, err = c.Resumed(data)
+	case EvtStageInstanceCreate:
+		evt, err = c.StageInstanceCreate(data)
+	case EvtStageInstanceDelete:
+		evt, err = c.StageInstanceDelete(data)
+	case EvtStageInstanceUpdate:
+		evt, err = c.StageInstanceUpdate(data)
+	case EvtSubscriptionCreate:
+		evt, err = c.SubscriptionCreate(data)
+	case EvtSubscriptionDelete:
+		evt, err = c.SubscriptionDelete(data)
+	case EvtSubscriptionUpdate:
+		evt, err = c.SubscriptionUpdate(data)
+	case EvtThreadCreate:
+		evt, err = c.ThreadCreate(data)
+	case EvtThreadDelete:
+		evt, err = c.ThreadDelete(data)
+	case EvtThreadListSync:
+		evt, err = c.ThreadListSync(data)
+	case EvtThreadMemberUpdate:
+		evt, err = c.ThreadMemberUpdate(data)
+	case EvtThreadMembersUpdate:
+		evt, err = c.ThreadMembersUpdate(data)
+	case EvtThreadUpdate:
+		evt, err = c.ThreadUpdate(data)
 	case EvtTypingStart:
 		evt, err = c.TypingStart(data)
 	case EvtUserUpdate:
@@ -180,7 +261,10 @@ func cacheDispatcher(c Cache, event string, data []byte) (evt EventType, err err
 }
 
 // nop cache
-type CacheNop struct{}
+type CacheNop struct {
+	// events recycles the most frequent events, see Config.PoolEvents
+	events *eventPools
+}
 
 var _ CacheUpdater = (*CacheNop)(nil)
 var _ CacheGetter = (*CacheNop)(nil)
@@ -194,6 +278,34 @@ func (c *CacheNop) Patch(v interface{}) {
 		deficient.updateInternals()
 	}
 }
+func (c *CacheNop) AutoModerationActionExecution(data []byte) (evt *AutoModerationActionExecution, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) AutoModerationRuleCreate(data []byte) (evt *AutoModerationRuleCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) AutoModerationRuleDelete(data []byte) (evt *AutoModerationRuleDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) AutoModerationRuleUpdate(data []byte) (evt *AutoModerationRuleUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
 func (c *CacheNop) ChannelCreate(data []byte) (evt *ChannelCreate, err error) {
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
@@ -222,6 +334,27 @@ func (c *CacheNop) ChannelUpdate(data []byte) (evt *ChannelUpdate, err error) {
 	c.Patch(evt)
 	return evt, nil
 }
+func (c *CacheNop) EntitlementCreate(data []byte) (evt *EntitlementCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) EntitlementDelete(data []byte) (evt *EntitlementDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) EntitlementUpdate(data []byte) (evt *EntitlementUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
 func (c *CacheNop) GuildBanAdd(data []byte) (evt *GuildBanAdd, err error) {
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
@@ -313,6 +446,48 @@ func (c *CacheNop) GuildRoleUpdate(data []byte) (evt *GuildRoleUpdate, err error
 	c.Patch(evt)
 	return evt, nil
 }
+func (c *CacheNop) GuildScheduledEventCreate(data []byte) (evt *GuildScheduledEventCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) GuildScheduledEventDelete(data []byte) (evt *GuildScheduledEventDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) GuildScheduledEventUpdate(data []byte) (evt *GuildScheduledEventUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) GuildScheduledEventUserAdd(data []byte) (evt *GuildScheduledEventUserAdd, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) GuildScheduledEventUserRemove(data []byte) (evt *GuildScheduledEventUserRemove, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) GuildStickersUpdate(data []byte) (evt *GuildStickersUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
 func (c *CacheNop) GuildUpdate(data []byte) (evt *GuildUpdate, err error) {
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
@@ -342,6 +517,9 @@ func (c *CacheNop) InviteDelete(data []byte) (evt *InviteDelete, err error) {
 	return evt, nil
 }
 func (c *CacheNop) MessageCreate(data []byte) (evt *MessageCreate, err error) {
+	if c.events != nil {
+		evt = c.events.messageCreate.Get().(*MessageCreate)
+	}
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
 	}
@@ -362,6 +540,20 @@ func (c *CacheNop) MessageDeleteBulk(data []byte) (evt *MessageDeleteBulk, err e
 	c.Patch(evt)
 	return evt, nil
 }
+func (c *CacheNop) MessagePollVoteAdd(data []byte) (evt *MessagePollVoteAdd, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) MessagePollVoteRemove(data []byte) (evt *MessagePollVoteRemove, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
 func (c *CacheNop) MessageReactionAdd(data []byte) (evt *MessageReactionAdd, err error) {
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
@@ -398,6 +590,9 @@ func (c *CacheNop) MessageUpdate(data []byte) (evt *MessageUpdate, err error) {
 	return evt, nil
 }
 func (c *CacheNop) PresenceUpdate(data []byte) (evt *PresenceUpdate, err error) {
+	if c.events != nil {
+		evt = c.events.presenceUpdate.Get().(*PresenceUpdate)
+	}
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
 	}
@@ -418,7 +613,94 @@ func (c *CacheNop) Resumed(data []byte) (evt *Resumed, err error) {
 	c.Patch(evt)
 	return evt, nil
 }
+func (c *CacheNop) StageInstanceCreate(data []byte) (evt *StageInstanceCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) StageInstanceDelete(data []byte) (evt *StageInstanceDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) StageInstanceUpdate(data []byte) (evt *StageInstanceUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) SubscriptionCreate(data []byte) (evt *SubscriptionCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) SubscriptionDelete(data []byte) (evt *SubscriptionDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) SubscriptionUpdate(data []byte) (evt *SubscriptionUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadCreate(data []byte) (evt *ThreadCreate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadDelete(data []byte) (evt *ThreadDelete, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadListSync(data []byte) (evt *ThreadListSync, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadMemberUpdate(data []byte) (evt *ThreadMemberUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadMembersUpdate(data []byte) (evt *ThreadMembersUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
+func (c *CacheNop) ThreadUpdate(data []byte) (evt *ThreadUpdate, err error) {
+	if err = json.Unmarshal(data, &evt); err != nil {
+		return nil, err
+	}
+	c.Patch(evt)
+	return evt, nil
+}
 func (c *CacheNop) TypingStart(data []byte) (evt *TypingStart, err error) {
+	if c.events != nil {
+		evt = c.events.typingStart.Get().(*TypingStart)
+	}
 	if err = json.Unmarshal(data, &evt); err != nil {
 		return nil, err
 	}
@@ -1565,3 +1565,81 @@ func TestBasicCache_GetGuildEmojis(t *testing.T) {
 		})
 	})
 }
+
+func TestBasicCache_Threads(t *testing.T) {
+	cache := NewBasicCache()
+	cache.currentUserID = 100
+	guildID := Snowflake(1)
+	parentID := Snowflake(2)
+	threadID := Snowflake(3)
+
+	cache.Guilds.Store[guildID] = &guildCacheContainer{
+		Guild:   &Guild{ID: guildID},
+		Members: map[Snowflake]*Member{},
+	}
+
+	createData := jsonbytes(`{"id":%d,"guild_id":%d,"parent_id":%d,"type":%d,"name":"help","thread_metadata":{"archived":false,"auto_archive_duration":60}}`, threadID, guildID, parentID, ChannelTypeGuildPublicThread)
+	deadlockTest(t, cache, EvtThreadCreate, createData)
+
+	guild, err := cache.GetGuild(guildID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(guild.Threads) != 1 || guild.Threads[0].ID != threadID {
+		t.Fatal("thread was not linked to the guild")
+	}
+	if !guild.Threads[0].IsThread() {
+		t.Error("expected channel to be a thread")
+	}
+
+	membersData := jsonbytes(`{"id":%d,"guild_id":%d,"member_count":2,"added_members":[{"id":%d,"user_id":%d,"flags":0}]}`, threadID, guildID, threadID, cache.currentUserID)
+	deadlockTest(t, cache, EvtThreadMembersUpdate, membersData)
+
+	thread, err := cache.GetChannel(threadID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thread.MemberCount != 2 || thread.Member == nil {
+		t.Error("thread members were not updated")
+	}
+
+	// updates should keep the thread member of the current user
+	deadlockTest(t, cache, EvtThreadUpdate, createData)
+	if thread, _ = cache.GetChannel(threadID); thread.Member == nil {
+		t.Error("thread member was removed on update")
+	}
+
+	syncData := jsonbytes(`{"guild_id":%d,"channel_ids":["%d"],"threads":[],"members":[]}`, guildID, parentID)
+	deadlockTest(t, cache, EvtThreadListSync, syncData)
+	if _, err = cache.GetChannel(threadID); err == nil {
+		t.Error("thread should have been removed by the list sync")
+	}
+
+	deadlockTest(t, cache, EvtThreadCreate, createData)
+	deleteData := jsonbytes(`{"id":%d,"guild_id":%d,"parent_id":%d,"type":%d}`, threadID, guildID, parentID, ChannelTypeGuildPublicThread)
+	deadlockTest(t, cache, EvtThreadDelete, deleteData)
+	if guild, _ = cache.GetGuild(guildID); len(guild.Threads) != 0 {
+		t.Error("thread was not removed from the guild")
+	}
+}
+
+func TestBasicCache_GuildCreateThreads(t *testing.T) {
+	cache := NewBasicCache()
+	guildID := Snowflake(1)
+	data := jsonbytes(`{"id":%d,"name":"test","channels":[{"id":2,"type":0}],"threads":[{"id":3,"parent_id":2,"type":%d}]}`, guildID, ChannelTypeGuildPublicThread)
+	deadlockTest(t, cache, EvtGuildCreate, data)
+
+	// the guild id must not depend on the event being patched first
+	guild := &Guild{ID: 4, Channels: []*Channel{{ID: 5}}, Threads: []*Channel{{ID: 6, Type: ChannelTypeGuildPublicThread}}}
+	cache.deconstructGuild(guild)
+
+	for id, wants := range map[Snowflake]Snowflake{2: guildID, 3: guildID, 5: 4, 6: 4} {
+		channel, err := cache.GetChannel(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if channel.GuildID != wants {
+			t.Errorf("expected channel %d to hold the guild id %d. Got %d", id, wants, channel.GuildID)
+		}
+	}
+}
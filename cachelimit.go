@@ -0,0 +1,268 @@
+package disgord
+
+import (
+	"container/list"
+	"time"
+)
+
+// CacheLimit bounds the number of cached entries of a resource, see BasicCacheConfig.
+type CacheLimit struct {
+	// MaxEntries is the maximum number of entries. The least recently used entry is evicted when
+	// a new entry is added to a full cache. 0 means no limit.
+	MaxEntries int
+
+	// TTL removes entries that have not been used, read or updated, within the duration. 0 means
+	// entries never expire.
+	TTL time.Duration
+}
+
+func (l CacheLimit) limited() bool {
+	return l.MaxEntries > 0 || l.TTL > 0
+}
+
+// BasicCacheConfig configures the BasicCache. Unbounded caches of members are the main source of
+// memory growth for large bots, so consider limiting those.
+//  cache := disgord.NewBasicCacheWithConfig(disgord.BasicCacheConfig{
+//      Members: disgord.CacheLimit{MaxEntries: 100000, TTL: time.Hour},
+//  })
+type BasicCacheConfig struct {
+	Users    CacheLimit
+	Channels CacheLimit
+	Guilds   CacheLimit
+
+	// Members limits the members across every guild. Members of evicted guilds are removed as well.
+	Members CacheLimit
+
+	// Policy disables caching of resources, or removes fields before entries are stored.
+	Policy CachePolicy
+
+	// Presences enables caching of member presences, which are discarded otherwise.
+	Presences PresenceCacheConfig
+}
+
+// memberKey identifies a member across guilds
+type memberKey struct {
+	guildID Snowflake
+	userID  Snowflake
+}
+
+type trackedEntry struct {
+	key  interface{}
+	used time.Time
+}
+
+// cacheTracker keeps the entries of a resource in the order they were used, to find entries to evict.
+// A nil tracker is a cache without limits. It must be locked by the store it tracks.
+type cacheTracker struct {
+	limit   CacheLimit
+	order   *list.List // most recently used first
+	entries map[interface{}]*list.Element
+	now     func() time.Time
+}
+
+func newCacheTracker(limit CacheLimit) *cacheTracker {
+	if !limit.limited() {
+		return nil
+	}
+	return &cacheTracker{
+		limit:   limit,
+		order:   list.New(),
+		entries: make(map[interface{}]*list.Element),
+		now:     time.Now,
+	}
+}
+
+// touch marks the entry as used, and returns the entries that must be evicted.
+func (t *cacheTracker) touch(key interface{}) (evicted []interface{}) {
+	if t == nil {
+		return nil
+	}
+
+	now := t.now()
+	if elem, ok := t.entries[key]; ok {
+		elem.Value.(*trackedEntry).used = now
+		t.order.MoveToFront(elem)
+	} else {
+		t.entries[key] = t.order.PushFront(&trackedEntry{key: key, used: now})
+	}
+
+	for t.order.Len() > 1 {
+		oldest := t.order.Back()
+		entry := oldest.Value.(*trackedEntry)
+		full := t.limit.MaxEntries > 0 && t.order.Len() > t.limit.MaxEntries
+		if !full && !t.expiredAt(entry, now) {
+			break
+		}
+
+		t.order.Remove(oldest)
+		delete(t.entries, entry.key)
+		evicted = append(evicted, entry.key)
+	}
+	return evicted
+}
+
+func (t *cacheTracker) expiredAt(entry *trackedEntry, now time.Time) bool {
+	return t.limit.TTL > 0 && now.Sub(entry.used) > t.limit.TTL
+}
+
+// expired reports whether the entry has not been used within the TTL.
+func (t *cacheTracker) expired(key interface{}) bool {
+	if t == nil {
+		return false
+	}
+	elem, ok := t.entries[key]
+	return ok && t.expiredAt(elem.Value.(*trackedEntry), t.now())
+}
+
+func (t *cacheTracker) remove(key interface{}) {
+	if t == nil {
+		return
+	}
+	if elem, ok := t.entries[key]; ok {
+		t.order.Remove(elem)
+		delete(t.entries, key)
+	}
+}
+
+// track must be called after a user has been stored. Users.Lock must be held.
+func (s *usersCache) track(id Snowflake) {
+	evicted := s.limits.touch(id)
+	s.counters.evicted(len(evicted))
+	for _, key := range evicted {
+		delete(s.Store, key.(Snowflake))
+	}
+}
+
+// get returns a user which has not expired. Users.Lock must be held.
+func (s *usersCache) get(id Snowflake) (*User, bool) {
+	user, ok := s.Store[id]
+	if ok && s.limits.expired(id) {
+		s.limits.remove(id)
+		delete(s.Store, id)
+		s.counters.evicted(1)
+		ok = false
+	}
+	s.counters.lookup(ok)
+	if !ok {
+		return nil, false
+	}
+	s.track(id)
+	return user, true
+}
+
+// track must be called after a channel has been stored. Channels.Lock must be held.
+func (s *channelsCache) track(id Snowflake) {
+	evicted := s.limits.touch(id)
+	s.counters.evicted(len(evicted))
+	for _, key := range evicted {
+		delete(s.Store, key.(Snowflake))
+	}
+}
+
+func (s *channelsCache) forget(id Snowflake) {
+	s.limits.remove(id)
+}
+
+// get returns a channel which has not expired. Channels.Lock must be held.
+func (s *channelsCache) get(id Snowflake) (*Channel, bool) {
+	channel, ok := s.Store[id]
+	if ok && s.limits.expired(id) {
+		s.limits.remove(id)
+		delete(s.Store, id)
+		s.counters.evicted(1)
+		ok = false
+	}
+	s.counters.lookup(ok)
+	if !ok {
+		return nil, false
+	}
+	s.track(id)
+	return channel, true
+}
+
+// track must be called after a guild has been stored. Guilds.Lock must be held.
+func (s *guildsCache) track(id Snowflake) {
+	evicted := s.limits.touch(id)
+	s.counters.evicted(len(evicted))
+	for _, key := range evicted {
+		s.delete(key.(Snowflake))
+	}
+}
+
+// delete removes the guild and its members. Guilds.Lock must be held.
+func (s *guildsCache) delete(id Snowflake) {
+	s.limits.remove(id)
+	if container, ok := s.Store[id]; ok {
+		s.forgetMembers(id, container)
+	}
+	delete(s.Store, id)
+}
+
+func (s *guildsCache) forgetMembers(guildID Snowflake, container *guildCacheContainer) {
+	if s.memberLimits == nil {
+		return
+	}
+	for userID := range container.Members {
+		s.memberLimits.remove(memberKey{guildID: guildID, userID: userID})
+	}
+}
+
+// get returns a guild which has not expired. Guilds.Lock must be held.
+func (s *guildsCache) get(id Snowflake) (*guildCacheContainer, bool) {
+	container, ok := s.Store[id]
+	if ok && s.limits.expired(id) {
+		s.delete(id)
+		s.counters.evicted(1)
+		ok = false
+	}
+	s.counters.lookup(ok)
+	if !ok {
+		return nil, false
+	}
+	s.track(id)
+	return container, true
+}
+
+// trackMember must be called after a member has been stored. Guilds.Lock must be held.
+func (s *guildsCache) trackMember(guildID, userID Snowflake) {
+	evicted := s.memberLimits.touch(memberKey{guildID: guildID, userID: userID})
+	s.memberCounters.evicted(len(evicted))
+	for _, key := range evicted {
+		member := key.(memberKey)
+		if container, ok := s.Store[member.guildID]; ok {
+			delete(container.Members, member.userID)
+		}
+	}
+}
+
+func (s *guildsCache) forgetMember(guildID, userID Snowflake) {
+	s.memberLimits.remove(memberKey{guildID: guildID, userID: userID})
+}
+
+// member returns a member which has not expired. Guilds.Lock must be held.
+func (s *guildsCache) member(container *guildCacheContainer, guildID, userID Snowflake) (*Member, bool) {
+	member, ok := container.Members[userID]
+	ok = ok && member != nil
+	key := memberKey{guildID: guildID, userID: userID}
+	if ok && s.memberLimits.expired(key) {
+		s.memberLimits.remove(key)
+		delete(container.Members, userID)
+		s.memberCounters.evicted(1)
+		ok = false
+	}
+	s.memberCounters.lookup(ok)
+	if !ok {
+		return nil, false
+	}
+	s.trackMember(guildID, userID)
+	return member, true
+}
+
+// reset forgets every entry
+func (t *cacheTracker) reset() {
+	if t == nil {
+		return
+	}
+	t.order.Init()
+	t.entries = make(map[interface{}]*list.Element)
+}
@@ -0,0 +1,66 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCacheTracker(t *testing.T) {
+	if newCacheTracker(CacheLimit{}) != nil {
+		t.Error("expected no tracker without limits")
+	}
+
+	now := time.Now()
+	tracker := newCacheTracker(CacheLimit{MaxEntries: 2, TTL: time.Minute})
+	tracker.now = func() time.Time { return now }
+
+	tracker.touch(1)
+	tracker.touch(2)
+	tracker.touch(1)
+	if evicted := tracker.touch(3); len(evicted) != 1 || evicted[0] != 2 {
+		t.Errorf("expected the least recently used entry to be evicted. Got %v", evicted)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if !tracker.expired(1) {
+		t.Error("expected the entry to expire")
+	}
+	if evicted := tracker.touch(4); len(evicted) != 2 {
+		t.Errorf("expected the expired entries to be evicted. Got %v", evicted)
+	}
+}
+
+func TestBasicCache_MemberLimit(t *testing.T) {
+	cache := NewBasicCacheWithConfig(BasicCacheConfig{
+		Members: CacheLimit{MaxEntries: 2},
+	})
+
+	guild := []byte(`{"id":"1","members":[{"user":{"id":"10"}},{"user":{"id":"11"}}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.GetMember(1, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.GuildMemberAdd([]byte(`{"guild_id":"1","user":{"id":"12"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.GetMember(1, 11); err != CacheMissErr {
+		t.Errorf("expected the least recently used member to be evicted. Got %v", err)
+	}
+	for _, userID := range []Snowflake{10, 12} {
+		if _, err := cache.GetMember(1, userID); err != nil {
+			t.Errorf("expected member %d to be cached. Got %v", userID, err)
+		}
+	}
+
+	if _, err := cache.GuildDelete([]byte(`{"id":"1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if len(cache.Guilds.memberLimits.entries) != 0 {
+		t.Error("expected the members of a deleted guild to be forgotten")
+	}
+}
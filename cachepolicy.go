@@ -0,0 +1,91 @@
+package disgord
+
+// CachePolicy decides what the BasicCache stores, see BasicCacheConfig. Bots that only need roles and
+// channels can save a lot of memory by not caching users and members, or by removing fields they do
+// not use before an entry is stored.
+//  cache := disgord.NewBasicCacheWithConfig(disgord.BasicCacheConfig{
+//      Policy: disgord.CachePolicy{
+//          DisableUsers: true,
+//          StripMember: func(member *disgord.Member) {
+//              member.Nick = ""
+//          },
+//      },
+//  })
+type CachePolicy struct {
+	// DisableUsers, DisableChannels and DisableMembers stop caching the resource. Threads are channels.
+	DisableUsers    bool
+	DisableChannels bool
+	DisableMembers  bool
+
+	// StripUser, StripChannel, StripMember and StripGuild are called before an entry is stored, and
+	// may remove any field. Events are not affected, only the cached entries.
+	StripUser    func(user *User)
+	StripChannel func(channel *Channel)
+	StripMember  func(member *Member)
+	StripGuild   func(guild *Guild)
+}
+
+// put stores a user, unless it is already cached. Users.Lock must be held.
+func (s *usersCache) put(user *User) {
+	if s.disabled {
+		return
+	}
+	if _, ok := s.Store[user.ID]; !ok {
+		if s.strip != nil {
+			s.strip(user)
+		}
+		s.Store[user.ID] = user
+	}
+	s.track(user.ID)
+}
+
+// put stores, or replaces, a channel. Channels.Lock must be held.
+func (s *channelsCache) put(channel *Channel) {
+	if s.disabled {
+		return
+	}
+	if s.strip != nil {
+		s.strip(channel)
+	}
+	s.Store[channel.ID] = channel
+	s.track(channel.ID)
+}
+
+// putMember stores, or replaces, a member of the guild. Guilds.Lock must be held.
+func (s *guildsCache) putMember(container *guildCacheContainer, guildID Snowflake, member *Member) {
+	if s.disableMembers {
+		delete(container.Members, member.UserID)
+		return
+	}
+	if s.stripMember != nil {
+		s.stripMember(member)
+	}
+	container.Members[member.UserID] = member
+	s.trackMember(guildID, member.UserID)
+}
+
+func (s *guildsCache) stripGuild(guild *Guild) {
+	if s.strip != nil {
+		s.strip(guild)
+	}
+}
+
+// put stores, or replaces, a guild and its members. Guilds.Lock must be held.
+func (s *guildsCache) put(guild *Guild, channelIDs, threadIDs []Snowflake, members map[Snowflake]*Member) {
+	if previous, ok := s.Store[guild.ID]; ok {
+		s.forgetMembers(guild.ID, previous)
+	}
+	s.stripGuild(guild)
+
+	container := &guildCacheContainer{
+		Guild:      guild,
+		ChannelIDs: channelIDs,
+		ThreadIDs:  threadIDs,
+		Members:    make(map[Snowflake]*Member, len(members)),
+	}
+	s.Store[guild.ID] = container
+	s.track(guild.ID)
+	for _, member := range members {
+		s.putMember(container, guild.ID, member)
+	}
+}
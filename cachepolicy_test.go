@@ -0,0 +1,66 @@
+// +build !integration
+
+package disgord
+
+import "testing"
+
+func TestBasicCache_Policy(t *testing.T) {
+	cache := NewBasicCacheWithConfig(BasicCacheConfig{
+		Policy: CachePolicy{
+			DisableUsers: true,
+			StripMember: func(member *Member) {
+				member.Nick = ""
+			},
+			StripGuild: func(guild *Guild) {
+				guild.Emojis = nil
+			},
+		},
+	})
+
+	guild := []byte(`{"id":"1","emojis":[{"id":"4","name":"x"}],"channels":[{"id":"2","type":0}],` +
+		`"members":[{"user":{"id":"10","username":"anders"},"nick":"andy"}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.GetUser(10); err != CacheMissErr {
+		t.Errorf("expected users to not be cached. Got %v", err)
+	}
+	member, err := cache.GetMember(1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Nick != "" {
+		t.Error("expected the nick to be stripped before the member was stored")
+	}
+	if emojis, _ := cache.GetGuildEmojis(1); len(emojis) != 0 {
+		t.Error("expected the emojis to be stripped before the guild was stored")
+	}
+	if _, err = cache.GetChannel(2); err != nil {
+		t.Errorf("expected channels to be cached. Got %v", err)
+	}
+}
+
+func TestBasicCache_DisableMembers(t *testing.T) {
+	cache := NewBasicCacheWithConfig(BasicCacheConfig{
+		Policy: CachePolicy{DisableMembers: true, DisableChannels: true},
+	})
+
+	guild := []byte(`{"id":"1","member_count":1,"channels":[{"id":"2","type":0}],"members":[{"user":{"id":"10"}}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.GuildMemberUpdate([]byte(`{"guild_id":"1","user":{"id":"10"},"nick":"x"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.GetMember(1, 10); err != CacheMissErr {
+		t.Errorf("expected members to not be cached. Got %v", err)
+	}
+	if _, err := cache.GetChannel(2); err != CacheMissErr {
+		t.Errorf("expected channels to not be cached. Got %v", err)
+	}
+	if g, _ := cache.GetGuild(1); g == nil || g.MemberCount != 1 {
+		t.Error("expected the member count to be unaffected by member updates")
+	}
+}
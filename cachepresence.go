@@ -0,0 +1,213 @@
+package disgord
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/Vedza/disgord/json"
+)
+
+// PresenceCacheConfig enables caching of presences, see BasicCacheConfig.Presences. Presence updates are
+// only sent with IntentGuildPresences, which is a privileged intent.
+type PresenceCacheConfig struct {
+	Enabled bool
+
+	// MaxBytes is the memory budget of the presences, estimated from the size of the received payloads. The
+	// least recently updated presences are evicted once the budget is exceeded. 0 means no limit.
+	MaxBytes int64
+}
+
+// Presence is the cached status of a member in a guild.
+type Presence struct {
+	UserID       Snowflake    `json:"user_id"`
+	GuildID      Snowflake    `json:"guild_id"`
+	Status       string       `json:"status"`
+	Activities   []*Activity  `json:"activities"`
+	ClientStatus ClientStatus `json:"client_status"`
+}
+
+func (p *Presence) copy() *Presence {
+	presence := *p
+	presence.Activities = make([]*Activity, 0, len(p.Activities))
+	for _, activity := range p.Activities {
+		if activity != nil {
+			presence.Activities = append(presence.Activities, DeepCopy(activity).(*Activity))
+		}
+	}
+	return &presence
+}
+
+type presenceEntry struct {
+	key      memberKey
+	presence *Presence
+	size     int64
+}
+
+// presenceCache holds the presences of every guild, with the least recently updated presences last.
+// A disabled cache has no store.
+type presenceCache struct {
+	sync.Mutex
+	Store    map[memberKey]*list.Element
+	order    *list.List
+	bytes    int64
+	maxBytes int64
+	counters cacheCounters
+}
+
+func newPresenceCache(conf PresenceCacheConfig) presenceCache {
+	if !conf.Enabled {
+		return presenceCache{}
+	}
+	return presenceCache{
+		Store:    make(map[memberKey]*list.Element),
+		order:    list.New(),
+		maxBytes: conf.MaxBytes,
+	}
+}
+
+func (s *presenceCache) enabled() bool {
+	return s.Store != nil
+}
+
+// put stores the presence, or removes it when the member went offline. Lock must be held.
+func (s *presenceCache) put(presence *Presence, size int64) {
+	key := memberKey{guildID: presence.GuildID, userID: presence.UserID}
+	s.remove(key)
+	if presence.Status == StatusOffline || presence.UserID.IsZero() {
+		return
+	}
+
+	s.Store[key] = s.order.PushFront(&presenceEntry{key: key, presence: presence, size: size})
+	s.bytes += size
+
+	var evicted int
+	for s.maxBytes > 0 && s.bytes > s.maxBytes && s.order.Len() > 1 {
+		s.remove(s.order.Back().Value.(*presenceEntry).key)
+		evicted++
+	}
+	s.counters.evicted(evicted)
+}
+
+// remove deletes the presence. Lock must be held.
+func (s *presenceCache) remove(key memberKey) {
+	if elem, ok := s.Store[key]; ok {
+		s.bytes -= elem.Value.(*presenceEntry).size
+		s.order.Remove(elem)
+		delete(s.Store, key)
+	}
+}
+
+// removeGuild deletes the presences of the guild. Lock must be held.
+func (s *presenceCache) removeGuild(guildID Snowflake) {
+	for key := range s.Store {
+		if key.guildID == guildID {
+			s.remove(key)
+		}
+	}
+}
+
+// savePresence copies the presence of an event into the cache.
+func (c *BasicCache) savePresence(evt *PresenceUpdate, size int) {
+	if !c.Presences.enabled() || evt.User == nil {
+		return
+	}
+	presence := (&Presence{
+		UserID:       evt.User.ID,
+		GuildID:      evt.GuildID,
+		Status:       evt.Status,
+		Activities:   evt.Activities,
+		ClientStatus: evt.ClientStatus,
+	}).copy()
+
+	c.Presences.Lock()
+	c.Presences.put(presence, int64(size))
+	c.Presences.Unlock()
+}
+
+func (c *BasicCache) PresenceUpdate(data []byte) (*PresenceUpdate, error) {
+	evt, err := c.CacheNop.PresenceUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.savePresence(evt, len(data))
+	return evt, nil
+}
+
+// saveGuildPresences stores the presences sent with guild creates and member chunks. These are decoded
+// separately to know the size of each presence.
+func (c *BasicCache) saveGuildPresences(guildID Snowflake, data []byte) error {
+	if !c.Presences.enabled() {
+		return nil
+	}
+
+	var holder struct {
+		Presences []json.RawMessage `json:"presences"`
+	}
+	if err := json.Unmarshal(data, &holder); err != nil {
+		return err
+	}
+	for _, raw := range holder.Presences {
+		evt := &PresenceUpdate{}
+		if err := json.Unmarshal(raw, evt); err != nil {
+			return err
+		}
+		evt.GuildID = guildID
+		c.savePresence(evt, len(raw))
+	}
+	return nil
+}
+
+// GetPresence returns the presence of a member. Offline members, and members without any presence update
+// since the bot connected, are cache misses.
+func (c *BasicCache) GetPresence(guildID, userID Snowflake) (*Presence, error) {
+	c.Presences.Lock()
+	defer c.Presences.Unlock()
+
+	elem, ok := c.Presences.Store[memberKey{guildID: guildID, userID: userID}]
+	c.Presences.counters.lookup(ok)
+	if !ok {
+		return nil, CacheMissErr
+	}
+	return elem.Value.(*presenceEntry).presence.copy(), nil
+}
+
+// GetPresences returns the presences of the online members of a guild.
+func (c *BasicCache) GetPresences(guildID Snowflake) ([]*Presence, error) {
+	c.Presences.Lock()
+	defer c.Presences.Unlock()
+
+	var presences []*Presence
+	for key, elem := range c.Presences.Store {
+		if key.guildID == guildID {
+			presences = append(presences, elem.Value.(*presenceEntry).presence.copy())
+		}
+	}
+	return presences, nil
+}
+
+// presenceCacheGetter is implemented by caches that store presences, see BasicCacheConfig.Presences.
+type presenceCacheGetter interface {
+	GetPresence(guildID, userID Snowflake) (*Presence, error)
+	GetPresences(guildID Snowflake) ([]*Presence, error)
+}
+
+// Presence [CACHE] Returns the cached presence of a member. Requires BasicCacheConfig.Presences to be
+// enabled, and IntentGuildPresences. Offline members are not cached.
+func (g guildQueryBuilder) Presence(userID Snowflake) (*Presence, error) {
+	cache, ok := g.client.cache.(presenceCacheGetter)
+	if !ok {
+		return nil, CacheMissErr
+	}
+	return cache.GetPresence(g.gid, userID)
+}
+
+// Presences [CACHE] Returns the cached presences of the online members of the guild. Requires
+// BasicCacheConfig.Presences to be enabled, and IntentGuildPresences.
+func (g guildQueryBuilder) Presences() ([]*Presence, error) {
+	cache, ok := g.client.cache.(presenceCacheGetter)
+	if !ok {
+		return nil, CacheMissErr
+	}
+	return cache.GetPresences(g.gid)
+}
@@ -0,0 +1,67 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+)
+
+func TestBasicCache_Presences(t *testing.T) {
+	update := func(userID, status string) []byte {
+		return []byte(`{"guild_id":"1","user":{"id":"` + userID + `"},"status":"` + status + `","activities":[{"name":"go","type":0}]}`)
+	}
+
+	cache := NewBasicCache()
+	if _, err := cache.PresenceUpdate(update("10", StatusOnline)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cache.GetPresence(1, 10); err != CacheMissErr {
+		t.Errorf("expected presences to be opt-in. Got %v", err)
+	}
+
+	size := int64(len(update("10", StatusIdle)))
+	cache = NewBasicCacheWithConfig(BasicCacheConfig{
+		Presences: PresenceCacheConfig{Enabled: true, MaxBytes: 2 * size},
+	})
+
+	guild := []byte(`{"id":"1","presences":[{"user":{"id":"10"},"status":"online","activities":[]}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+	if presence, err := cache.GetPresence(1, 10); err != nil || presence.Status != StatusOnline {
+		t.Fatalf("expected the presence from the guild create. Got %+v, %v", presence, err)
+	}
+
+	for _, userID := range []string{"10", "11", "12"} {
+		if _, err := cache.PresenceUpdate(update(userID, StatusIdle)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := cache.GetPresence(1, 10); err != CacheMissErr {
+		t.Errorf("expected the least recently updated presence to be evicted. Got %v", err)
+	}
+	presence, err := cache.GetPresence(1, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if presence.Status != StatusIdle || len(presence.Activities) != 1 || presence.Activities[0].Name != "go" {
+		t.Errorf("unexpected presence %+v", presence)
+	}
+	if stats := cache.Stats().Presences; stats.Entries != 2 || stats.Bytes != 2*size || stats.Evictions != 1 {
+		t.Errorf("unexpected presence stats %+v", stats)
+	}
+
+	if _, err = cache.PresenceUpdate(update("12", StatusOffline)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cache.GetPresence(1, 12); err != CacheMissErr {
+		t.Errorf("expected offline members to be removed. Got %v", err)
+	}
+
+	if _, err = cache.GuildDelete([]byte(`{"id":"1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if presences, _ := cache.GetPresences(1); len(presences) != 0 {
+		t.Errorf("expected the presences to be removed with the guild. Got %d", len(presences))
+	}
+}
@@ -0,0 +1,156 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/Vedza/disgord/json"
+)
+
+const cacheSnapshotVersion = 1
+
+// cacheSnapshot is the serialized content of a BasicCache
+type cacheSnapshot struct {
+	Version     int              `json:"version"`
+	CurrentUser *User            `json:"current_user"`
+	Users       []*User          `json:"users"`
+	Channels    []*Channel       `json:"channels"`
+	Guilds      []*guildSnapshot `json:"guilds"`
+}
+
+type guildSnapshot struct {
+	Guild      *Guild            `json:"guild"`
+	ChannelIDs []Snowflake       `json:"channel_ids"`
+	ThreadIDs  []Snowflake       `json:"thread_ids"`
+	Members    []*memberSnapshot `json:"members"`
+}
+
+// memberSnapshot holds the user id, as the cached members do not hold the user object
+type memberSnapshot struct {
+	UserID Snowflake `json:"user_id"`
+	Member *Member   `json:"member"`
+}
+
+// Export writes the content of the cache as JSON, such that a restarted bot can Import it and resume
+// with a warm cache. Combine it with a session store to avoid fetching everything after a deploy.
+//  file, err := os.Create("cache.json")
+//  if err != nil {
+//      return err
+//  }
+//  defer file.Close()
+//  err = cache.Export(file)
+func (c *BasicCache) Export(w io.Writer) error {
+	snapshot := &cacheSnapshot{Version: cacheSnapshotVersion}
+
+	c.CurrentUserMu.Lock()
+	if c.CurrentUser != nil {
+		snapshot.CurrentUser = DeepCopy(c.CurrentUser).(*User)
+	}
+	c.CurrentUserMu.Unlock()
+
+	c.Users.Lock()
+	snapshot.Users = make([]*User, 0, len(c.Users.Store))
+	for _, user := range c.Users.Store {
+		snapshot.Users = append(snapshot.Users, DeepCopy(user).(*User))
+	}
+	c.Users.Unlock()
+
+	c.Channels.Lock()
+	snapshot.Channels = make([]*Channel, 0, len(c.Channels.Store))
+	for _, channel := range c.Channels.Store {
+		snapshot.Channels = append(snapshot.Channels, DeepCopy(channel).(*Channel))
+	}
+	c.Channels.Unlock()
+
+	c.Guilds.Lock()
+	snapshot.Guilds = make([]*guildSnapshot, 0, len(c.Guilds.Store))
+	for _, container := range c.Guilds.Store {
+		guild := &guildSnapshot{
+			Guild:      DeepCopy(container.Guild).(*Guild),
+			ChannelIDs: append([]Snowflake(nil), container.ChannelIDs...),
+			ThreadIDs:  append([]Snowflake(nil), container.ThreadIDs...),
+			Members:    make([]*memberSnapshot, 0, len(container.Members)),
+		}
+		for userID, member := range container.Members {
+			if member == nil {
+				continue
+			}
+			guild.Members = append(guild.Members, &memberSnapshot{
+				UserID: userID,
+				Member: DeepCopy(member).(*Member),
+			})
+		}
+		snapshot.Guilds = append(snapshot.Guilds, guild)
+	}
+	c.Guilds.Unlock()
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Import replaces the content of the cache with a snapshot written by Export. The cache limits and
+// policy of this cache are applied to the imported entries. Import must be called before connecting.
+func (c *BasicCache) Import(r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	snapshot := &cacheSnapshot{}
+	if err = json.Unmarshal(data, snapshot); err != nil {
+		return err
+	}
+	if snapshot.Version != cacheSnapshotVersion {
+		return fmt.Errorf("unsupported cache snapshot version %d", snapshot.Version)
+	}
+	if snapshot.CurrentUser == nil {
+		return errors.New("cache snapshot is missing the current user")
+	}
+
+	c.CurrentUserMu.Lock()
+	c.CurrentUser = snapshot.CurrentUser
+	c.Patch(c.CurrentUser)
+	c.CurrentUserMu.Unlock()
+
+	c.Users.Lock()
+	c.Users.Store = make(map[Snowflake]*User, len(snapshot.Users))
+	c.Users.limits.reset()
+	for _, user := range snapshot.Users {
+		c.Patch(user)
+		c.Users.put(user)
+	}
+	c.Users.Unlock()
+
+	c.Channels.Lock()
+	c.Channels.Store = make(map[Snowflake]*Channel, len(snapshot.Channels))
+	c.Channels.limits.reset()
+	for _, channel := range snapshot.Channels {
+		c.Patch(channel)
+		c.Channels.put(channel)
+	}
+	c.Channels.Unlock()
+
+	c.Guilds.Lock()
+	c.Guilds.Store = make(map[Snowflake]*guildCacheContainer, len(snapshot.Guilds))
+	c.Guilds.limits.reset()
+	c.Guilds.memberLimits.reset()
+	for _, guild := range snapshot.Guilds {
+		c.Patch(guild.Guild)
+		members := make(map[Snowflake]*Member, len(guild.Members))
+		for _, entry := range guild.Members {
+			entry.Member.UserID = entry.UserID
+			entry.Member.GuildID = guild.Guild.ID
+			members[entry.UserID] = entry.Member
+		}
+		c.Guilds.put(guild.Guild, guild.ChannelIDs, guild.ThreadIDs, members)
+	}
+	c.Guilds.Unlock()
+
+	return nil
+}
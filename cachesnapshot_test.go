@@ -0,0 +1,51 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBasicCache_ExportImport(t *testing.T) {
+	cache := NewBasicCache()
+	cache.CurrentUser = &User{ID: 99, Username: "bot"}
+
+	guild := []byte(`{"id":"1","name":"test","roles":[{"id":"5","name":"mod"}],"channels":[{"id":"2","type":0}],` +
+		`"members":[{"user":{"id":"10","username":"anders"},"nick":"andy","roles":["5"]}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := cache.Export(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := NewBasicCache()
+	if err := restored.Import(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if user, err := restored.GetCurrentUser(); err != nil || user.Username != "bot" {
+		t.Errorf("expected the current user to be restored. Got %v, %v", user, err)
+	}
+	g, err := restored.GetGuild(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Name != "test" || len(g.Channels) != 1 || len(g.Roles) != 1 {
+		t.Errorf("unexpected restored guild: %+v", g)
+	}
+	member, err := restored.GetMember(1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Nick != "andy" || member.User == nil || member.User.Username != "anders" {
+		t.Errorf("unexpected restored member: %+v", member)
+	}
+
+	if err = restored.Import(bytes.NewBufferString(`{"version":0}`)); err == nil {
+		t.Error("expected an error for an unknown snapshot version")
+	}
+}
@@ -0,0 +1,136 @@
+package disgord
+
+import (
+	"go.uber.org/atomic"
+
+	"github.com/Vedza/disgord/json"
+)
+
+// cacheStatsSampleSize is the number of entries serialized to estimate the memory usage of a resource
+const cacheStatsSampleSize = 100
+
+// CacheResourceStats holds the statistics of a cached resource, see BasicCache.Stats.
+type CacheResourceStats struct {
+	Entries int
+
+	// Bytes is a rough estimate of the memory used, based on the serialized size of a sample of the entries.
+	Bytes int64
+
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// CacheStats holds the statistics of every resource in the BasicCache.
+type CacheStats struct {
+	Users    CacheResourceStats
+	Channels CacheResourceStats
+	Guilds   CacheResourceStats
+	Members  CacheResourceStats
+
+	// Presences uses the payload sizes that are tracked for the memory budget, instead of an estimate.
+	Presences CacheResourceStats
+}
+
+type cacheCounters struct {
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+func (c *cacheCounters) lookup(found bool) {
+	if found {
+		c.hits.Inc()
+	} else {
+		c.misses.Inc()
+	}
+}
+
+func (c *cacheCounters) evicted(n int) {
+	if n > 0 {
+		c.evictions.Add(uint64(n))
+	}
+}
+
+func (c *cacheCounters) stats(entries int, bytes int64) CacheResourceStats {
+	return CacheResourceStats{
+		Entries:   entries,
+		Bytes:     bytes,
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+// estimateBytes serializes up to cacheStatsSampleSize entries and scales the size to every entry.
+// The callback must return false once the entries have been iterated.
+func estimateBytes(entries int, next func(sample func(v interface{}) bool)) int64 {
+	var size int64
+	var sampled int
+	next(func(v interface{}) bool {
+		if data, err := json.Marshal(v); err == nil {
+			size += int64(len(data))
+		}
+		sampled++
+		return sampled < cacheStatsSampleSize
+	})
+	if sampled == 0 {
+		return 0
+	}
+	return size * int64(entries) / int64(sampled)
+}
+
+// Stats returns the number of entries, an estimate of their size, and the hits, misses and evictions
+// of every resource since the cache was created. Lookups made by the cache getters are counted.
+func (c *BasicCache) Stats() CacheStats {
+	var stats CacheStats
+
+	c.Users.Lock()
+	stats.Users = c.Users.counters.stats(len(c.Users.Store), estimateBytes(len(c.Users.Store), func(sample func(interface{}) bool) {
+		for _, user := range c.Users.Store {
+			if !sample(user) {
+				return
+			}
+		}
+	}))
+	c.Users.Unlock()
+
+	c.Channels.Lock()
+	stats.Channels = c.Channels.counters.stats(len(c.Channels.Store), estimateBytes(len(c.Channels.Store), func(sample func(interface{}) bool) {
+		for _, channel := range c.Channels.Store {
+			if !sample(channel) {
+				return
+			}
+		}
+	}))
+	c.Channels.Unlock()
+
+	c.Guilds.Lock()
+	var members int
+	for _, container := range c.Guilds.Store {
+		members += len(container.Members)
+	}
+	stats.Guilds = c.Guilds.counters.stats(len(c.Guilds.Store), estimateBytes(len(c.Guilds.Store), func(sample func(interface{}) bool) {
+		for _, container := range c.Guilds.Store {
+			if !sample(container.Guild) {
+				return
+			}
+		}
+	}))
+	stats.Members = c.Guilds.memberCounters.stats(members, estimateBytes(members, func(sample func(interface{}) bool) {
+		for _, container := range c.Guilds.Store {
+			for _, member := range container.Members {
+				if !sample(member) {
+					return
+				}
+			}
+		}
+	}))
+	c.Guilds.Unlock()
+
+	c.Presences.Lock()
+	stats.Presences = c.Presences.counters.stats(len(c.Presences.Store), c.Presences.bytes)
+	c.Presences.Unlock()
+
+	return stats
+}
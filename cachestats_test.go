@@ -0,0 +1,34 @@
+// +build !integration
+
+package disgord
+
+import "testing"
+
+func TestBasicCache_Stats(t *testing.T) {
+	cache := NewBasicCacheWithConfig(BasicCacheConfig{
+		Members: CacheLimit{MaxEntries: 1},
+	})
+
+	guild := []byte(`{"id":"1","channels":[{"id":"2","type":0,"name":"general"}],` +
+		`"members":[{"user":{"id":"10"}},{"user":{"id":"11"}}]}`)
+	if _, err := cache.GuildCreate(guild); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = cache.GetChannel(2)
+	_, _ = cache.GetChannel(3)
+	_, _ = cache.GetChannel(3)
+
+	stats := cache.Stats()
+	if stats.Channels.Entries != 1 || stats.Channels.Hits != 1 || stats.Channels.Misses != 2 {
+		t.Errorf("unexpected channel stats: %+v", stats.Channels)
+	}
+	if stats.Channels.Bytes == 0 {
+		t.Error("expected an estimate of the channel size")
+	}
+	if stats.Members.Entries != 1 || stats.Members.Evictions != 1 {
+		t.Errorf("unexpected member stats: %+v", stats.Members)
+	}
+	if stats.Guilds.Entries != 1 || stats.Users.Entries != 2 {
+		t.Errorf("unexpected entries. Got %d guilds and %d users", stats.Guilds.Entries, stats.Users.Entries)
+	}
+}
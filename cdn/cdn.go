@@ -0,0 +1,179 @@
+// Package cdn builds links to the images hosted by the Discord CDN, such as avatars, icons and banners.
+// Images are identified by the id of their owner and a hash, as found on the users, guilds, roles etc.
+//
+//  link, err := cdn.Avatar(user.ID, user.Avatar, &cdn.Options{Size: 256, Animated: true})
+//  link, err := cdn.GuildIcon(guild.ID, guild.Icon, &cdn.Options{Format: cdn.PNG})
+//
+// See https://discord.com/developers/docs/reference#image-formatting
+package cdn
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/Vedza/disgord/internal/util"
+)
+
+// BaseURL is the root of every CDN link.
+const BaseURL = "https://cdn.discordapp.com"
+
+// Format is the file format of an image.
+type Format string
+
+const (
+	PNG  Format = "png"
+	JPEG Format = "jpg"
+	WebP Format = "webp"
+	GIF  Format = "gif"
+
+	// Lottie is only available for stickers
+	Lottie Format = "json"
+)
+
+// Options decide the size and format of an image. The zero value gives a png in its original size.
+type Options struct {
+	// Size is any power of two between 16 and 4096. 0 gives the original size.
+	Size int
+
+	// Format of the image. Defaults to PNG.
+	Format Format
+
+	// Animated gives a gif when the image is animated, regardless of the format.
+	Animated bool
+}
+
+// IsAnimated reports whether the image hash belongs to an animated image.
+func IsAnimated(hash string) bool {
+	return strings.HasPrefix(hash, "a_")
+}
+
+func validSize(size int) bool {
+	return size == 0 || (size >= 16 && size <= 4096 && size&(size-1) == 0)
+}
+
+func link(path string, opts *Options, animated bool) (string, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if !validSize(opts.Size) {
+		return "", errors.New("image size can be any power of two between 16 and 4096")
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = PNG
+	}
+	if opts.Animated && animated {
+		format = GIF
+	}
+	switch format {
+	case PNG, JPEG, WebP:
+	case GIF:
+		if !animated {
+			return "", errors.New("gif is only available for animated images")
+		}
+	default:
+		return "", errors.New("unsupported image format " + string(format))
+	}
+
+	url := BaseURL + path + "." + string(format)
+	if opts.Size > 0 {
+		url += "?size=" + strconv.Itoa(opts.Size)
+	}
+	return url, nil
+}
+
+func hashed(path string, hash string, opts *Options) (string, error) {
+	if hash == "" {
+		return "", errors.New("missing image hash")
+	}
+	return link(path+"/"+hash, opts, IsAnimated(hash))
+}
+
+// Avatar links to the avatar of a user.
+func Avatar(userID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/avatars/"+userID.String(), hash, opts)
+}
+
+// DefaultAvatar links to the avatar shown for users without an avatar. Use 0 as the discriminator for
+// users that have migrated to the new username system.
+func DefaultAvatar(userID util.Snowflake, discriminator int) string {
+	index := uint64(discriminator % 5)
+	if discriminator == 0 {
+		index = (uint64(userID) >> 22) % 6
+	}
+	return BaseURL + "/embed/avatars/" + strconv.FormatUint(index, 10) + ".png"
+}
+
+// MemberAvatar links to the guild specific avatar of a member.
+func MemberAvatar(guildID, userID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/guilds/"+guildID.String()+"/users/"+userID.String()+"/avatars", hash, opts)
+}
+
+// AvatarDecoration links to an avatar decoration, using the asset of the avatar decoration data.
+func AvatarDecoration(asset string) (string, error) {
+	if asset == "" {
+		return "", errors.New("missing avatar decoration asset")
+	}
+	return BaseURL + "/avatar-decoration-presets/" + asset + ".png", nil
+}
+
+// UserBanner links to the profile banner of a user.
+func UserBanner(userID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/banners/"+userID.String(), hash, opts)
+}
+
+// GuildIcon links to the icon of a guild.
+func GuildIcon(guildID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/icons/"+guildID.String(), hash, opts)
+}
+
+// GuildBanner links to the banner of a guild.
+func GuildBanner(guildID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/banners/"+guildID.String(), hash, opts)
+}
+
+// GuildSplash links to the invite splash of a guild.
+func GuildSplash(guildID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/splashes/"+guildID.String(), hash, opts)
+}
+
+// GuildDiscoverySplash links to the discovery splash of a guild.
+func GuildDiscoverySplash(guildID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/discovery-splashes/"+guildID.String(), hash, opts)
+}
+
+// RoleIcon links to the icon of a role.
+func RoleIcon(roleID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/role-icons/"+roleID.String(), hash, opts)
+}
+
+// ScheduledEventCover links to the cover image of a scheduled event.
+func ScheduledEventCover(eventID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/guild-events/"+eventID.String(), hash, opts)
+}
+
+// ApplicationIcon links to the icon of an application.
+func ApplicationIcon(applicationID util.Snowflake, hash string, opts *Options) (string, error) {
+	return hashed("/app-icons/"+applicationID.String(), hash, opts)
+}
+
+// Emoji links to a custom emoji. Emojis have no hash, so tell whether the emoji is animated.
+func Emoji(emojiID util.Snowflake, animated bool, opts *Options) (string, error) {
+	if emojiID.IsZero() {
+		return "", errors.New("unicode emojis are not hosted on the CDN")
+	}
+	return link("/emojis/"+emojiID.String(), opts, animated)
+}
+
+// Sticker links to a sticker, where the format must match the format type of the sticker: PNG for png and
+// apng stickers, Lottie for lottie stickers and GIF for gif stickers.
+func Sticker(stickerID util.Snowflake, format Format) (string, error) {
+	switch format {
+	case PNG, Lottie, GIF:
+	default:
+		return "", errors.New("stickers are only available as png, lottie or gif")
+	}
+	return BaseURL + "/stickers/" + stickerID.String() + "." + string(format), nil
+}
@@ -0,0 +1,62 @@
+// +build !integration
+
+package cdn
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Vedza/disgord/internal/util"
+)
+
+func TestLinks(t *testing.T) {
+	id := util.Snowflake(80351110224678912)
+	testCases := []struct {
+		name     string
+		link     func() (string, error)
+		expected string
+	}{
+		{"avatar", func() (string, error) { return Avatar(id, "abc", nil) }, BaseURL + "/avatars/80351110224678912/abc.png"},
+		{"animated avatar", func() (string, error) {
+			return Avatar(id, "a_abc", &Options{Size: 64, Format: WebP, Animated: true})
+		}, BaseURL + "/avatars/80351110224678912/a_abc.gif?size=64"},
+		{"static animated avatar", func() (string, error) {
+			return Avatar(id, "a_abc", &Options{Format: WebP})
+		}, BaseURL + "/avatars/80351110224678912/a_abc.webp"},
+		{"member avatar", func() (string, error) { return MemberAvatar(1, id, "abc", nil) }, BaseURL + "/guilds/1/users/80351110224678912/avatars/abc.png"},
+		{"guild icon", func() (string, error) { return GuildIcon(id, "abc", &Options{Format: JPEG}) }, BaseURL + "/icons/80351110224678912/abc.jpg"},
+		{"guild splash", func() (string, error) { return GuildSplash(id, "abc", nil) }, BaseURL + "/splashes/80351110224678912/abc.png"},
+		{"role icon", func() (string, error) { return RoleIcon(id, "abc", nil) }, BaseURL + "/role-icons/80351110224678912/abc.png"},
+		{"event cover", func() (string, error) { return ScheduledEventCover(id, "abc", nil) }, BaseURL + "/guild-events/80351110224678912/abc.png"},
+		{"emoji", func() (string, error) { return Emoji(id, true, &Options{Animated: true}) }, BaseURL + "/emojis/80351110224678912.gif"},
+		{"sticker", func() (string, error) { return Sticker(id, Lottie) }, BaseURL + "/stickers/80351110224678912.json"},
+		{"decoration", func() (string, error) { return AvatarDecoration("a_deco") }, BaseURL + "/avatar-decoration-presets/a_deco.png"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			link, err := tc.link()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if link != tc.expected {
+				t.Errorf("expected %s. Got %s", tc.expected, link)
+			}
+		})
+	}
+
+	if _, err := Avatar(id, "abc", &Options{Size: 100}); err == nil {
+		t.Error("expected an error for a size that is not a power of two")
+	}
+	if _, err := GuildBanner(id, "abc", &Options{Format: GIF}); err == nil {
+		t.Error("expected an error for a gif of a static image")
+	}
+	if _, err := GuildIcon(id, "", nil); err == nil {
+		t.Error("expected an error without a hash")
+	}
+	if link := DefaultAvatar(id, 0); link != BaseURL+"/embed/avatars/"+strconv.FormatUint((uint64(id)>>22)%6, 10)+".png" {
+		t.Errorf("unexpected default avatar %s", link)
+	}
+	if link := DefaultAvatar(id, 1337); link != BaseURL+"/embed/avatars/2.png" {
+		t.Errorf("unexpected default avatar %s", link)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Vedza/disgord/internal/endpoint"
 	"github.com/Vedza/disgord/internal/httd"
@@ -30,6 +31,69 @@ const (
 	ChannelTypeGuildStore
 )
 
+// Thread and stage channel types
+const (
+	ChannelTypeGuildNewsThread ChannelType = iota + 10
+	ChannelTypeGuildPublicThread
+	ChannelTypeGuildPrivateThread
+	ChannelTypeGuildStageVoice
+	ChannelTypeGuildDirectory
+	ChannelTypeGuildForum
+	ChannelTypeGuildMedia
+)
+
+// ChannelFlag https://discord.com/developers/docs/resources/channel#channel-object-channel-flags
+type ChannelFlag uint
+
+const (
+	// ChannelFlagPinned this thread is pinned to the top of its parent forum or media channel
+	ChannelFlagPinned ChannelFlag = 1 << 1
+	// ChannelFlagRequireTag a tag is required to be specified when creating a thread in a forum or media channel
+	ChannelFlagRequireTag ChannelFlag = 1 << 4
+	// ChannelFlagHideMediaDownloadOptions hides the embedded media download options, media channels only
+	ChannelFlagHideMediaDownloadOptions ChannelFlag = 1 << 15
+)
+
+// SortOrderType is the default sort order of posts in a forum or media channel
+type SortOrderType uint
+
+const (
+	SortOrderLatestActivity SortOrderType = iota
+	SortOrderCreationDate
+)
+
+// ForumLayoutType is the default layout of posts in a forum channel
+type ForumLayoutType uint
+
+const (
+	ForumLayoutNotSet ForumLayoutType = iota
+	ForumLayoutListView
+	ForumLayoutGalleryView
+)
+
+// ForumTag is a tag that can be applied to threads in a forum or media channel.
+// https://discord.com/developers/docs/resources/channel#forum-tag-object
+type ForumTag struct {
+	ID        Snowflake `json:"id,omitempty"`
+	Name      string    `json:"name"`
+	Moderated bool      `json:"moderated"`
+	EmojiID   Snowflake `json:"emoji_id,omitempty"`
+	EmojiName string    `json:"emoji_name,omitempty"`
+}
+
+var _ Copier = (*ForumTag)(nil)
+var _ DeepCopier = (*ForumTag)(nil)
+
+// DefaultReaction is the emoji shown in the add reaction button of threads in a forum or media channel.
+// https://discord.com/developers/docs/resources/channel#default-reaction-object
+type DefaultReaction struct {
+	EmojiID   Snowflake `json:"emoji_id,omitempty"`
+	EmojiName string    `json:"emoji_name,omitempty"`
+}
+
+var _ Copier = (*DefaultReaction)(nil)
+var _ DeepCopier = (*DefaultReaction)(nil)
+
 // Deprecated: use PermissionOverwrite* instead (note the Type keyword is removed)
 // PermissionOverwriteTypeMember => PermissionOverwriteMember
 const (
@@ -47,6 +111,9 @@ type Attachment struct {
 	Height   uint      `json:"height"`
 	Width    uint      `json:"width"`
 
+	// ContentType is the media type of the attachment, such as "image/png"
+	ContentType string `json:"content_type,omitempty"`
+
 	SpoilerTag bool `json:"-"`
 }
 
@@ -111,6 +178,25 @@ type Channel struct {
 	ApplicationID        Snowflake             `json:"application_id,omitempty"`
 	ParentID             Snowflake             `json:"parent_id,omitempty"`
 	LastPinTimestamp     Time                  `json:"last_pin_timestamp,omitempty"`
+
+	// voice and stage channels
+	RTCRegion string `json:"rtc_region,omitempty"` // voice region id, empty when it is picked automatically
+
+	// threads
+	MessageCount               uint            `json:"message_count,omitempty"`
+	MemberCount                uint            `json:"member_count,omitempty"`
+	ThreadMetadata             *ThreadMetadata `json:"thread_metadata,omitempty"`
+	Member                     *ThreadMember   `json:"member,omitempty"` // current user, if joined
+	DefaultAutoArchiveDuration uint            `json:"default_auto_archive_duration,omitempty"`
+
+	// forum and media channels
+	Flags                         ChannelFlag      `json:"flags,omitempty"`
+	AvailableTags                 []*ForumTag      `json:"available_tags,omitempty"`
+	AppliedTags                   []Snowflake      `json:"applied_tags,omitempty"` // threads only
+	DefaultReactionEmoji          *DefaultReaction `json:"default_reaction_emoji,omitempty"`
+	DefaultThreadRateLimitPerUser uint             `json:"default_thread_rate_limit_per_user,omitempty"`
+	DefaultSortOrder              *SortOrderType   `json:"default_sort_order,omitempty"`
+	DefaultForumLayout            ForumLayoutType  `json:"default_forum_layout,omitempty"`
 }
 
 var _ Reseter = (*Channel)(nil)
@@ -119,6 +205,20 @@ var _ Copier = (*Channel)(nil)
 var _ DeepCopier = (*Channel)(nil)
 var _ Mentioner = (*Channel)(nil)
 
+// IsForum checks if the channel is a forum or media channel, where every message is a thread.
+func (c *Channel) IsForum() bool {
+	return c.Type == ChannelTypeGuildForum || c.Type == ChannelTypeGuildMedia
+}
+
+// IsThread checks if the channel is a news, public or private thread.
+func (c *Channel) IsThread() bool {
+	switch c.Type {
+	case ChannelTypeGuildNewsThread, ChannelTypeGuildPublicThread, ChannelTypeGuildPrivateThread:
+		return true
+	}
+	return false
+}
+
 func (c *Channel) String() string {
 	return "channel{name:'" + c.Name + "', id:" + c.ID.String() + "}"
 }
@@ -140,7 +240,7 @@ func (c *Channel) valid() bool {
 }
 
 // GetPermissions is used to get a members permissions in a channel.
-func (c *Channel) GetPermissions(ctx context.Context, s GuildQueryBuilderCaller, member *Member, flags ...Flag) (permissions PermissionBit, err error) {
+func (c *Channel) GetPermissions(ctx context.Context, s GuildQueryBuilderCaller, member *Member, flags ...RequestOption) (permissions PermissionBit, err error) {
 	// Get the guild permissions.
 	permissions, err = member.GetPermissions(ctx, s, flags...)
 	if err != nil {
@@ -243,85 +343,139 @@ type ChannelQueryBuilder interface {
 	// this route. However, if a bot is responding to a command and expects the computation to take a few seconds, this
 	// endpoint may be called to let the user know that the bot is processing their message. Returns a 204 empty response
 	// on success. Fires a Typing Start Gateway event.
-	TriggerTypingIndicator(flags ...Flag) error
+	TriggerTypingIndicator(flags ...RequestOption) error
+
+	// TriggerTypingUntil Keeps the typing indicator shown in the channel until the context is cancelled.
+	TriggerTypingUntil(ctx context.Context, flags ...RequestOption) error
 
 	// GetChannel Get a channel by Snowflake. Returns a channel object.
-	Get(flags ...Flag) (*Channel, error)
+	Get(flags ...RequestOption) (*Channel, error)
 
 	// UpdateChannel Update a Channels settings. Requires the 'MANAGE_CHANNELS' permission for the guild. Returns
 	// a channel on success, and a 400 BAD REQUEST on invalid parameters. Fires a Channel Update Gateway event. If
 	// modifying a category, individual Channel Update events will fire for each child channel that also changes.
 	// For the PATCH method, all the JSON Params are optional.
-	UpdateBuilder(flags ...Flag) *updateChannelBuilder
+	UpdateBuilder(flags ...RequestOption) *updateChannelBuilder
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) *updateChannelBuilder
+	Update(flags ...RequestOption) *updateChannelBuilder
 
 	// DeleteChannel Delete a channel, or close a private message. Requires the 'MANAGE_CHANNELS' permission for
 	// the guild. Deleting a category does not delete its child Channels; they will have their parent_id removed and a
 	// Channel Update Gateway event will fire for each of them. Returns a channel object on success.
 	// Fires a Channel Delete Gateway event.
-	Delete(flags ...Flag) (*Channel, error)
+	Delete(flags ...RequestOption) (*Channel, error)
 
 	// EditChannelPermissions Edit the channel permission overwrites for a user or role in a channel. Only usable
 	// for guild Channels. Requires the 'MANAGE_ROLES' permission. Returns a 204 empty response on success.
 	// For more information about permissions, see permissions.
-	UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...Flag) error
+	UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...RequestOption) error
 
 	// GetChannelInvites Returns a list of invite objects (with invite metadata) for the channel. Only usable for
 	// guild Channels. Requires the 'MANAGE_CHANNELS' permission.
-	GetInvites(flags ...Flag) ([]*Invite, error)
+	GetInvites(flags ...RequestOption) ([]*Invite, error)
 
 	// CreateChannelInvite Create a new invite object for the channel. Only usable for guild Channels. Requires
 	// the CREATE_INSTANT_INVITE permission. All JSON parameters for this route are optional, however the request
 	// body is not. If you are not sending any fields, you still have to send an empty JSON object ({}).
 	// Returns an invite object.
-	CreateInvite(flags ...Flag) *createChannelInviteBuilder
+	CreateInvite(flags ...RequestOption) *createChannelInviteBuilder
 
 	// DeleteChannelPermission Delete a channel permission overwrite for a user or role in a channel. Only usable
 	// for guild Channels. Requires the 'MANAGE_ROLES' permission. Returns a 204 empty response on success. For more
 	// information about permissions,
 	// see permissions: https://discord.com/developers/docs/topics/permissions#permissions
-	DeletePermission(overwriteID Snowflake, flags ...Flag) error
+	DeletePermission(overwriteID Snowflake, flags ...RequestOption) error
 
 	// AddDMParticipant Adds a recipient to a Group DM using their access token. Returns a 204 empty response
 	// on success.
-	AddDMParticipant(participant *GroupDMParticipant, flags ...Flag) error
+	AddDMParticipant(participant *GroupDMParticipant, flags ...RequestOption) error
 
 	// KickParticipant Removes a recipient from a Group DM. Returns a 204 empty response on success.
-	KickParticipant(userID Snowflake, flags ...Flag) error
+	KickParticipant(userID Snowflake, flags ...RequestOption) error
 
 	// GetPinnedMessages Returns all pinned messages in the channel as an array of message objects.
-	GetPinnedMessages(flags ...Flag) ([]*Message, error)
+	GetPinnedMessages(flags ...RequestOption) ([]*Message, error)
 
 	// DeleteMessages Delete multiple messages in a single request. This endpoint can only be used on guild
 	// Channels and requires the 'MANAGE_MESSAGES' permission. Returns a 204 empty response on success. Fires multiple
 	// Message Delete Gateway events.Any message IDs given that do not exist or are invalid will count towards
 	// the minimum and maximum message count (currently 2 and 100 respectively). Additionally, duplicated IDs
 	// will only be counted once.
-	DeleteMessages(params *DeleteMessagesParams, flags ...Flag) error
+	DeleteMessages(params *DeleteMessagesParams, flags ...RequestOption) error
 
 	// GetMessages Returns the messages for a channel. If operating on a guild channel, this endpoint requires
 	// the 'VIEW_CHANNEL' permission to be present on the current user. If the current user is missing
 	// the 'READ_MESSAGE_HISTORY' permission in the channel then this will return no messages
 	// (since they cannot read the message history). Returns an array of message objects on success.
-	GetMessages(params *GetMessagesParams, flags ...Flag) ([]*Message, error)
+	GetMessages(params *GetMessagesParams, flags ...RequestOption) ([]*Message, error)
+
+	// IterateMessages iterates over the messages of the channel, fetching pages as needed.
+	IterateMessages(params *GetMessagesParams, flags ...RequestOption) *MessageIterator
+
+	// PurgeMessages deletes the messages accepted by the filter, using bulk deletes where possible.
+	PurgeMessages(ctx context.Context, params *PurgeMessagesParams, flags ...RequestOption) (int, error)
 
 	// CreateMessage Post a message to a guild text or DM channel. If operating on a guild channel, this
 	// endpoint requires the 'SEND_MESSAGES' permission to be present on the current user. If the tts field is set to true,
 	// the SEND_TTS_MESSAGES permission is required for the message to be spoken. Returns a message object. Fires a
 	// Message Create Gateway event. See message formatting for more information on how to properly format messages.
 	// The maximum request size when sending a message is 8MB.
-	CreateMessage(params *CreateMessageParams, flags ...Flag) (*Message, error)
+	CreateMessage(params *CreateMessageParams, flags ...RequestOption) (*Message, error)
 
 	// CreateWebhook Create a new webhook. Requires the 'MANAGE_WEBHOOKS' permission.
 	// Returns a webhook object on success.
-	CreateWebhook(params *CreateWebhookParams, flags ...Flag) (ret *Webhook, err error)
+	CreateWebhook(params *CreateWebhookParams, flags ...RequestOption) (ret *Webhook, err error)
 
 	// GetChannelWebhooks Returns a list of channel webhook objects. Requires the 'MANAGE_WEBHOOKS' permission.
-	GetWebhooks(flags ...Flag) (ret []*Webhook, err error)
+	GetWebhooks(flags ...RequestOption) (ret []*Webhook, err error)
 
 	Message(id Snowflake) MessageQueryBuilder
+
+	// StartThread Creates a new thread that is not connected to an existing message.
+	StartThread(params *StartThreadParams, flags ...RequestOption) (*Channel, error)
+
+	// StartThreadInForum Creates a new thread in a forum or media channel, along with the starter message.
+	StartThreadInForum(params *StartThreadInForumParams, flags ...RequestOption) (*ForumThread, error)
+
+	// JoinThread Adds the current user to a thread.
+	JoinThread(flags ...RequestOption) error
+
+	// LeaveThread Removes the current user from a thread.
+	LeaveThread(flags ...RequestOption) error
+
+	// AddThreadMember Adds another member to a thread.
+	AddThreadMember(userID Snowflake, flags ...RequestOption) error
+
+	// RemoveThreadMember Removes another member from a thread.
+	RemoveThreadMember(userID Snowflake, flags ...RequestOption) error
+
+	// GetThreadMember Returns a thread member object for the specified user if they are a member of the thread.
+	GetThreadMember(userID Snowflake, flags ...RequestOption) (*ThreadMember, error)
+
+	// GetThreadMembers Returns array of thread members objects that are members of the thread.
+	GetThreadMembers(flags ...RequestOption) ([]*ThreadMember, error)
+
+	// GetPublicArchivedThreads Returns archived threads in the channel that are public.
+	GetPublicArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error)
+
+	// GetPrivateArchivedThreads Returns archived threads in the channel that are of type GUILD_PRIVATE_THREAD.
+	GetPrivateArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error)
+
+	// GetJoinedPrivateArchivedThreads Returns archived private threads in the channel that the current user has joined.
+	GetJoinedPrivateArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error)
+
+	// CreateStageInstance Creates a new stage instance associated to this stage channel.
+	CreateStageInstance(params *CreateStageInstanceParams, flags ...RequestOption) (*StageInstance, error)
+
+	// GetStageInstance Gets the stage instance associated with this stage channel, if it exists.
+	GetStageInstance(flags ...RequestOption) (*StageInstance, error)
+
+	// UpdateStageInstance Updates fields of the existing stage instance of this stage channel.
+	UpdateStageInstance(params *UpdateStageInstanceParams, flags ...RequestOption) (*StageInstance, error)
+
+	// DeleteStageInstance Deletes the stage instance of this stage channel.
+	DeleteStageInstance(flags ...RequestOption) error
 }
 
 type channelQueryBuilder struct {
@@ -343,7 +497,7 @@ func (c channelQueryBuilder) WithContext(ctx context.Context) ChannelQueryBuilde
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#get-channel
 //  Reviewed                2018-06-07
 //  Comment                 -
-func (c channelQueryBuilder) Get(flags ...Flag) (*Channel, error) {
+func (c channelQueryBuilder) Get(flags ...RequestOption) (*Channel, error) {
 	if c.cid.IsZero() {
 		return nil, errors.New("not a valid snowflake")
 	}
@@ -358,12 +512,24 @@ func (c channelQueryBuilder) Get(flags ...Flag) (*Channel, error) {
 		Endpoint: endpoint.Channel(c.cid),
 		Ctx:      c.ctx,
 	}, flags)
-	r.pool = c.client.pool.channel
 	r.factory = func() interface{} {
 		return &Channel{}
 	}
+	r.cacheFill = func(body []byte) {
+		_, _ = c.client.cache.ChannelUpdate(body)
+	}
 
-	return getChannel(r.Execute)
+	v, shared, err := c.client.requests.do("channel:"+c.cid.String(), func() (interface{}, error) {
+		return getChannel(r.Execute)
+	})
+	if err != nil {
+		return nil, err
+	}
+	channel := v.(*Channel)
+	if shared {
+		channel = DeepCopy(channel).(*Channel)
+	}
+	return channel, nil
 }
 
 // UpdateChannel [REST] Update a Channels settings. Requires the 'MANAGE_CHANNELS' permission for the guild. Returns
@@ -375,7 +541,7 @@ func (c channelQueryBuilder) Get(flags ...Flag) (*Channel, error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#modify-channel
 //  Reviewed                2018-06-07
 //  Comment                 andersfylling: only implemented the patch method, as its parameters are optional.
-func (c channelQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateChannelBuilder) {
+func (c channelQueryBuilder) UpdateBuilder(flags ...RequestOption) (builder *updateChannelBuilder) {
 	builder = &updateChannelBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return c.client.pool.channel.Get()
@@ -403,7 +569,7 @@ func (c channelQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateChanne
 //                          is impossible to undo this action when performed on a guild channel. In
 //                          contrast, when used with a private message, it is possible to undo the
 //                          action by opening a private message with the recipient again.
-func (c channelQueryBuilder) Delete(flags ...Flag) (channel *Channel, err error) {
+func (c channelQueryBuilder) Delete(flags ...RequestOption) (channel *Channel, err error) {
 	if c.cid.IsZero() {
 		err = errors.New("not a valid snowflake")
 		return
@@ -430,7 +596,7 @@ func (c channelQueryBuilder) Delete(flags ...Flag) (channel *Channel, err error)
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#trigger-typing-indicator
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c channelQueryBuilder) TriggerTypingIndicator(flags ...Flag) (err error) {
+func (c channelQueryBuilder) TriggerTypingIndicator(flags ...RequestOption) (err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodPost,
 		Endpoint: endpoint.ChannelTyping(c.cid),
@@ -441,6 +607,44 @@ func (c channelQueryBuilder) TriggerTypingIndicator(flags ...Flag) (err error) {
 	return err
 }
 
+// typingIndicatorInterval is how often the typing indicator is triggered again. The indicator lasts 10
+// seconds, or until a message is sent.
+var typingIndicatorInterval = 8 * time.Second
+
+// TriggerTypingUntil triggers the typing indicator, and triggers it again every 8 seconds until the context
+// is cancelled. This is meant for commands that take a long time to complete, and returns as soon as the first
+// typing indicator is posted. Errors after the first trigger are logged.
+//  ctx, cancel := context.WithCancel(ctx)
+//  defer cancel()
+//  if err := client.Channel(channelID).TriggerTypingUntil(ctx); err != nil {
+//      return err
+//  }
+//  result := render()
+func (c channelQueryBuilder) TriggerTypingUntil(ctx context.Context, flags ...RequestOption) error {
+	c.ctx = ctx
+	if err := c.TriggerTypingIndicator(flags...); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(typingIndicatorInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := c.TriggerTypingIndicator(flags...); err != nil && ctx.Err() == nil {
+				c.client.Logger().Error("unable to trigger the typing indicator in channel", c.cid, err)
+			}
+		}
+	}()
+	return nil
+}
+
 // UpdateChannelPermissionsParams https://discord.com/developers/docs/resources/channel#edit-channel-permissions-json-params
 type UpdateChannelPermissionsParams struct {
 	Allow PermissionBit `json:"allow"` // the bitwise value of all allowed permissions
@@ -456,7 +660,7 @@ type UpdateChannelPermissionsParams struct {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#edit-channel-permissions
 //  Reviewed                2018-06-07
 //  Comment                 -
-func (c channelQueryBuilder) UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...Flag) (err error) {
+func (c channelQueryBuilder) UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...RequestOption) (err error) {
 	if c.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -483,7 +687,7 @@ func (c channelQueryBuilder) UpdatePermissions(overwriteID Snowflake, params *Up
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#get-channel-invites
 //  Reviewed                2018-06-07
 //  Comment                 -
-func (c channelQueryBuilder) GetInvites(flags ...Flag) (invites []*Invite, err error) {
+func (c channelQueryBuilder) GetInvites(flags ...RequestOption) (invites []*Invite, err error) {
 	if c.cid.IsZero() {
 		err = errors.New("channelID must be set to target the correct channel")
 		return
@@ -509,7 +713,7 @@ func (c channelQueryBuilder) GetInvites(flags ...Flag) (invites []*Invite, err e
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#create-channel-invite
 //  Reviewed                2018-06-07
 //  Comment                 -
-func (c channelQueryBuilder) CreateInvite(flags ...Flag) (builder *createChannelInviteBuilder) {
+func (c channelQueryBuilder) CreateInvite(flags ...RequestOption) (builder *createChannelInviteBuilder) {
 	builder = &createChannelInviteBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Invite{}
@@ -533,7 +737,7 @@ func (c channelQueryBuilder) CreateInvite(flags ...Flag) (builder *createChannel
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-channel-permission
 //  Reviewed                2018-06-07
 //  Comment                 -
-func (c channelQueryBuilder) DeletePermission(overwriteID Snowflake, flags ...Flag) (err error) {
+func (c channelQueryBuilder) DeletePermission(overwriteID Snowflake, flags ...RequestOption) (err error) {
 	if c.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -579,7 +783,7 @@ func (g *GroupDMParticipant) FindErrors() error {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#group-dm-add-recipient
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c channelQueryBuilder) AddDMParticipant(participant *GroupDMParticipant, flags ...Flag) error {
+func (c channelQueryBuilder) AddDMParticipant(participant *GroupDMParticipant, flags ...RequestOption) error {
 	if c.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -608,7 +812,7 @@ func (c channelQueryBuilder) AddDMParticipant(participant *GroupDMParticipant, f
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#group-dm-remove-recipient
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c channelQueryBuilder) KickParticipant(userID Snowflake, flags ...Flag) (err error) {
+func (c channelQueryBuilder) KickParticipant(userID Snowflake, flags ...RequestOption) (err error) {
 	if c.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -665,7 +869,7 @@ var _ URLQueryStringer = (*GetMessagesParams)(nil)
 //  Reviewed                2018-06-10
 //  Comment                 The before, after, and around keys are mutually exclusive, only one may
 //                          be passed at a time. see ReqGetChannelMessagesParams.
-func (c channelQueryBuilder) getMessages(params URLQueryStringer, flags ...Flag) (ret []*Message, err error) {
+func (c channelQueryBuilder) getMessages(params URLQueryStringer, flags ...RequestOption) (ret []*Message, err error) {
 	if c.cid.IsZero() {
 		err = errors.New("channelID must be set to get channel messages")
 		return
@@ -689,7 +893,7 @@ func (c channelQueryBuilder) getMessages(params URLQueryStringer, flags ...Flag)
 }
 
 // GetMessages bypasses discord limitations and iteratively fetches messages until the set filters are met.
-func (c channelQueryBuilder) GetMessages(filter *GetMessagesParams, flags ...Flag) (messages []*Message, err error) {
+func (c channelQueryBuilder) GetMessages(filter *GetMessagesParams, flags ...RequestOption) (messages []*Message, err error) {
 	// discord values
 	const filterLimit = 100
 	const filterDefault = 50
@@ -852,7 +1056,7 @@ func (p *DeleteMessagesParams) AddMessage(msg *Message) (err error) {
 //  Reviewed                2018-06-10
 //  Comment                 This endpoint will not delete messages older than 2 weeks, and will fail if any message
 //                          provided is older than that.
-func (c channelQueryBuilder) DeleteMessages(params *DeleteMessagesParams, flags ...Flag) (err error) {
+func (c channelQueryBuilder) DeleteMessages(params *DeleteMessagesParams, flags ...RequestOption) (err error) {
 	if c.cid.IsZero() {
 		err = errors.New("channelID must be set to get channel messages")
 		return err
@@ -883,6 +1087,13 @@ type AllowedMentions struct {
 	RepliedUser bool        `json:"replied_user,omitempty"`
 }
 
+// Mention types for AllowedMentions.Parse
+const (
+	AllowUserMentions     = "users"
+	AllowRoleMentions     = "roles"
+	AllowEveryoneMentions = "everyone"
+)
+
 // CreateMessageFileParams contains the information needed to upload a file to Discord, it is part of the
 // CreateMessageParams struct.
 type CreateMessageFileParams struct {
@@ -920,7 +1131,9 @@ type CreateMessageParams struct {
 	Content    string                    `json:"content"`
 	Nonce      string                    `json:"nonce,omitempty"` // THIS IS A STRING. NOT A SNOWFLAKE! DONT TOUCH!
 	Tts        bool                      `json:"tts,omitempty"`
-	Embed      *Embed                    `json:"embed,omitempty"` // embedded rich content
+	Embed      *Embed                    `json:"embed,omitempty"`  // embedded rich content
+	Embeds     []*Embed                  `json:"embeds,omitempty"` // up to 10 embeds
+	Flags      MessageFlag               `json:"flags,omitempty"`
 	Components []*MessageComponent       `json:"components"`
 	Files      []CreateMessageFileParams `json:"-"` // Always omit as this is included in multipart, not JSON payload
 
@@ -929,6 +1142,11 @@ type CreateMessageParams struct {
 
 	AllowedMentions  *AllowedMentions  `json:"allowed_mentions,omitempty"` // The allowed mentions object for the message.
 	MessageReference *MessageReference `json:"message_reference,omitempty"`
+
+	// StickerIDs holds up to 3 stickers, from the guild or the standard sticker packs, to send in the message
+	StickerIDs []Snowflake `json:"sticker_ids,omitempty"`
+
+	Poll *CreatePollParams `json:"poll,omitempty"`
 }
 
 func (p *CreateMessageParams) prepare() (postBody interface{}, contentType string, err error) {
@@ -949,19 +1167,31 @@ func (p *CreateMessageParams) prepare() (postBody interface{}, contentType strin
 		}
 	}
 
+	embeds := p.Embeds
 	if p.Embed != nil {
+		embeds = append([]*Embed{p.Embed}, embeds...)
+	}
+	for _, embed := range embeds {
+		if embed == nil || embed.Image == nil {
+			continue
+		}
 		// check for spoilers
 		for i := range p.Files {
-			if p.Files[i].SpoilerTag && strings.Contains(p.Embed.Image.URL, p.Files[i].FileName) {
-				s := strings.Split(p.Embed.Image.URL, p.Files[i].FileName)
+			if p.Files[i].SpoilerTag && strings.Contains(embed.Image.URL, p.Files[i].FileName) {
+				s := strings.Split(embed.Image.URL, p.Files[i].FileName)
 				if len(s) > 0 {
 					s[0] += AttachmentSpoilerPrefix + p.Files[i].FileName
-					p.Embed.Image.URL = strings.Join(s, "")
+					embed.Image.URL = strings.Join(s, "")
 				}
 			}
 		}
 	}
 
+	return writeMultipart(p, p.Files)
+}
+
+// writeMultipart creates a multipart body holding the JSON payload along with the files.
+func writeMultipart(p interface{}, files []CreateMessageFileParams) (postBody interface{}, contentType string, err error) {
 	// Set up a new multipart writer, as we'll be using this for the POST body instead
 	buf := new(bytes.Buffer)
 	mp := multipart.NewWriter(buf)
@@ -977,7 +1207,7 @@ func (p *CreateMessageParams) prepare() (postBody interface{}, contentType strin
 	}
 
 	// Iterate through all the files and write them to the multipart blob
-	for i, file := range p.Files {
+	for i, file := range files {
 		if err = file.write(i, mp); err != nil {
 			return
 		}
@@ -1001,7 +1231,7 @@ func (p *CreateMessageParams) prepare() (postBody interface{}, contentType strin
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#create-message
 //  Reviewed                2018-06-10
 //  Comment                 Before using this endpoint, you must connect to and identify with a gateway at least once.
-func (c channelQueryBuilder) CreateMessage(params *CreateMessageParams, flags ...Flag) (ret *Message, err error) {
+func (c channelQueryBuilder) CreateMessage(params *CreateMessageParams, flags ...RequestOption) (ret *Message, err error) {
 	if c.cid.IsZero() {
 		err = errors.New("channelID must be set to get channel messages")
 		return nil, err
@@ -1010,6 +1240,16 @@ func (c channelQueryBuilder) CreateMessage(params *CreateMessageParams, flags ..
 		err = errors.New("message must be set")
 		return nil, err
 	}
+	if params.Poll != nil {
+		if err = params.Poll.FindErrors(); err != nil {
+			return nil, err
+		}
+	}
+	if params.AllowedMentions == nil && c.client.allowedMentions != nil {
+		p := *params
+		p.AllowedMentions = c.client.allowedMentions
+		params = &p
+	}
 
 	var (
 		postBody    interface{}
@@ -1041,7 +1281,7 @@ func (c channelQueryBuilder) CreateMessage(params *CreateMessageParams, flags ..
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#get-pinned-messages
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c channelQueryBuilder) GetPinnedMessages(flags ...Flag) (ret []*Message, err error) {
+func (c channelQueryBuilder) GetPinnedMessages(flags ...RequestOption) (ret []*Message, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.ChannelPins(c.cid),
 		Ctx:      c.ctx,
@@ -1081,7 +1321,7 @@ func (c *CreateWebhookParams) FindErrors() error {
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#create-webhook
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (c channelQueryBuilder) CreateWebhook(params *CreateWebhookParams, flags ...Flag) (ret *Webhook, err error) {
+func (c channelQueryBuilder) CreateWebhook(params *CreateWebhookParams, flags ...RequestOption) (ret *Webhook, err error) {
 	if params == nil {
 		return nil, errors.New("params was nil")
 	}
@@ -1110,7 +1350,7 @@ func (c channelQueryBuilder) CreateWebhook(params *CreateWebhookParams, flags ..
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#get-channel-webhooks
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (c channelQueryBuilder) GetWebhooks(flags ...Flag) (ret []*Webhook, err error) {
+func (c channelQueryBuilder) GetWebhooks(flags ...RequestOption) (ret []*Webhook, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.ChannelWebhooks(c.cid),
 		Ctx:      c.ctx,
@@ -1129,7 +1369,7 @@ func (c channelQueryBuilder) GetWebhooks(flags ...Flag) (ret []*Webhook, err err
 //
 //////////////////////////////////////////////////////
 
-//generate-rest-params: max_age:int, max_uses:int, temporary:bool, unique:bool,
+//generate-rest-params: max_age:int, max_uses:int, temporary:bool, unique:bool, target_type:InviteTargetType, target_user_id:Snowflake, target_application_id:Snowflake,
 //generate-rest-basic-execute: invite:*Invite,
 type createChannelInviteBuilder struct {
 	r RESTBuilder
@@ -1157,6 +1397,18 @@ func (b *updateChannelBuilder) AddPermissionOverwrite(permission PermissionOverw
 	}
 	return b
 }
+// SetRTCRegion sets the voice region of a voice or stage channel. See GetVoiceRegions for the region ids.
+func (b *updateChannelBuilder) SetRTCRegion(regionID string) UpdateChannelBuilder {
+	b.r.param("rtc_region", regionID)
+	return b
+}
+
+// AutomaticRTCRegion lets Discord pick the voice region of a voice or stage channel.
+func (b *updateChannelBuilder) AutomaticRTCRegion() UpdateChannelBuilder {
+	b.r.param("rtc_region", nil)
+	return b
+}
+
 func (b *updateChannelBuilder) AddPermissionOverwrites(permissions []PermissionOverwrite) *updateChannelBuilder {
 	for i := range permissions {
 		b.AddPermissionOverwrite(permissions[i])
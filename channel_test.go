@@ -3,8 +3,15 @@
 package disgord
 
 import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/Vedza/disgord/internal/httd"
 	"github.com/Vedza/disgord/json"
 )
 
@@ -51,3 +58,141 @@ func TestChannel_JSONIconNull(t *testing.T) {
 		t.Error(c.Icon, "was not empty")
 	}
 }
+
+func TestStartThread_DefaultType(t *testing.T) {
+	var sent StartThreadParams
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(body, &sent)
+			return jsonResponse(req, `{"id":"2","type":12}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := &StartThreadParams{Name: "help"}
+	if _, err = client.Channel(1).StartThread(params); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Type != ChannelTypeGuildPrivateThread {
+		t.Errorf("expected a private thread by default. Got %d", sent.Type)
+	}
+	if params.Type != 0 {
+		t.Errorf("expected the params of the caller to be left as is. Got type %d", params.Type)
+	}
+}
+
+func TestStartThreadInForumParams(t *testing.T) {
+	params := &StartThreadInForumParams{Name: "help", AppliedTags: []Snowflake{1}}
+	if err := params.FindErrors(); err == nil {
+		t.Error("expected missing starter message to fail")
+	}
+
+	params.Message = &CreateMessageParams{Content: "how do I..."}
+	if err := params.FindErrors(); err != nil {
+		t.Fatal(err)
+	}
+	if _, contentType, err := params.prepare(); err != nil || contentType != httd.ContentTypeJSON {
+		t.Errorf("expected a json body, got %s (%v)", contentType, err)
+	}
+
+	params.Message.Files = []CreateMessageFileParams{{Reader: strings.NewReader("log"), FileName: "log.txt"}}
+	body, contentType, err := params.prepare()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(contentType, "multipart/form-data") {
+		t.Errorf("expected a multipart body, got %s", contentType)
+	}
+	if b := body.(*bytes.Buffer).String(); !strings.Contains(b, `"applied_tags":["1"]`) || !strings.Contains(b, "log.txt") {
+		t.Error("multipart body is missing the payload or the file")
+	}
+}
+
+func TestChannelQueryBuilder_CreateMessage_AllowedMentions(t *testing.T) {
+	var body string
+	client, err := NewClient(context.Background(), Config{
+		BotToken:        "testing",
+		AllowedMentions: &AllowedMentions{Parse: []string{AllowUserMentions}},
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			body = string(data)
+			return jsonResponse(req, `{"id":"2","channel_id":"1"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := &CreateMessageParams{Content: "@everyone"}
+	if _, err = client.Channel(1).CreateMessage(params); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `"allowed_mentions":{"parse":["users"]}`) {
+		t.Errorf("expected the default allowed mentions. Got %s", body)
+	}
+	if params.AllowedMentions != nil {
+		t.Error("expected the params to not be modified")
+	}
+
+	params.AllowedMentions = &AllowedMentions{Parse: []string{AllowEveryoneMentions}}
+	if _, err = client.Channel(1).CreateMessage(params); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body, `"allowed_mentions":{"parse":["everyone"]}`) {
+		t.Errorf("expected the allowed mentions of the message to override the default. Got %s", body)
+	}
+}
+
+func TestChannelQueryBuilder_TriggerTypingUntil(t *testing.T) {
+	defer func(interval time.Duration) {
+		typingIndicatorInterval = interval
+	}(typingIndicatorInterval)
+	typingIndicatorInterval = 10 * time.Millisecond
+
+	triggers := make(chan string, 10)
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			select {
+			case triggers <- req.Method + " " + req.URL.Path:
+			default:
+			}
+			resp := jsonResponse(req, "")
+			resp.StatusCode = http.StatusNoContent
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err = client.Channel(1).TriggerTypingUntil(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case trigger := <-triggers:
+			if !strings.HasSuffix(trigger, "/channels/1/typing") || !strings.HasPrefix(trigger, http.MethodPost) {
+				t.Errorf("unexpected request %s", trigger)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected the typing indicator to be triggered again. Got %d triggers", i)
+		}
+	}
+	cancel()
+
+	// a trigger might be in flight while cancelling
+	time.Sleep(5 * typingIndicatorInterval)
+	for len(triggers) > 0 {
+		<-triggers
+	}
+	time.Sleep(5 * typingIndicatorInterval)
+	if len(triggers) != 0 {
+		t.Error("expected the typing indicator to stop when the context is cancelled")
+	}
+}
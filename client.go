@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"go.uber.org/atomic"
 	"golang.org/x/net/proxy"
 
 	"github.com/Vedza/disgord/internal/gateway"
@@ -17,10 +18,15 @@ import (
 	"github.com/Vedza/disgord/internal/constant"
 
 	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
 )
 
 var DefaultHttpClient = &http.Client{}
 
+// DefaultRESTTimeout is the maximum duration of a REST request, including the time spent waiting for
+// rate limits, when Config.RESTTimeout is not set.
+const DefaultRESTTimeout = time.Minute
+
 // New create a Client. But panics on configuration/setup errors.
 func New(conf Config) *Client {
 	client, err := NewClient(context.Background(), conf)
@@ -53,11 +59,15 @@ func verifyClientProduction(ctx context.Context, client *Client) (Snowflake, err
 
 var verifyClient func(ctx context.Context, client *Client) (Snowflake, error) = verifyClientProduction
 
-// NewClient creates a new Disgord Client and returns an error on configuration issues
-func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
+// newRequester applies the configuration shared by every client, and creates the REST client
+func newRequester(conf *Config) (*httd.Client, error) {
 	if conf.Logger == nil {
 		conf.Logger = logger.Empty{}
 	}
+	conf.Logger = logger.Redact(conf.Logger, conf.BotToken)
+	if conf.JSON != nil {
+		json.Use(*conf.JSON)
+	}
 	if conf.HTTPClient == nil {
 		// WARNING: do not set http.Client.Timeout (!)
 		conf.HTTPClient = DefaultHttpClient
@@ -72,6 +82,41 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 			},
 		}
 	}
+
+	if conf.RESTProxyRateLimits && conf.RESTBaseURL == "" {
+		return nil, errors.New("RESTProxyRateLimits requires the RESTBaseURL of the API proxy")
+	}
+	if conf.RESTBucketManager == nil {
+		manager := httd.NewManager(nil)
+		manager.SetPreemption(!conf.RESTReactiveRateLimits, conf.RESTRateLimitMargin)
+		conf.RESTBucketManager = manager
+	}
+
+	return httd.NewClient(&httd.Config{
+		APIVersion:                   constant.DiscordVersion,
+		BotToken:                     conf.BotToken,
+		UserAgentSourceURL:           constant.GitHubURL,
+		UserAgentVersion:             constant.Version,
+		UserAgentExtra:               conf.ProjectName,
+		HttpClient:                   conf.HTTPClient,
+		CancelRequestWhenRateLimited: conf.CancelRequestWhenRateLimited,
+		RESTBucketManager:            conf.RESTBucketManager,
+		OnRateLimited:                conf.OnRateLimited,
+		RequestsPerSecond:            conf.RESTRequestsPerSecond,
+		MaxConcurrentRequests:        conf.RESTMaxConcurrentRequests,
+		Timeout:                      restTimeout(conf.RESTTimeout),
+		BaseURL:                      conf.RESTBaseURL,
+		ProxyRateLimits:              conf.RESTProxyRateLimits,
+		CompressRequestsAbove:        conf.RESTCompressRequestsAbove,
+	})
+}
+
+// NewClient creates a new Disgord Client and returns an error on configuration issues
+func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
+	httdClient, err := newRequester(conf)
+	if err != nil {
+		return nil, err
+	}
 	if conf.HttpClient == nil {
 		if conf.HTTPClient != nil {
 			conf.HttpClient = conf.HTTPClient
@@ -87,7 +132,7 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 		conf.DMIntents |= conf.Intents
 	}
 
-	const DMIntents = IntentDirectMessageReactions | IntentDirectMessages | IntentDirectMessageTyping
+	const DMIntents = IntentDirectMessageReactions | IntentDirectMessages | IntentDirectMessageTyping | IntentDirectMessagePolls
 	if validRange := conf.DMIntents & DMIntents; (conf.DMIntents ^ validRange) > 0 {
 		return nil, errors.New("you have specified intents that are not for DM usage. See documentation")
 	}
@@ -117,20 +162,6 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 		conf.RejectEvents = append(conf.RejectEvents, eventName)
 	}
 
-	httdClient, err := httd.NewClient(&httd.Config{
-		APIVersion:                   constant.DiscordVersion,
-		BotToken:                     conf.BotToken,
-		UserAgentSourceURL:           constant.GitHubURL,
-		UserAgentVersion:             constant.Version,
-		UserAgentExtra:               conf.ProjectName,
-		HttpClient:                   conf.HTTPClient,
-		CancelRequestWhenRateLimited: conf.CancelRequestWhenRateLimited,
-		RESTBucketManager:            conf.RESTBucketManager,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	if conf.ProjectName == "" {
 		conf.ProjectName = LibraryInfo()
 	}
@@ -157,6 +188,23 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 
 	// event dispatcher
 	dispatch := newDispatcher()
+	if err = dispatch.setPools(conf.DispatchPools); err != nil {
+		return nil, err
+	}
+	dispatch.onHandlerError = conf.OnHandlerError
+	dispatch.unknownEvents = conf.UnknownEvents
+	dispatch.suspension.bufferSize = conf.SuspendBufferSize
+	if conf.PoolEvents {
+		dispatch.events = newEventPools()
+		if user, ok := cache.(eventPoolUser); ok {
+			user.usePools(dispatch.events)
+		}
+	}
+
+	logs, err := newSubsystemLoggers(conf.Logger, conf.LogLevels)
+	if err != nil {
+		return nil, err
+	}
 
 	// create a disgord Client/instance/session
 	c = &Client{
@@ -168,13 +216,22 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 		req:                 httdClient,
 		cache:               cache,
 		log:                 conf.Logger,
+		logs:                logs,
 		pool:                newPools(),
+		allowedMentions:     conf.AllowedMentions,
 		eventChan:           evtChan,
 	}
 	c.handlers.c = c // parent reference
 	c.dispatcher.addSessionInstance(c)
 	c.clientQueryBuilder.client = c
 	c.voiceRepository = newVoiceRepository(c)
+	if conf.OnUnknownField != nil {
+		c.unknownFields = newUnknownFields(conf.OnUnknownField)
+	}
+	if conf.EventSink != nil {
+		c.forwarder = newEventForwarder(conf.EventSink, conf.EventSinkBufferSize, c.subsystemLog(LogGateway))
+		go c.forwarder.run(c.shutdownChan)
+	}
 
 	// this external requests ensures two things:
 	//  - the bot token is valid (a disgord instance is locked to a bot token)
@@ -193,6 +250,27 @@ func createClient(ctx context.Context, conf *Config) (c *Client, err error) {
 
 type ShardConfig = gateway.ShardConfig
 
+// ShardCoordinator divides the shards between multiple processes of a bot, see ShardConfig.Coordinator.
+// A Redis implementation can be found in the std package.
+type ShardCoordinator = gateway.ShardCoordinator
+type ShardHealth = gateway.ShardHealth
+
+// SessionStore persists gateway sessions such that they can be resumed after a restart, see
+// ShardConfig.SessionStore. File and Redis implementations can be found in the std package.
+type SessionStore = gateway.SessionStore
+type GatewaySession = gateway.Session
+
+// WebsocketConn is a websocket implementation, see Config.NewWebsocket. Read must return a *WebsocketCloseError
+// when Discord closes the connection, such that the close code can be handled.
+type WebsocketConn = gateway.WebsocketConn
+type WebsocketCloseError = gateway.WebsocketCloseError
+type WebsocketMessageType = gateway.WebsocketMessageType
+
+const (
+	WebsocketMessageText   = gateway.WebsocketMessageText
+	WebsocketMessageBinary = gateway.WebsocketMessageBinary
+)
+
 type HttpClientDoer interface {
 	Do(req *http.Request) (*http.Response, error)
 }
@@ -217,6 +295,11 @@ type Config struct {
 	HttpClient          HttpClientDoer
 	WebsocketHttpClient *http.Client // no way around this, sadly. At least for now.
 
+	// NewWebsocket creates a websocket implementation for every gateway and voice connection. Defaults to
+	// nhooyr.io/websocket using the WebsocketHttpClient. Can be used to provide a different websocket library,
+	// or a fake gateway in tests.
+	NewWebsocket func() WebsocketConn
+
 	// Deprecated: use WebsocketHttpClient and HttpClient
 	HTTPClient *http.Client
 
@@ -234,6 +317,23 @@ type Config struct {
 	// You can see sent intents by enabling debug logging. Remember that derived from RejectIntents are appended.
 	DMIntents Intent
 
+	// GatewayIntents replaces the intents derived from DMIntents and RejectEvents, such that only the given
+	// intents are sent when connecting. See NewIntents.
+	GatewayIntents Intent
+
+	// DeriveIntents adds the intents needed by the registered handlers, and IntentGuilds for the cache, to
+	// GatewayIntents when connecting. Handlers must be registered before calling Connect. Voice connections
+	// also require IntentGuildVoiceStates.
+	DeriveIntents bool
+
+	// StrictIntents makes Connect fail when a handler is registered for an event that is rejected, or that
+	// can not be received with the configured intents. Otherwise a warning is logged, see OnIntentWarning.
+	StrictIntents bool
+
+	// OnIntentWarning is called when handlers are registered for events that the intents will not deliver,
+	// and when discord rejects the privileged intents of the application. The warnings are logged regardless.
+	OnIntentWarning func(warning *IntentWarning)
+
 	// your project name, name of bot, or application
 	ProjectName string
 
@@ -242,8 +342,56 @@ type Config struct {
 	// AlwaysParseChannelMentions bool
 	// TODO
 
+	// CancelRequestWhenRateLimited makes REST requests fail with ErrRateLimited instead of waiting for the
+	// rate limit to reset. Can be overridden per request, see WithRateLimitPolicy.
 	CancelRequestWhenRateLimited bool
 
+	// OnRateLimited is called whenever Discord responds to a REST request with a http 429, and tells the
+	// scope of the rate limit and how long to wait. It must not block.
+	OnRateLimited func(hit *RateLimitHit)
+
+	// RESTRequestsPerSecond caps the REST requests sent per second across every route, so bursts from many
+	// routes do not trip the global rate limit. Defaults to 50, which is the global rate limit of most bots.
+	// A negative value disables the cap.
+	RESTRequestsPerSecond int
+
+	// RESTMaxConcurrentRequests caps the number of REST requests in flight. 0 means no cap.
+	RESTMaxConcurrentRequests int
+
+	// RESTTimeout guarantees that no REST request hangs forever, even when the context has no deadline.
+	// It includes the time spent waiting for rate limits and retries. Defaults to DefaultRESTTimeout,
+	// while a negative value disables the timeout. Can be overridden per request, see WithTimeout.
+	RESTTimeout time.Duration
+
+	// RESTReactiveRateLimits sends REST requests even when their rate limit bucket has no remaining requests,
+	// such that they only wait after Discord responds with a http 429. By default, requests wait for the
+	// bucket to reset before they are sent. Ignored when RESTBucketManager is set.
+	RESTReactiveRateLimits bool
+
+	// RESTRateLimitMargin is added to the wait for a rate limit bucket to reset, to make up for latency and
+	// clock drift. Ignored when RESTBucketManager is set.
+	RESTRateLimitMargin time.Duration
+
+	// RESTBaseURL routes the REST requests through an API proxy or a mock server instead of Discord. It
+	// excludes the API version, eg. "http://localhost:8080/api".
+	RESTBaseURL string
+
+	// RESTProxyRateLimits tells that the API proxy at RESTBaseURL respects the rate limits, such as a
+	// centralised rate limit proxy shared by many processes. Requests are then sent without waiting for
+	// their rate limit bucket to reset.
+	RESTProxyRateLimits bool
+
+	// RESTCompressRequestsAbove gzip compresses the json body of REST requests larger than the given number of
+	// bytes, such as bulk command registrations. 0 disables the compression. Can be overridden per request,
+	// see WithCompression.
+	RESTCompressRequestsAbove int
+
+	// AllowedMentions is the default for every message sent, edited, or used as an interaction response,
+	// which does not set its own allowed mentions. Use it to prevent user controlled content from
+	// pinging everyone or roles.
+	//  AllowedMentions: &disgord.AllowedMentions{Parse: []string{disgord.AllowUserMentions}},
+	AllowedMentions *AllowedMentions
+
 	// LoadMembersQuietly will start fetching members for all Guilds in the background.
 	// There is currently no proper way to detect when the loading is done nor if it
 	// finished successfully.
@@ -259,6 +407,10 @@ type Config struct {
 	// disgord.DefaultLogger() can be used
 	Logger Logger
 
+	// LogLevels sets the initial log level of the subsystems, which log everything by default. The levels
+	// can be changed at runtime, see Client.SetLogLevel.
+	LogLevels map[LogSubsystem]LogLevel
+
 	// ################################################
 	// ##
 	// ## WARNING! For advanced Users only.
@@ -274,6 +426,14 @@ type Config struct {
 	Cache        Cache
 	ShardConfig  ShardConfig
 
+	// GatewayCompression enables zlib-stream transport compression on the gateway connections. The whole
+	// connection is compressed as one stream, which greatly reduces the bandwidth used by large bots.
+	GatewayCompression bool
+
+	// JSON swaps out the JSON implementation, such as encoding/json being replaced by a faster drop in
+	// replacement. The implementation is shared by every client in the process, see json.Use.
+	JSON *json.Codec
+
 	// IgnoreEvents will skip events that matches the given event names.
 	// WARNING! This can break your caching, so be careful about what you want to ignore.
 	//
@@ -284,6 +444,53 @@ type Config struct {
 	IgnoreEvents []string
 
 	RejectEvents []string
+
+	// DispatchPools configures worker pools for dispatching specific event types to their handlers, keyed by
+	// the event name. By default every event is dispatched in its own go routine, which allows a slow handler
+	// to pile up go routines. A pool limits the concurrency of an event type and decides what happens when
+	// the handlers can not keep up.
+	//  DispatchPools: map[string]disgord.DispatchPoolConfig{
+	//      disgord.EvtMessageCreate: {Workers: 8, QueueSize: 100, Overflow: disgord.DispatchOverflowDrop},
+	//  }
+	DispatchPools map[string]DispatchPoolConfig
+
+	// UnknownEvents decides what happens to gateway events that disgord does not support yet. By default they
+	// are only given to the raw handlers, see UnknownEventPolicy.
+	UnknownEvents UnknownEventPolicy
+
+	// OnUnknownField is called for json fields sent by Discord that disgord does not know, and therefore discards,
+	// such that new fields are noticed before they matter. Setting it enables the detection, which compares every
+	// gateway event and REST response with the struct it is decoded into, and is therefore not free. Each unknown
+	// field is only reported once per struct.
+	OnUnknownField func(field *UnknownField)
+
+	// EventSink receives every gateway event, such that the events can be published to a message broker and
+	// consumed by worker services, see std.NATSEventSink and std.KafkaEventSink. The events are still cached
+	// and dispatched to the handlers of the client.
+	EventSink EventSink
+
+	// EventSinkBufferSize is the number of events waiting to be published to the EventSink. Events are dropped
+	// when the sink can not keep up. Defaults to DefaultEventSinkBufferSize.
+	EventSinkBufferSize int
+
+	// SuspendBufferSize is the number of events buffered while the client is suspended, see Client.Suspend.
+	// Events beyond the buffer are dropped.
+	SuspendBufferSize int
+
+	// OnHandlerError is called when an event handler, or middleware, panics. The panic is recovered such that
+	// the remaining handlers still run. Panics are logged as errors when this is not set.
+	OnHandlerError func(evtName string, err error, stack []byte)
+
+	// PoolEvents recycles the structs of MessageCreate, TypingStart and PresenceUpdate events, which reduces
+	// the garbage collection of bots receiving thousands of events per second. Once every handler has
+	// returned, the event is reset and reused for a later event. This adds the following ownership rules:
+	//  - handlers and middlewares must not keep the event, or any of its fields, after returning. Use
+	//    DeepCopy to keep a copy.
+	//  - handlers must not pass the event to another go routine without waiting for it to finish.
+	//  - events sent to channel handlers, streams, handlers with a controller such as Once, and the Await
+	//    helpers are never recycled, as the receiver owns them.
+	// Only caches that embed CacheNop, such as the BasicCache, decode into recycled structs.
+	PoolEvents bool
 }
 
 // Client is the main disgord Client to hold your state and data. You must always initiate it using the constructor
@@ -318,6 +525,21 @@ type Client struct {
 	// req holds the rate limiting logic and error parsing unique for Discord
 	req *httd.Client
 
+	// forwarder publishes the events to Config.EventSink
+	forwarder *eventForwarder
+
+	// unknownEventsLogged holds the names of the unknown events that were logged, see UnknownEventsDrop
+	unknownEventsLogged sync.Map
+
+	// unknownFields detects fields that are not decoded, see Config.OnUnknownField
+	unknownFields *unknownFields
+
+	// pendingRequests counts the REST requests that are waiting or in progress, see Client.Shutdown
+	pendingRequests atomic.Int64
+
+	// shutdownOnce closes the shutdown channels, such that Shutdown can be retried after a timeout
+	shutdownOnce sync.Once
+
 	WebsocketHttpClient *http.Client
 
 	shardManager gateway.ShardManager
@@ -328,7 +550,14 @@ type Client struct {
 
 	cache Cache
 
-	log Logger
+	// requests deduplicates concurrent REST requests caused by cache misses
+	requests requestGroup
+
+	log  Logger
+	logs *subsystemLoggers
+
+	// allowedMentions is the default of messages without allowed mentions, see Config.AllowedMentions
+	allowedMentions *AllowedMentions
 
 	// voice
 	*voiceRepository
@@ -406,6 +635,30 @@ func (c *Client) GetConnectedGuilds() []Snowflake {
 	return guildIDs
 }
 
+// UpdateToken replaces the bot token without restarting, such as when the token is rotated. REST requests use
+// the new token right away, while connected shards close their sessions and identify again with the new token.
+// The new token is redacted from the logs as well.
+func (c *Client) UpdateToken(token string) error {
+	if token == "" {
+		return errors.New("the bot token can not be empty")
+	}
+
+	logger.AddSecret(c.log, token)
+	c.req.UpdateToken(token)
+
+	c.mu.Lock()
+	c.botToken = token
+	c.config.BotToken = token
+	shards := c.shardManager
+	c.mu.Unlock()
+
+	if shards == nil {
+		return nil
+	}
+	c.subsystemLog(LogGateway).Info("Bot token was updated, reconnecting the shards")
+	return shards.UpdateToken(token)
+}
+
 // Logger returns the log instance of Disgord.
 // Note that this instance is never nil. When the conf.Logger is not assigned
 // an empty struct is used instead. Such that all calls are simply discarded at compile time
@@ -424,6 +677,12 @@ func (c *Client) RESTRatelimitBuckets() (group map[string][]string) {
 	return c.req.BucketGrouping()
 }
 
+// RateLimits returns the last known state of every REST rate limit bucket, to find the routes that are
+// saturating. Nil is returned when a custom RESTBucketManager does not implement httd.RateLimitInspector.
+func (c *Client) RateLimits() []RateLimit {
+	return c.req.RateLimits()
+}
+
 // Cache returns the cacheLink manager for the session
 func (c *Client) Cache() Cache {
 	return c.cache
@@ -445,6 +704,7 @@ func (c *Client) setupConnectEnv() {
 	c.Gateway().GuildDelete(c.handlers.deleteGuildID)
 
 	// start demultiplexer which also trigger dispatching
+	c.dispatcher.startPools()
 	go c.demultiplexer(c.dispatcher, c.eventChan)
 }
 
@@ -537,6 +797,12 @@ func (c *Client) EditInteractionResponse(ctx context.Context, interaction *Inter
 
 func (c *Client) SendInteractionResponse(ctx context.Context, interaction *InteractionCreate, data *InteractionResponse) error {
 	endpoint := fmt.Sprintf("/interactions/%d/%s/callback", interaction.ID, interaction.Token)
+	if data != nil && data.Data != nil && data.Data.AllowedMentions == nil && c.allowedMentions != nil {
+		response, callback := *data, *data.Data
+		callback.AllowedMentions = c.allowedMentions
+		response.Data = &callback
+		data = &response
+	}
 	req := &httd.Request{
 		Endpoint:    endpoint,
 		Method:      "POST",
@@ -550,7 +816,7 @@ func (c *Client) SendInteractionResponse(ctx context.Context, interaction *Inter
 
 /* status updates */
 
-// UpdateStatus updates the Client's game status
+// UpdateStatus updates the Client's game status, see NewPresence to build the payload.
 // note: for simple games, check out UpdateStatusString
 func (c *Client) UpdateStatus(s *UpdateStatusPayload) error {
 	_, err := c.Gateway().Dispatch(UpdateStatus, s)
@@ -560,27 +826,29 @@ func (c *Client) UpdateStatus(s *UpdateStatusPayload) error {
 // UpdateStatusString sets the Client's game activity to the provided string, status to online
 // and type to Playing
 func (c *Client) UpdateStatusString(s string) error {
-	updateData := &UpdateStatusPayload{
-		Since: nil,
-		Game: []*Activity{
-			{
-				Name: s,
-				Type: ActivityTypeGame,
-			},
-		},
-		Status: StatusOnline,
-		AFK:    false,
+	return c.UpdateStatus(NewPresence().Playing(s).Build())
+}
+
+// restTimeout applies the default to a configured REST timeout. A negative timeout disables it.
+func restTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return DefaultRESTTimeout
+	}
+	if timeout < 0 {
+		return 0
 	}
-	return c.UpdateStatus(updateData)
+	return timeout
 }
 
-func (c *Client) newRESTRequest(conf *httd.Request, flags []Flag) *rest {
+func (c *Client) newRESTRequest(conf *httd.Request, flags []RequestOption) *rest {
 	r := &rest{
 		c:    c,
 		conf: conf,
 	}
 	r.init()
-	r.flags = mergeFlags(flags)
+	opts := newRequestOptions(flags)
+	opts.apply(conf)
+	r.flags = opts.flags
 
 	return r
 }
@@ -0,0 +1,206 @@
+package cmdkit
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Vedza/disgord"
+)
+
+var snowflakeType = reflect.TypeOf(disgord.Snowflake(0))
+
+// maxExactFloat is the largest integer a float64 holds without losing precision
+const maxExactFloat = 1 << 53
+
+// optionName returns the option name a struct field binds to, or "" when the field is skipped.
+func optionName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return "" // unexported
+	}
+	tag := field.Tag.Get("option")
+	if tag == "-" {
+		return ""
+	}
+	if tag != "" {
+		return tag
+	}
+	return strings.ToLower(field.Name)
+}
+
+func validateArgs(t reflect.Type) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if optionName(field) == "" {
+			continue
+		}
+
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if !bindable(ft) {
+			return fmt.Errorf("field %s.%s of type %s can not be bound to an option", t.Name(), field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+func bindable(t reflect.Type) bool {
+	if t == snowflakeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// bind populates the struct v using the given options.
+func bind(v reflect.Value, options []*disgord.ApplicationCommandInteractionDataOption) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := optionName(field)
+		if name == "" {
+			continue
+		}
+
+		var opt *disgord.ApplicationCommandInteractionDataOption
+		for _, o := range options {
+			if o.Name == name {
+				opt = o
+				break
+			}
+		}
+		if opt == nil || opt.Value == nil {
+			continue
+		}
+
+		dst := v.Field(i)
+		if dst.Kind() == reflect.Ptr {
+			dst.Set(reflect.New(dst.Type().Elem()))
+			dst = dst.Elem()
+		}
+		if err := setValue(dst, opt.Value); err != nil {
+			return fmt.Errorf("cmdkit: unable to bind option %q to %s.%s: %w", name, t.Name(), field.Name, err)
+		}
+	}
+	return nil
+}
+
+func setValue(dst reflect.Value, value interface{}) error {
+	if dst.Type() == snowflakeType {
+		// snowflakes exceed the precision of a float64, so they must be sent as strings
+		var raw string
+		switch x := value.(type) {
+		case string:
+			raw = x
+		case json.Number:
+			raw = x.String()
+		default:
+			return fmt.Errorf("unexpected value type %T for a snowflake, expected a string", value)
+		}
+		id, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid snowflake %q: %w", raw, err)
+		}
+		dst.Set(reflect.ValueOf(disgord.Snowflake(id)))
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		switch x := value.(type) {
+		case string:
+			dst.SetString(x)
+		case float64:
+			dst.SetString(strconv.FormatFloat(x, 'f', -1, 64))
+		case bool:
+			dst.SetString(strconv.FormatBool(x))
+		default:
+			return fmt.Errorf("unexpected value type %T for a string", value)
+		}
+	case reflect.Bool:
+		x, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected value type %T for a bool", value)
+		}
+		dst.SetBool(x)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var x int64
+		if f, ok := value.(float64); ok {
+			if f != math.Trunc(f) || math.Abs(f) > maxExactFloat {
+				return fmt.Errorf("value %v does not fit in %s", f, dst.Type())
+			}
+			x = int64(f)
+		} else if raw, ok := numberString(value); ok {
+			var err error
+			if x, err = strconv.ParseInt(raw, 10, 64); err != nil {
+				return err
+			}
+		} else {
+			return fmt.Errorf("unexpected value type %T for a number", value)
+		}
+		if dst.OverflowInt(x) {
+			return fmt.Errorf("value %v does not fit in %s", x, dst.Type())
+		}
+		dst.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var x uint64
+		if f, ok := value.(float64); ok {
+			if f < 0 || f != math.Trunc(f) || f > maxExactFloat {
+				return fmt.Errorf("value %v does not fit in %s", f, dst.Type())
+			}
+			x = uint64(f)
+		} else if raw, ok := numberString(value); ok {
+			var err error
+			if x, err = strconv.ParseUint(raw, 10, 64); err != nil {
+				return err
+			}
+		} else {
+			return fmt.Errorf("unexpected value type %T for a number", value)
+		}
+		if dst.OverflowUint(x) {
+			return fmt.Errorf("value %v does not fit in %s", x, dst.Type())
+		}
+		dst.SetUint(x)
+	case reflect.Float32, reflect.Float64:
+		x, err := toFloat(value)
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(x)
+	default:
+		return fmt.Errorf("unsupported field type %s", dst.Type())
+	}
+	return nil
+}
+
+func toFloat(value interface{}) (float64, error) {
+	if x, ok := value.(float64); ok {
+		return x, nil
+	}
+	if raw, ok := numberString(value); ok {
+		return strconv.ParseFloat(raw, 64)
+	}
+	return 0, fmt.Errorf("unexpected value type %T for a number", value)
+}
+
+// numberString returns the textual form of numbers that were decoded as a string or json.Number.
+func numberString(value interface{}) (string, bool) {
+	switch x := value.(type) {
+	case string:
+		return x, true
+	case json.Number:
+		return x.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,251 @@
+// Package cmdkit is a small command framework on top of disgord's interaction events. It routes
+// slash command interactions to handlers by command name, sub command group and sub command, and
+// binds the command options into a user defined struct.
+//
+//  router := cmdkit.New()
+//  router.Handle("ban", func(ctx *cmdkit.Context, args BanArgs) error { ... })
+//  router.Handle("config prefix set", func(ctx *cmdkit.Context, args PrefixArgs) error { ... })
+//
+//  client.Gateway().InteractionCreate(router.InteractionCreate)
+package cmdkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Vedza/disgord"
+)
+
+// ErrUnknownCommand is given to the error handler when no handler is registered for the
+// incoming command path.
+var ErrUnknownCommand = errors.New("cmdkit: unknown command")
+
+// Context holds the interaction that triggered a command handler.
+type Context struct {
+	context.Context
+	Session     disgord.Session
+	Interaction *disgord.InteractionCreate
+
+	// Path is the resolved command path, eg. "config prefix set".
+	Path string
+
+	// Options holds the options given to the resolved (sub) command.
+	Options []*disgord.ApplicationCommandInteractionDataOption
+}
+
+// Option returns the option with the given name for the resolved (sub) command, or nil.
+func (c *Context) Option(name string) *disgord.ApplicationCommandInteractionDataOption {
+	for _, opt := range c.Options {
+		if opt.Name == name {
+			return opt
+		}
+	}
+	return nil
+}
+
+// Locale returns the locale of the user that invoked the command, falling back to the
+// guild locale when the user locale is unknown.
+func (c *Context) Locale() disgord.Locale {
+	if c.Interaction.Locale != "" {
+		return c.Interaction.Locale
+	}
+	return c.Interaction.GuildLocale
+}
+
+// Reply responds to the interaction with a channel message.
+func (c *Context) Reply(data *disgord.InteractionApplicationCommandCallbackData) error {
+	return c.Session.SendInteractionResponse(c, c.Interaction, &disgord.InteractionResponse{
+		Type: disgord.ChannelMessageWithSource,
+		Data: data,
+	})
+}
+
+// ErrorHandler is called whenever a command handler returns an error, panics with an error,
+// or when a command can not be routed.
+type ErrorHandler = func(ctx *Context, err error)
+
+type handler struct {
+	fn      reflect.Value
+	argType reflect.Type // nil when the handler takes no arguments
+	argPtr  bool
+}
+
+var (
+	contextType = reflect.TypeOf(&Context{})
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func newHandler(fn interface{}) (*handler, error) {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func {
+		return nil, fmt.Errorf("handler must be a function, got %s", t)
+	}
+	if t.NumOut() != 1 || t.Out(0) != errorType {
+		return nil, fmt.Errorf("handler must return exactly one error, got %s", t)
+	}
+	if t.NumIn() < 1 || t.NumIn() > 2 || t.In(0) != contextType {
+		return nil, fmt.Errorf("handler must be func(*cmdkit.Context) error or func(*cmdkit.Context, Args) error, got %s", t)
+	}
+
+	h := &handler{fn: v}
+	if t.NumIn() == 2 {
+		arg := t.In(1)
+		if arg.Kind() == reflect.Ptr {
+			h.argPtr = true
+			arg = arg.Elem()
+		}
+		if arg.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("handler arguments must be a struct or a struct pointer, got %s", t.In(1))
+		}
+		if err := validateArgs(arg); err != nil {
+			return nil, err
+		}
+		h.argType = arg
+	}
+	return h, nil
+}
+
+func (h *handler) call(ctx *Context) error {
+	in := []reflect.Value{reflect.ValueOf(ctx)}
+	if h.argType != nil {
+		args := reflect.New(h.argType)
+		if err := bind(args.Elem(), ctx.Options); err != nil {
+			return err
+		}
+		if !h.argPtr {
+			args = args.Elem()
+		}
+		in = append(in, args)
+	}
+
+	out := h.fn.Call(in)
+	if err, ok := out[0].Interface().(error); ok {
+		return err
+	}
+	return nil
+}
+
+// New creates a new, empty, command router.
+func New() *Router {
+	return &Router{
+		handlers: make(map[string]*handler),
+	}
+}
+
+// Router routes application command interactions to the registered handlers.
+type Router struct {
+	sync.RWMutex
+	handlers map[string]*handler
+	onError  ErrorHandler
+}
+
+// Handle registers a handler for the given command path. The path is the command name,
+// optionally followed by the sub command group and/or the sub command, separated by spaces:
+//  "ping", "config reset", "config prefix set"
+//
+// The handler must be of the form func(*cmdkit.Context) error, or func(*cmdkit.Context, Args) error
+// where Args is a struct (or struct pointer) whose fields are populated from the command options.
+// Fields are matched to options using the `option:"name"` tag, or the lower cased field name
+// when no tag is given. Pointer fields are left nil when the option was not supplied.
+// Handle panics on an invalid path or handler signature.
+func (r *Router) Handle(path string, fn interface{}) {
+	key := normalizePath(path)
+	if key == "" || len(strings.Split(key, " ")) > 3 {
+		panic(fmt.Sprintf("cmdkit: invalid command path %q", path))
+	}
+
+	h, err := newHandler(fn)
+	if err != nil {
+		panic(fmt.Sprintf("cmdkit: %q: %s", path, err.Error()))
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if _, exists := r.handlers[key]; exists {
+		panic(fmt.Sprintf("cmdkit: a handler for %q is already registered", path))
+	}
+	r.handlers[key] = h
+}
+
+// OnError sets the error handler. By default errors are written to the session logger.
+func (r *Router) OnError(fn ErrorHandler) {
+	r.Lock()
+	r.onError = fn
+	r.Unlock()
+}
+
+// InteractionCreate routes the interaction to the matching command handler. Interactions that
+// are not application commands are ignored. Register it as a disgord.HandlerInteractionCreate:
+//  client.Gateway().InteractionCreate(router.InteractionCreate)
+func (r *Router) InteractionCreate(s disgord.Session, evt *disgord.InteractionCreate) {
+	if evt == nil || evt.Type != disgord.InteractionApplicationCommand || evt.Data == nil {
+		return
+	}
+
+	path, options := resolve(evt.Data)
+	ctx := &Context{
+		Context:     context.Background(),
+		Session:     s,
+		Interaction: evt,
+		Path:        path,
+		Options:     options,
+	}
+
+	r.RLock()
+	h, ok := r.handlers[path]
+	r.RUnlock()
+	if !ok {
+		r.handleErr(ctx, fmt.Errorf("%w: %s", ErrUnknownCommand, path))
+		return
+	}
+
+	if err := r.call(h, ctx); err != nil {
+		r.handleErr(ctx, err)
+	}
+}
+
+func (r *Router) call(h *handler, ctx *Context) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("cmdkit: handler for %q panicked: %v", ctx.Path, p)
+		}
+	}()
+	return h.call(ctx)
+}
+
+func (r *Router) handleErr(ctx *Context, err error) {
+	r.RLock()
+	onError := r.onError
+	r.RUnlock()
+
+	if onError != nil {
+		onError(ctx, err)
+	} else if ctx.Session != nil {
+		ctx.Session.Logger().Error("cmdkit:", ctx.Path, err)
+	}
+}
+
+// resolve walks down the sub command groups and sub commands, and returns the
+// command path along with the options of the deepest command.
+func resolve(data *disgord.ApplicationCommandInteractionData) (path string, options []*disgord.ApplicationCommandInteractionDataOption) {
+	segments := []string{strings.ToLower(data.Name)}
+	options = data.Options
+	for len(options) == 1 {
+		opt := options[0]
+		if opt.Type != disgord.SUB_COMMAND_GROUP && opt.Type != disgord.SUB_COMMAND {
+			break
+		}
+		segments = append(segments, strings.ToLower(opt.Name))
+		options = opt.Options
+	}
+	return strings.Join(segments, " "), options
+}
+
+func normalizePath(path string) string {
+	return strings.ToLower(strings.Join(strings.Fields(path), " "))
+}
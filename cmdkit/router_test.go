@@ -0,0 +1,192 @@
+// +build !integration
+
+package cmdkit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Vedza/disgord"
+)
+
+func command(name string, options ...*disgord.ApplicationCommandInteractionDataOption) *disgord.InteractionCreate {
+	return &disgord.InteractionCreate{
+		Type: disgord.InteractionApplicationCommand,
+		Data: &disgord.ApplicationCommandInteractionData{
+			Name:    name,
+			Options: options,
+		},
+	}
+}
+
+func sub(t disgord.OptionType, name string, options ...*disgord.ApplicationCommandInteractionDataOption) *disgord.ApplicationCommandInteractionDataOption {
+	return &disgord.ApplicationCommandInteractionDataOption{Name: name, Type: t, Options: options}
+}
+
+func opt(t disgord.OptionType, name string, value interface{}) *disgord.ApplicationCommandInteractionDataOption {
+	return &disgord.ApplicationCommandInteractionDataOption{Name: name, Type: t, Value: value}
+}
+
+func TestRouter_Routing(t *testing.T) {
+	var called string
+	r := New()
+	r.Handle("ping", func(ctx *Context) error {
+		called = ctx.Path
+		return nil
+	})
+	r.Handle("config reset", func(ctx *Context) error {
+		called = ctx.Path
+		return nil
+	})
+	r.Handle("Config  Prefix set", func(ctx *Context) error {
+		called = ctx.Path
+		return nil
+	})
+
+	testCases := []struct {
+		name string
+		evt  *disgord.InteractionCreate
+		path string
+	}{
+		{"command", command("ping"), "ping"},
+		{"sub command", command("config", sub(disgord.SUB_COMMAND, "reset")), "config reset"},
+		{"sub command group", command("config", sub(disgord.SUB_COMMAND_GROUP, "prefix", sub(disgord.SUB_COMMAND, "set", opt(disgord.STRING, "value", "!")))), "config prefix set"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			called = ""
+			r.InteractionCreate(nil, tc.evt)
+			if called != tc.path {
+				t.Errorf("expected handler for %q to be called, got %q", tc.path, called)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		var routeErr error
+		r.OnError(func(ctx *Context, err error) {
+			routeErr = err
+		})
+		r.InteractionCreate(nil, command("config", sub(disgord.SUB_COMMAND, "unknown")))
+		if !errors.Is(routeErr, ErrUnknownCommand) {
+			t.Errorf("expected ErrUnknownCommand, got %v", routeErr)
+		}
+	})
+
+	t.Run("ignores components", func(t *testing.T) {
+		called = ""
+		evt := command("ping")
+		evt.Type = disgord.InteractionMessageComponent
+		r.InteractionCreate(nil, evt)
+		if called != "" {
+			t.Error("message components should not be routed")
+		}
+	})
+}
+
+type banArgs struct {
+	User    disgord.Snowflake `option:"user"`
+	Reason  *string
+	Days    int `option:"delete_days"`
+	Silent  bool
+	Skipped string `option:"-"`
+}
+
+func TestRouter_Binding(t *testing.T) {
+	var args banArgs
+	r := New()
+	r.Handle("ban", func(ctx *Context, a banArgs) error {
+		args = a
+		return nil
+	})
+	r.Handle("kick", func(ctx *Context, a *banArgs) error {
+		args = *a
+		return nil
+	})
+
+	r.InteractionCreate(nil, command("ban",
+		opt(disgord.USER, "user", "228846961774559232"),
+		opt(disgord.INTEGER, "delete_days", float64(7)),
+		opt(disgord.BOOLEAN, "silent", true),
+		opt(disgord.STRING, "skipped", "nope"),
+	))
+	if args.User != disgord.Snowflake(228846961774559232) {
+		t.Errorf("expected user id to be bound, got %d", args.User)
+	}
+	if args.Reason != nil {
+		t.Error("expected optional reason to be nil")
+	}
+	if args.Days != 7 || !args.Silent {
+		t.Errorf("unexpected bound values %+v", args)
+	}
+	if args.Skipped != "" {
+		t.Error("ignored field was bound")
+	}
+
+	args = banArgs{}
+	r.InteractionCreate(nil, command("kick", opt(disgord.STRING, "reason", "spam")))
+	if args.Reason == nil || *args.Reason != "spam" {
+		t.Error("expected optional reason to be bound")
+	}
+
+	var bindErr error
+	r.OnError(func(ctx *Context, err error) {
+		bindErr = err
+	})
+	r.InteractionCreate(nil, command("ban", opt(disgord.INTEGER, "delete_days", 1.5)))
+	if bindErr == nil {
+		t.Error("expected an error when binding a fraction to an int")
+	}
+
+	// 2^53 + 1 can not be represented by a float64
+	bindErr = nil
+	r.InteractionCreate(nil, command("ban", opt(disgord.USER, "user", float64(9007199254740993))))
+	if bindErr == nil {
+		t.Error("expected an error when binding a float64 to a snowflake")
+	}
+
+	bindErr, args = nil, banArgs{}
+	r.InteractionCreate(nil, command("ban", opt(disgord.USER, "user", json.Number("9007199254740993"))))
+	if bindErr != nil || args.User != disgord.Snowflake(9007199254740993) {
+		t.Errorf("expected a json.Number to be bound without losing precision. Got %d, %v", args.User, bindErr)
+	}
+
+	r.InteractionCreate(nil, command("ban", opt(disgord.USER, "user", "not a snowflake")))
+	if bindErr == nil {
+		t.Error("expected an error when binding an invalid snowflake")
+	}
+}
+
+func TestRouter_Handle(t *testing.T) {
+	invalid := map[string]interface{}{
+		"not a func":    "ping",
+		"no error":      func(ctx *Context) {},
+		"no context":    func() error { return nil },
+		"non-struct":    func(ctx *Context, s string) error { return nil },
+		"bad field":     func(ctx *Context, a struct{ X []string }) error { return nil },
+		"too many args": func(ctx *Context, a, b banArgs) error { return nil },
+	}
+	for name, fn := range invalid {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected Handle to panic")
+				}
+			}()
+			New().Handle("ping", fn)
+		})
+	}
+
+	t.Run("duplicate", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected Handle to panic")
+			}
+		}()
+		r := New()
+		r.Handle("ping", func(ctx *Context) error { return nil })
+		r.Handle("PING", func(ctx *Context) error { return nil })
+	})
+}
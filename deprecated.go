@@ -1,54 +1,56 @@
 package disgord
 
 // Deprecated: use UpdateBuilder
-func (m messageQueryBuilder) Update(flags ...Flag) (builder *updateMessageBuilder) {
+func (m messageQueryBuilder) Update(flags ...RequestOption) (builder *updateMessageBuilder) {
 	return m.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (g guildMemberQueryBuilder) Update(flags ...Flag) UpdateGuildMemberBuilder {
+func (g guildMemberQueryBuilder) Update(flags ...RequestOption) UpdateGuildMemberBuilder {
 	return g.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (g guildQueryBuilder) Update(flags ...Flag) UpdateGuildBuilder { return g.UpdateBuilder(flags...) }
+func (g guildQueryBuilder) Update(flags ...RequestOption) UpdateGuildBuilder {
+	return g.UpdateBuilder(flags...)
+}
 
 // Deprecated: use UpdateBuilder
-func (g guildEmojiQueryBuilder) Update(flags ...Flag) UpdateGuildEmojiBuilder {
+func (g guildEmojiQueryBuilder) Update(flags ...RequestOption) UpdateGuildEmojiBuilder {
 	return g.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (c channelQueryBuilder) Update(flags ...Flag) (builder *updateChannelBuilder) {
+func (c channelQueryBuilder) Update(flags ...RequestOption) (builder *updateChannelBuilder) {
 	return c.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (guildQueryBuilderNop) Update(flags ...Flag) UpdateGuildBuilder {
+func (guildQueryBuilderNop) Update(flags ...RequestOption) UpdateGuildBuilder {
 	return nil
 }
 
 // Deprecated: use UpdateBuilder
-func (currentUserQueryBuilderNop) Update(_ ...Flag) UpdateCurrentUserBuilder {
+func (currentUserQueryBuilderNop) Update(_ ...RequestOption) UpdateCurrentUserBuilder {
 	return nil
 }
 
 // Deprecated: use UpdateBuilder
-func (g guildRoleQueryBuilder) Update(flags ...Flag) UpdateGuildRoleBuilder {
+func (g guildRoleQueryBuilder) Update(flags ...RequestOption) UpdateGuildRoleBuilder {
 	return g.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (c currentUserQueryBuilder) Update(flags ...Flag) UpdateCurrentUserBuilder {
+func (c currentUserQueryBuilder) Update(flags ...RequestOption) UpdateCurrentUserBuilder {
 	return c.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (w webhookQueryBuilder) Update(flags ...Flag) (builder *updateWebhookBuilder) {
+func (w webhookQueryBuilder) Update(flags ...RequestOption) (builder *updateWebhookBuilder) {
 	return w.UpdateBuilder(flags...)
 }
 
 // Deprecated: use UpdateBuilder
-func (w webhookWithTokenQueryBuilder) Update(flags ...Flag) (builder *updateWebhookBuilder) {
+func (w webhookWithTokenQueryBuilder) Update(flags ...RequestOption) (builder *updateWebhookBuilder) {
 	return w.UpdateBuilder(flags...)
 }
@@ -142,6 +142,10 @@ func (g *mockerWSReceiveOnly) Close() (err error) {
 	return
 }
 
+func (g *mockerWSReceiveOnly) CloseResumable() (err error) {
+	return
+}
+
 func (g *mockerWSReceiveOnly) Read(ctx context.Context) (packet []byte, err error) {
 	packet = <-g.reading
 	return
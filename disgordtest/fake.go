@@ -0,0 +1,354 @@
+// Package disgordtest provides a fake Disgord client for unit testing bots, without network access.
+//
+//  func TestPing(t *testing.T) {
+//      fake := disgordtest.New(t, disgord.Config{})
+//      fake.Gateway().MessageCreate(ping) // the handler under test
+//
+//      fake.Emit(&disgord.MessageCreate{Message: &disgord.Message{ChannelID: 1, Content: "!ping"}})
+//
+//      msgs := fake.Messages(1)
+//      if len(msgs) != 1 || msgs[0].Content != "pong" {
+//          t.Errorf("expected a pong, got %+v", msgs)
+//      }
+//  }
+package disgordtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// BotID is the id of the bot user of a Fake.
+const BotID disgord.Snowflake = 1
+
+// EmitTimeout is how long Emit waits for the handlers to finish.
+var EmitTimeout = 5 * time.Second
+
+// Request is a REST request sent by the client. The path excludes the API version, eg. "/channels/1/messages".
+type Request struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// Decode unmarshals the json body into v. For multipart bodies, the json payload is used.
+func (r *Request) Decode(v interface{}) error {
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return json.Unmarshal(r.Body, v)
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(r.Body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return err
+		}
+		if part.FormName() == "payload_json" {
+			data, err := ioutil.ReadAll(part)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(data, v)
+		}
+	}
+}
+
+// Response is the reply to a REST request, see Fake.Respond.
+type Response struct {
+	Status int
+	Body   interface{} // marshalled as json, unless it is a []byte or string
+}
+
+// Fake is a disgord client which records the REST requests instead of sending them to Discord, and dispatches
+// the events given to Emit. It embeds a real *disgord.Client, such that it can be given to code expecting a
+// disgord.Session, and handlers registered through Gateway() run as they would in production.
+//
+// Requests are answered by the responses given to Respond. Without a response, the bot user is returned for
+// "/users/@me", created messages are echoed back with an id, and anything else is answered with a 404.
+type Fake struct {
+	*disgord.Client
+
+	t         testing.TB
+	mu        sync.Mutex
+	requests  []*Request
+	responses map[string]func(req *Request) *Response
+	lastID    disgord.Snowflake
+}
+
+var _ disgord.Session = (*Fake)(nil)
+
+// New creates a Fake client. The config is used as is, except for the HTTP client which is replaced. The client
+// is not connected to the gateway, so Dispatch and voice connections fail.
+func New(t testing.TB, conf disgord.Config) *Fake {
+	t.Helper()
+
+	f := &Fake{
+		t:         t,
+		responses: map[string]func(req *Request) *Response{},
+		lastID:    BotID,
+	}
+	if conf.BotToken == "" {
+		conf.BotToken = "disgordtest"
+	}
+	if conf.RESTRequestsPerSecond == 0 {
+		conf.RESTRequestsPerSecond = -1
+	}
+	conf.HTTPClient = &http.Client{Transport: f}
+	conf.HttpClient = nil
+
+	client, err := disgord.NewClient(context.Background(), conf)
+	if err != nil {
+		t.Fatal("disgordtest: unable to create the client: ", err)
+	}
+	f.Client = client
+
+	// the bot user is fetched when creating the client, which is not interesting to the test
+	f.Reset()
+	return f
+}
+
+// Respond sets the response of the requests with the given method and path, eg. Respond("GET", "/channels/1", ...).
+func (f *Fake) Respond(method, path string, status int, body interface{}) {
+	f.RespondFunc(method, path, func(_ *Request) *Response {
+		return &Response{Status: status, Body: body}
+	})
+}
+
+// RespondFunc sets a function which creates the responses of the requests with the given method and path.
+func (f *Fake) RespondFunc(method, path string, respond func(req *Request) *Response) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[method+" "+path] = respond
+}
+
+// Requests returns the REST requests sent so far, in the order they were sent.
+func (f *Fake) Requests() []*Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*Request(nil), f.requests...)
+}
+
+// Reset forgets the recorded requests. The responses are kept.
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = nil
+}
+
+// Messages returns the messages created in the channel, in the order they were sent.
+func (f *Fake) Messages(channelID disgord.Snowflake) (msgs []*disgord.CreateMessageParams) {
+	f.t.Helper()
+
+	path := "/channels/" + channelID.String() + "/messages"
+	for _, req := range f.Requests() {
+		if req.Method != http.MethodPost || req.Path != path {
+			continue
+		}
+		msg := &disgord.CreateMessageParams{}
+		if err := req.Decode(msg); err != nil {
+			f.t.Fatal("disgordtest: unable to decode the message: ", err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// Emit dispatches the event, eg. a *disgord.MessageCreate, as if it was received from the gateway, and waits
+// for the handlers to finish. The cache is updated as well.
+func (f *Fake) Emit(evt interface{}) {
+	f.t.Helper()
+
+	envelope, err := newEnvelope(evt)
+	if err != nil {
+		f.t.Fatal("disgordtest: ", err)
+	}
+	data, err := disgord.EncodeEvent(envelope)
+	if err != nil {
+		f.t.Fatal("disgordtest: ", err)
+	}
+	if err = f.DispatchSerialized(data); err != nil {
+		f.t.Fatal("disgordtest: ", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), EmitTimeout)
+	defer cancel()
+	if err = f.Drain(ctx); err != nil {
+		f.t.Fatal("disgordtest: the handlers did not finish: ", err)
+	}
+}
+
+// RoundTrip records the request and replies with the configured response.
+func (f *Fake) RoundTrip(httpReq *http.Request) (*http.Response, error) {
+	req := &Request{
+		Method: httpReq.Method,
+		Path:   httpReq.URL.Path,
+		Header: httpReq.Header.Clone(),
+	}
+	if strings.HasPrefix(req.Path, "/api/v") {
+		if i := strings.Index(req.Path[len("/api/v"):], "/"); i >= 0 {
+			req.Path = req.Path[len("/api/v")+i:]
+		}
+	}
+	if httpReq.Body != nil {
+		body, err := ioutil.ReadAll(httpReq.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	respond, ok := f.responses[req.Method+" "+req.Path]
+	f.mu.Unlock()
+
+	var resp *Response
+	if ok {
+		resp = respond(req)
+	} else {
+		resp = f.defaultResponse(req)
+	}
+	return f.encodeResponse(httpReq, resp)
+}
+
+func (f *Fake) defaultResponse(req *Request) *Response {
+	switch {
+	case req.Method == http.MethodGet && req.Path == "/users/@me":
+		return &Response{Status: http.StatusOK, Body: &disgord.User{ID: BotID, Username: "disgordtest", Bot: true}}
+	case req.Method == http.MethodPost && strings.HasPrefix(req.Path, "/channels/") && strings.HasSuffix(req.Path, "/messages"):
+		params := &disgord.CreateMessageParams{}
+		if err := req.Decode(params); err != nil {
+			return &Response{Status: http.StatusBadRequest, Body: map[string]interface{}{"code": 50035, "message": err.Error()}}
+		}
+		channelID, err := disgord.GetSnowflake(strings.TrimSuffix(strings.TrimPrefix(req.Path, "/channels/"), "/messages"))
+		if err != nil || channelID.IsZero() {
+			return &Response{Status: http.StatusBadRequest, Body: map[string]interface{}{"code": 50035, "message": "invalid channel id"}}
+		}
+
+		f.mu.Lock()
+		f.lastID++
+		id := f.lastID
+		f.mu.Unlock()
+		return &Response{Status: http.StatusOK, Body: &disgord.Message{
+			ID:        id,
+			ChannelID: channelID,
+			Author:    &disgord.User{ID: BotID, Username: "disgordtest", Bot: true},
+			Content:   params.Content,
+			Embeds:    params.Embeds,
+			Tts:       params.Tts,
+		}}
+	default:
+		return &Response{Status: http.StatusNotFound, Body: map[string]interface{}{
+			"code":    0,
+			"message": "disgordtest: no response for " + req.Method + " " + req.Path,
+		}}
+	}
+}
+
+func (f *Fake) encodeResponse(req *http.Request, resp *Response) (*http.Response, error) {
+	var body []byte
+	switch b := resp.Body.(type) {
+	case nil:
+	case []byte:
+		body = b
+	case string:
+		body = []byte(b)
+	default:
+		var err error
+		if body, err = json.Marshal(b); err != nil {
+			return nil, err
+		}
+	}
+
+	status := resp.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		StatusCode:    status,
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// newEnvelope finds the event name from the type, and encodes the event the way Discord sends it.
+func newEnvelope(evt interface{}) (*disgord.EventEnvelope, error) {
+	v := reflect.ValueOf(evt)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, errors.New("the event is nil")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not an event", evt)
+	}
+
+	name := eventName(v.Type().Name())
+	var known bool
+	for _, e := range disgord.AllEvents() {
+		if known = e == name; known {
+			break
+		}
+	}
+	if !known {
+		return nil, fmt.Errorf("%T is not an event", evt)
+	}
+
+	envelope := &disgord.EventEnvelope{Name: name, Received: time.Now()}
+	if shardID := v.FieldByName("ShardID"); shardID.IsValid() {
+		envelope.ShardID = uint(shardID.Uint())
+	}
+
+	// events such as MessageCreate wrap the object Discord sends
+	payload := v.Interface()
+	ptr := reflect.New(v.Type())
+	if _, wraps := ptr.Interface().(json.Unmarshaler); wraps && v.NumField() == 2 {
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name != "ShardID" {
+				payload = v.Field(i).Interface()
+			}
+		}
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	envelope.Data = data
+	return envelope, nil
+}
+
+// eventName converts a type name to the event name, eg. MessageCreate to MESSAGE_CREATE.
+func eventName(typeName string) string {
+	var b strings.Builder
+	for i, r := range typeName {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
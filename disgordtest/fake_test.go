@@ -0,0 +1,89 @@
+// +build !integration
+
+package disgordtest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord"
+)
+
+func TestFake_Emit(t *testing.T) {
+	fake := New(t, disgord.Config{})
+	fake.Gateway().MessageCreate(func(s disgord.Session, h *disgord.MessageCreate) {
+		if h.Message.Content != "!ping" || h.ShardID != 1 {
+			return
+		}
+		if _, err := s.Channel(h.Message.ChannelID).CreateMessage(&disgord.CreateMessageParams{Content: "pong"}); err != nil {
+			t.Error(err)
+		}
+	})
+	deleted := make(chan disgord.Snowflake, 1)
+	fake.Gateway().MessageDelete(func(s disgord.Session, h *disgord.MessageDelete) {
+		deleted <- h.MessageID
+	})
+
+	fake.Emit(&disgord.MessageCreate{Message: &disgord.Message{ID: 10, ChannelID: 2, Content: "!ping"}, ShardID: 1})
+	msgs := fake.Messages(2)
+	if len(msgs) != 1 || msgs[0].Content != "pong" {
+		t.Fatalf("expected a pong. Got %+v", msgs)
+	}
+
+	fake.Emit(disgord.MessageDelete{MessageID: 10, ChannelID: 2})
+	select {
+	case id := <-deleted:
+		if id != 10 {
+			t.Errorf("expected message 10 to be deleted. Got %d", id)
+		}
+	default:
+		t.Error("expected Emit to wait for the handler")
+	}
+}
+
+func TestFake_Respond(t *testing.T) {
+	fake := New(t, disgord.Config{DisableCache: true})
+	fake.Respond(http.MethodGet, "/channels/5", http.StatusOK, &disgord.Channel{ID: 5, Name: "general"})
+
+	channel, err := fake.Channel(5).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channel.Name != "general" {
+		t.Errorf("expected the configured channel. Got %+v", channel)
+	}
+	if _, err = fake.Channel(6).Get(); err == nil {
+		t.Error("expected requests without a response to fail")
+	}
+
+	requests := fake.Requests()
+	if len(requests) != 2 || requests[0].Path != "/channels/5" || requests[1].Path != "/channels/6" {
+		t.Errorf("unexpected requests %+v", requests)
+	}
+}
+
+func TestFake_InvalidChannelID(t *testing.T) {
+	fake := New(t, disgord.Config{DisableCache: true})
+
+	body := strings.NewReader(`{"content":"pong"}`)
+	req, err := http.NewRequest(http.MethodPost, "https://discord.com/api/v10/channels/general/messages", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := fake.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected a bad request for a non-numeric channel id. Got %d", resp.StatusCode)
+	}
+}
+
+func TestEventName(t *testing.T) {
+	if name := eventName("GuildScheduledEventUserAdd"); name != disgord.EvtGuildScheduledEventUserAdd {
+		t.Errorf("unexpected event name %s", name)
+	}
+}
@@ -0,0 +1,134 @@
+package disgordtest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// LoadPayloads reads the gateway events captured in a directory, in the order they were received. Two layouts
+// are understood:
+//  - the packets saved by the disgord_diagnosews build tag: {unix nano}_E_IN_id{shard}_op{op}_s{seq}_{event}.json
+//  - a corpus of numbered packets, such as disgord's testdata: {index}_{op}[_{event}].json
+// Each file holds a gateway packet, {"op": 0, "s": 42, "t": "MESSAGE_CREATE", "d": {...}}. Packets that are not
+// events are skipped, as are outgoing and voice packets. Only diagnose captures hold the time of receipt.
+func LoadPayloads(dir string) ([]*disgord.EventEnvelope, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type capture struct {
+		order int64
+		evt   *disgord.EventEnvelope
+	}
+	var captures []capture
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), ".json")
+		if file.IsDir() || name == file.Name() {
+			continue
+		}
+
+		parts := strings.Split(name, "_")
+		order, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		evt := &disgord.EventEnvelope{Version: disgord.EventEnvelopeVersion}
+		if diagnosed := len(parts) >= 6 && (parts[2] == "IN" || parts[2] == "OUT"); diagnosed {
+			if parts[1] != "E" || parts[2] != "IN" {
+				continue
+			}
+			shardID, _ := strconv.ParseUint(strings.TrimPrefix(parts[3], "id"), 10, 32)
+			evt.ShardID = uint(shardID)
+			evt.Received = time.Unix(0, order)
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var packet struct {
+			Op       int             `json:"op"`
+			Sequence uint            `json:"s"`
+			Name     string          `json:"t"`
+			Data     json.RawMessage `json:"d"`
+		}
+		if err = json.Unmarshal(data, &packet); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		if packet.Op != 0 || packet.Name == "" {
+			continue
+		}
+		evt.Name, evt.Sequence, evt.Data = packet.Name, packet.Sequence, packet.Data
+		captures = append(captures, capture{order: order, evt: evt})
+	}
+
+	sort.SliceStable(captures, func(i, j int) bool {
+		return captures[i].order < captures[j].order
+	})
+	events := make([]*disgord.EventEnvelope, len(captures))
+	for i := range captures {
+		events[i] = captures[i].evt
+	}
+	return events, nil
+}
+
+// ReplayConfig configures Replay.
+type ReplayConfig struct {
+	// Speed scales the time between the events as they were received, such that 2 replays twice as fast.
+	// 0 replays the events without waiting, which is always the case for events without a time of receipt.
+	Speed float64
+}
+
+// Replay dispatches the events through the unmarshalling, caching and dispatching of the client, as if they
+// were received from the gateway, and waits for the handlers to finish. See LoadPayloads.
+func Replay(ctx context.Context, client *disgord.Client, events []*disgord.EventEnvelope, conf ReplayConfig) error {
+	var previous time.Time
+	for _, evt := range events {
+		if conf.Speed > 0 && !previous.IsZero() && evt.Received.After(previous) {
+			delay := time.Duration(float64(evt.Received.Sub(previous)) / conf.Speed)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		previous = evt.Received
+
+		data, err := disgord.EncodeEvent(evt)
+		if err != nil {
+			return err
+		}
+		if err = client.DispatchSerialized(data); err != nil {
+			return err
+		}
+	}
+	return client.Drain(ctx)
+}
+
+// Replay dispatches the events captured in the directory without delays, and waits for the handlers to finish.
+// See LoadPayloads.
+func (f *Fake) Replay(dir string) {
+	f.t.Helper()
+
+	events, err := LoadPayloads(dir)
+	if err != nil {
+		f.t.Fatal("disgordtest: ", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), EmitTimeout)
+	defer cancel()
+	if err = Replay(ctx, f.Client, events, ReplayConfig{}); err != nil {
+		f.t.Fatal("disgordtest: ", err)
+	}
+}
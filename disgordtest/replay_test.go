@@ -0,0 +1,122 @@
+// +build !integration
+
+package disgordtest
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord"
+)
+
+const corpus = "../testdata/v8/phases/startup-smooth-1"
+
+func TestFake_Replay(t *testing.T) {
+	fake := New(t, disgord.Config{})
+
+	var messages int
+	fake.Gateway().MessageCreate(func(s disgord.Session, h *disgord.MessageCreate) {
+		messages++
+	})
+	fake.Replay(corpus)
+
+	if messages != 2 {
+		t.Errorf("expected the two messages of the corpus to be dispatched. Got %d", messages)
+	}
+	if _, err := fake.Cache().GetGuild(486833041486905345); err != nil {
+		t.Errorf("expected the guild to be cached. Got %v", err)
+	}
+}
+
+func TestLoadPayloads(t *testing.T) {
+	events, err := LoadPayloads(corpus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 23 {
+		t.Fatalf("expected 23 events. Got %d", len(events))
+	}
+	if events[0].Name != disgord.EvtReady || events[5].Name != disgord.EvtMessageCreate || events[6].Name != disgord.EvtChannelDelete {
+		t.Errorf("expected the events to be ordered by their index")
+	}
+
+	// captured using the disgord_diagnosews build tag
+	dir, err := ioutil.TempDir("", "disgordtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"2000_E_IN_id3_op0_s2_MESSAGE_DELETE.json":     `{"op":0,"s":2,"t":"MESSAGE_DELETE","d":{"id":"1","channel_id":"2"}}`,
+		"1000_E_IN_id3_op0_s1_TYPING_START.json":       `{"op":0,"s":1,"t":"TYPING_START","d":{"channel_id":"2","user_id":"3"}}`,
+		"1500_E_OUT_id3_op1_s1.json":                   `{"op":1,"d":1}`,
+		"1600_E_IN_id3_op11_s0_EMPTY.json":             `{"op":11}`,
+		"1700_V_IN_id3_op0_s1_VOICE_STATE_UPDATE.json": `{"op":0,"s":1,"t":"VOICE_STATE_UPDATE","d":{}}`,
+	}
+	for name, content := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if events, err = LoadPayloads(dir); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected two events. Got %d", len(events))
+	}
+	if events[0].Name != disgord.EvtTypingStart || events[0].ShardID != 3 || !events[0].Received.Equal(time.Unix(0, 1000)) {
+		t.Errorf("unexpected first event %+v", events[0])
+	}
+	if events[1].Name != disgord.EvtMessageDelete {
+		t.Errorf("unexpected second event %s", events[1].Name)
+	}
+}
+
+func TestReplay_Speed(t *testing.T) {
+	fake := New(t, disgord.Config{})
+	start := time.Now()
+	events := []*disgord.EventEnvelope{
+		{Name: disgord.EvtTypingStart, Received: start, Data: []byte(`{"channel_id":"2","user_id":"3"}`)},
+		{Name: disgord.EvtTypingStart, Received: start.Add(100 * time.Millisecond), Data: []byte(`{"channel_id":"2","user_id":"3"}`)},
+	}
+
+	if err := Replay(context.Background(), fake.Client, events, ReplayConfig{Speed: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected the replay to take half the captured time. Took %s", elapsed)
+	}
+}
+
+// BenchmarkReplay measures the throughput of unmarshalling, caching and dispatching the events of the corpus.
+func BenchmarkReplay(b *testing.B) {
+	events, err := LoadPayloads(corpus)
+	if err != nil {
+		b.Fatal(err)
+	}
+	payloads := make([][]byte, len(events))
+	for i := range events {
+		if payloads[i], err = disgord.EncodeEvent(events[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+	fake := New(b, disgord.Config{})
+	fake.Gateway().MessageCreate(func(s disgord.Session, h *disgord.MessageCreate) {})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err = fake.DispatchSerialized(payloads[i%len(payloads)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err = fake.Drain(context.Background()); err != nil {
+		b.Fatal(err)
+	}
+}
@@ -0,0 +1,158 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+// DispatchOverflow decides what happens to an event when the queue of its dispatch pool is full.
+type DispatchOverflow int
+
+const (
+	// DispatchOverflowBlock waits until the queue has room. Note that this holds back every following
+	// event, regardless of the event type.
+	DispatchOverflowBlock DispatchOverflow = iota
+
+	// DispatchOverflowDrop discards the event.
+	DispatchOverflowDrop
+
+	// DispatchOverflowSpill dispatches the event in a new go routine, outside the pool.
+	DispatchOverflowSpill
+)
+
+// DispatchPoolConfig configures a worker pool for dispatching an event type to its handlers, see
+// Config.DispatchPools. Events of the same type are handled in order when there is only one worker.
+type DispatchPoolConfig struct {
+	// Workers is the number of go routines handling the events. Defaults to 1.
+	Workers int
+
+	// QueueSize is the number of events that can wait for a worker.
+	QueueSize int
+
+	// Overflow decides what happens when the queue is full. Defaults to DispatchOverflowBlock.
+	Overflow DispatchOverflow
+}
+
+func (conf *DispatchPoolConfig) validate() error {
+	if conf.Workers < 0 {
+		return errors.New("the number of workers can not be negative")
+	}
+	if conf.QueueSize < 0 {
+		return errors.New("the queue size can not be negative")
+	}
+	switch conf.Overflow {
+	case DispatchOverflowBlock, DispatchOverflowDrop, DispatchOverflowSpill:
+	default:
+		return fmt.Errorf("unknown overflow policy %d", conf.Overflow)
+	}
+	return nil
+}
+
+type dispatchJob struct {
+	evtName string
+	guildID Snowflake
+	evt     resource
+}
+
+type dispatchPool struct {
+	conf  DispatchPoolConfig
+	queue chan *dispatchJob
+}
+
+func newDispatchPool(conf DispatchPoolConfig) (*dispatchPool, error) {
+	if err := conf.validate(); err != nil {
+		return nil, err
+	}
+	if conf.Workers == 0 {
+		conf.Workers = 1
+	}
+
+	return &dispatchPool{
+		conf:  conf,
+		queue: make(chan *dispatchJob, conf.QueueSize),
+	}, nil
+}
+
+func (p *dispatchPool) start(d *dispatcher) {
+	for i := 0; i < p.conf.Workers; i++ {
+		go func() {
+			for {
+				select {
+				case job := <-p.queue:
+					d.dispatch(job.evtName, job.guildID, job.evt)
+				case <-d.shutdown:
+					return
+				}
+			}
+		}()
+	}
+}
+
+// submit queues the event according to the overflow policy, and reports whether it was dropped.
+func (p *dispatchPool) submit(d *dispatcher, job *dispatchJob) (dropped bool) {
+	select {
+	case p.queue <- job:
+		return false
+	default:
+	}
+
+	switch p.conf.Overflow {
+	case DispatchOverflowDrop:
+		return true
+	case DispatchOverflowSpill:
+		go d.dispatch(job.evtName, job.guildID, job.evt)
+	default:
+		select {
+		case p.queue <- job:
+		case <-d.shutdown:
+		}
+	}
+	return false
+}
+
+// setPools creates the dispatch pools. Must be called before the demultiplexer is started.
+func (d *dispatcher) setPools(pools map[string]DispatchPoolConfig) error {
+	d.pools = make(map[string]*dispatchPool, len(pools))
+	for evtName, conf := range pools {
+		pool, err := newDispatchPool(conf)
+		if err != nil {
+			return fmt.Errorf("dispatch pool for %s: %w", evtName, err)
+		}
+		d.pools[evtName] = pool
+	}
+	return nil
+}
+
+// startPools starts the workers of the pools, unless they are already running.
+func (d *dispatcher) startPools() {
+	d.poolsOnce.Do(func() {
+		for _, pool := range d.pools {
+			pool.start(d)
+		}
+	})
+}
+
+// schedule dispatches the event using the pool of the event type. Events without a pool are
+// dispatched in their own go routine. Events are held back while the dispatcher is suspended.
+func (d *dispatcher) schedule(evtName string, guildID Snowflake, evt resource) {
+	if d.hold(evtName, guildID, evt) {
+		return
+	}
+	d.enqueue(evtName, guildID, evt)
+}
+
+func (d *dispatcher) enqueue(evtName string, guildID Snowflake, evt resource) {
+	pool, ok := d.pools[evtName]
+	if !ok {
+		go d.dispatch(evtName, guildID, evt)
+		return
+	}
+
+	if dropped := pool.submit(d, &dispatchJob{evtName: evtName, guildID: guildID, evt: evt}); dropped {
+		d.pending.Dec()
+		log := logger.With(d.session.Logger(), logger.Field{Key: "event", Value: evtName})
+		log.Debug("dispatch queue is full, event was dropped")
+	}
+}
@@ -0,0 +1,61 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+func TestDispatchPool_Drop(t *testing.T) {
+	d := newDispatcher()
+	d.addSessionInstance(&Client{log: &logger.Empty{}})
+	defer close(d.shutdown)
+
+	err := d.setPools(map[string]DispatchPoolConfig{
+		EvtMessageCreate: {Workers: 1, QueueSize: 1, Overflow: DispatchOverflowDrop},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.startPools()
+
+	started := make(chan bool, 3)
+	release := make(chan bool)
+	err = d.register(EvtMessageCreate, func(_ Session, _ *MessageCreate) {
+		started <- true
+		<-release
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.schedule(EvtMessageCreate, 0, &MessageCreate{})
+	<-started // the worker is busy
+	d.schedule(EvtMessageCreate, 0, &MessageCreate{})
+	d.schedule(EvtMessageCreate, 0, &MessageCreate{}) // the queue is full
+	close(release)
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("the queued event was not dispatched")
+	}
+	select {
+	case <-started:
+		t.Error("expected the third event to be dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDispatchPoolConfig_Validate(t *testing.T) {
+	d := newDispatcher()
+	if err := d.setPools(map[string]DispatchPoolConfig{EvtReady: {Workers: -1}}); err == nil {
+		t.Error("expected an error for a negative number of workers")
+	}
+	if err := d.setPools(map[string]DispatchPoolConfig{EvtReady: {Overflow: 10}}); err == nil {
+		t.Error("expected an error for an unknown overflow policy")
+	}
+}
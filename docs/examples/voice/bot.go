@@ -34,6 +34,9 @@ func main() {
 			_ = voice.StartSpeaking() // Sending a speaking signal is mandatory before sending voice data
 			_ = voice.SendDCA(f)      // Or use voice.SendOpusFrame, this blocks until done sending (realtime audio duration)
 			_ = voice.StopSpeaking()  // Tell Discord we are done sending data.
+
+			// Alternatively, Play handles the speaking state for you and accepts any audio source,
+			// eg. disgord.NewOggOpusAudioSource for .opus files.
 		}
 	})
 }
@@ -0,0 +1,183 @@
+package disgord
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// Embed limits, in characters. See https://discord.com/developers/docs/resources/channel#embed-object-embed-limits
+const (
+	EmbedTitleLimit       = 256
+	EmbedDescriptionLimit = 4096
+	EmbedFieldsLimit      = 25
+	EmbedFieldNameLimit   = 256
+	EmbedFieldValueLimit  = 1024
+	EmbedFooterTextLimit  = 2048
+	EmbedAuthorNameLimit  = 256
+
+	// EmbedTotalLimit is the combined length of the title, description, field names and values, footer
+	// text and author name. It also applies to every embed of a message combined.
+	EmbedTotalLimit = 6000
+)
+
+// EmbedLimitError is returned when an embed exceeds one of the limits Discord enforces.
+type EmbedLimitError struct {
+	Field  string // such as "title" or "fields[2].value"
+	Length int
+	Limit  int
+}
+
+func (e *EmbedLimitError) Error() string {
+	return fmt.Sprintf("embed %s is %d long, which exceeds the limit of %d", e.Field, e.Length, e.Limit)
+}
+
+// Validate checks the embed against the limits Discord enforces, to avoid a request failing with a
+// 400 Bad Request. A *EmbedLimitError is returned for the first limit exceeded.
+func (e *Embed) Validate() error {
+	check := func(field, s string, limit int) error {
+		if length := utf8.RuneCountInString(s); length > limit {
+			return &EmbedLimitError{Field: field, Length: length, Limit: limit}
+		}
+		return nil
+	}
+
+	if err := check("title", e.Title, EmbedTitleLimit); err != nil {
+		return err
+	}
+	if err := check("description", e.Description, EmbedDescriptionLimit); err != nil {
+		return err
+	}
+	if len(e.Fields) > EmbedFieldsLimit {
+		return &EmbedLimitError{Field: "fields", Length: len(e.Fields), Limit: EmbedFieldsLimit}
+	}
+	for i, field := range e.Fields {
+		if field == nil {
+			continue
+		}
+		if err := check(fmt.Sprintf("fields[%d].name", i), field.Name, EmbedFieldNameLimit); err != nil {
+			return err
+		}
+		if err := check(fmt.Sprintf("fields[%d].value", i), field.Value, EmbedFieldValueLimit); err != nil {
+			return err
+		}
+	}
+	if e.Footer != nil {
+		if err := check("footer.text", e.Footer.Text, EmbedFooterTextLimit); err != nil {
+			return err
+		}
+	}
+	if e.Author != nil {
+		if err := check("author.name", e.Author.Name, EmbedAuthorNameLimit); err != nil {
+			return err
+		}
+	}
+
+	if total := e.length(); total > EmbedTotalLimit {
+		return &EmbedLimitError{Field: "total", Length: total, Limit: EmbedTotalLimit}
+	}
+	return nil
+}
+
+// length is the number of characters counted towards EmbedTotalLimit.
+func (e *Embed) length() int {
+	length := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	for _, field := range e.Fields {
+		if field != nil {
+			length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+		}
+	}
+	if e.Footer != nil {
+		length += utf8.RuneCountInString(e.Footer.Text)
+	}
+	if e.Author != nil {
+		length += utf8.RuneCountInString(e.Author.Name)
+	}
+	return length
+}
+
+// EmbedBuilder builds a rich embed, and validates it against the limits Discord enforces.
+//  embed, err := disgord.NewEmbed().
+//      Title("Release").
+//      Description("A new version is out").
+//      Color(0x5865F2).
+//      Field("Version", "v0.30.0", true).
+//      Footer("disgord", "").
+//      Build()
+type EmbedBuilder struct {
+	embed Embed
+}
+
+// NewEmbed creates a builder for a rich embed.
+func NewEmbed() *EmbedBuilder {
+	return &EmbedBuilder{embed: Embed{Type: EmbedTypeRich}}
+}
+
+// Title sets the title.
+func (b *EmbedBuilder) Title(title string) *EmbedBuilder {
+	b.embed.Title = title
+	return b
+}
+
+// Description sets the description.
+func (b *EmbedBuilder) Description(description string) *EmbedBuilder {
+	b.embed.Description = description
+	return b
+}
+
+// URL sets the url the title links to.
+func (b *EmbedBuilder) URL(url string) *EmbedBuilder {
+	b.embed.URL = url
+	return b
+}
+
+// Color sets the color of the left border, as a RGB value such as 0xFF0000.
+func (b *EmbedBuilder) Color(color int) *EmbedBuilder {
+	b.embed.Color = color
+	return b
+}
+
+// Timestamp sets the time shown in the footer.
+func (b *EmbedBuilder) Timestamp(t time.Time) *EmbedBuilder {
+	b.embed.Timestamp = Time{Time: t}
+	return b
+}
+
+// Author sets the author. The url and icon url are optional.
+func (b *EmbedBuilder) Author(name, url, iconURL string) *EmbedBuilder {
+	b.embed.Author = &EmbedAuthor{Name: name, URL: url, IconURL: iconURL}
+	return b
+}
+
+// Footer sets the footer. The icon url is optional.
+func (b *EmbedBuilder) Footer(text, iconURL string) *EmbedBuilder {
+	b.embed.Footer = &EmbedFooter{Text: text, IconURL: iconURL}
+	return b
+}
+
+// Image sets the image. Use "attachment://{filename}" to show an uploaded file.
+func (b *EmbedBuilder) Image(url string) *EmbedBuilder {
+	b.embed.Image = &EmbedImage{URL: url}
+	return b
+}
+
+// Thumbnail sets the thumbnail. Use "attachment://{filename}" to show an uploaded file.
+func (b *EmbedBuilder) Thumbnail(url string) *EmbedBuilder {
+	b.embed.Thumbnail = &EmbedThumbnail{URL: url}
+	return b
+}
+
+// Field adds a field. Inline fields are shown next to each other.
+func (b *EmbedBuilder) Field(name, value string, inline bool) *EmbedBuilder {
+	b.embed.Fields = append(b.embed.Fields, &EmbedField{Name: name, Value: value, Inline: inline})
+	return b
+}
+
+// Build creates the embed, or returns a *EmbedLimitError when the embed exceeds a limit.
+func (b *EmbedBuilder) Build() (*Embed, error) {
+	embed := DeepCopy(&b.embed).(*Embed)
+	if err := embed.Validate(); err != nil {
+		return nil, err
+	}
+	return embed, nil
+}
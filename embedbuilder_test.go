@@ -0,0 +1,70 @@
+// +build !integration
+
+package disgord
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEmbedBuilder(t *testing.T) {
+	builder := NewEmbed().
+		Title("Release").
+		Description("A new version is out").
+		Color(0xFF0000).
+		Field("Version", "v1", true).
+		Footer("disgord", "")
+
+	embed, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if embed.Type != EmbedTypeRich || embed.Title != "Release" || len(embed.Fields) != 1 || embed.Footer.Text != "disgord" {
+		t.Errorf("unexpected embed %+v", embed)
+	}
+
+	builder.Field("changes", "none", false)
+	if len(embed.Fields) != 1 {
+		t.Error("expected the built embed to be a copy")
+	}
+
+	_, err = NewEmbed().Title(strings.Repeat("é", EmbedTitleLimit)).Build()
+	if err != nil {
+		t.Errorf("expected the title limit to be counted in characters. Got %s", err)
+	}
+
+	testCases := []struct {
+		name    string
+		builder *EmbedBuilder
+		field   string
+	}{
+		{"title", NewEmbed().Title(strings.Repeat("a", EmbedTitleLimit+1)), "title"},
+		{"description", NewEmbed().Description(strings.Repeat("a", EmbedDescriptionLimit+1)), "description"},
+		{"field value", NewEmbed().Field("a", "b", false).Field("a", strings.Repeat("a", EmbedFieldValueLimit+1), false), "fields[1].value"},
+		{"footer", NewEmbed().Footer(strings.Repeat("a", EmbedFooterTextLimit+1), ""), "footer.text"},
+		{"total", NewEmbed().Description(strings.Repeat("a", 4000)).Footer(strings.Repeat("a", 2001), ""), "total"},
+	}
+	tooManyFields := NewEmbed()
+	for i := 0; i <= EmbedFieldsLimit; i++ {
+		tooManyFields.Field("a", "b", true)
+	}
+	testCases = append(testCases, struct {
+		name    string
+		builder *EmbedBuilder
+		field   string
+	}{"fields", tooManyFields, "fields"})
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.builder.Build()
+			var limitErr *EmbedLimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("expected a limit error. Got %v", err)
+			}
+			if limitErr.Field != tc.field {
+				t.Errorf("expected the error to be about %s. Got %s", tc.field, limitErr)
+			}
+		})
+	}
+}
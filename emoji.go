@@ -2,6 +2,7 @@ package disgord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -48,10 +49,10 @@ type PartialEmoji = Emoji
 func (e *Emoji) Mention() string {
 	prefix := ""
 	if e.Animated {
-		prefix = "a:"
+		prefix = "a"
 	}
 
-	return "<:" + prefix + e.Name + ":" + e.ID.String() + ">"
+	return "<" + prefix + ":" + e.Name + ":" + e.ID.String() + ">"
 }
 
 //////////////////////////////////////////////////////
@@ -69,12 +70,12 @@ func (e *Emoji) Mention() string {
 type GuildEmojiQueryBuilder interface {
 	WithContext(ctx context.Context) GuildEmojiQueryBuilder
 
-	Get(flags ...Flag) (*Emoji, error)
-	UpdateBuilder(flags ...Flag) UpdateGuildEmojiBuilder
-	Delete(flags ...Flag) error
+	Get(flags ...RequestOption) (*Emoji, error)
+	UpdateBuilder(flags ...RequestOption) UpdateGuildEmojiBuilder
+	Delete(flags ...RequestOption) error
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) UpdateGuildEmojiBuilder
+	Update(flags ...RequestOption) UpdateGuildEmojiBuilder
 }
 
 func (g guildQueryBuilder) Emoji(emojiID Snowflake) GuildEmojiQueryBuilder {
@@ -93,7 +94,7 @@ func (g guildEmojiQueryBuilder) WithContext(ctx context.Context) GuildEmojiQuery
 	return &g
 }
 
-func (g guildEmojiQueryBuilder) Get(flags ...Flag) (*Emoji, error) {
+func (g guildEmojiQueryBuilder) Get(flags ...RequestOption) (*Emoji, error) {
 	if !ignoreCache(flags...) {
 		if emoji, _ := g.client.cache.GetGuildEmoji(g.gid, g.emojiID); emoji != nil {
 			return emoji, nil
@@ -114,7 +115,7 @@ func (g guildEmojiQueryBuilder) Get(flags ...Flag) (*Emoji, error) {
 
 // UpdateEmoji Modify the given emoji. Requires the 'MANAGE_EMOJIS' permission.
 // Returns the updated emoji object on success. Fires a Guild Emojis Update Gateway event.
-func (g guildEmojiQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildEmojiBuilder {
+func (g guildEmojiQueryBuilder) UpdateBuilder(flags ...RequestOption) UpdateGuildEmojiBuilder {
 	builder := &updateGuildEmojiBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Emoji{}
@@ -132,7 +133,7 @@ func (g guildEmojiQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildEmojiBui
 
 // DeleteEmoji Delete the given emoji. Requires the 'MANAGE_EMOJIS' permission. Returns 204 No Content on
 // success. Fires a Guild Emojis Update Gateway event.
-func (g guildEmojiQueryBuilder) Delete(flags ...Flag) (err error) {
+func (g guildEmojiQueryBuilder) Delete(flags ...RequestOption) (err error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.GuildEmoji(g.gid, g.emojiID),
@@ -160,3 +161,214 @@ type updateGuildEmojiBuilder struct {
 type createGuildEmojiBuilder struct {
 	r RESTBuilder
 }
+
+//////////////////////////////////////////////////////
+//
+// Application emojis
+//
+// https://discord.com/developers/docs/resources/emoji#list-application-emojis
+// Applications can own up to 2000 emojis that can only be used by the app.
+//
+//////////////////////////////////////////////////////
+
+// CreateApplicationEmojiParams JSON params for func CreateEmoji
+type CreateApplicationEmojiParams struct {
+	Name  string `json:"name"`  // required
+	Image string `json:"image"` // required, base64 encoded image data with prefix
+}
+
+func (p *CreateApplicationEmojiParams) FindErrors() error {
+	if !validEmojiName(p.Name) {
+		return errors.New("invalid emoji name")
+	}
+	if !validAvatarPrefix(p.Image) {
+		return errors.New("image string must be base64 encoded with base64 prefix")
+	}
+	return nil
+}
+
+// UpdateApplicationEmojiParams JSON params for func Update
+type UpdateApplicationEmojiParams struct {
+	Name string `json:"name"`
+}
+
+func (p *UpdateApplicationEmojiParams) FindErrors() error {
+	if !validEmojiName(p.Name) {
+		return errors.New("invalid emoji name")
+	}
+	return nil
+}
+
+type applicationEmojisResponse struct {
+	Items []*Emoji `json:"items"`
+}
+
+// GetEmojis [REST] Returns the emojis owned by the application.
+//  Method                  GET
+//  Endpoint                /applications/{application.id}/emojis
+//  Discord documentation   https://discord.com/developers/docs/resources/emoji#list-application-emojis
+//  Reviewed                2024-08-26
+//  Comment                 -
+func (a applicationQueryBuilder) GetEmojis(flags ...RequestOption) ([]*Emoji, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ApplicationEmojis(a.appID),
+		Ctx:      a.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &applicationEmojisResponse{}
+	}
+
+	v, err := r.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*applicationEmojisResponse).Items, nil
+}
+
+// CreateEmoji [REST] Creates a new emoji owned by the application. Returns the new emoji object on success.
+//  Method                  POST
+//  Endpoint                /applications/{application.id}/emojis
+//  Discord documentation   https://discord.com/developers/docs/resources/emoji#create-application-emoji
+//  Reviewed                2024-08-26
+//  Comment                 Emojis can be up to 256kb in size.
+func (a applicationQueryBuilder) CreateEmoji(params *CreateApplicationEmojiParams, flags ...RequestOption) (*Emoji, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         a.ctx,
+		Endpoint:    endpoint.ApplicationEmojis(a.appID),
+		ContentType: httd.ContentTypeJSON,
+		Body:        params,
+	}, flags)
+	r.pool = a.client.pool.emoji
+	r.factory = func() interface{} {
+		return &Emoji{}
+	}
+
+	return getEmoji(r.Execute)
+}
+
+type ApplicationEmojiQueryBuilder interface {
+	WithContext(ctx context.Context) ApplicationEmojiQueryBuilder
+
+	Get(flags ...RequestOption) (*Emoji, error)
+	Update(params *UpdateApplicationEmojiParams, flags ...RequestOption) (*Emoji, error)
+	Delete(flags ...RequestOption) error
+}
+
+func (a applicationQueryBuilder) Emoji(emojiID Snowflake) ApplicationEmojiQueryBuilder {
+	return &applicationEmojiQueryBuilder{client: a.client, appID: a.appID, emojiID: emojiID}
+}
+
+type applicationEmojiQueryBuilder struct {
+	ctx     context.Context
+	client  *Client
+	appID   Snowflake
+	emojiID Snowflake
+}
+
+var _ ApplicationEmojiQueryBuilder = (*applicationEmojiQueryBuilder)(nil)
+
+func (a applicationEmojiQueryBuilder) WithContext(ctx context.Context) ApplicationEmojiQueryBuilder {
+	a.ctx = ctx
+	return &a
+}
+
+func (a applicationEmojiQueryBuilder) validate() error {
+	if a.appID.IsZero() {
+		return errors.New("applicationID must be set to target the correct application")
+	}
+	if a.emojiID.IsZero() {
+		return errors.New("emojiID must be set to target the correct emoji")
+	}
+	return nil
+}
+
+// Get [REST] Returns an emoji object for the given application and emoji IDs.
+//  Method                  GET
+//  Endpoint                /applications/{application.id}/emojis/{emoji.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/emoji#get-application-emoji
+//  Reviewed                2024-08-26
+//  Comment                 -
+func (a applicationEmojiQueryBuilder) Get(flags ...RequestOption) (*Emoji, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ApplicationEmoji(a.appID, a.emojiID),
+		Ctx:      a.ctx,
+	}, flags)
+	r.pool = a.client.pool.emoji
+	r.factory = func() interface{} {
+		return &Emoji{}
+	}
+
+	return getEmoji(r.Execute)
+}
+
+// Update [REST] Modify the given emoji. Returns the updated emoji object on success.
+//  Method                  PATCH
+//  Endpoint                /applications/{application.id}/emojis/{emoji.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/emoji#modify-application-emoji
+//  Reviewed                2024-08-26
+//  Comment                 -
+func (a applicationEmojiQueryBuilder) Update(params *UpdateApplicationEmojiParams, flags ...RequestOption) (*Emoji, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         a.ctx,
+		Endpoint:    endpoint.ApplicationEmoji(a.appID, a.emojiID),
+		ContentType: httd.ContentTypeJSON,
+		Body:        params,
+	}, flags)
+	r.pool = a.client.pool.emoji
+	r.factory = func() interface{} {
+		return &Emoji{}
+	}
+
+	return getEmoji(r.Execute)
+}
+
+// Delete [REST] Delete the given emoji. Returns 204 No Content on success.
+//  Method                  DELETE
+//  Endpoint                /applications/{application.id}/emojis/{emoji.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/emoji#delete-application-emoji
+//  Reviewed                2024-08-26
+//  Comment                 -
+func (a applicationEmojiQueryBuilder) Delete(flags ...RequestOption) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Endpoint: endpoint.ApplicationEmoji(a.appID, a.emojiID),
+		Ctx:      a.ctx,
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
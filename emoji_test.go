@@ -0,0 +1,90 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestApplicationEmojis(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/applications"):]
+			requests = append(requests, req.Method+" "+path)
+			body = nil
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+			switch {
+			case req.Method == http.MethodDelete:
+				resp := jsonResponse(req, "")
+				resp.StatusCode = http.StatusNoContent
+				return resp, nil
+			case strings.HasSuffix(path, "/emojis") && req.Method == http.MethodGet:
+				return jsonResponse(req, `{"items":[{"id":"2","name":"wave","animated":true},{"id":"3","name":"ok"}]}`), nil
+			}
+			return jsonResponse(req, `{"id":"2","name":"wave","user":{"id":"4"}}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emojis, err := client.Application(1).GetEmojis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(emojis) != 2 || emojis[0].ID != 2 || emojis[0].Name != "wave" || !emojis[0].Animated || emojis[1].ID != 3 {
+		t.Errorf("expected the emojis to be decoded from the items. Got %+v", emojis)
+	}
+
+	image := "data:image/png;base64,iVBORw0KGgo="
+	emoji, err := client.Application(1).CreateEmoji(&CreateApplicationEmojiParams{Name: "wave", Image: image})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emoji.ID != 2 || emoji.User == nil || emoji.User.ID != 4 {
+		t.Errorf("unexpected emoji %+v", emoji)
+	}
+	if body["name"] != "wave" || body["image"] != image {
+		t.Errorf("unexpected body %+v", body)
+	}
+
+	if _, err = client.Application(1).Emoji(2).Get(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Application(1).Emoji(2).Update(&UpdateApplicationEmojiParams{Name: "hello"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != 1 || body["name"] != "hello" {
+		t.Errorf("unexpected body %+v", body)
+	}
+	if err = client.Application(1).Emoji(2).Delete(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"GET /applications/1/emojis",
+		"POST /applications/1/emojis",
+		"GET /applications/1/emojis/2",
+		"PATCH /applications/1/emojis/2",
+		"DELETE /applications/1/emojis/2",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	if _, err = client.Application(1).CreateEmoji(&CreateApplicationEmojiParams{Name: "wave", Image: "iVBORw0KGgo="}); err == nil {
+		t.Error("expected an error when the image is not a data URI")
+	}
+}
@@ -0,0 +1,71 @@
+package disgord
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// EmojiIdentifier identifies an emoji in the reaction endpoints: the unicode characters of a standard
+// emoji, such as "👍", or "name:id" for a custom emoji. It is escaped when used in an endpoint, so
+// callers never have to URL encode emojis themselves.
+//  err := client.Channel(channelID).Message(msgID).Reaction(disgord.EmojiIdentifier("👍")).Create()
+type EmojiIdentifier string
+
+// Escaped returns the URL encoded identifier, as used in the path of the reaction endpoints.
+func (id EmojiIdentifier) Escaped() string {
+	return url.PathEscape(string(id))
+}
+
+// Identifier returns the identifier of the emoji, to be used with the reaction endpoints.
+func (e *Emoji) Identifier() EmojiIdentifier {
+	if e.ID.IsZero() {
+		return EmojiIdentifier(e.Name)
+	}
+	return EmojiIdentifier(e.Name + ":" + e.ID.String())
+}
+
+// ParseEmoji parses a custom emoji mention, such as "<:name:id>" or "<a:name:id>", a custom emoji identifier,
+// "name:id", or a unicode emoji. URL encoded emojis, as found in reaction endpoints, are decoded first.
+//  emoji, err := disgord.ParseEmoji("<a:dance:123456789>")
+//  // emoji.Name == "dance", emoji.ID == 123456789, emoji.Animated == true
+func ParseEmoji(s string) (*Emoji, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "%") {
+		unescaped, err := url.PathUnescape(s)
+		if err != nil {
+			return nil, err
+		}
+		s = unescaped
+	}
+	if s == "" {
+		return nil, errors.New("emoji is empty")
+	}
+
+	mention := strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">")
+	if mention {
+		s = s[1 : len(s)-1]
+	}
+
+	parts := strings.Split(s, ":")
+	switch {
+	case len(parts) == 3 && (parts[0] == "" || parts[0] == "a") && (mention || parts[2] != ""):
+		// :name:id or a:name:id
+		return customEmoji(parts[1], parts[2], parts[0] == "a")
+	case len(parts) == 2:
+		// name:id
+		return customEmoji(parts[0], parts[1], false)
+	case mention:
+		return nil, errors.New("invalid emoji mention " + s)
+	}
+
+	return &Emoji{Name: unwrapEmoji(s)}, nil
+}
+
+func customEmoji(name, id string, animated bool) (*Emoji, error) {
+	emojiID, err := GetSnowflake(id)
+	if err != nil || name == "" || emojiID.IsZero() {
+		return nil, errors.New("invalid custom emoji " + name + ":" + id)
+	}
+	return &Emoji{Name: name, ID: emojiID, Animated: animated}, nil
+}
@@ -0,0 +1,78 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestParseEmoji(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Emoji
+	}{
+		{"<:pepe:123>", Emoji{Name: "pepe", ID: 123}},
+		{"<a:dance:456>", Emoji{Name: "dance", ID: 456, Animated: true}},
+		{"pepe:123", Emoji{Name: "pepe", ID: 123}},
+		{"pepe%3A123", Emoji{Name: "pepe", ID: 123}},
+		{"👍", Emoji{Name: "👍"}},
+		{"%F0%9F%91%8D", Emoji{Name: "👍"}},
+		{":thumbsup:", Emoji{Name: "thumbsup"}},
+	}
+	for _, tc := range testCases {
+		emoji, err := ParseEmoji(tc.input)
+		if err != nil {
+			t.Errorf("unable to parse %s: %s", tc.input, err)
+			continue
+		}
+		if emoji.Name != tc.expected.Name || emoji.ID != tc.expected.ID || emoji.Animated != tc.expected.Animated {
+			t.Errorf("parsing %s gave %+v", tc.input, emoji)
+		}
+	}
+
+	for _, input := range []string{"", "<:pepe:>", "<pepe>", "pepe:abc"} {
+		if _, err := ParseEmoji(input); err == nil {
+			t.Errorf("expected an error when parsing %q", input)
+		}
+	}
+
+	animated := &Emoji{Name: "dance", ID: 456, Animated: true}
+	if parsed, err := ParseEmoji(animated.Mention()); err != nil || parsed.ID != animated.ID || !parsed.Animated {
+		t.Errorf("expected the mention %s to be parsed. Got %+v, %v", animated.Mention(), parsed, err)
+	}
+}
+
+func TestEmojiIdentifier(t *testing.T) {
+	if id := (&Emoji{Name: "pepe", ID: 123}).Identifier(); id != "pepe:123" {
+		t.Errorf("unexpected identifier %s", id)
+	}
+	if escaped := EmojiIdentifier("👍").Escaped(); escaped != "%F0%9F%91%8D" {
+		t.Errorf("expected the emoji to be escaped. Got %s", escaped)
+	}
+
+	var path string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path = req.URL.EscapedPath()
+			resp := jsonResponse(req, "")
+			resp.StatusCode = http.StatusNoContent
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, emoji := range []interface{}{"👍", EmojiIdentifier("👍"), &Emoji{Name: "👍"}} {
+		if err = client.Channel(1).Message(2).Reaction(emoji).Create(); err != nil {
+			t.Fatal(err)
+		}
+		if expected := "/channels/1/messages/2/reactions/%F0%9F%91%8D/@me"; !strings.HasSuffix(path, expected) {
+			t.Errorf("expected the emoji to be escaped once. Got %s", path)
+		}
+	}
+}
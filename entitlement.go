@@ -0,0 +1,267 @@
+package disgord
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// SKUType https://discord.com/developers/docs/monetization/skus#sku-object-sku-types
+type SKUType uint
+
+const (
+	SKUTypeDurable           SKUType = 2
+	SKUTypeConsumable        SKUType = 3
+	SKUTypeSubscription      SKUType = 5
+	SKUTypeSubscriptionGroup SKUType = 6
+)
+
+// SKUFlag https://discord.com/developers/docs/monetization/skus#sku-object-sku-flags
+type SKUFlag uint
+
+const (
+	SKUFlagAvailable         SKUFlag = 1 << 2
+	SKUFlagGuildSubscription SKUFlag = 1 << 7
+	SKUFlagUserSubscription  SKUFlag = 1 << 8
+)
+
+// SKU represents a premium offering that can be made available to the application's users or guilds.
+// https://discord.com/developers/docs/monetization/skus#sku-object
+type SKU struct {
+	ID            Snowflake `json:"id"`
+	Type          SKUType   `json:"type"`
+	ApplicationID Snowflake `json:"application_id"`
+	Name          string    `json:"name"`
+	Slug          string    `json:"slug"`
+	Flags         SKUFlag   `json:"flags"`
+}
+
+var _ Copier = (*SKU)(nil)
+var _ DeepCopier = (*SKU)(nil)
+
+// EntitlementType https://discord.com/developers/docs/monetization/entitlements#entitlement-object-entitlement-types
+type EntitlementType uint
+
+const (
+	_ EntitlementType = iota
+	EntitlementTypePurchase
+	EntitlementTypePremiumSubscription
+	EntitlementTypeDeveloperGift
+	EntitlementTypeTestModePurchase
+	EntitlementTypeFreePurchase
+	EntitlementTypeUserGift
+	EntitlementTypePremiumPurchase
+	EntitlementTypeApplicationSubscription
+)
+
+// Entitlement represents that a user or guild has access to a premium offering in the application.
+// https://discord.com/developers/docs/monetization/entitlements#entitlement-object
+type Entitlement struct {
+	ID            Snowflake       `json:"id"`
+	SKUID         Snowflake       `json:"sku_id"`
+	ApplicationID Snowflake       `json:"application_id"`
+	UserID        Snowflake       `json:"user_id,omitempty"`
+	GuildID       Snowflake       `json:"guild_id,omitempty"`
+	Type          EntitlementType `json:"type"`
+	Deleted       bool            `json:"deleted"`
+	Consumed      bool            `json:"consumed,omitempty"`
+
+	// StartsAt and EndsAt are not set for test entitlements
+	StartsAt Time `json:"starts_at,omitempty"`
+	EndsAt   Time `json:"ends_at,omitempty"`
+}
+
+var _ Copier = (*Entitlement)(nil)
+var _ DeepCopier = (*Entitlement)(nil)
+
+// GetEntitlementsParams https://discord.com/developers/docs/monetization/entitlements#list-entitlements-query-string-params
+type GetEntitlementsParams struct {
+	UserID  Snowflake
+	GuildID Snowflake
+	SKUIDs  []Snowflake
+	Before  Snowflake
+	After   Snowflake
+	Limit   int // 1-100, defaults to 100
+
+	ExcludeEnded bool
+
+	// IncludeDeleted also returns entitlements that were deleted, these are excluded by default.
+	IncludeDeleted bool
+}
+
+func (p *GetEntitlementsParams) URLQueryString() string {
+	params := make(urlQuery)
+	if !p.UserID.IsZero() {
+		params["user_id"] = p.UserID
+	}
+	if !p.GuildID.IsZero() {
+		params["guild_id"] = p.GuildID
+	}
+	if len(p.SKUIDs) > 0 {
+		ids := make([]string, 0, len(p.SKUIDs))
+		for i := range p.SKUIDs {
+			ids = append(ids, p.SKUIDs[i].String())
+		}
+		params["sku_ids"] = strings.Join(ids, ",")
+	}
+	if !p.Before.IsZero() {
+		params["before"] = p.Before
+	}
+	if !p.After.IsZero() {
+		params["after"] = p.After
+	}
+	if p.Limit != 0 {
+		params["limit"] = p.Limit
+	}
+	if p.ExcludeEnded {
+		params["exclude_ended"] = true
+	}
+	if p.IncludeDeleted {
+		params["exclude_deleted"] = false
+	}
+
+	return params.URLQueryString()
+}
+
+// EntitlementOwnerType https://discord.com/developers/docs/monetization/entitlements#create-test-entitlement-json-params
+type EntitlementOwnerType uint
+
+const (
+	_ EntitlementOwnerType = iota
+	EntitlementOwnerGuild
+	EntitlementOwnerUser
+)
+
+// CreateTestEntitlementParams https://discord.com/developers/docs/monetization/entitlements#create-test-entitlement-json-params
+type CreateTestEntitlementParams struct {
+	SKUID     Snowflake            `json:"sku_id"`
+	OwnerID   Snowflake            `json:"owner_id"`
+	OwnerType EntitlementOwnerType `json:"owner_type"`
+}
+
+func (p *CreateTestEntitlementParams) FindErrors() error {
+	if p.SKUID.IsZero() {
+		return errors.New("sku id must be set")
+	}
+	if p.OwnerID.IsZero() {
+		return errors.New("owner id must be set")
+	}
+	if p.OwnerType != EntitlementOwnerGuild && p.OwnerType != EntitlementOwnerUser {
+		return errors.New("owner type must be either a guild or a user")
+	}
+	return nil
+}
+
+// GetSKUs [REST] Returns all SKUs for the application.
+//  Method                  GET
+//  Endpoint                /applications/{application.id}/skus
+//  Discord documentation   https://discord.com/developers/docs/monetization/skus#list-skus
+//  Reviewed                2023-09-26
+//  Comment                 Subscriptions have both a SKUTypeSubscription and a SKUTypeSubscriptionGroup SKU,
+//                          use the SKUTypeSubscription SKU when checking entitlements.
+func (a applicationQueryBuilder) GetSKUs(flags ...RequestOption) ([]*SKU, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ApplicationSKUs(a.appID),
+		Ctx:      a.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*SKU, 0)
+		return &tmp
+	}
+
+	return getSKUs(r.Execute)
+}
+
+// GetEntitlements [REST] Returns all entitlements for the application, active and expired.
+//  Method                  GET
+//  Endpoint                /applications/{application.id}/entitlements
+//  Discord documentation   https://discord.com/developers/docs/monetization/entitlements#list-entitlements
+//  Reviewed                2023-09-26
+//  Comment                 -
+func (a applicationQueryBuilder) GetEntitlements(params *GetEntitlementsParams, flags ...RequestOption) ([]*Entitlement, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	query := ""
+	if params != nil {
+		if params.Limit < 0 || params.Limit > 100 {
+			return nil, errors.New("limit must be between 1 and 100")
+		}
+		query += params.URLQueryString()
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ApplicationEntitlements(a.appID) + query,
+		Ctx:      a.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*Entitlement, 0)
+		return &tmp
+	}
+
+	return getEntitlements(r.Execute)
+}
+
+// CreateTestEntitlement [REST] Creates a test entitlement to a given SKU for a given guild or user. Discord will
+// act as though that user or guild has entitlement to your premium offering.
+//  Method                  POST
+//  Endpoint                /applications/{application.id}/entitlements
+//  Discord documentation   https://discord.com/developers/docs/monetization/entitlements#create-test-entitlement
+//  Reviewed                2023-09-26
+//  Comment                 The returned entitlement is a partial entitlement object.
+func (a applicationQueryBuilder) CreateTestEntitlement(params *CreateTestEntitlementParams, flags ...RequestOption) (*Entitlement, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         a.ctx,
+		Endpoint:    endpoint.ApplicationEntitlements(a.appID),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Entitlement{}
+	}
+
+	return getEntitlement(r.Execute)
+}
+
+// DeleteTestEntitlement [REST] Deletes a currently-active test entitlement. Discord will act as though that user
+// or guild no longer has entitlement to your premium offering. Returns 204 No Content on success.
+//  Method                  DELETE
+//  Endpoint                /applications/{application.id}/entitlements/{entitlement.id}
+//  Discord documentation   https://discord.com/developers/docs/monetization/entitlements#delete-test-entitlement
+//  Reviewed                2023-09-26
+//  Comment                 -
+func (a applicationQueryBuilder) DeleteTestEntitlement(entitlementID Snowflake, flags ...RequestOption) error {
+	if err := a.validate(); err != nil {
+		return err
+	}
+	if entitlementID.IsZero() {
+		return errors.New("entitlementID must be set to target the correct entitlement")
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      a.ctx,
+		Endpoint: endpoint.ApplicationEntitlement(a.appID, entitlementID),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
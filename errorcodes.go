@@ -0,0 +1,106 @@
+package disgord
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+//go:generate go run internal/generate/errorcodes/main.go
+
+// ErrorCode is a JSON error code returned by Discord. The code can be matched with errors.Is.
+//  _, err := client.Channel(channelID).Message(messageID).Get()
+//  if errors.Is(err, disgord.ErrCodeUnknownMessage) {
+//      // the message was deleted
+//  }
+type ErrorCode int
+
+var _ error = ErrorCode(0)
+
+func (c ErrorCode) Error() string {
+	if msg, ok := errorCodeMessages[c]; ok {
+		return msg
+	}
+	return "discord error code " + strconv.Itoa(int(c))
+}
+
+// DiscordErrorCode is used by ErrRest to match the error code.
+func (c ErrorCode) DiscordErrorCode() int {
+	return int(c)
+}
+
+// Categories of REST errors. Every ErrRest unwraps to one of these when possible, eg. an unknown message
+// is also ErrNotFound.
+var (
+	ErrUnauthorized   = httd.ErrUnauthorized
+	ErrForbidden      = httd.ErrForbidden
+	ErrNotFound       = httd.ErrNotFound
+	ErrLimitReached   = httd.ErrLimitReached
+	ErrInvalidRequest = httd.ErrInvalidRequest
+	ErrServerError    = httd.ErrServerError
+)
+
+// ErrorCodeOf returns the Discord error code of a REST error.
+func ErrorCodeOf(err error) (code ErrorCode, ok bool) {
+	var errRest *ErrRest
+	if !errors.As(err, &errRest) || errRest.Code == 0 {
+		return 0, false
+	}
+	return ErrorCode(errRest.Code), true
+}
+
+// IsUnknownMessage reports whether the message does not exist, or was deleted.
+func IsUnknownMessage(err error) bool {
+	return errors.Is(err, ErrCodeUnknownMessage)
+}
+
+// IsUnknownChannel reports whether the channel does not exist, or was deleted.
+func IsUnknownChannel(err error) bool {
+	return errors.Is(err, ErrCodeUnknownChannel)
+}
+
+// IsUnknownGuild reports whether the guild does not exist, or the bot is not a member.
+func IsUnknownGuild(err error) bool {
+	return errors.Is(err, ErrCodeUnknownGuild)
+}
+
+// IsUnknownMember reports whether the user is not a member of the guild.
+func IsUnknownMember(err error) bool {
+	return errors.Is(err, ErrCodeUnknownMember)
+}
+
+// IsUnknownUser reports whether the user does not exist.
+func IsUnknownUser(err error) bool {
+	return errors.Is(err, ErrCodeUnknownUser)
+}
+
+// IsUnknownRole reports whether the role does not exist, or was deleted.
+func IsUnknownRole(err error) bool {
+	return errors.Is(err, ErrCodeUnknownRole)
+}
+
+// IsUnknownInteraction reports whether the interaction expired, or was already responded to.
+func IsUnknownInteraction(err error) bool {
+	return errors.Is(err, ErrCodeUnknownInteraction)
+}
+
+// IsMissingPermissions reports whether the bot lacks the permissions for the action.
+func IsMissingPermissions(err error) bool {
+	return errors.Is(err, ErrCodeMissingPermissions)
+}
+
+// IsMissingAccess reports whether the bot can not access the resource, eg. a channel it can not view.
+func IsMissingAccess(err error) bool {
+	return errors.Is(err, ErrCodeMissingAccess)
+}
+
+// IsCannotMessageUser reports whether the user does not accept direct messages from the bot.
+func IsCannotMessageUser(err error) bool {
+	return errors.Is(err, ErrCodeCannotSendMessagesToUser)
+}
+
+// IsRateLimited reports whether the request was rate limited, either locally or by Discord.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
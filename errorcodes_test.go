@@ -0,0 +1,52 @@
+// +build !integration
+
+package disgord
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	var err error = &ErrRest{Code: int(ErrCodeUnknownMessage), HTTPCode: http.StatusNotFound}
+	err = fmt.Errorf("unable to delete message: %w", err)
+
+	if !IsUnknownMessage(err) {
+		t.Error("expected an unknown message error")
+	}
+	if IsUnknownChannel(err) {
+		t.Error("did not expect an unknown channel error")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("expected the error to be categorized as not found")
+	}
+	if code, ok := ErrorCodeOf(err); !ok || code != ErrCodeUnknownMessage {
+		t.Errorf("unexpected error code. Got %d", code)
+	}
+	if ErrCodeUnknownMessage.Error() != "Unknown message" {
+		t.Errorf("unexpected message. Got %s", ErrCodeUnknownMessage.Error())
+	}
+
+	categories := []struct {
+		err      *ErrRest
+		category error
+	}{
+		{&ErrRest{Code: int(ErrCodeMissingPermissions), HTTPCode: http.StatusForbidden}, ErrForbidden},
+		{&ErrRest{Code: int(ErrCodeMaxPins), HTTPCode: http.StatusBadRequest}, ErrLimitReached},
+		{&ErrRest{Code: int(ErrCodeInvalidFormBody), HTTPCode: http.StatusBadRequest}, ErrInvalidRequest},
+		{&ErrRest{HTTPCode: http.StatusTooManyRequests}, ErrRateLimited},
+		{&ErrRest{HTTPCode: http.StatusBadGateway}, ErrServerError},
+		{&ErrRest{HTTPCode: http.StatusUnauthorized}, ErrUnauthorized},
+	}
+	for _, c := range categories {
+		if !errors.Is(c.err, c.category) {
+			t.Errorf("expected %d/%d to be categorized as %s", c.err.Code, c.err.HTTPCode, c.category)
+		}
+	}
+
+	if _, ok := ErrorCodeOf(errors.New("not a REST error")); ok {
+		t.Error("did not expect an error code")
+	}
+}
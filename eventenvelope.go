@@ -0,0 +1,87 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/json"
+)
+
+// EventEnvelopeVersion is the version of the serialized EventEnvelope. It changes when the format changes in a
+// way that older versions of disgord can not decode.
+const EventEnvelopeVersion = 1
+
+// EventEnvelope is a gateway event as received from Discord, which can be shipped to another process and
+// dispatched there, see EncodeEvent and Client.DispatchSerialized.
+type EventEnvelope struct {
+	Version  int             `json:"v"`
+	Name     string          `json:"name"`
+	ShardID  uint            `json:"shard_id"`
+	Sequence uint            `json:"seq"`
+	Received time.Time       `json:"received"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func newEventEnvelope(evt *gateway.Event) *EventEnvelope {
+	return &EventEnvelope{
+		Version:  EventEnvelopeVersion,
+		Name:     evt.Name,
+		ShardID:  evt.ShardID,
+		Sequence: evt.Sequence,
+		Received: evt.Received,
+		Data:     evt.Data,
+	}
+}
+
+// EncodeEvent serializes the event as json:
+//  {"v":1,"name":"MESSAGE_CREATE","shard_id":0,"seq":42,"received":"2021-06-01T12:00:00Z","data":{...}}
+func EncodeEvent(evt *EventEnvelope) ([]byte, error) {
+	if evt.Version == 0 {
+		e := *evt
+		e.Version = EventEnvelopeVersion
+		evt = &e
+	}
+	return json.Marshal(evt)
+}
+
+// DecodeEvent deserializes an event encoded by EncodeEvent.
+func DecodeEvent(data []byte) (*EventEnvelope, error) {
+	evt := &EventEnvelope{}
+	if err := json.Unmarshal(data, evt); err != nil {
+		return nil, err
+	}
+	if evt.Version != EventEnvelopeVersion {
+		return nil, fmt.Errorf("unsupported event envelope version %d", evt.Version)
+	}
+	if evt.Name == "" {
+		return nil, errors.New("the event envelope has no event name")
+	}
+	return evt, nil
+}
+
+// DispatchSerialized decodes an event encoded by EncodeEvent, such as one consumed from a message broker, and
+// dispatches it to the handlers as if it was received from the gateway. The cache is updated as well. Call it
+// from a single go routine to dispatch the events in order. The client does not have to be connected.
+//  for msg := range queue {
+//      if err := client.DispatchSerialized(msg); err != nil {
+//          log.Println(err)
+//      }
+//  }
+func (c *Client) DispatchSerialized(data []byte) error {
+	evt, err := DecodeEvent(data)
+	if err != nil {
+		return err
+	}
+
+	c.dispatcher.startPools()
+	c.demultiplex(c.dispatcher, &gateway.Event{
+		Name:     evt.Name,
+		Data:     evt.Data,
+		ShardID:  evt.ShardID,
+		Sequence: evt.Sequence,
+		Received: evt.Received,
+	})
+	return nil
+}
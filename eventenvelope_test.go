@@ -0,0 +1,79 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestEncodeEvent(t *testing.T) {
+	received := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	data, err := EncodeEvent(&EventEnvelope{
+		Name:     EvtMessageCreate,
+		ShardID:  2,
+		Sequence: 42,
+		Received: received,
+		Data:     []byte(`{"id":"1"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"v":1,"name":"MESSAGE_CREATE","shard_id":2,"seq":42,"received":"2021-06-01T12:00:00Z","data":{"id":"1"}}`
+	if string(data) != expected {
+		t.Errorf("unexpected encoding %s", data)
+	}
+
+	evt, err := DecodeEvent(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if evt.Name != EvtMessageCreate || evt.ShardID != 2 || evt.Sequence != 42 || !evt.Received.Equal(received) || string(evt.Data) != `{"id":"1"}` {
+		t.Errorf("the decoded event differs: %+v", evt)
+	}
+
+	if _, err = DecodeEvent([]byte(`{"v":2,"name":"MESSAGE_CREATE"}`)); err == nil {
+		t.Error("expected an unknown version to be rejected")
+	}
+}
+
+func TestClient_DispatchSerialized(t *testing.T) {
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(req, `{"id":"1"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan *MessageCreate, 1)
+	client.Gateway().MessageCreate(func(s Session, h *MessageCreate) {
+		handled <- h
+	})
+
+	data, err := EncodeEvent(&EventEnvelope{Name: EvtMessageCreate, ShardID: 3, Data: []byte(`{"id":"5","channel_id":"10"}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.DispatchSerialized(data); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case evt := <-handled:
+		if evt.Message.ID != 5 || evt.ShardID != 3 {
+			t.Errorf("unexpected event, message %d from shard %d", evt.Message.ID, evt.ShardID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the event to be dispatched")
+	}
+
+	if err = client.DispatchSerialized([]byte(`not json`)); err == nil {
+		t.Error("expected an error for malformed data")
+	}
+}
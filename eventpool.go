@@ -0,0 +1,91 @@
+package disgord
+
+import "reflect"
+
+// eventPools recycles the structs of the most frequent events, see Config.PoolEvents.
+type eventPools struct {
+	messageCreate  Pool
+	typingStart    Pool
+	presenceUpdate Pool
+}
+
+func newEventPools() *eventPools {
+	return &eventPools{
+		messageCreate: &pool{
+			New: func() Reseter {
+				return &MessageCreate{}
+			},
+		},
+		typingStart: &pool{
+			New: func() Reseter {
+				return &TypingStart{}
+			},
+		},
+		presenceUpdate: &pool{
+			New: func() Reseter {
+				return &PresenceUpdate{}
+			},
+		},
+	}
+}
+
+// put returns the event to its pool. Events that are not pooled are ignored.
+func (p *eventPools) put(evt interface{}) {
+	switch t := evt.(type) {
+	case *MessageCreate:
+		p.messageCreate.Put(t)
+	case *TypingStart:
+		p.typingStart.Put(t)
+	case *PresenceUpdate:
+		p.presenceUpdate.Put(t)
+	}
+}
+
+var _ Reseter = (*TypingStart)(nil)
+var _ Reseter = (*PresenceUpdate)(nil)
+
+func (t *TypingStart) reset() {
+	member := t.Member
+	if member != nil {
+		Reset(member)
+	}
+	*t = TypingStart{Member: member}
+}
+
+func (p *PresenceUpdate) reset() {
+	user := p.User
+	if user != nil {
+		Reset(user)
+	}
+	// handlers may have kept the activities, which must not be overwritten by the next event
+	*p = PresenceUpdate{User: user}
+}
+
+// usePools makes the cache decode events into recycled structs.
+func (c *CacheNop) usePools(pools *eventPools) {
+	c.events = pools
+}
+
+type eventPoolUser interface {
+	usePools(pools *eventPools)
+}
+
+var _ eventPoolUser = (*CacheNop)(nil)
+
+func isChannelHandler(handler Handler) bool {
+	return reflect.TypeOf(handler).Kind() == reflect.Chan
+}
+
+// poolable reports whether the event can be returned to its pool once the handlers have returned. Events
+// given to handlers that keep them, such as channels, are owned by the receiver.
+func (d *dispatcher) poolable(specs []*handlerSpec) bool {
+	if d.events == nil {
+		return false
+	}
+	for _, spec := range specs {
+		if spec.retains {
+			return false
+		}
+	}
+	return true
+}
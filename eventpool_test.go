@@ -0,0 +1,185 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/logger"
+	"github.com/Vedza/disgord/json"
+)
+
+func TestEventPools(t *testing.T) {
+	data := []byte(`{"id":"1","channel_id":"2","content":"hello"}`)
+
+	t.Run("handler", func(t *testing.T) {
+		d := newDispatcher()
+		d.events = newEventPools()
+		cache := &CacheNop{}
+		cache.usePools(d.events)
+
+		var content string
+		handler := func(s Session, evt *MessageCreate) {
+			content = evt.Message.Content
+		}
+		if err := d.register(EvtMessageCreate, handler); err != nil {
+			t.Fatal(err)
+		}
+
+		evt, err := cache.MessageCreate(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.dispatch(EvtMessageCreate, 0, evt)
+
+		if content != "hello" {
+			t.Errorf("expected the handler to receive the event. Got content '%s'", content)
+		}
+		if evt.Message == nil || evt.Message.Content != "" {
+			t.Error("expected the event to be reset once the handlers returned")
+		}
+	})
+	t.Run("channel", func(t *testing.T) {
+		d := newDispatcher()
+		d.events = newEventPools()
+		cache := &CacheNop{}
+		cache.usePools(d.events)
+
+		handler := make(chan *MessageCreate, 1)
+		if err := d.register(EvtMessageCreate, handler); err != nil {
+			t.Fatal(err)
+		}
+
+		evt, err := cache.MessageCreate(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.dispatch(EvtMessageCreate, 0, evt)
+
+		if received := <-handler; received.Message.Content != "hello" {
+			t.Error("expected events sent to channels to be kept")
+		}
+	})
+	t.Run("once", func(t *testing.T) {
+		d := newDispatcher()
+		d.events = newEventPools()
+		cache := &CacheNop{}
+		cache.usePools(d.events)
+
+		var kept *MessageCreate
+		handler := func(s Session, evt *MessageCreate) {
+			kept = evt
+		}
+		if err := d.register(EvtMessageCreate, handler, Once()); err != nil {
+			t.Fatal(err)
+		}
+
+		evt, err := cache.MessageCreate(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d.dispatch(EvtMessageCreate, 0, evt)
+
+		if kept == nil || kept.Message.Content != "hello" {
+			t.Error("expected events given to one-shot handlers to be kept")
+		}
+	})
+	t.Run("stream", func(t *testing.T) {
+		d := newDispatcher()
+		d.addSessionInstance(&Client{log: &logger.Empty{}})
+		d.events = newEventPools()
+		cache := &CacheNop{}
+		cache.usePools(d.events)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		stream := socketHandlerRegister{reactor: d}.MessageCreateStream(ctx)
+
+		go func() {
+			for _, content := range []string{"hello", "other"} {
+				evt, _ := cache.MessageCreate([]byte(`{"id":"1","channel_id":"2","content":"` + content + `"}`))
+				d.dispatch(EvtMessageCreate, 0, evt)
+			}
+		}()
+
+		first := <-stream
+		second := <-stream
+		if first == second {
+			t.Error("expected events sent to streams to not be pooled")
+		}
+		if first.Message.Content != "hello" {
+			t.Errorf("expected the first event to be unchanged. Got content '%s'", first.Message.Content)
+		}
+	})
+	t.Run("await", func(t *testing.T) {
+		c := &Client{dispatcher: newDispatcher(), log: &logger.Empty{}}
+		c.dispatcher.addSessionInstance(c)
+		c.dispatcher.events = newEventPools()
+		cache := &CacheNop{}
+		cache.usePools(c.dispatcher.events)
+
+		go func() {
+			// wait for the await handler to be registered
+			for {
+				c.dispatcher.RLock()
+				registered := len(c.dispatcher.handlerSpecs[EvtMessageCreate]) > 0
+				c.dispatcher.RUnlock()
+				if registered {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			evt, _ := cache.MessageCreate(data)
+			c.dispatcher.dispatch(EvtMessageCreate, 0, evt)
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		awaited, err := c.AwaitMessage(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the next event must not reuse the awaited one
+		evt, err := cache.MessageCreate([]byte(`{"id":"3","channel_id":"2","content":"other"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.dispatcher.dispatch(EvtMessageCreate, 0, evt)
+
+		if evt == awaited {
+			t.Error("expected the awaited event to not be pooled")
+		}
+		if awaited.Message.Content != "hello" {
+			t.Errorf("expected the awaited event to be unchanged. Got content '%s'", awaited.Message.Content)
+		}
+	})
+}
+
+func TestPresenceUpdate_reset(t *testing.T) {
+	evt := &PresenceUpdate{
+		User:       &User{ID: 1},
+		GuildID:    2,
+		Status:     StatusOnline,
+		Activities: []*Activity{{Name: "test"}},
+	}
+	activity := evt.Activities[0]
+	Reset(evt)
+
+	if evt.User == nil || evt.User.ID != 0 || evt.GuildID != 0 || evt.Status != "" {
+		t.Errorf("expected the presence to be reset. Got %+v", evt)
+	}
+	if evt.Activities != nil {
+		t.Error("expected the activities to be removed, as handlers may have kept them")
+	}
+
+	if err := json.Unmarshal([]byte(`{"activities":[{"name":"other"}]}`), evt); err != nil {
+		t.Fatal(err)
+	}
+	if activity.Name != "test" {
+		t.Errorf("expected the previous activities to be left as is. Got %s", activity.Name)
+	}
+}
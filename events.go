@@ -112,6 +112,130 @@ type ChannelPinsUpdate struct {
 
 // ---------------------------
 
+// ThreadCreate thread was created, or the current user was added to a private thread
+type ThreadCreate struct {
+	Thread  *Channel `json:"thread"`
+	ShardID uint     `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *ThreadCreate) UnmarshalJSON(data []byte) error {
+	obj.Thread = &Channel{}
+	return json.Unmarshal(data, obj.Thread)
+}
+
+// ---------------------------
+
+// ThreadUpdate thread was updated
+type ThreadUpdate struct {
+	Thread  *Channel `json:"thread"`
+	ShardID uint     `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *ThreadUpdate) UnmarshalJSON(data []byte) error {
+	obj.Thread = &Channel{}
+	return json.Unmarshal(data, obj.Thread)
+}
+
+// ---------------------------
+
+// ThreadDelete thread was deleted. Only the id, guild_id, parent_id and type fields are set.
+type ThreadDelete struct {
+	Thread  *Channel `json:"thread"`
+	ShardID uint     `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *ThreadDelete) UnmarshalJSON(data []byte) error {
+	obj.Thread = &Channel{}
+	return json.Unmarshal(data, obj.Thread)
+}
+
+// ---------------------------
+
+// ThreadListSync the current user gained access to a channel, and is given the active threads of that channel
+type ThreadListSync struct {
+	GuildID Snowflake `json:"guild_id"`
+
+	// ChannelIDs holds the parent channel ids whose threads are being synced. If empty, the
+	// threads of the entire guild are synced.
+	ChannelIDs []Snowflake     `json:"channel_ids,omitempty"`
+	Threads    []*Channel      `json:"threads"`
+	Members    []*ThreadMember `json:"members"`
+	ShardID    uint            `json:"-"`
+}
+
+// ---------------------------
+
+// ThreadMemberUpdate the thread member object for the current user was updated
+type ThreadMemberUpdate struct {
+	Member  *ThreadMember `json:"member"`
+	ShardID uint          `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *ThreadMemberUpdate) UnmarshalJSON(data []byte) error {
+	obj.Member = &ThreadMember{}
+	return json.Unmarshal(data, obj.Member)
+}
+
+// ---------------------------
+
+// ThreadMembersUpdate user(s) were added to or removed from a thread
+type ThreadMembersUpdate struct {
+	ID               Snowflake       `json:"id"` // thread id
+	GuildID          Snowflake       `json:"guild_id"`
+	MemberCount      uint            `json:"member_count"`
+	AddedMembers     []*ThreadMember `json:"added_members,omitempty"`
+	RemovedMemberIDs []Snowflake     `json:"removed_member_ids,omitempty"`
+	ShardID          uint            `json:"-"`
+}
+
+// ---------------------------
+
+// StageInstanceCreate stage instance was created, meaning a stage channel went live
+type StageInstanceCreate struct {
+	StageInstance *StageInstance `json:"stage_instance"`
+	ShardID       uint           `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *StageInstanceCreate) UnmarshalJSON(data []byte) error {
+	obj.StageInstance = &StageInstance{}
+	return json.Unmarshal(data, obj.StageInstance)
+}
+
+// ---------------------------
+
+// StageInstanceUpdate stage instance was updated
+type StageInstanceUpdate struct {
+	StageInstance *StageInstance `json:"stage_instance"`
+	ShardID       uint           `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *StageInstanceUpdate) UnmarshalJSON(data []byte) error {
+	obj.StageInstance = &StageInstance{}
+	return json.Unmarshal(data, obj.StageInstance)
+}
+
+// ---------------------------
+
+// StageInstanceDelete stage instance was deleted, meaning the stage was closed
+type StageInstanceDelete struct {
+	StageInstance *StageInstance `json:"stage_instance"`
+	ShardID       uint           `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *StageInstanceDelete) UnmarshalJSON(data []byte) error {
+	obj.StageInstance = &StageInstance{}
+	return json.Unmarshal(data, obj.StageInstance)
+}
+
+// ---------------------------
+
 // TypingStart user started typing in a channel
 type TypingStart struct {
 	ChannelID     Snowflake `json:"channel_id"`
@@ -149,7 +273,9 @@ func (obj *MessageCreate) updateInternals() {
 
 // UnmarshalJSON ...
 func (obj *MessageCreate) UnmarshalJSON(data []byte) error {
-	obj.Message = &Message{}
+	if obj.Message == nil {
+		obj.Message = &Message{}
+	}
 	if err := json.Unmarshal(data, obj.Message); err != nil {
 		return err
 	}
@@ -232,7 +358,12 @@ type InteractionCreate struct {
 	Token         string                             `json:"token"`
 	Version       int                                `json:"version"`
 	Message       *Message                           `json:"message"`
+	Locale        Locale                             `json:"locale"`
+	GuildLocale   Locale                             `json:"guild_locale"`
 	ShardID       uint                               `json:"-"`
+
+	// Entitlements holds the entitlements of the invoking user, for monetized apps
+	Entitlements []*Entitlement `json:"entitlements,omitempty"`
 }
 
 // ---------------------------
@@ -271,6 +402,30 @@ type MessageReactionRemoveEmoji struct {
 
 // ---------------------------
 
+// MessagePollVoteAdd user voted on a poll
+type MessagePollVoteAdd struct {
+	UserID    Snowflake `json:"user_id"`
+	ChannelID Snowflake `json:"channel_id"`
+	MessageID Snowflake `json:"message_id"`
+	GuildID   Snowflake `json:"guild_id,omitempty"`
+	AnswerID  int       `json:"answer_id"`
+	ShardID   uint      `json:"-"`
+}
+
+// ---------------------------
+
+// MessagePollVoteRemove user removed their vote on a poll
+type MessagePollVoteRemove struct {
+	UserID    Snowflake `json:"user_id"`
+	ChannelID Snowflake `json:"channel_id"`
+	MessageID Snowflake `json:"message_id"`
+	GuildID   Snowflake `json:"guild_id,omitempty"`
+	AnswerID  int       `json:"answer_id"`
+	ShardID   uint      `json:"-"`
+}
+
+// ---------------------------
+
 // GuildEmojisUpdate guild emojis were updated
 type GuildEmojisUpdate struct {
 	GuildID Snowflake `json:"guild_id"`
@@ -285,6 +440,15 @@ func (g *GuildEmojisUpdate) updateInternals() {
 
 // ---------------------------
 
+// GuildStickersUpdate guild stickers were updated
+type GuildStickersUpdate struct {
+	GuildID  Snowflake  `json:"guild_id"`
+	Stickers []*Sticker `json:"stickers"`
+	ShardID  uint       `json:"-"`
+}
+
+// ---------------------------
+
 // GuildCreate This event can be sent in three different scenarios:
 //  1. When a user is initially connecting, to lazily load and backfill information for all unavailable Guilds
 //     sent in the Ready event.
@@ -482,6 +646,216 @@ type GuildRoleDelete struct {
 
 // ---------------------------
 
+// GuildScheduledEventCreate guild scheduled event was created
+type GuildScheduledEventCreate struct {
+	GuildScheduledEvent *GuildScheduledEvent `json:"guild_scheduled_event"`
+	ShardID             uint                 `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *GuildScheduledEventCreate) UnmarshalJSON(data []byte) error {
+	obj.GuildScheduledEvent = &GuildScheduledEvent{}
+	return json.Unmarshal(data, obj.GuildScheduledEvent)
+}
+
+// ---------------------------
+
+// GuildScheduledEventUpdate guild scheduled event was updated
+type GuildScheduledEventUpdate struct {
+	GuildScheduledEvent *GuildScheduledEvent `json:"guild_scheduled_event"`
+	ShardID             uint                 `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *GuildScheduledEventUpdate) UnmarshalJSON(data []byte) error {
+	obj.GuildScheduledEvent = &GuildScheduledEvent{}
+	return json.Unmarshal(data, obj.GuildScheduledEvent)
+}
+
+// ---------------------------
+
+// GuildScheduledEventDelete guild scheduled event was deleted
+type GuildScheduledEventDelete struct {
+	GuildScheduledEvent *GuildScheduledEvent `json:"guild_scheduled_event"`
+	ShardID             uint                 `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *GuildScheduledEventDelete) UnmarshalJSON(data []byte) error {
+	obj.GuildScheduledEvent = &GuildScheduledEvent{}
+	return json.Unmarshal(data, obj.GuildScheduledEvent)
+}
+
+// ---------------------------
+
+// GuildScheduledEventUserAdd user subscribed to a guild scheduled event
+type GuildScheduledEventUserAdd struct {
+	GuildScheduledEventID Snowflake `json:"guild_scheduled_event_id"`
+	UserID                Snowflake `json:"user_id"`
+	GuildID               Snowflake `json:"guild_id"`
+	ShardID               uint      `json:"-"`
+}
+
+// ---------------------------
+
+// GuildScheduledEventUserRemove user unsubscribed from a guild scheduled event
+type GuildScheduledEventUserRemove struct {
+	GuildScheduledEventID Snowflake `json:"guild_scheduled_event_id"`
+	UserID                Snowflake `json:"user_id"`
+	GuildID               Snowflake `json:"guild_id"`
+	ShardID               uint      `json:"-"`
+}
+
+// ---------------------------
+
+// AutoModerationRuleCreate auto moderation rule was created
+type AutoModerationRuleCreate struct {
+	Rule    *AutoModerationRule `json:"rule"`
+	ShardID uint                `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *AutoModerationRuleCreate) UnmarshalJSON(data []byte) error {
+	obj.Rule = &AutoModerationRule{}
+	return json.Unmarshal(data, obj.Rule)
+}
+
+// ---------------------------
+
+// AutoModerationRuleUpdate auto moderation rule was updated
+type AutoModerationRuleUpdate struct {
+	Rule    *AutoModerationRule `json:"rule"`
+	ShardID uint                `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *AutoModerationRuleUpdate) UnmarshalJSON(data []byte) error {
+	obj.Rule = &AutoModerationRule{}
+	return json.Unmarshal(data, obj.Rule)
+}
+
+// ---------------------------
+
+// AutoModerationRuleDelete auto moderation rule was deleted
+type AutoModerationRuleDelete struct {
+	Rule    *AutoModerationRule `json:"rule"`
+	ShardID uint                `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *AutoModerationRuleDelete) UnmarshalJSON(data []byte) error {
+	obj.Rule = &AutoModerationRule{}
+	return json.Unmarshal(data, obj.Rule)
+}
+
+// ---------------------------
+
+// AutoModerationActionExecution a rule was triggered and an action was executed, eg. a message was blocked
+type AutoModerationActionExecution struct {
+	GuildID         Snowflake                 `json:"guild_id"`
+	Action          *AutoModerationAction     `json:"action"`
+	RuleID          Snowflake                 `json:"rule_id"`
+	RuleTriggerType AutoModerationTriggerType `json:"rule_trigger_type"`
+	UserID          Snowflake                 `json:"user_id"`
+	ChannelID       Snowflake                 `json:"channel_id,omitempty"`
+
+	// MessageID is not set when the message was blocked by AutoMod or the content was not part of a message
+	MessageID            Snowflake `json:"message_id,omitempty"`
+	AlertSystemMessageID Snowflake `json:"alert_system_message_id,omitempty"`
+
+	// Content requires the message content intent
+	Content        string `json:"content"`
+	MatchedKeyword string `json:"matched_keyword"`
+	MatchedContent string `json:"matched_content"`
+	ShardID        uint   `json:"-"`
+}
+
+// ---------------------------
+
+// EntitlementCreate a user subscribed to a SKU
+type EntitlementCreate struct {
+	Entitlement *Entitlement `json:"entitlement"`
+	ShardID     uint         `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *EntitlementCreate) UnmarshalJSON(data []byte) error {
+	obj.Entitlement = &Entitlement{}
+	return json.Unmarshal(data, obj.Entitlement)
+}
+
+// ---------------------------
+
+// EntitlementUpdate a user's subscription renewed for the next billing period
+type EntitlementUpdate struct {
+	Entitlement *Entitlement `json:"entitlement"`
+	ShardID     uint         `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *EntitlementUpdate) UnmarshalJSON(data []byte) error {
+	obj.Entitlement = &Entitlement{}
+	return json.Unmarshal(data, obj.Entitlement)
+}
+
+// ---------------------------
+
+// EntitlementDelete a user's entitlement was deleted
+type EntitlementDelete struct {
+	Entitlement *Entitlement `json:"entitlement"`
+	ShardID     uint         `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *EntitlementDelete) UnmarshalJSON(data []byte) error {
+	obj.Entitlement = &Entitlement{}
+	return json.Unmarshal(data, obj.Entitlement)
+}
+
+// ---------------------------
+
+// SubscriptionCreate a user subscribed to a SKU
+type SubscriptionCreate struct {
+	Subscription *Subscription `json:"subscription"`
+	ShardID      uint          `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *SubscriptionCreate) UnmarshalJSON(data []byte) error {
+	obj.Subscription = &Subscription{}
+	return json.Unmarshal(data, obj.Subscription)
+}
+
+// ---------------------------
+
+// SubscriptionUpdate a user's subscription was updated, eg. it renewed or is ending
+type SubscriptionUpdate struct {
+	Subscription *Subscription `json:"subscription"`
+	ShardID      uint          `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *SubscriptionUpdate) UnmarshalJSON(data []byte) error {
+	obj.Subscription = &Subscription{}
+	return json.Unmarshal(data, obj.Subscription)
+}
+
+// ---------------------------
+
+// SubscriptionDelete a user's subscription was deleted
+type SubscriptionDelete struct {
+	Subscription *Subscription `json:"subscription"`
+	ShardID      uint          `json:"-"`
+}
+
+// UnmarshalJSON ...
+func (obj *SubscriptionDelete) UnmarshalJSON(data []byte) error {
+	obj.Subscription = &Subscription{}
+	return json.Unmarshal(data, obj.Subscription)
+}
+
+// ---------------------------
+
 // PresenceUpdate user's presence was updated in a guild
 type PresenceUpdate struct {
 	User         *User        `json:"user"`
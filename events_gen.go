@@ -4,6 +4,8 @@ package disgord
 // Warning: This file is overwritten at "go generate", instead adapt events.go and event/events.go and run go generate
 
 import (
+	"context"
+
 	"github.com/Vedza/disgord/internal/event"
 )
 
@@ -19,6 +21,38 @@ func AllEventsExcept(except ...string) []string {
 
 // ---------------------------
 
+// EvtAutoModerationActionExecution Sent when a rule is triggered and an action is executed (e.g. when a message is blocked).
+//
+const EvtAutoModerationActionExecution = event.AutoModerationActionExecution
+
+func (h *AutoModerationActionExecution) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtAutoModerationRuleCreate Sent when an auto moderation rule is created.
+//
+const EvtAutoModerationRuleCreate = event.AutoModerationRuleCreate
+
+func (h *AutoModerationRuleCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtAutoModerationRuleDelete Sent when an auto moderation rule is deleted.
+//
+const EvtAutoModerationRuleDelete = event.AutoModerationRuleDelete
+
+func (h *AutoModerationRuleDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtAutoModerationRuleUpdate Sent when an auto moderation rule is updated.
+//
+const EvtAutoModerationRuleUpdate = event.AutoModerationRuleUpdate
+
+func (h *AutoModerationRuleUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
 // EvtChannelCreate Sent when a new channel is created, relevant to the current user. The inner payload is a DM channel or
 // guild channel object.
 //
@@ -52,6 +86,30 @@ func (h *ChannelUpdate) setShardID(id uint) { h.ShardID = id }
 
 // ---------------------------
 
+// EvtEntitlementCreate Sent when an entitlement is created, eg. when a user subscribes to a SKU.
+//
+const EvtEntitlementCreate = event.EntitlementCreate
+
+func (h *EntitlementCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtEntitlementDelete Sent when an entitlement is deleted, eg. when Discord issues a refund or a test entitlement is removed.
+//
+const EvtEntitlementDelete = event.EntitlementDelete
+
+func (h *EntitlementDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtEntitlementUpdate Sent when an entitlement is updated, eg. when a subscription renews for the next billing period.
+//
+const EvtEntitlementUpdate = event.EntitlementUpdate
+
+func (h *EntitlementUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
 // EvtGuildBanAdd Sent when a user is banned from a guild. The inner payload is a user object, with an extra guild_id key.
 //
 const EvtGuildBanAdd = event.GuildBanAdd
@@ -162,6 +220,54 @@ func (h *GuildRoleUpdate) setShardID(id uint) { h.ShardID = id }
 
 // ---------------------------
 
+// EvtGuildScheduledEventCreate Sent when a guild scheduled event is created.
+//
+const EvtGuildScheduledEventCreate = event.GuildScheduledEventCreate
+
+func (h *GuildScheduledEventCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtGuildScheduledEventDelete Sent when a guild scheduled event is deleted.
+//
+const EvtGuildScheduledEventDelete = event.GuildScheduledEventDelete
+
+func (h *GuildScheduledEventDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtGuildScheduledEventUpdate Sent when a guild scheduled event is updated.
+//
+const EvtGuildScheduledEventUpdate = event.GuildScheduledEventUpdate
+
+func (h *GuildScheduledEventUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtGuildScheduledEventUserAdd Sent when a user has subscribed to a guild scheduled event.
+//
+const EvtGuildScheduledEventUserAdd = event.GuildScheduledEventUserAdd
+
+func (h *GuildScheduledEventUserAdd) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtGuildScheduledEventUserRemove Sent when a user has unsubscribed from a guild scheduled event.
+//
+const EvtGuildScheduledEventUserRemove = event.GuildScheduledEventUserRemove
+
+func (h *GuildScheduledEventUserRemove) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtGuildStickersUpdate Sent when a guild's stickers have been updated.
+//
+const EvtGuildStickersUpdate = event.GuildStickersUpdate
+
+func (h *GuildStickersUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
 // EvtGuildUpdate Sent when a guild is updated. The inner payload is a guild object.
 //
 const EvtGuildUpdate = event.GuildUpdate
@@ -218,6 +324,22 @@ func (h *MessageDeleteBulk) setShardID(id uint) { h.ShardID = id }
 
 // ---------------------------
 
+// EvtMessagePollVoteAdd Sent when a user votes on a poll. Polls with multi-select send one event per answer.
+//
+const EvtMessagePollVoteAdd = event.MessagePollVoteAdd
+
+func (h *MessagePollVoteAdd) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtMessagePollVoteRemove Sent when a user removes their vote on a poll.
+//
+const EvtMessagePollVoteRemove = event.MessagePollVoteRemove
+
+func (h *MessagePollVoteRemove) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
 // EvtMessageReactionAdd Sent when a user adds a reaction to a message.
 //
 const EvtMessageReactionAdd = event.MessageReactionAdd
@@ -287,6 +409,102 @@ func (h *Resumed) setShardID(id uint) { h.ShardID = id }
 
 // ---------------------------
 
+// EvtStageInstanceCreate Sent when a stage instance is created, i.e. the stage is now live.
+//
+const EvtStageInstanceCreate = event.StageInstanceCreate
+
+func (h *StageInstanceCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtStageInstanceDelete Sent when a stage instance has been deleted, i.e. the stage has been closed.
+//
+const EvtStageInstanceDelete = event.StageInstanceDelete
+
+func (h *StageInstanceDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtStageInstanceUpdate Sent when a stage instance has been updated.
+//
+const EvtStageInstanceUpdate = event.StageInstanceUpdate
+
+func (h *StageInstanceUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtSubscriptionCreate Sent when a subscription for a premium offering is created.
+//
+const EvtSubscriptionCreate = event.SubscriptionCreate
+
+func (h *SubscriptionCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtSubscriptionDelete Sent when a subscription is deleted.
+//
+const EvtSubscriptionDelete = event.SubscriptionDelete
+
+func (h *SubscriptionDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtSubscriptionUpdate Sent when a subscription is updated, eg. when it renews or is canceled.
+//
+const EvtSubscriptionUpdate = event.SubscriptionUpdate
+
+func (h *SubscriptionUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadCreate Sent when a thread is created, relevant to the current user, or when the current user is added to a thread.
+//
+const EvtThreadCreate = event.ThreadCreate
+
+func (h *ThreadCreate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadDelete Sent when a thread relevant to the current user is deleted.
+//
+const EvtThreadDelete = event.ThreadDelete
+
+func (h *ThreadDelete) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadListSync Sent when the current user gains access to a channel. Holds all the active threads of the channel(s).
+//
+const EvtThreadListSync = event.ThreadListSync
+
+func (h *ThreadListSync) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadMemberUpdate Sent when the thread member object for the current user is updated.
+//
+const EvtThreadMemberUpdate = event.ThreadMemberUpdate
+
+func (h *ThreadMemberUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadMembersUpdate Sent when anyone is added to or removed from a thread.
+//
+const EvtThreadMembersUpdate = event.ThreadMembersUpdate
+
+func (h *ThreadMembersUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
+// EvtThreadUpdate Sent when a thread is updated.
+//
+const EvtThreadUpdate = event.ThreadUpdate
+
+func (h *ThreadUpdate) setShardID(id uint) { h.ShardID = id }
+
+// ---------------------------
+
 // EvtTypingStart Sent when a user starts typing in a channel.
 //
 const EvtTypingStart = event.TypingStart
@@ -337,12 +555,22 @@ type socketHandlerRegister struct {
 	middlewares []Middleware
 	handlers    []Handler
 	ctrl        HandlerCtrl
+	guilds      []Snowflake
+
+	// retains is true when the handlers hand the event over to a receiver, such that it is never pooled
+	retains bool
 
 	reactor dispatchRegistrater
 }
 
 func (shr *socketHandlerRegister) build() {
-	inputs := make([]interface{}, 0, len(shr.middlewares)+len(shr.handlers)+1)
+	inputs := make([]interface{}, 0, len(shr.middlewares)+len(shr.handlers)+3)
+	if shr.retains {
+		inputs = append(inputs, retainEvents{})
+	}
+	if len(shr.guilds) > 0 {
+		inputs = append(inputs, guildScope(shr.guilds))
+	}
 	for _, mdlw := range shr.middlewares {
 		inputs = append(inputs, mdlw)
 	}
@@ -372,11 +600,18 @@ func (shr socketHandlerRegister) WithMiddleware(first Middleware, extra ...Middl
 	return shr
 }
 
-// ChannelCreate Sent when a new channel is created, relevant to the current user. The inner payload is a DM channel or
-// guild channel object.
+// WithGuild limits the handlers to events from the given guilds. Events that do not belong to a guild,
+// such as direct messages, are not received.
+func (shr socketHandlerRegister) WithGuild(first Snowflake, extra ...Snowflake) SocketHandlerRegistrator {
+	shr.guilds = append(shr.guilds, first)
+	shr.guilds = append(shr.guilds, extra...)
+	return shr
+}
+
+// AutoModerationActionExecution Sent when a rule is triggered and an action is executed (e.g. when a message is blocked).
 //
-func (shr socketHandlerRegister) ChannelCreate(handler HandlerChannelCreate, moreHandlers ...HandlerChannelCreate) {
-	shr.evtName = EvtChannelCreate
+func (shr socketHandlerRegister) AutoModerationActionExecution(handler HandlerAutoModerationActionExecution, moreHandlers ...HandlerAutoModerationActionExecution) {
+	shr.evtName = EvtAutoModerationActionExecution
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -384,8 +619,8 @@ func (shr socketHandlerRegister) ChannelCreate(handler HandlerChannelCreate, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ChannelCreateChan(handler chan *ChannelCreate, moreHandlers ...chan *ChannelCreate) {
-	shr.evtName = EvtChannelCreate
+func (shr socketHandlerRegister) AutoModerationActionExecutionChan(handler chan *AutoModerationActionExecution, moreHandlers ...chan *AutoModerationActionExecution) {
+	shr.evtName = EvtAutoModerationActionExecution
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -393,10 +628,25 @@ func (shr socketHandlerRegister) ChannelCreateChan(handler chan *ChannelCreate,
 	shr.build()
 }
 
-// ChannelDelete Sent when a channel relevant to the current user is deleted. The inner payload is a DM or Guild channel object.
+// AutoModerationActionExecutionStream returns a channel of AutoModerationActionExecution events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) AutoModerationActionExecutionStream(ctx context.Context) <-chan *AutoModerationActionExecution {
+	channel := make(chan *AutoModerationActionExecution)
+	shr.evtName = EvtAutoModerationActionExecution
+	shr.handlers = append(shr.handlers, func(_ Session, evt *AutoModerationActionExecution) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// AutoModerationRuleCreate Sent when an auto moderation rule is created.
 //
-func (shr socketHandlerRegister) ChannelDelete(handler HandlerChannelDelete, moreHandlers ...HandlerChannelDelete) {
-	shr.evtName = EvtChannelDelete
+func (shr socketHandlerRegister) AutoModerationRuleCreate(handler HandlerAutoModerationRuleCreate, moreHandlers ...HandlerAutoModerationRuleCreate) {
+	shr.evtName = EvtAutoModerationRuleCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -404,8 +654,8 @@ func (shr socketHandlerRegister) ChannelDelete(handler HandlerChannelDelete, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ChannelDeleteChan(handler chan *ChannelDelete, moreHandlers ...chan *ChannelDelete) {
-	shr.evtName = EvtChannelDelete
+func (shr socketHandlerRegister) AutoModerationRuleCreateChan(handler chan *AutoModerationRuleCreate, moreHandlers ...chan *AutoModerationRuleCreate) {
+	shr.evtName = EvtAutoModerationRuleCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -413,10 +663,25 @@ func (shr socketHandlerRegister) ChannelDeleteChan(handler chan *ChannelDelete,
 	shr.build()
 }
 
-// ChannelPinsUpdate Sent when a message is pinned or unpinned in a text channel. This is not sent when a pinned message is deleted.
+// AutoModerationRuleCreateStream returns a channel of AutoModerationRuleCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) AutoModerationRuleCreateStream(ctx context.Context) <-chan *AutoModerationRuleCreate {
+	channel := make(chan *AutoModerationRuleCreate)
+	shr.evtName = EvtAutoModerationRuleCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *AutoModerationRuleCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// AutoModerationRuleDelete Sent when an auto moderation rule is deleted.
 //
-func (shr socketHandlerRegister) ChannelPinsUpdate(handler HandlerChannelPinsUpdate, moreHandlers ...HandlerChannelPinsUpdate) {
-	shr.evtName = EvtChannelPinsUpdate
+func (shr socketHandlerRegister) AutoModerationRuleDelete(handler HandlerAutoModerationRuleDelete, moreHandlers ...HandlerAutoModerationRuleDelete) {
+	shr.evtName = EvtAutoModerationRuleDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -424,8 +689,8 @@ func (shr socketHandlerRegister) ChannelPinsUpdate(handler HandlerChannelPinsUpd
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ChannelPinsUpdateChan(handler chan *ChannelPinsUpdate, moreHandlers ...chan *ChannelPinsUpdate) {
-	shr.evtName = EvtChannelPinsUpdate
+func (shr socketHandlerRegister) AutoModerationRuleDeleteChan(handler chan *AutoModerationRuleDelete, moreHandlers ...chan *AutoModerationRuleDelete) {
+	shr.evtName = EvtAutoModerationRuleDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -433,10 +698,25 @@ func (shr socketHandlerRegister) ChannelPinsUpdateChan(handler chan *ChannelPins
 	shr.build()
 }
 
-// ChannelUpdate Sent when a channel is updated. The inner payload is a guild channel object.
+// AutoModerationRuleDeleteStream returns a channel of AutoModerationRuleDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) AutoModerationRuleDeleteStream(ctx context.Context) <-chan *AutoModerationRuleDelete {
+	channel := make(chan *AutoModerationRuleDelete)
+	shr.evtName = EvtAutoModerationRuleDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *AutoModerationRuleDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// AutoModerationRuleUpdate Sent when an auto moderation rule is updated.
 //
-func (shr socketHandlerRegister) ChannelUpdate(handler HandlerChannelUpdate, moreHandlers ...HandlerChannelUpdate) {
-	shr.evtName = EvtChannelUpdate
+func (shr socketHandlerRegister) AutoModerationRuleUpdate(handler HandlerAutoModerationRuleUpdate, moreHandlers ...HandlerAutoModerationRuleUpdate) {
+	shr.evtName = EvtAutoModerationRuleUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -444,8 +724,8 @@ func (shr socketHandlerRegister) ChannelUpdate(handler HandlerChannelUpdate, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ChannelUpdateChan(handler chan *ChannelUpdate, moreHandlers ...chan *ChannelUpdate) {
-	shr.evtName = EvtChannelUpdate
+func (shr socketHandlerRegister) AutoModerationRuleUpdateChan(handler chan *AutoModerationRuleUpdate, moreHandlers ...chan *AutoModerationRuleUpdate) {
+	shr.evtName = EvtAutoModerationRuleUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -453,10 +733,26 @@ func (shr socketHandlerRegister) ChannelUpdateChan(handler chan *ChannelUpdate,
 	shr.build()
 }
 
-// GuildBanAdd Sent when a user is banned from a guild. The inner payload is a user object, with an extra guild_id key.
+// AutoModerationRuleUpdateStream returns a channel of AutoModerationRuleUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) AutoModerationRuleUpdateStream(ctx context.Context) <-chan *AutoModerationRuleUpdate {
+	channel := make(chan *AutoModerationRuleUpdate)
+	shr.evtName = EvtAutoModerationRuleUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *AutoModerationRuleUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ChannelCreate Sent when a new channel is created, relevant to the current user. The inner payload is a DM channel or
+// guild channel object.
 //
-func (shr socketHandlerRegister) GuildBanAdd(handler HandlerGuildBanAdd, moreHandlers ...HandlerGuildBanAdd) {
-	shr.evtName = EvtGuildBanAdd
+func (shr socketHandlerRegister) ChannelCreate(handler HandlerChannelCreate, moreHandlers ...HandlerChannelCreate) {
+	shr.evtName = EvtChannelCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -464,8 +760,8 @@ func (shr socketHandlerRegister) GuildBanAdd(handler HandlerGuildBanAdd, moreHan
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildBanAddChan(handler chan *GuildBanAdd, moreHandlers ...chan *GuildBanAdd) {
-	shr.evtName = EvtGuildBanAdd
+func (shr socketHandlerRegister) ChannelCreateChan(handler chan *ChannelCreate, moreHandlers ...chan *ChannelCreate) {
+	shr.evtName = EvtChannelCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -473,10 +769,25 @@ func (shr socketHandlerRegister) GuildBanAddChan(handler chan *GuildBanAdd, more
 	shr.build()
 }
 
-// GuildBanRemove Sent when a user is unbanned from a guild. The inner payload is a user object, with an extra guild_id key.
+// ChannelCreateStream returns a channel of ChannelCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ChannelCreateStream(ctx context.Context) <-chan *ChannelCreate {
+	channel := make(chan *ChannelCreate)
+	shr.evtName = EvtChannelCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ChannelCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ChannelDelete Sent when a channel relevant to the current user is deleted. The inner payload is a DM or Guild channel object.
 //
-func (shr socketHandlerRegister) GuildBanRemove(handler HandlerGuildBanRemove, moreHandlers ...HandlerGuildBanRemove) {
-	shr.evtName = EvtGuildBanRemove
+func (shr socketHandlerRegister) ChannelDelete(handler HandlerChannelDelete, moreHandlers ...HandlerChannelDelete) {
+	shr.evtName = EvtChannelDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -484,8 +795,8 @@ func (shr socketHandlerRegister) GuildBanRemove(handler HandlerGuildBanRemove, m
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildBanRemoveChan(handler chan *GuildBanRemove, moreHandlers ...chan *GuildBanRemove) {
-	shr.evtName = EvtGuildBanRemove
+func (shr socketHandlerRegister) ChannelDeleteChan(handler chan *ChannelDelete, moreHandlers ...chan *ChannelDelete) {
+	shr.evtName = EvtChannelDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -493,14 +804,25 @@ func (shr socketHandlerRegister) GuildBanRemoveChan(handler chan *GuildBanRemove
 	shr.build()
 }
 
-// GuildCreate This event can be sent in three different scenarios:
-//  1. When a user is initially connecting, to lazily load and backfill information for all unavailable guilds
-//     sent in the Ready event.
-// 	2. When a Guild becomes available again to the client.
-// 	3. When the current user joins a new Guild.
+// ChannelDeleteStream returns a channel of ChannelDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ChannelDeleteStream(ctx context.Context) <-chan *ChannelDelete {
+	channel := make(chan *ChannelDelete)
+	shr.evtName = EvtChannelDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ChannelDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ChannelPinsUpdate Sent when a message is pinned or unpinned in a text channel. This is not sent when a pinned message is deleted.
 //
-func (shr socketHandlerRegister) GuildCreate(handler HandlerGuildCreate, moreHandlers ...HandlerGuildCreate) {
-	shr.evtName = EvtGuildCreate
+func (shr socketHandlerRegister) ChannelPinsUpdate(handler HandlerChannelPinsUpdate, moreHandlers ...HandlerChannelPinsUpdate) {
+	shr.evtName = EvtChannelPinsUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -508,8 +830,8 @@ func (shr socketHandlerRegister) GuildCreate(handler HandlerGuildCreate, moreHan
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildCreateChan(handler chan *GuildCreate, moreHandlers ...chan *GuildCreate) {
-	shr.evtName = EvtGuildCreate
+func (shr socketHandlerRegister) ChannelPinsUpdateChan(handler chan *ChannelPinsUpdate, moreHandlers ...chan *ChannelPinsUpdate) {
+	shr.evtName = EvtChannelPinsUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -517,12 +839,25 @@ func (shr socketHandlerRegister) GuildCreateChan(handler chan *GuildCreate, more
 	shr.build()
 }
 
-// GuildDelete Sent when a guild becomes unavailable during a guild outage, or when the user leaves or is removed from a guild.
-// The inner payload is an unavailable guild object. If the unavailable field is not set, the user was removed
-// from the guild.
+// ChannelPinsUpdateStream returns a channel of ChannelPinsUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ChannelPinsUpdateStream(ctx context.Context) <-chan *ChannelPinsUpdate {
+	channel := make(chan *ChannelPinsUpdate)
+	shr.evtName = EvtChannelPinsUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ChannelPinsUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ChannelUpdate Sent when a channel is updated. The inner payload is a guild channel object.
 //
-func (shr socketHandlerRegister) GuildDelete(handler HandlerGuildDelete, moreHandlers ...HandlerGuildDelete) {
-	shr.evtName = EvtGuildDelete
+func (shr socketHandlerRegister) ChannelUpdate(handler HandlerChannelUpdate, moreHandlers ...HandlerChannelUpdate) {
+	shr.evtName = EvtChannelUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -530,8 +865,8 @@ func (shr socketHandlerRegister) GuildDelete(handler HandlerGuildDelete, moreHan
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildDeleteChan(handler chan *GuildDelete, moreHandlers ...chan *GuildDelete) {
-	shr.evtName = EvtGuildDelete
+func (shr socketHandlerRegister) ChannelUpdateChan(handler chan *ChannelUpdate, moreHandlers ...chan *ChannelUpdate) {
+	shr.evtName = EvtChannelUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -539,10 +874,25 @@ func (shr socketHandlerRegister) GuildDeleteChan(handler chan *GuildDelete, more
 	shr.build()
 }
 
-// GuildEmojisUpdate Sent when a guild's emojis have been updated.
+// ChannelUpdateStream returns a channel of ChannelUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ChannelUpdateStream(ctx context.Context) <-chan *ChannelUpdate {
+	channel := make(chan *ChannelUpdate)
+	shr.evtName = EvtChannelUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ChannelUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// EntitlementCreate Sent when an entitlement is created, eg. when a user subscribes to a SKU.
 //
-func (shr socketHandlerRegister) GuildEmojisUpdate(handler HandlerGuildEmojisUpdate, moreHandlers ...HandlerGuildEmojisUpdate) {
-	shr.evtName = EvtGuildEmojisUpdate
+func (shr socketHandlerRegister) EntitlementCreate(handler HandlerEntitlementCreate, moreHandlers ...HandlerEntitlementCreate) {
+	shr.evtName = EvtEntitlementCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -550,8 +900,8 @@ func (shr socketHandlerRegister) GuildEmojisUpdate(handler HandlerGuildEmojisUpd
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildEmojisUpdateChan(handler chan *GuildEmojisUpdate, moreHandlers ...chan *GuildEmojisUpdate) {
-	shr.evtName = EvtGuildEmojisUpdate
+func (shr socketHandlerRegister) EntitlementCreateChan(handler chan *EntitlementCreate, moreHandlers ...chan *EntitlementCreate) {
+	shr.evtName = EvtEntitlementCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -559,10 +909,25 @@ func (shr socketHandlerRegister) GuildEmojisUpdateChan(handler chan *GuildEmojis
 	shr.build()
 }
 
-// GuildIntegrationsUpdate Sent when a guild integration is updated.
+// EntitlementCreateStream returns a channel of EntitlementCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) EntitlementCreateStream(ctx context.Context) <-chan *EntitlementCreate {
+	channel := make(chan *EntitlementCreate)
+	shr.evtName = EvtEntitlementCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *EntitlementCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// EntitlementDelete Sent when an entitlement is deleted, eg. when Discord issues a refund or a test entitlement is removed.
 //
-func (shr socketHandlerRegister) GuildIntegrationsUpdate(handler HandlerGuildIntegrationsUpdate, moreHandlers ...HandlerGuildIntegrationsUpdate) {
-	shr.evtName = EvtGuildIntegrationsUpdate
+func (shr socketHandlerRegister) EntitlementDelete(handler HandlerEntitlementDelete, moreHandlers ...HandlerEntitlementDelete) {
+	shr.evtName = EvtEntitlementDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -570,8 +935,8 @@ func (shr socketHandlerRegister) GuildIntegrationsUpdate(handler HandlerGuildInt
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildIntegrationsUpdateChan(handler chan *GuildIntegrationsUpdate, moreHandlers ...chan *GuildIntegrationsUpdate) {
-	shr.evtName = EvtGuildIntegrationsUpdate
+func (shr socketHandlerRegister) EntitlementDeleteChan(handler chan *EntitlementDelete, moreHandlers ...chan *EntitlementDelete) {
+	shr.evtName = EvtEntitlementDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -579,10 +944,976 @@ func (shr socketHandlerRegister) GuildIntegrationsUpdateChan(handler chan *Guild
 	shr.build()
 }
 
-// GuildMemberAdd Sent when a new user joins a guild.
+// EntitlementDeleteStream returns a channel of EntitlementDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) EntitlementDeleteStream(ctx context.Context) <-chan *EntitlementDelete {
+	channel := make(chan *EntitlementDelete)
+	shr.evtName = EvtEntitlementDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *EntitlementDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// EntitlementUpdate Sent when an entitlement is updated, eg. when a subscription renews for the next billing period.
 //
-func (shr socketHandlerRegister) GuildMemberAdd(handler HandlerGuildMemberAdd, moreHandlers ...HandlerGuildMemberAdd) {
+func (shr socketHandlerRegister) EntitlementUpdate(handler HandlerEntitlementUpdate, moreHandlers ...HandlerEntitlementUpdate) {
+	shr.evtName = EvtEntitlementUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) EntitlementUpdateChan(handler chan *EntitlementUpdate, moreHandlers ...chan *EntitlementUpdate) {
+	shr.evtName = EvtEntitlementUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// EntitlementUpdateStream returns a channel of EntitlementUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) EntitlementUpdateStream(ctx context.Context) <-chan *EntitlementUpdate {
+	channel := make(chan *EntitlementUpdate)
+	shr.evtName = EvtEntitlementUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *EntitlementUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildBanAdd Sent when a user is banned from a guild. The inner payload is a user object, with an extra guild_id key.
+//
+func (shr socketHandlerRegister) GuildBanAdd(handler HandlerGuildBanAdd, moreHandlers ...HandlerGuildBanAdd) {
+	shr.evtName = EvtGuildBanAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildBanAddChan(handler chan *GuildBanAdd, moreHandlers ...chan *GuildBanAdd) {
+	shr.evtName = EvtGuildBanAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildBanAddStream returns a channel of GuildBanAdd events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildBanAddStream(ctx context.Context) <-chan *GuildBanAdd {
+	channel := make(chan *GuildBanAdd)
+	shr.evtName = EvtGuildBanAdd
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildBanAdd) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildBanRemove Sent when a user is unbanned from a guild. The inner payload is a user object, with an extra guild_id key.
+//
+func (shr socketHandlerRegister) GuildBanRemove(handler HandlerGuildBanRemove, moreHandlers ...HandlerGuildBanRemove) {
+	shr.evtName = EvtGuildBanRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildBanRemoveChan(handler chan *GuildBanRemove, moreHandlers ...chan *GuildBanRemove) {
+	shr.evtName = EvtGuildBanRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildBanRemoveStream returns a channel of GuildBanRemove events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildBanRemoveStream(ctx context.Context) <-chan *GuildBanRemove {
+	channel := make(chan *GuildBanRemove)
+	shr.evtName = EvtGuildBanRemove
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildBanRemove) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildCreate This event can be sent in three different scenarios:
+//  1. When a user is initially connecting, to lazily load and backfill information for all unavailable guilds
+//     sent in the Ready event.
+// 	2. When a Guild becomes available again to the client.
+// 	3. When the current user joins a new Guild.
+//
+func (shr socketHandlerRegister) GuildCreate(handler HandlerGuildCreate, moreHandlers ...HandlerGuildCreate) {
+	shr.evtName = EvtGuildCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildCreateChan(handler chan *GuildCreate, moreHandlers ...chan *GuildCreate) {
+	shr.evtName = EvtGuildCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildCreateStream returns a channel of GuildCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildCreateStream(ctx context.Context) <-chan *GuildCreate {
+	channel := make(chan *GuildCreate)
+	shr.evtName = EvtGuildCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildDelete Sent when a guild becomes unavailable during a guild outage, or when the user leaves or is removed from a guild.
+// The inner payload is an unavailable guild object. If the unavailable field is not set, the user was removed
+// from the guild.
+//
+func (shr socketHandlerRegister) GuildDelete(handler HandlerGuildDelete, moreHandlers ...HandlerGuildDelete) {
+	shr.evtName = EvtGuildDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildDeleteChan(handler chan *GuildDelete, moreHandlers ...chan *GuildDelete) {
+	shr.evtName = EvtGuildDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildDeleteStream returns a channel of GuildDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildDeleteStream(ctx context.Context) <-chan *GuildDelete {
+	channel := make(chan *GuildDelete)
+	shr.evtName = EvtGuildDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildEmojisUpdate Sent when a guild's emojis have been updated.
+//
+func (shr socketHandlerRegister) GuildEmojisUpdate(handler HandlerGuildEmojisUpdate, moreHandlers ...HandlerGuildEmojisUpdate) {
+	shr.evtName = EvtGuildEmojisUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildEmojisUpdateChan(handler chan *GuildEmojisUpdate, moreHandlers ...chan *GuildEmojisUpdate) {
+	shr.evtName = EvtGuildEmojisUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildEmojisUpdateStream returns a channel of GuildEmojisUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildEmojisUpdateStream(ctx context.Context) <-chan *GuildEmojisUpdate {
+	channel := make(chan *GuildEmojisUpdate)
+	shr.evtName = EvtGuildEmojisUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildEmojisUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildIntegrationsUpdate Sent when a guild integration is updated.
+//
+func (shr socketHandlerRegister) GuildIntegrationsUpdate(handler HandlerGuildIntegrationsUpdate, moreHandlers ...HandlerGuildIntegrationsUpdate) {
+	shr.evtName = EvtGuildIntegrationsUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildIntegrationsUpdateChan(handler chan *GuildIntegrationsUpdate, moreHandlers ...chan *GuildIntegrationsUpdate) {
+	shr.evtName = EvtGuildIntegrationsUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildIntegrationsUpdateStream returns a channel of GuildIntegrationsUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildIntegrationsUpdateStream(ctx context.Context) <-chan *GuildIntegrationsUpdate {
+	channel := make(chan *GuildIntegrationsUpdate)
+	shr.evtName = EvtGuildIntegrationsUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildIntegrationsUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildMemberAdd Sent when a new user joins a guild.
+//
+func (shr socketHandlerRegister) GuildMemberAdd(handler HandlerGuildMemberAdd, moreHandlers ...HandlerGuildMemberAdd) {
+	shr.evtName = EvtGuildMemberAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildMemberAddChan(handler chan *GuildMemberAdd, moreHandlers ...chan *GuildMemberAdd) {
+	shr.evtName = EvtGuildMemberAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildMemberAddStream returns a channel of GuildMemberAdd events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildMemberAddStream(ctx context.Context) <-chan *GuildMemberAdd {
+	channel := make(chan *GuildMemberAdd)
 	shr.evtName = EvtGuildMemberAdd
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildMemberAdd) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildMemberRemove Sent when a user is removed from a guild (leave/kick/ban).
+//
+func (shr socketHandlerRegister) GuildMemberRemove(handler HandlerGuildMemberRemove, moreHandlers ...HandlerGuildMemberRemove) {
+	shr.evtName = EvtGuildMemberRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildMemberRemoveChan(handler chan *GuildMemberRemove, moreHandlers ...chan *GuildMemberRemove) {
+	shr.evtName = EvtGuildMemberRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildMemberRemoveStream returns a channel of GuildMemberRemove events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildMemberRemoveStream(ctx context.Context) <-chan *GuildMemberRemove {
+	channel := make(chan *GuildMemberRemove)
+	shr.evtName = EvtGuildMemberRemove
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildMemberRemove) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildMemberUpdate Sent when a guild member is updated.
+//
+func (shr socketHandlerRegister) GuildMemberUpdate(handler HandlerGuildMemberUpdate, moreHandlers ...HandlerGuildMemberUpdate) {
+	shr.evtName = EvtGuildMemberUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildMemberUpdateChan(handler chan *GuildMemberUpdate, moreHandlers ...chan *GuildMemberUpdate) {
+	shr.evtName = EvtGuildMemberUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildMemberUpdateStream returns a channel of GuildMemberUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildMemberUpdateStream(ctx context.Context) <-chan *GuildMemberUpdate {
+	channel := make(chan *GuildMemberUpdate)
+	shr.evtName = EvtGuildMemberUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildMemberUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildMembersChunk Sent in response to Gateway Request Guild Members.
+//
+func (shr socketHandlerRegister) GuildMembersChunk(handler HandlerGuildMembersChunk, moreHandlers ...HandlerGuildMembersChunk) {
+	shr.evtName = EvtGuildMembersChunk
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildMembersChunkChan(handler chan *GuildMembersChunk, moreHandlers ...chan *GuildMembersChunk) {
+	shr.evtName = EvtGuildMembersChunk
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildMembersChunkStream returns a channel of GuildMembersChunk events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildMembersChunkStream(ctx context.Context) <-chan *GuildMembersChunk {
+	channel := make(chan *GuildMembersChunk)
+	shr.evtName = EvtGuildMembersChunk
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildMembersChunk) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildRoleCreate Sent when a guild role is created.
+//
+func (shr socketHandlerRegister) GuildRoleCreate(handler HandlerGuildRoleCreate, moreHandlers ...HandlerGuildRoleCreate) {
+	shr.evtName = EvtGuildRoleCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildRoleCreateChan(handler chan *GuildRoleCreate, moreHandlers ...chan *GuildRoleCreate) {
+	shr.evtName = EvtGuildRoleCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildRoleCreateStream returns a channel of GuildRoleCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildRoleCreateStream(ctx context.Context) <-chan *GuildRoleCreate {
+	channel := make(chan *GuildRoleCreate)
+	shr.evtName = EvtGuildRoleCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildRoleCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildRoleDelete Sent when a guild role is created.
+//
+func (shr socketHandlerRegister) GuildRoleDelete(handler HandlerGuildRoleDelete, moreHandlers ...HandlerGuildRoleDelete) {
+	shr.evtName = EvtGuildRoleDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildRoleDeleteChan(handler chan *GuildRoleDelete, moreHandlers ...chan *GuildRoleDelete) {
+	shr.evtName = EvtGuildRoleDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildRoleDeleteStream returns a channel of GuildRoleDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildRoleDeleteStream(ctx context.Context) <-chan *GuildRoleDelete {
+	channel := make(chan *GuildRoleDelete)
+	shr.evtName = EvtGuildRoleDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildRoleDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildRoleUpdate Sent when a guild role is created.
+//
+func (shr socketHandlerRegister) GuildRoleUpdate(handler HandlerGuildRoleUpdate, moreHandlers ...HandlerGuildRoleUpdate) {
+	shr.evtName = EvtGuildRoleUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildRoleUpdateChan(handler chan *GuildRoleUpdate, moreHandlers ...chan *GuildRoleUpdate) {
+	shr.evtName = EvtGuildRoleUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildRoleUpdateStream returns a channel of GuildRoleUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildRoleUpdateStream(ctx context.Context) <-chan *GuildRoleUpdate {
+	channel := make(chan *GuildRoleUpdate)
+	shr.evtName = EvtGuildRoleUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildRoleUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildScheduledEventCreate Sent when a guild scheduled event is created.
+//
+func (shr socketHandlerRegister) GuildScheduledEventCreate(handler HandlerGuildScheduledEventCreate, moreHandlers ...HandlerGuildScheduledEventCreate) {
+	shr.evtName = EvtGuildScheduledEventCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildScheduledEventCreateChan(handler chan *GuildScheduledEventCreate, moreHandlers ...chan *GuildScheduledEventCreate) {
+	shr.evtName = EvtGuildScheduledEventCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildScheduledEventCreateStream returns a channel of GuildScheduledEventCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildScheduledEventCreateStream(ctx context.Context) <-chan *GuildScheduledEventCreate {
+	channel := make(chan *GuildScheduledEventCreate)
+	shr.evtName = EvtGuildScheduledEventCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildScheduledEventCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildScheduledEventDelete Sent when a guild scheduled event is deleted.
+//
+func (shr socketHandlerRegister) GuildScheduledEventDelete(handler HandlerGuildScheduledEventDelete, moreHandlers ...HandlerGuildScheduledEventDelete) {
+	shr.evtName = EvtGuildScheduledEventDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildScheduledEventDeleteChan(handler chan *GuildScheduledEventDelete, moreHandlers ...chan *GuildScheduledEventDelete) {
+	shr.evtName = EvtGuildScheduledEventDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildScheduledEventDeleteStream returns a channel of GuildScheduledEventDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildScheduledEventDeleteStream(ctx context.Context) <-chan *GuildScheduledEventDelete {
+	channel := make(chan *GuildScheduledEventDelete)
+	shr.evtName = EvtGuildScheduledEventDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildScheduledEventDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildScheduledEventUpdate Sent when a guild scheduled event is updated.
+//
+func (shr socketHandlerRegister) GuildScheduledEventUpdate(handler HandlerGuildScheduledEventUpdate, moreHandlers ...HandlerGuildScheduledEventUpdate) {
+	shr.evtName = EvtGuildScheduledEventUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildScheduledEventUpdateChan(handler chan *GuildScheduledEventUpdate, moreHandlers ...chan *GuildScheduledEventUpdate) {
+	shr.evtName = EvtGuildScheduledEventUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildScheduledEventUpdateStream returns a channel of GuildScheduledEventUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildScheduledEventUpdateStream(ctx context.Context) <-chan *GuildScheduledEventUpdate {
+	channel := make(chan *GuildScheduledEventUpdate)
+	shr.evtName = EvtGuildScheduledEventUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildScheduledEventUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildScheduledEventUserAdd Sent when a user has subscribed to a guild scheduled event.
+//
+func (shr socketHandlerRegister) GuildScheduledEventUserAdd(handler HandlerGuildScheduledEventUserAdd, moreHandlers ...HandlerGuildScheduledEventUserAdd) {
+	shr.evtName = EvtGuildScheduledEventUserAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildScheduledEventUserAddChan(handler chan *GuildScheduledEventUserAdd, moreHandlers ...chan *GuildScheduledEventUserAdd) {
+	shr.evtName = EvtGuildScheduledEventUserAdd
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildScheduledEventUserAddStream returns a channel of GuildScheduledEventUserAdd events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildScheduledEventUserAddStream(ctx context.Context) <-chan *GuildScheduledEventUserAdd {
+	channel := make(chan *GuildScheduledEventUserAdd)
+	shr.evtName = EvtGuildScheduledEventUserAdd
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildScheduledEventUserAdd) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildScheduledEventUserRemove Sent when a user has unsubscribed from a guild scheduled event.
+//
+func (shr socketHandlerRegister) GuildScheduledEventUserRemove(handler HandlerGuildScheduledEventUserRemove, moreHandlers ...HandlerGuildScheduledEventUserRemove) {
+	shr.evtName = EvtGuildScheduledEventUserRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildScheduledEventUserRemoveChan(handler chan *GuildScheduledEventUserRemove, moreHandlers ...chan *GuildScheduledEventUserRemove) {
+	shr.evtName = EvtGuildScheduledEventUserRemove
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildScheduledEventUserRemoveStream returns a channel of GuildScheduledEventUserRemove events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildScheduledEventUserRemoveStream(ctx context.Context) <-chan *GuildScheduledEventUserRemove {
+	channel := make(chan *GuildScheduledEventUserRemove)
+	shr.evtName = EvtGuildScheduledEventUserRemove
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildScheduledEventUserRemove) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildStickersUpdate Sent when a guild's stickers have been updated.
+//
+func (shr socketHandlerRegister) GuildStickersUpdate(handler HandlerGuildStickersUpdate, moreHandlers ...HandlerGuildStickersUpdate) {
+	shr.evtName = EvtGuildStickersUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildStickersUpdateChan(handler chan *GuildStickersUpdate, moreHandlers ...chan *GuildStickersUpdate) {
+	shr.evtName = EvtGuildStickersUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildStickersUpdateStream returns a channel of GuildStickersUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildStickersUpdateStream(ctx context.Context) <-chan *GuildStickersUpdate {
+	channel := make(chan *GuildStickersUpdate)
+	shr.evtName = EvtGuildStickersUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildStickersUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// GuildUpdate Sent when a guild is updated. The inner payload is a guild object.
+//
+func (shr socketHandlerRegister) GuildUpdate(handler HandlerGuildUpdate, moreHandlers ...HandlerGuildUpdate) {
+	shr.evtName = EvtGuildUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) GuildUpdateChan(handler chan *GuildUpdate, moreHandlers ...chan *GuildUpdate) {
+	shr.evtName = EvtGuildUpdate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// GuildUpdateStream returns a channel of GuildUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) GuildUpdateStream(ctx context.Context) <-chan *GuildUpdate {
+	channel := make(chan *GuildUpdate)
+	shr.evtName = EvtGuildUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *GuildUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// InteractionCreate Sent when a user in a guild uses a Slash Command.
+//
+func (shr socketHandlerRegister) InteractionCreate(handler HandlerInteractionCreate, moreHandlers ...HandlerInteractionCreate) {
+	shr.evtName = EvtInteractionCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) InteractionCreateChan(handler chan *InteractionCreate, moreHandlers ...chan *InteractionCreate) {
+	shr.evtName = EvtInteractionCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// InteractionCreateStream returns a channel of InteractionCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) InteractionCreateStream(ctx context.Context) <-chan *InteractionCreate {
+	channel := make(chan *InteractionCreate)
+	shr.evtName = EvtInteractionCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *InteractionCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// InviteCreate Sent when a guild's invite is created.
+//
+func (shr socketHandlerRegister) InviteCreate(handler HandlerInviteCreate, moreHandlers ...HandlerInviteCreate) {
+	shr.evtName = EvtInviteCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) InviteCreateChan(handler chan *InviteCreate, moreHandlers ...chan *InviteCreate) {
+	shr.evtName = EvtInviteCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// InviteCreateStream returns a channel of InviteCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) InviteCreateStream(ctx context.Context) <-chan *InviteCreate {
+	channel := make(chan *InviteCreate)
+	shr.evtName = EvtInviteCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *InviteCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// InviteDelete Sent when an invite is deleted.
+//
+func (shr socketHandlerRegister) InviteDelete(handler HandlerInviteDelete, moreHandlers ...HandlerInviteDelete) {
+	shr.evtName = EvtInviteDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) InviteDeleteChan(handler chan *InviteDelete, moreHandlers ...chan *InviteDelete) {
+	shr.evtName = EvtInviteDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// InviteDeleteStream returns a channel of InviteDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) InviteDeleteStream(ctx context.Context) <-chan *InviteDelete {
+	channel := make(chan *InviteDelete)
+	shr.evtName = EvtInviteDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *InviteDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageCreate Sent when a message is created. The inner payload is a message object.
+//
+func (shr socketHandlerRegister) MessageCreate(handler HandlerMessageCreate, moreHandlers ...HandlerMessageCreate) {
+	shr.evtName = EvtMessageCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) MessageCreateChan(handler chan *MessageCreate, moreHandlers ...chan *MessageCreate) {
+	shr.evtName = EvtMessageCreate
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// MessageCreateStream returns a channel of MessageCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageCreateStream(ctx context.Context) <-chan *MessageCreate {
+	channel := make(chan *MessageCreate)
+	shr.evtName = EvtMessageCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageDelete Sent when a message is deleted.
+//
+func (shr socketHandlerRegister) MessageDelete(handler HandlerMessageDelete, moreHandlers ...HandlerMessageDelete) {
+	shr.evtName = EvtMessageDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) MessageDeleteChan(handler chan *MessageDelete, moreHandlers ...chan *MessageDelete) {
+	shr.evtName = EvtMessageDelete
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// MessageDeleteStream returns a channel of MessageDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageDeleteStream(ctx context.Context) <-chan *MessageDelete {
+	channel := make(chan *MessageDelete)
+	shr.evtName = EvtMessageDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageDeleteBulk Sent when multiple messages are deleted at once.
+//
+func (shr socketHandlerRegister) MessageDeleteBulk(handler HandlerMessageDeleteBulk, moreHandlers ...HandlerMessageDeleteBulk) {
+	shr.evtName = EvtMessageDeleteBulk
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+func (shr socketHandlerRegister) MessageDeleteBulkChan(handler chan *MessageDeleteBulk, moreHandlers ...chan *MessageDeleteBulk) {
+	shr.evtName = EvtMessageDeleteBulk
+	shr.handlers = append(shr.handlers, handler)
+	for _, h := range moreHandlers {
+		shr.handlers = append(shr.handlers, h)
+	}
+	shr.build()
+}
+
+// MessageDeleteBulkStream returns a channel of MessageDeleteBulk events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageDeleteBulkStream(ctx context.Context) <-chan *MessageDeleteBulk {
+	channel := make(chan *MessageDeleteBulk)
+	shr.evtName = EvtMessageDeleteBulk
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageDeleteBulk) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessagePollVoteAdd Sent when a user votes on a poll. Polls with multi-select send one event per answer.
+//
+func (shr socketHandlerRegister) MessagePollVoteAdd(handler HandlerMessagePollVoteAdd, moreHandlers ...HandlerMessagePollVoteAdd) {
+	shr.evtName = EvtMessagePollVoteAdd
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -590,8 +1921,8 @@ func (shr socketHandlerRegister) GuildMemberAdd(handler HandlerGuildMemberAdd, m
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildMemberAddChan(handler chan *GuildMemberAdd, moreHandlers ...chan *GuildMemberAdd) {
-	shr.evtName = EvtGuildMemberAdd
+func (shr socketHandlerRegister) MessagePollVoteAddChan(handler chan *MessagePollVoteAdd, moreHandlers ...chan *MessagePollVoteAdd) {
+	shr.evtName = EvtMessagePollVoteAdd
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -599,10 +1930,25 @@ func (shr socketHandlerRegister) GuildMemberAddChan(handler chan *GuildMemberAdd
 	shr.build()
 }
 
-// GuildMemberRemove Sent when a user is removed from a guild (leave/kick/ban).
+// MessagePollVoteAddStream returns a channel of MessagePollVoteAdd events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessagePollVoteAddStream(ctx context.Context) <-chan *MessagePollVoteAdd {
+	channel := make(chan *MessagePollVoteAdd)
+	shr.evtName = EvtMessagePollVoteAdd
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessagePollVoteAdd) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessagePollVoteRemove Sent when a user removes their vote on a poll.
 //
-func (shr socketHandlerRegister) GuildMemberRemove(handler HandlerGuildMemberRemove, moreHandlers ...HandlerGuildMemberRemove) {
-	shr.evtName = EvtGuildMemberRemove
+func (shr socketHandlerRegister) MessagePollVoteRemove(handler HandlerMessagePollVoteRemove, moreHandlers ...HandlerMessagePollVoteRemove) {
+	shr.evtName = EvtMessagePollVoteRemove
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -610,8 +1956,8 @@ func (shr socketHandlerRegister) GuildMemberRemove(handler HandlerGuildMemberRem
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildMemberRemoveChan(handler chan *GuildMemberRemove, moreHandlers ...chan *GuildMemberRemove) {
-	shr.evtName = EvtGuildMemberRemove
+func (shr socketHandlerRegister) MessagePollVoteRemoveChan(handler chan *MessagePollVoteRemove, moreHandlers ...chan *MessagePollVoteRemove) {
+	shr.evtName = EvtMessagePollVoteRemove
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -619,10 +1965,25 @@ func (shr socketHandlerRegister) GuildMemberRemoveChan(handler chan *GuildMember
 	shr.build()
 }
 
-// GuildMemberUpdate Sent when a guild member is updated.
+// MessagePollVoteRemoveStream returns a channel of MessagePollVoteRemove events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessagePollVoteRemoveStream(ctx context.Context) <-chan *MessagePollVoteRemove {
+	channel := make(chan *MessagePollVoteRemove)
+	shr.evtName = EvtMessagePollVoteRemove
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessagePollVoteRemove) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageReactionAdd Sent when a user adds a reaction to a message.
 //
-func (shr socketHandlerRegister) GuildMemberUpdate(handler HandlerGuildMemberUpdate, moreHandlers ...HandlerGuildMemberUpdate) {
-	shr.evtName = EvtGuildMemberUpdate
+func (shr socketHandlerRegister) MessageReactionAdd(handler HandlerMessageReactionAdd, moreHandlers ...HandlerMessageReactionAdd) {
+	shr.evtName = EvtMessageReactionAdd
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -630,8 +1991,8 @@ func (shr socketHandlerRegister) GuildMemberUpdate(handler HandlerGuildMemberUpd
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildMemberUpdateChan(handler chan *GuildMemberUpdate, moreHandlers ...chan *GuildMemberUpdate) {
-	shr.evtName = EvtGuildMemberUpdate
+func (shr socketHandlerRegister) MessageReactionAddChan(handler chan *MessageReactionAdd, moreHandlers ...chan *MessageReactionAdd) {
+	shr.evtName = EvtMessageReactionAdd
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -639,10 +2000,25 @@ func (shr socketHandlerRegister) GuildMemberUpdateChan(handler chan *GuildMember
 	shr.build()
 }
 
-// GuildMembersChunk Sent in response to Gateway Request Guild Members.
+// MessageReactionAddStream returns a channel of MessageReactionAdd events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageReactionAddStream(ctx context.Context) <-chan *MessageReactionAdd {
+	channel := make(chan *MessageReactionAdd)
+	shr.evtName = EvtMessageReactionAdd
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageReactionAdd) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageReactionRemove Sent when a user removes a reaction from a message.
 //
-func (shr socketHandlerRegister) GuildMembersChunk(handler HandlerGuildMembersChunk, moreHandlers ...HandlerGuildMembersChunk) {
-	shr.evtName = EvtGuildMembersChunk
+func (shr socketHandlerRegister) MessageReactionRemove(handler HandlerMessageReactionRemove, moreHandlers ...HandlerMessageReactionRemove) {
+	shr.evtName = EvtMessageReactionRemove
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -650,8 +2026,8 @@ func (shr socketHandlerRegister) GuildMembersChunk(handler HandlerGuildMembersCh
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildMembersChunkChan(handler chan *GuildMembersChunk, moreHandlers ...chan *GuildMembersChunk) {
-	shr.evtName = EvtGuildMembersChunk
+func (shr socketHandlerRegister) MessageReactionRemoveChan(handler chan *MessageReactionRemove, moreHandlers ...chan *MessageReactionRemove) {
+	shr.evtName = EvtMessageReactionRemove
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -659,10 +2035,25 @@ func (shr socketHandlerRegister) GuildMembersChunkChan(handler chan *GuildMember
 	shr.build()
 }
 
-// GuildRoleCreate Sent when a guild role is created.
+// MessageReactionRemoveStream returns a channel of MessageReactionRemove events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageReactionRemoveStream(ctx context.Context) <-chan *MessageReactionRemove {
+	channel := make(chan *MessageReactionRemove)
+	shr.evtName = EvtMessageReactionRemove
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageReactionRemove) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageReactionRemoveAll Sent when a user explicitly removes all reactions from a message.
 //
-func (shr socketHandlerRegister) GuildRoleCreate(handler HandlerGuildRoleCreate, moreHandlers ...HandlerGuildRoleCreate) {
-	shr.evtName = EvtGuildRoleCreate
+func (shr socketHandlerRegister) MessageReactionRemoveAll(handler HandlerMessageReactionRemoveAll, moreHandlers ...HandlerMessageReactionRemoveAll) {
+	shr.evtName = EvtMessageReactionRemoveAll
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -670,8 +2061,8 @@ func (shr socketHandlerRegister) GuildRoleCreate(handler HandlerGuildRoleCreate,
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildRoleCreateChan(handler chan *GuildRoleCreate, moreHandlers ...chan *GuildRoleCreate) {
-	shr.evtName = EvtGuildRoleCreate
+func (shr socketHandlerRegister) MessageReactionRemoveAllChan(handler chan *MessageReactionRemoveAll, moreHandlers ...chan *MessageReactionRemoveAll) {
+	shr.evtName = EvtMessageReactionRemoveAll
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -679,10 +2070,25 @@ func (shr socketHandlerRegister) GuildRoleCreateChan(handler chan *GuildRoleCrea
 	shr.build()
 }
 
-// GuildRoleDelete Sent when a guild role is created.
+// MessageReactionRemoveAllStream returns a channel of MessageReactionRemoveAll events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageReactionRemoveAllStream(ctx context.Context) <-chan *MessageReactionRemoveAll {
+	channel := make(chan *MessageReactionRemoveAll)
+	shr.evtName = EvtMessageReactionRemoveAll
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageReactionRemoveAll) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageReactionRemoveEmoji Sent when a bot removes all instances of a given emoji from the reactions of a message.
 //
-func (shr socketHandlerRegister) GuildRoleDelete(handler HandlerGuildRoleDelete, moreHandlers ...HandlerGuildRoleDelete) {
-	shr.evtName = EvtGuildRoleDelete
+func (shr socketHandlerRegister) MessageReactionRemoveEmoji(handler HandlerMessageReactionRemoveEmoji, moreHandlers ...HandlerMessageReactionRemoveEmoji) {
+	shr.evtName = EvtMessageReactionRemoveEmoji
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -690,8 +2096,8 @@ func (shr socketHandlerRegister) GuildRoleDelete(handler HandlerGuildRoleDelete,
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildRoleDeleteChan(handler chan *GuildRoleDelete, moreHandlers ...chan *GuildRoleDelete) {
-	shr.evtName = EvtGuildRoleDelete
+func (shr socketHandlerRegister) MessageReactionRemoveEmojiChan(handler chan *MessageReactionRemoveEmoji, moreHandlers ...chan *MessageReactionRemoveEmoji) {
+	shr.evtName = EvtMessageReactionRemoveEmoji
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -699,10 +2105,27 @@ func (shr socketHandlerRegister) GuildRoleDeleteChan(handler chan *GuildRoleDele
 	shr.build()
 }
 
-// GuildRoleUpdate Sent when a guild role is created.
+// MessageReactionRemoveEmojiStream returns a channel of MessageReactionRemoveEmoji events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageReactionRemoveEmojiStream(ctx context.Context) <-chan *MessageReactionRemoveEmoji {
+	channel := make(chan *MessageReactionRemoveEmoji)
+	shr.evtName = EvtMessageReactionRemoveEmoji
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageReactionRemoveEmoji) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// MessageUpdate Sent when a message is updated. The inner payload is a message object.
 //
-func (shr socketHandlerRegister) GuildRoleUpdate(handler HandlerGuildRoleUpdate, moreHandlers ...HandlerGuildRoleUpdate) {
-	shr.evtName = EvtGuildRoleUpdate
+// NOTE! Has _at_least_ the GuildID and ChannelID fields.
+//
+func (shr socketHandlerRegister) MessageUpdate(handler HandlerMessageUpdate, moreHandlers ...HandlerMessageUpdate) {
+	shr.evtName = EvtMessageUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -710,8 +2133,8 @@ func (shr socketHandlerRegister) GuildRoleUpdate(handler HandlerGuildRoleUpdate,
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildRoleUpdateChan(handler chan *GuildRoleUpdate, moreHandlers ...chan *GuildRoleUpdate) {
-	shr.evtName = EvtGuildRoleUpdate
+func (shr socketHandlerRegister) MessageUpdateChan(handler chan *MessageUpdate, moreHandlers ...chan *MessageUpdate) {
+	shr.evtName = EvtMessageUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -719,10 +2142,25 @@ func (shr socketHandlerRegister) GuildRoleUpdateChan(handler chan *GuildRoleUpda
 	shr.build()
 }
 
-// GuildUpdate Sent when a guild is updated. The inner payload is a guild object.
+// MessageUpdateStream returns a channel of MessageUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) MessageUpdateStream(ctx context.Context) <-chan *MessageUpdate {
+	channel := make(chan *MessageUpdate)
+	shr.evtName = EvtMessageUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *MessageUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// PresenceUpdate A user's presence is their current state on a guild. This event is sent when a user's presence is updated for a guild.
 //
-func (shr socketHandlerRegister) GuildUpdate(handler HandlerGuildUpdate, moreHandlers ...HandlerGuildUpdate) {
-	shr.evtName = EvtGuildUpdate
+func (shr socketHandlerRegister) PresenceUpdate(handler HandlerPresenceUpdate, moreHandlers ...HandlerPresenceUpdate) {
+	shr.evtName = EvtPresenceUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -730,8 +2168,8 @@ func (shr socketHandlerRegister) GuildUpdate(handler HandlerGuildUpdate, moreHan
 	shr.build()
 }
 
-func (shr socketHandlerRegister) GuildUpdateChan(handler chan *GuildUpdate, moreHandlers ...chan *GuildUpdate) {
-	shr.evtName = EvtGuildUpdate
+func (shr socketHandlerRegister) PresenceUpdateChan(handler chan *PresenceUpdate, moreHandlers ...chan *PresenceUpdate) {
+	shr.evtName = EvtPresenceUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -739,10 +2177,27 @@ func (shr socketHandlerRegister) GuildUpdateChan(handler chan *GuildUpdate, more
 	shr.build()
 }
 
-// InteractionCreate Sent when a user in a guild uses a Slash Command.
+// PresenceUpdateStream returns a channel of PresenceUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) PresenceUpdateStream(ctx context.Context) <-chan *PresenceUpdate {
+	channel := make(chan *PresenceUpdate)
+	shr.evtName = EvtPresenceUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *PresenceUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// Ready The ready event is dispatched when a client has completed the initial handshake with the gateway (for new sessions).
+// The ready event can be the largest and most complex event the gateway will send, as it contains all the state
+// required for a client to begin interacting with the rest of the platform.
 //
-func (shr socketHandlerRegister) InteractionCreate(handler HandlerInteractionCreate, moreHandlers ...HandlerInteractionCreate) {
-	shr.evtName = EvtInteractionCreate
+func (shr socketHandlerRegister) Ready(handler HandlerReady, moreHandlers ...HandlerReady) {
+	shr.evtName = EvtReady
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -750,8 +2205,8 @@ func (shr socketHandlerRegister) InteractionCreate(handler HandlerInteractionCre
 	shr.build()
 }
 
-func (shr socketHandlerRegister) InteractionCreateChan(handler chan *InteractionCreate, moreHandlers ...chan *InteractionCreate) {
-	shr.evtName = EvtInteractionCreate
+func (shr socketHandlerRegister) ReadyChan(handler chan *Ready, moreHandlers ...chan *Ready) {
+	shr.evtName = EvtReady
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -759,10 +2214,26 @@ func (shr socketHandlerRegister) InteractionCreateChan(handler chan *Interaction
 	shr.build()
 }
 
-// InviteCreate Sent when a guild's invite is created.
+// ReadyStream returns a channel of Ready events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ReadyStream(ctx context.Context) <-chan *Ready {
+	channel := make(chan *Ready)
+	shr.evtName = EvtReady
+	shr.handlers = append(shr.handlers, func(_ Session, evt *Ready) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// Resumed The resumed event is dispatched when a client has sent a resume payload to the gateway
+// (for resuming existing sessions).
 //
-func (shr socketHandlerRegister) InviteCreate(handler HandlerInviteCreate, moreHandlers ...HandlerInviteCreate) {
-	shr.evtName = EvtInviteCreate
+func (shr socketHandlerRegister) Resumed(handler HandlerResumed, moreHandlers ...HandlerResumed) {
+	shr.evtName = EvtResumed
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -770,8 +2241,8 @@ func (shr socketHandlerRegister) InviteCreate(handler HandlerInviteCreate, moreH
 	shr.build()
 }
 
-func (shr socketHandlerRegister) InviteCreateChan(handler chan *InviteCreate, moreHandlers ...chan *InviteCreate) {
-	shr.evtName = EvtInviteCreate
+func (shr socketHandlerRegister) ResumedChan(handler chan *Resumed, moreHandlers ...chan *Resumed) {
+	shr.evtName = EvtResumed
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -779,10 +2250,25 @@ func (shr socketHandlerRegister) InviteCreateChan(handler chan *InviteCreate, mo
 	shr.build()
 }
 
-// InviteDelete Sent when an invite is deleted.
+// ResumedStream returns a channel of Resumed events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ResumedStream(ctx context.Context) <-chan *Resumed {
+	channel := make(chan *Resumed)
+	shr.evtName = EvtResumed
+	shr.handlers = append(shr.handlers, func(_ Session, evt *Resumed) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// StageInstanceCreate Sent when a stage instance is created, i.e. the stage is now live.
 //
-func (shr socketHandlerRegister) InviteDelete(handler HandlerInviteDelete, moreHandlers ...HandlerInviteDelete) {
-	shr.evtName = EvtInviteDelete
+func (shr socketHandlerRegister) StageInstanceCreate(handler HandlerStageInstanceCreate, moreHandlers ...HandlerStageInstanceCreate) {
+	shr.evtName = EvtStageInstanceCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -790,8 +2276,8 @@ func (shr socketHandlerRegister) InviteDelete(handler HandlerInviteDelete, moreH
 	shr.build()
 }
 
-func (shr socketHandlerRegister) InviteDeleteChan(handler chan *InviteDelete, moreHandlers ...chan *InviteDelete) {
-	shr.evtName = EvtInviteDelete
+func (shr socketHandlerRegister) StageInstanceCreateChan(handler chan *StageInstanceCreate, moreHandlers ...chan *StageInstanceCreate) {
+	shr.evtName = EvtStageInstanceCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -799,10 +2285,25 @@ func (shr socketHandlerRegister) InviteDeleteChan(handler chan *InviteDelete, mo
 	shr.build()
 }
 
-// MessageCreate Sent when a message is created. The inner payload is a message object.
+// StageInstanceCreateStream returns a channel of StageInstanceCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) StageInstanceCreateStream(ctx context.Context) <-chan *StageInstanceCreate {
+	channel := make(chan *StageInstanceCreate)
+	shr.evtName = EvtStageInstanceCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *StageInstanceCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// StageInstanceDelete Sent when a stage instance has been deleted, i.e. the stage has been closed.
 //
-func (shr socketHandlerRegister) MessageCreate(handler HandlerMessageCreate, moreHandlers ...HandlerMessageCreate) {
-	shr.evtName = EvtMessageCreate
+func (shr socketHandlerRegister) StageInstanceDelete(handler HandlerStageInstanceDelete, moreHandlers ...HandlerStageInstanceDelete) {
+	shr.evtName = EvtStageInstanceDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -810,8 +2311,8 @@ func (shr socketHandlerRegister) MessageCreate(handler HandlerMessageCreate, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageCreateChan(handler chan *MessageCreate, moreHandlers ...chan *MessageCreate) {
-	shr.evtName = EvtMessageCreate
+func (shr socketHandlerRegister) StageInstanceDeleteChan(handler chan *StageInstanceDelete, moreHandlers ...chan *StageInstanceDelete) {
+	shr.evtName = EvtStageInstanceDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -819,10 +2320,25 @@ func (shr socketHandlerRegister) MessageCreateChan(handler chan *MessageCreate,
 	shr.build()
 }
 
-// MessageDelete Sent when a message is deleted.
+// StageInstanceDeleteStream returns a channel of StageInstanceDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) StageInstanceDeleteStream(ctx context.Context) <-chan *StageInstanceDelete {
+	channel := make(chan *StageInstanceDelete)
+	shr.evtName = EvtStageInstanceDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *StageInstanceDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// StageInstanceUpdate Sent when a stage instance has been updated.
 //
-func (shr socketHandlerRegister) MessageDelete(handler HandlerMessageDelete, moreHandlers ...HandlerMessageDelete) {
-	shr.evtName = EvtMessageDelete
+func (shr socketHandlerRegister) StageInstanceUpdate(handler HandlerStageInstanceUpdate, moreHandlers ...HandlerStageInstanceUpdate) {
+	shr.evtName = EvtStageInstanceUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -830,8 +2346,8 @@ func (shr socketHandlerRegister) MessageDelete(handler HandlerMessageDelete, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageDeleteChan(handler chan *MessageDelete, moreHandlers ...chan *MessageDelete) {
-	shr.evtName = EvtMessageDelete
+func (shr socketHandlerRegister) StageInstanceUpdateChan(handler chan *StageInstanceUpdate, moreHandlers ...chan *StageInstanceUpdate) {
+	shr.evtName = EvtStageInstanceUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -839,10 +2355,25 @@ func (shr socketHandlerRegister) MessageDeleteChan(handler chan *MessageDelete,
 	shr.build()
 }
 
-// MessageDeleteBulk Sent when multiple messages are deleted at once.
+// StageInstanceUpdateStream returns a channel of StageInstanceUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) StageInstanceUpdateStream(ctx context.Context) <-chan *StageInstanceUpdate {
+	channel := make(chan *StageInstanceUpdate)
+	shr.evtName = EvtStageInstanceUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *StageInstanceUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// SubscriptionCreate Sent when a subscription for a premium offering is created.
 //
-func (shr socketHandlerRegister) MessageDeleteBulk(handler HandlerMessageDeleteBulk, moreHandlers ...HandlerMessageDeleteBulk) {
-	shr.evtName = EvtMessageDeleteBulk
+func (shr socketHandlerRegister) SubscriptionCreate(handler HandlerSubscriptionCreate, moreHandlers ...HandlerSubscriptionCreate) {
+	shr.evtName = EvtSubscriptionCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -850,8 +2381,8 @@ func (shr socketHandlerRegister) MessageDeleteBulk(handler HandlerMessageDeleteB
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageDeleteBulkChan(handler chan *MessageDeleteBulk, moreHandlers ...chan *MessageDeleteBulk) {
-	shr.evtName = EvtMessageDeleteBulk
+func (shr socketHandlerRegister) SubscriptionCreateChan(handler chan *SubscriptionCreate, moreHandlers ...chan *SubscriptionCreate) {
+	shr.evtName = EvtSubscriptionCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -859,10 +2390,25 @@ func (shr socketHandlerRegister) MessageDeleteBulkChan(handler chan *MessageDele
 	shr.build()
 }
 
-// MessageReactionAdd Sent when a user adds a reaction to a message.
+// SubscriptionCreateStream returns a channel of SubscriptionCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) SubscriptionCreateStream(ctx context.Context) <-chan *SubscriptionCreate {
+	channel := make(chan *SubscriptionCreate)
+	shr.evtName = EvtSubscriptionCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *SubscriptionCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// SubscriptionDelete Sent when a subscription is deleted.
 //
-func (shr socketHandlerRegister) MessageReactionAdd(handler HandlerMessageReactionAdd, moreHandlers ...HandlerMessageReactionAdd) {
-	shr.evtName = EvtMessageReactionAdd
+func (shr socketHandlerRegister) SubscriptionDelete(handler HandlerSubscriptionDelete, moreHandlers ...HandlerSubscriptionDelete) {
+	shr.evtName = EvtSubscriptionDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -870,8 +2416,8 @@ func (shr socketHandlerRegister) MessageReactionAdd(handler HandlerMessageReacti
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageReactionAddChan(handler chan *MessageReactionAdd, moreHandlers ...chan *MessageReactionAdd) {
-	shr.evtName = EvtMessageReactionAdd
+func (shr socketHandlerRegister) SubscriptionDeleteChan(handler chan *SubscriptionDelete, moreHandlers ...chan *SubscriptionDelete) {
+	shr.evtName = EvtSubscriptionDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -879,10 +2425,25 @@ func (shr socketHandlerRegister) MessageReactionAddChan(handler chan *MessageRea
 	shr.build()
 }
 
-// MessageReactionRemove Sent when a user removes a reaction from a message.
+// SubscriptionDeleteStream returns a channel of SubscriptionDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) SubscriptionDeleteStream(ctx context.Context) <-chan *SubscriptionDelete {
+	channel := make(chan *SubscriptionDelete)
+	shr.evtName = EvtSubscriptionDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *SubscriptionDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// SubscriptionUpdate Sent when a subscription is updated, eg. when it renews or is canceled.
 //
-func (shr socketHandlerRegister) MessageReactionRemove(handler HandlerMessageReactionRemove, moreHandlers ...HandlerMessageReactionRemove) {
-	shr.evtName = EvtMessageReactionRemove
+func (shr socketHandlerRegister) SubscriptionUpdate(handler HandlerSubscriptionUpdate, moreHandlers ...HandlerSubscriptionUpdate) {
+	shr.evtName = EvtSubscriptionUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -890,8 +2451,8 @@ func (shr socketHandlerRegister) MessageReactionRemove(handler HandlerMessageRea
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageReactionRemoveChan(handler chan *MessageReactionRemove, moreHandlers ...chan *MessageReactionRemove) {
-	shr.evtName = EvtMessageReactionRemove
+func (shr socketHandlerRegister) SubscriptionUpdateChan(handler chan *SubscriptionUpdate, moreHandlers ...chan *SubscriptionUpdate) {
+	shr.evtName = EvtSubscriptionUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -899,10 +2460,25 @@ func (shr socketHandlerRegister) MessageReactionRemoveChan(handler chan *Message
 	shr.build()
 }
 
-// MessageReactionRemoveAll Sent when a user explicitly removes all reactions from a message.
+// SubscriptionUpdateStream returns a channel of SubscriptionUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) SubscriptionUpdateStream(ctx context.Context) <-chan *SubscriptionUpdate {
+	channel := make(chan *SubscriptionUpdate)
+	shr.evtName = EvtSubscriptionUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *SubscriptionUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadCreate Sent when a thread is created, relevant to the current user, or when the current user is added to a thread.
 //
-func (shr socketHandlerRegister) MessageReactionRemoveAll(handler HandlerMessageReactionRemoveAll, moreHandlers ...HandlerMessageReactionRemoveAll) {
-	shr.evtName = EvtMessageReactionRemoveAll
+func (shr socketHandlerRegister) ThreadCreate(handler HandlerThreadCreate, moreHandlers ...HandlerThreadCreate) {
+	shr.evtName = EvtThreadCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -910,8 +2486,8 @@ func (shr socketHandlerRegister) MessageReactionRemoveAll(handler HandlerMessage
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageReactionRemoveAllChan(handler chan *MessageReactionRemoveAll, moreHandlers ...chan *MessageReactionRemoveAll) {
-	shr.evtName = EvtMessageReactionRemoveAll
+func (shr socketHandlerRegister) ThreadCreateChan(handler chan *ThreadCreate, moreHandlers ...chan *ThreadCreate) {
+	shr.evtName = EvtThreadCreate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -919,10 +2495,25 @@ func (shr socketHandlerRegister) MessageReactionRemoveAllChan(handler chan *Mess
 	shr.build()
 }
 
-// MessageReactionRemoveEmoji Sent when a bot removes all instances of a given emoji from the reactions of a message.
+// ThreadCreateStream returns a channel of ThreadCreate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadCreateStream(ctx context.Context) <-chan *ThreadCreate {
+	channel := make(chan *ThreadCreate)
+	shr.evtName = EvtThreadCreate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadCreate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadDelete Sent when a thread relevant to the current user is deleted.
 //
-func (shr socketHandlerRegister) MessageReactionRemoveEmoji(handler HandlerMessageReactionRemoveEmoji, moreHandlers ...HandlerMessageReactionRemoveEmoji) {
-	shr.evtName = EvtMessageReactionRemoveEmoji
+func (shr socketHandlerRegister) ThreadDelete(handler HandlerThreadDelete, moreHandlers ...HandlerThreadDelete) {
+	shr.evtName = EvtThreadDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -930,8 +2521,8 @@ func (shr socketHandlerRegister) MessageReactionRemoveEmoji(handler HandlerMessa
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageReactionRemoveEmojiChan(handler chan *MessageReactionRemoveEmoji, moreHandlers ...chan *MessageReactionRemoveEmoji) {
-	shr.evtName = EvtMessageReactionRemoveEmoji
+func (shr socketHandlerRegister) ThreadDeleteChan(handler chan *ThreadDelete, moreHandlers ...chan *ThreadDelete) {
+	shr.evtName = EvtThreadDelete
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -939,12 +2530,25 @@ func (shr socketHandlerRegister) MessageReactionRemoveEmojiChan(handler chan *Me
 	shr.build()
 }
 
-// MessageUpdate Sent when a message is updated. The inner payload is a message object.
-//
-// NOTE! Has _at_least_ the GuildID and ChannelID fields.
+// ThreadDeleteStream returns a channel of ThreadDelete events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadDeleteStream(ctx context.Context) <-chan *ThreadDelete {
+	channel := make(chan *ThreadDelete)
+	shr.evtName = EvtThreadDelete
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadDelete) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadListSync Sent when the current user gains access to a channel. Holds all the active threads of the channel(s).
 //
-func (shr socketHandlerRegister) MessageUpdate(handler HandlerMessageUpdate, moreHandlers ...HandlerMessageUpdate) {
-	shr.evtName = EvtMessageUpdate
+func (shr socketHandlerRegister) ThreadListSync(handler HandlerThreadListSync, moreHandlers ...HandlerThreadListSync) {
+	shr.evtName = EvtThreadListSync
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -952,8 +2556,8 @@ func (shr socketHandlerRegister) MessageUpdate(handler HandlerMessageUpdate, mor
 	shr.build()
 }
 
-func (shr socketHandlerRegister) MessageUpdateChan(handler chan *MessageUpdate, moreHandlers ...chan *MessageUpdate) {
-	shr.evtName = EvtMessageUpdate
+func (shr socketHandlerRegister) ThreadListSyncChan(handler chan *ThreadListSync, moreHandlers ...chan *ThreadListSync) {
+	shr.evtName = EvtThreadListSync
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -961,10 +2565,25 @@ func (shr socketHandlerRegister) MessageUpdateChan(handler chan *MessageUpdate,
 	shr.build()
 }
 
-// PresenceUpdate A user's presence is their current state on a guild. This event is sent when a user's presence is updated for a guild.
+// ThreadListSyncStream returns a channel of ThreadListSync events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadListSyncStream(ctx context.Context) <-chan *ThreadListSync {
+	channel := make(chan *ThreadListSync)
+	shr.evtName = EvtThreadListSync
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadListSync) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadMemberUpdate Sent when the thread member object for the current user is updated.
 //
-func (shr socketHandlerRegister) PresenceUpdate(handler HandlerPresenceUpdate, moreHandlers ...HandlerPresenceUpdate) {
-	shr.evtName = EvtPresenceUpdate
+func (shr socketHandlerRegister) ThreadMemberUpdate(handler HandlerThreadMemberUpdate, moreHandlers ...HandlerThreadMemberUpdate) {
+	shr.evtName = EvtThreadMemberUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -972,8 +2591,8 @@ func (shr socketHandlerRegister) PresenceUpdate(handler HandlerPresenceUpdate, m
 	shr.build()
 }
 
-func (shr socketHandlerRegister) PresenceUpdateChan(handler chan *PresenceUpdate, moreHandlers ...chan *PresenceUpdate) {
-	shr.evtName = EvtPresenceUpdate
+func (shr socketHandlerRegister) ThreadMemberUpdateChan(handler chan *ThreadMemberUpdate, moreHandlers ...chan *ThreadMemberUpdate) {
+	shr.evtName = EvtThreadMemberUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -981,12 +2600,25 @@ func (shr socketHandlerRegister) PresenceUpdateChan(handler chan *PresenceUpdate
 	shr.build()
 }
 
-// Ready The ready event is dispatched when a client has completed the initial handshake with the gateway (for new sessions).
-// The ready event can be the largest and most complex event the gateway will send, as it contains all the state
-// required for a client to begin interacting with the rest of the platform.
+// ThreadMemberUpdateStream returns a channel of ThreadMemberUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadMemberUpdateStream(ctx context.Context) <-chan *ThreadMemberUpdate {
+	channel := make(chan *ThreadMemberUpdate)
+	shr.evtName = EvtThreadMemberUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadMemberUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadMembersUpdate Sent when anyone is added to or removed from a thread.
 //
-func (shr socketHandlerRegister) Ready(handler HandlerReady, moreHandlers ...HandlerReady) {
-	shr.evtName = EvtReady
+func (shr socketHandlerRegister) ThreadMembersUpdate(handler HandlerThreadMembersUpdate, moreHandlers ...HandlerThreadMembersUpdate) {
+	shr.evtName = EvtThreadMembersUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -994,8 +2626,8 @@ func (shr socketHandlerRegister) Ready(handler HandlerReady, moreHandlers ...Han
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ReadyChan(handler chan *Ready, moreHandlers ...chan *Ready) {
-	shr.evtName = EvtReady
+func (shr socketHandlerRegister) ThreadMembersUpdateChan(handler chan *ThreadMembersUpdate, moreHandlers ...chan *ThreadMembersUpdate) {
+	shr.evtName = EvtThreadMembersUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -1003,11 +2635,25 @@ func (shr socketHandlerRegister) ReadyChan(handler chan *Ready, moreHandlers ...
 	shr.build()
 }
 
-// Resumed The resumed event is dispatched when a client has sent a resume payload to the gateway
-// (for resuming existing sessions).
+// ThreadMembersUpdateStream returns a channel of ThreadMembersUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadMembersUpdateStream(ctx context.Context) <-chan *ThreadMembersUpdate {
+	channel := make(chan *ThreadMembersUpdate)
+	shr.evtName = EvtThreadMembersUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadMembersUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
+// ThreadUpdate Sent when a thread is updated.
 //
-func (shr socketHandlerRegister) Resumed(handler HandlerResumed, moreHandlers ...HandlerResumed) {
-	shr.evtName = EvtResumed
+func (shr socketHandlerRegister) ThreadUpdate(handler HandlerThreadUpdate, moreHandlers ...HandlerThreadUpdate) {
+	shr.evtName = EvtThreadUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -1015,8 +2661,8 @@ func (shr socketHandlerRegister) Resumed(handler HandlerResumed, moreHandlers ..
 	shr.build()
 }
 
-func (shr socketHandlerRegister) ResumedChan(handler chan *Resumed, moreHandlers ...chan *Resumed) {
-	shr.evtName = EvtResumed
+func (shr socketHandlerRegister) ThreadUpdateChan(handler chan *ThreadUpdate, moreHandlers ...chan *ThreadUpdate) {
+	shr.evtName = EvtThreadUpdate
 	shr.handlers = append(shr.handlers, handler)
 	for _, h := range moreHandlers {
 		shr.handlers = append(shr.handlers, h)
@@ -1024,6 +2670,21 @@ func (shr socketHandlerRegister) ResumedChan(handler chan *Resumed, moreHandlers
 	shr.build()
 }
 
+// ThreadUpdateStream returns a channel of ThreadUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) ThreadUpdateStream(ctx context.Context) <-chan *ThreadUpdate {
+	channel := make(chan *ThreadUpdate)
+	shr.evtName = EvtThreadUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *ThreadUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 // TypingStart Sent when a user starts typing in a channel.
 //
 func (shr socketHandlerRegister) TypingStart(handler HandlerTypingStart, moreHandlers ...HandlerTypingStart) {
@@ -1044,6 +2705,21 @@ func (shr socketHandlerRegister) TypingStartChan(handler chan *TypingStart, more
 	shr.build()
 }
 
+// TypingStartStream returns a channel of TypingStart events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) TypingStartStream(ctx context.Context) <-chan *TypingStart {
+	channel := make(chan *TypingStart)
+	shr.evtName = EvtTypingStart
+	shr.handlers = append(shr.handlers, func(_ Session, evt *TypingStart) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 // UserUpdate Sent when properties about the user change. Inner payload is a user object.
 //
 func (shr socketHandlerRegister) UserUpdate(handler HandlerUserUpdate, moreHandlers ...HandlerUserUpdate) {
@@ -1064,6 +2740,21 @@ func (shr socketHandlerRegister) UserUpdateChan(handler chan *UserUpdate, moreHa
 	shr.build()
 }
 
+// UserUpdateStream returns a channel of UserUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) UserUpdateStream(ctx context.Context) <-chan *UserUpdate {
+	channel := make(chan *UserUpdate)
+	shr.evtName = EvtUserUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *UserUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 // VoiceServerUpdate Sent when a guild's voice server is updated. This is sent when initially connecting to voice, and when the current
 // voice instance fails over to a new server.
 //
@@ -1085,6 +2776,21 @@ func (shr socketHandlerRegister) VoiceServerUpdateChan(handler chan *VoiceServer
 	shr.build()
 }
 
+// VoiceServerUpdateStream returns a channel of VoiceServerUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) VoiceServerUpdateStream(ctx context.Context) <-chan *VoiceServerUpdate {
+	channel := make(chan *VoiceServerUpdate)
+	shr.evtName = EvtVoiceServerUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *VoiceServerUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 // VoiceStateUpdate Sent when someone joins/leaves/moves voice channels. Inner payload is a voice state object.
 //
 func (shr socketHandlerRegister) VoiceStateUpdate(handler HandlerVoiceStateUpdate, moreHandlers ...HandlerVoiceStateUpdate) {
@@ -1105,6 +2811,21 @@ func (shr socketHandlerRegister) VoiceStateUpdateChan(handler chan *VoiceStateUp
 	shr.build()
 }
 
+// VoiceStateUpdateStream returns a channel of VoiceStateUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) VoiceStateUpdateStream(ctx context.Context) <-chan *VoiceStateUpdate {
+	channel := make(chan *VoiceStateUpdate)
+	shr.evtName = EvtVoiceStateUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *VoiceStateUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 // WebhooksUpdate Sent when a guild channel's WebHook is created, updated, or deleted.
 //
 func (shr socketHandlerRegister) WebhooksUpdate(handler HandlerWebhooksUpdate, moreHandlers ...HandlerWebhooksUpdate) {
@@ -1125,81 +2846,215 @@ func (shr socketHandlerRegister) WebhooksUpdateChan(handler chan *WebhooksUpdate
 	shr.build()
 }
 
+// WebhooksUpdateStream returns a channel of WebhooksUpdate events. The handler is removed and the channel closed once the context is done.
+func (shr socketHandlerRegister) WebhooksUpdateStream(ctx context.Context) <-chan *WebhooksUpdate {
+	channel := make(chan *WebhooksUpdate)
+	shr.evtName = EvtWebhooksUpdate
+	shr.handlers = append(shr.handlers, func(_ Session, evt *WebhooksUpdate) {
+		select {
+		case channel <- evt:
+		case <-ctx.Done():
+		}
+	})
+	shr.withStreamCtrl(ctx, channel)
+	shr.build()
+	return channel
+}
+
 type SocketHandlerRegistrator interface {
+	AutoModerationActionExecution(handler HandlerAutoModerationActionExecution, moreHandlers ...HandlerAutoModerationActionExecution)
+	AutoModerationActionExecutionChan(handler chan *AutoModerationActionExecution, moreHandlers ...chan *AutoModerationActionExecution)
+	AutoModerationActionExecutionStream(ctx context.Context) <-chan *AutoModerationActionExecution
+	AutoModerationRuleCreate(handler HandlerAutoModerationRuleCreate, moreHandlers ...HandlerAutoModerationRuleCreate)
+	AutoModerationRuleCreateChan(handler chan *AutoModerationRuleCreate, moreHandlers ...chan *AutoModerationRuleCreate)
+	AutoModerationRuleCreateStream(ctx context.Context) <-chan *AutoModerationRuleCreate
+	AutoModerationRuleDelete(handler HandlerAutoModerationRuleDelete, moreHandlers ...HandlerAutoModerationRuleDelete)
+	AutoModerationRuleDeleteChan(handler chan *AutoModerationRuleDelete, moreHandlers ...chan *AutoModerationRuleDelete)
+	AutoModerationRuleDeleteStream(ctx context.Context) <-chan *AutoModerationRuleDelete
+	AutoModerationRuleUpdate(handler HandlerAutoModerationRuleUpdate, moreHandlers ...HandlerAutoModerationRuleUpdate)
+	AutoModerationRuleUpdateChan(handler chan *AutoModerationRuleUpdate, moreHandlers ...chan *AutoModerationRuleUpdate)
+	AutoModerationRuleUpdateStream(ctx context.Context) <-chan *AutoModerationRuleUpdate
 	ChannelCreate(handler HandlerChannelCreate, moreHandlers ...HandlerChannelCreate)
 	ChannelCreateChan(handler chan *ChannelCreate, moreHandlers ...chan *ChannelCreate)
+	ChannelCreateStream(ctx context.Context) <-chan *ChannelCreate
 	ChannelDelete(handler HandlerChannelDelete, moreHandlers ...HandlerChannelDelete)
 	ChannelDeleteChan(handler chan *ChannelDelete, moreHandlers ...chan *ChannelDelete)
+	ChannelDeleteStream(ctx context.Context) <-chan *ChannelDelete
 	ChannelPinsUpdate(handler HandlerChannelPinsUpdate, moreHandlers ...HandlerChannelPinsUpdate)
 	ChannelPinsUpdateChan(handler chan *ChannelPinsUpdate, moreHandlers ...chan *ChannelPinsUpdate)
+	ChannelPinsUpdateStream(ctx context.Context) <-chan *ChannelPinsUpdate
 	ChannelUpdate(handler HandlerChannelUpdate, moreHandlers ...HandlerChannelUpdate)
 	ChannelUpdateChan(handler chan *ChannelUpdate, moreHandlers ...chan *ChannelUpdate)
+	ChannelUpdateStream(ctx context.Context) <-chan *ChannelUpdate
+	EntitlementCreate(handler HandlerEntitlementCreate, moreHandlers ...HandlerEntitlementCreate)
+	EntitlementCreateChan(handler chan *EntitlementCreate, moreHandlers ...chan *EntitlementCreate)
+	EntitlementCreateStream(ctx context.Context) <-chan *EntitlementCreate
+	EntitlementDelete(handler HandlerEntitlementDelete, moreHandlers ...HandlerEntitlementDelete)
+	EntitlementDeleteChan(handler chan *EntitlementDelete, moreHandlers ...chan *EntitlementDelete)
+	EntitlementDeleteStream(ctx context.Context) <-chan *EntitlementDelete
+	EntitlementUpdate(handler HandlerEntitlementUpdate, moreHandlers ...HandlerEntitlementUpdate)
+	EntitlementUpdateChan(handler chan *EntitlementUpdate, moreHandlers ...chan *EntitlementUpdate)
+	EntitlementUpdateStream(ctx context.Context) <-chan *EntitlementUpdate
 	GuildBanAdd(handler HandlerGuildBanAdd, moreHandlers ...HandlerGuildBanAdd)
 	GuildBanAddChan(handler chan *GuildBanAdd, moreHandlers ...chan *GuildBanAdd)
+	GuildBanAddStream(ctx context.Context) <-chan *GuildBanAdd
 	GuildBanRemove(handler HandlerGuildBanRemove, moreHandlers ...HandlerGuildBanRemove)
 	GuildBanRemoveChan(handler chan *GuildBanRemove, moreHandlers ...chan *GuildBanRemove)
+	GuildBanRemoveStream(ctx context.Context) <-chan *GuildBanRemove
 	GuildCreate(handler HandlerGuildCreate, moreHandlers ...HandlerGuildCreate)
 	GuildCreateChan(handler chan *GuildCreate, moreHandlers ...chan *GuildCreate)
+	GuildCreateStream(ctx context.Context) <-chan *GuildCreate
 	GuildDelete(handler HandlerGuildDelete, moreHandlers ...HandlerGuildDelete)
 	GuildDeleteChan(handler chan *GuildDelete, moreHandlers ...chan *GuildDelete)
+	GuildDeleteStream(ctx context.Context) <-chan *GuildDelete
 	GuildEmojisUpdate(handler HandlerGuildEmojisUpdate, moreHandlers ...HandlerGuildEmojisUpdate)
 	GuildEmojisUpdateChan(handler chan *GuildEmojisUpdate, moreHandlers ...chan *GuildEmojisUpdate)
+	GuildEmojisUpdateStream(ctx context.Context) <-chan *GuildEmojisUpdate
 	GuildIntegrationsUpdate(handler HandlerGuildIntegrationsUpdate, moreHandlers ...HandlerGuildIntegrationsUpdate)
 	GuildIntegrationsUpdateChan(handler chan *GuildIntegrationsUpdate, moreHandlers ...chan *GuildIntegrationsUpdate)
+	GuildIntegrationsUpdateStream(ctx context.Context) <-chan *GuildIntegrationsUpdate
 	GuildMemberAdd(handler HandlerGuildMemberAdd, moreHandlers ...HandlerGuildMemberAdd)
 	GuildMemberAddChan(handler chan *GuildMemberAdd, moreHandlers ...chan *GuildMemberAdd)
+	GuildMemberAddStream(ctx context.Context) <-chan *GuildMemberAdd
 	GuildMemberRemove(handler HandlerGuildMemberRemove, moreHandlers ...HandlerGuildMemberRemove)
 	GuildMemberRemoveChan(handler chan *GuildMemberRemove, moreHandlers ...chan *GuildMemberRemove)
+	GuildMemberRemoveStream(ctx context.Context) <-chan *GuildMemberRemove
 	GuildMemberUpdate(handler HandlerGuildMemberUpdate, moreHandlers ...HandlerGuildMemberUpdate)
 	GuildMemberUpdateChan(handler chan *GuildMemberUpdate, moreHandlers ...chan *GuildMemberUpdate)
+	GuildMemberUpdateStream(ctx context.Context) <-chan *GuildMemberUpdate
 	GuildMembersChunk(handler HandlerGuildMembersChunk, moreHandlers ...HandlerGuildMembersChunk)
 	GuildMembersChunkChan(handler chan *GuildMembersChunk, moreHandlers ...chan *GuildMembersChunk)
+	GuildMembersChunkStream(ctx context.Context) <-chan *GuildMembersChunk
 	GuildRoleCreate(handler HandlerGuildRoleCreate, moreHandlers ...HandlerGuildRoleCreate)
 	GuildRoleCreateChan(handler chan *GuildRoleCreate, moreHandlers ...chan *GuildRoleCreate)
+	GuildRoleCreateStream(ctx context.Context) <-chan *GuildRoleCreate
 	GuildRoleDelete(handler HandlerGuildRoleDelete, moreHandlers ...HandlerGuildRoleDelete)
 	GuildRoleDeleteChan(handler chan *GuildRoleDelete, moreHandlers ...chan *GuildRoleDelete)
+	GuildRoleDeleteStream(ctx context.Context) <-chan *GuildRoleDelete
 	GuildRoleUpdate(handler HandlerGuildRoleUpdate, moreHandlers ...HandlerGuildRoleUpdate)
 	GuildRoleUpdateChan(handler chan *GuildRoleUpdate, moreHandlers ...chan *GuildRoleUpdate)
+	GuildRoleUpdateStream(ctx context.Context) <-chan *GuildRoleUpdate
+	GuildScheduledEventCreate(handler HandlerGuildScheduledEventCreate, moreHandlers ...HandlerGuildScheduledEventCreate)
+	GuildScheduledEventCreateChan(handler chan *GuildScheduledEventCreate, moreHandlers ...chan *GuildScheduledEventCreate)
+	GuildScheduledEventCreateStream(ctx context.Context) <-chan *GuildScheduledEventCreate
+	GuildScheduledEventDelete(handler HandlerGuildScheduledEventDelete, moreHandlers ...HandlerGuildScheduledEventDelete)
+	GuildScheduledEventDeleteChan(handler chan *GuildScheduledEventDelete, moreHandlers ...chan *GuildScheduledEventDelete)
+	GuildScheduledEventDeleteStream(ctx context.Context) <-chan *GuildScheduledEventDelete
+	GuildScheduledEventUpdate(handler HandlerGuildScheduledEventUpdate, moreHandlers ...HandlerGuildScheduledEventUpdate)
+	GuildScheduledEventUpdateChan(handler chan *GuildScheduledEventUpdate, moreHandlers ...chan *GuildScheduledEventUpdate)
+	GuildScheduledEventUpdateStream(ctx context.Context) <-chan *GuildScheduledEventUpdate
+	GuildScheduledEventUserAdd(handler HandlerGuildScheduledEventUserAdd, moreHandlers ...HandlerGuildScheduledEventUserAdd)
+	GuildScheduledEventUserAddChan(handler chan *GuildScheduledEventUserAdd, moreHandlers ...chan *GuildScheduledEventUserAdd)
+	GuildScheduledEventUserAddStream(ctx context.Context) <-chan *GuildScheduledEventUserAdd
+	GuildScheduledEventUserRemove(handler HandlerGuildScheduledEventUserRemove, moreHandlers ...HandlerGuildScheduledEventUserRemove)
+	GuildScheduledEventUserRemoveChan(handler chan *GuildScheduledEventUserRemove, moreHandlers ...chan *GuildScheduledEventUserRemove)
+	GuildScheduledEventUserRemoveStream(ctx context.Context) <-chan *GuildScheduledEventUserRemove
+	GuildStickersUpdate(handler HandlerGuildStickersUpdate, moreHandlers ...HandlerGuildStickersUpdate)
+	GuildStickersUpdateChan(handler chan *GuildStickersUpdate, moreHandlers ...chan *GuildStickersUpdate)
+	GuildStickersUpdateStream(ctx context.Context) <-chan *GuildStickersUpdate
 	GuildUpdate(handler HandlerGuildUpdate, moreHandlers ...HandlerGuildUpdate)
 	GuildUpdateChan(handler chan *GuildUpdate, moreHandlers ...chan *GuildUpdate)
+	GuildUpdateStream(ctx context.Context) <-chan *GuildUpdate
 	InteractionCreate(handler HandlerInteractionCreate, moreHandlers ...HandlerInteractionCreate)
 	InteractionCreateChan(handler chan *InteractionCreate, moreHandlers ...chan *InteractionCreate)
+	InteractionCreateStream(ctx context.Context) <-chan *InteractionCreate
 	InviteCreate(handler HandlerInviteCreate, moreHandlers ...HandlerInviteCreate)
 	InviteCreateChan(handler chan *InviteCreate, moreHandlers ...chan *InviteCreate)
+	InviteCreateStream(ctx context.Context) <-chan *InviteCreate
 	InviteDelete(handler HandlerInviteDelete, moreHandlers ...HandlerInviteDelete)
 	InviteDeleteChan(handler chan *InviteDelete, moreHandlers ...chan *InviteDelete)
+	InviteDeleteStream(ctx context.Context) <-chan *InviteDelete
 	MessageCreate(handler HandlerMessageCreate, moreHandlers ...HandlerMessageCreate)
 	MessageCreateChan(handler chan *MessageCreate, moreHandlers ...chan *MessageCreate)
+	MessageCreateStream(ctx context.Context) <-chan *MessageCreate
 	MessageDelete(handler HandlerMessageDelete, moreHandlers ...HandlerMessageDelete)
 	MessageDeleteChan(handler chan *MessageDelete, moreHandlers ...chan *MessageDelete)
+	MessageDeleteStream(ctx context.Context) <-chan *MessageDelete
 	MessageDeleteBulk(handler HandlerMessageDeleteBulk, moreHandlers ...HandlerMessageDeleteBulk)
 	MessageDeleteBulkChan(handler chan *MessageDeleteBulk, moreHandlers ...chan *MessageDeleteBulk)
+	MessageDeleteBulkStream(ctx context.Context) <-chan *MessageDeleteBulk
+	MessagePollVoteAdd(handler HandlerMessagePollVoteAdd, moreHandlers ...HandlerMessagePollVoteAdd)
+	MessagePollVoteAddChan(handler chan *MessagePollVoteAdd, moreHandlers ...chan *MessagePollVoteAdd)
+	MessagePollVoteAddStream(ctx context.Context) <-chan *MessagePollVoteAdd
+	MessagePollVoteRemove(handler HandlerMessagePollVoteRemove, moreHandlers ...HandlerMessagePollVoteRemove)
+	MessagePollVoteRemoveChan(handler chan *MessagePollVoteRemove, moreHandlers ...chan *MessagePollVoteRemove)
+	MessagePollVoteRemoveStream(ctx context.Context) <-chan *MessagePollVoteRemove
 	MessageReactionAdd(handler HandlerMessageReactionAdd, moreHandlers ...HandlerMessageReactionAdd)
 	MessageReactionAddChan(handler chan *MessageReactionAdd, moreHandlers ...chan *MessageReactionAdd)
+	MessageReactionAddStream(ctx context.Context) <-chan *MessageReactionAdd
 	MessageReactionRemove(handler HandlerMessageReactionRemove, moreHandlers ...HandlerMessageReactionRemove)
 	MessageReactionRemoveChan(handler chan *MessageReactionRemove, moreHandlers ...chan *MessageReactionRemove)
+	MessageReactionRemoveStream(ctx context.Context) <-chan *MessageReactionRemove
 	MessageReactionRemoveAll(handler HandlerMessageReactionRemoveAll, moreHandlers ...HandlerMessageReactionRemoveAll)
 	MessageReactionRemoveAllChan(handler chan *MessageReactionRemoveAll, moreHandlers ...chan *MessageReactionRemoveAll)
+	MessageReactionRemoveAllStream(ctx context.Context) <-chan *MessageReactionRemoveAll
 	MessageReactionRemoveEmoji(handler HandlerMessageReactionRemoveEmoji, moreHandlers ...HandlerMessageReactionRemoveEmoji)
 	MessageReactionRemoveEmojiChan(handler chan *MessageReactionRemoveEmoji, moreHandlers ...chan *MessageReactionRemoveEmoji)
+	MessageReactionRemoveEmojiStream(ctx context.Context) <-chan *MessageReactionRemoveEmoji
 	MessageUpdate(handler HandlerMessageUpdate, moreHandlers ...HandlerMessageUpdate)
 	MessageUpdateChan(handler chan *MessageUpdate, moreHandlers ...chan *MessageUpdate)
+	MessageUpdateStream(ctx context.Context) <-chan *MessageUpdate
 	PresenceUpdate(handler HandlerPresenceUpdate, moreHandlers ...HandlerPresenceUpdate)
 	PresenceUpdateChan(handler chan *PresenceUpdate, moreHandlers ...chan *PresenceUpdate)
+	PresenceUpdateStream(ctx context.Context) <-chan *PresenceUpdate
 	Ready(handler HandlerReady, moreHandlers ...HandlerReady)
 	ReadyChan(handler chan *Ready, moreHandlers ...chan *Ready)
+	ReadyStream(ctx context.Context) <-chan *Ready
 	Resumed(handler HandlerResumed, moreHandlers ...HandlerResumed)
 	ResumedChan(handler chan *Resumed, moreHandlers ...chan *Resumed)
+	ResumedStream(ctx context.Context) <-chan *Resumed
+	StageInstanceCreate(handler HandlerStageInstanceCreate, moreHandlers ...HandlerStageInstanceCreate)
+	StageInstanceCreateChan(handler chan *StageInstanceCreate, moreHandlers ...chan *StageInstanceCreate)
+	StageInstanceCreateStream(ctx context.Context) <-chan *StageInstanceCreate
+	StageInstanceDelete(handler HandlerStageInstanceDelete, moreHandlers ...HandlerStageInstanceDelete)
+	StageInstanceDeleteChan(handler chan *StageInstanceDelete, moreHandlers ...chan *StageInstanceDelete)
+	StageInstanceDeleteStream(ctx context.Context) <-chan *StageInstanceDelete
+	StageInstanceUpdate(handler HandlerStageInstanceUpdate, moreHandlers ...HandlerStageInstanceUpdate)
+	StageInstanceUpdateChan(handler chan *StageInstanceUpdate, moreHandlers ...chan *StageInstanceUpdate)
+	StageInstanceUpdateStream(ctx context.Context) <-chan *StageInstanceUpdate
+	SubscriptionCreate(handler HandlerSubscriptionCreate, moreHandlers ...HandlerSubscriptionCreate)
+	SubscriptionCreateChan(handler chan *SubscriptionCreate, moreHandlers ...chan *SubscriptionCreate)
+	SubscriptionCreateStream(ctx context.Context) <-chan *SubscriptionCreate
+	SubscriptionDelete(handler HandlerSubscriptionDelete, moreHandlers ...HandlerSubscriptionDelete)
+	SubscriptionDeleteChan(handler chan *SubscriptionDelete, moreHandlers ...chan *SubscriptionDelete)
+	SubscriptionDeleteStream(ctx context.Context) <-chan *SubscriptionDelete
+	SubscriptionUpdate(handler HandlerSubscriptionUpdate, moreHandlers ...HandlerSubscriptionUpdate)
+	SubscriptionUpdateChan(handler chan *SubscriptionUpdate, moreHandlers ...chan *SubscriptionUpdate)
+	SubscriptionUpdateStream(ctx context.Context) <-chan *SubscriptionUpdate
+	ThreadCreate(handler HandlerThreadCreate, moreHandlers ...HandlerThreadCreate)
+	ThreadCreateChan(handler chan *ThreadCreate, moreHandlers ...chan *ThreadCreate)
+	ThreadCreateStream(ctx context.Context) <-chan *ThreadCreate
+	ThreadDelete(handler HandlerThreadDelete, moreHandlers ...HandlerThreadDelete)
+	ThreadDeleteChan(handler chan *ThreadDelete, moreHandlers ...chan *ThreadDelete)
+	ThreadDeleteStream(ctx context.Context) <-chan *ThreadDelete
+	ThreadListSync(handler HandlerThreadListSync, moreHandlers ...HandlerThreadListSync)
+	ThreadListSyncChan(handler chan *ThreadListSync, moreHandlers ...chan *ThreadListSync)
+	ThreadListSyncStream(ctx context.Context) <-chan *ThreadListSync
+	ThreadMemberUpdate(handler HandlerThreadMemberUpdate, moreHandlers ...HandlerThreadMemberUpdate)
+	ThreadMemberUpdateChan(handler chan *ThreadMemberUpdate, moreHandlers ...chan *ThreadMemberUpdate)
+	ThreadMemberUpdateStream(ctx context.Context) <-chan *ThreadMemberUpdate
+	ThreadMembersUpdate(handler HandlerThreadMembersUpdate, moreHandlers ...HandlerThreadMembersUpdate)
+	ThreadMembersUpdateChan(handler chan *ThreadMembersUpdate, moreHandlers ...chan *ThreadMembersUpdate)
+	ThreadMembersUpdateStream(ctx context.Context) <-chan *ThreadMembersUpdate
+	ThreadUpdate(handler HandlerThreadUpdate, moreHandlers ...HandlerThreadUpdate)
+	ThreadUpdateChan(handler chan *ThreadUpdate, moreHandlers ...chan *ThreadUpdate)
+	ThreadUpdateStream(ctx context.Context) <-chan *ThreadUpdate
 	TypingStart(handler HandlerTypingStart, moreHandlers ...HandlerTypingStart)
 	TypingStartChan(handler chan *TypingStart, moreHandlers ...chan *TypingStart)
+	TypingStartStream(ctx context.Context) <-chan *TypingStart
 	UserUpdate(handler HandlerUserUpdate, moreHandlers ...HandlerUserUpdate)
 	UserUpdateChan(handler chan *UserUpdate, moreHandlers ...chan *UserUpdate)
+	UserUpdateStream(ctx context.Context) <-chan *UserUpdate
 	VoiceServerUpdate(handler HandlerVoiceServerUpdate, moreHandlers ...HandlerVoiceServerUpdate)
 	VoiceServerUpdateChan(handler chan *VoiceServerUpdate, moreHandlers ...chan *VoiceServerUpdate)
+	VoiceServerUpdateStream(ctx context.Context) <-chan *VoiceServerUpdate
 	VoiceStateUpdate(handler HandlerVoiceStateUpdate, moreHandlers ...HandlerVoiceStateUpdate)
 	VoiceStateUpdateChan(handler chan *VoiceStateUpdate, moreHandlers ...chan *VoiceStateUpdate)
+	VoiceStateUpdateStream(ctx context.Context) <-chan *VoiceStateUpdate
 	WebhooksUpdate(handler HandlerWebhooksUpdate, moreHandlers ...HandlerWebhooksUpdate)
 	WebhooksUpdateChan(handler chan *WebhooksUpdate, moreHandlers ...chan *WebhooksUpdate)
+	WebhooksUpdateStream(ctx context.Context) <-chan *WebhooksUpdate
 	WithCtrl(HandlerCtrl) SocketHandlerRegistrator
 	WithMiddleware(first Middleware, extra ...Middleware) SocketHandlerRegistrator
+	WithGuild(first Snowflake, extra ...Snowflake) SocketHandlerRegistrator
 }
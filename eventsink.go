@@ -0,0 +1,82 @@
+package disgord
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+// EventSink publishes gateway events to an external message broker, such that worker services can consume the
+// events without holding a gateway connection. Workers can dispatch the events to their handlers using
+// Client.DispatchSerialized, see EncodeEvent. See Config.EventSink, and the NATS and Kafka sinks of the std package.
+type EventSink interface {
+	Publish(ctx context.Context, evt *EventEnvelope) error
+}
+
+// DefaultEventSinkBufferSize is the number of events waiting to be published, when Config.EventSinkBufferSize is not set
+const DefaultEventSinkBufferSize = 1000
+
+// eventSinkTimeout caps how long a single event may take to publish
+var eventSinkTimeout = 10 * time.Second
+
+// eventForwarder publishes the events to the sink in the order they were received, without holding back the
+// demultiplexer.
+type eventForwarder struct {
+	sink    EventSink
+	queue   chan *EventEnvelope
+	pending atomic.Int64
+	dropped atomic.Int64
+	log     Logger
+}
+
+func newEventForwarder(sink EventSink, bufferSize int, log Logger) *eventForwarder {
+	if bufferSize <= 0 {
+		bufferSize = DefaultEventSinkBufferSize
+	}
+	return &eventForwarder{
+		sink:  sink,
+		queue: make(chan *EventEnvelope, bufferSize),
+		log:   log,
+	}
+}
+
+// forward queues the event, or drops it when the sink can not keep up.
+func (f *eventForwarder) forward(evt *gateway.Event) {
+	f.pending.Inc()
+	select {
+	case f.queue <- newEventEnvelope(evt):
+	default:
+		f.pending.Dec()
+		f.dropped.Inc()
+	}
+}
+
+func (f *eventForwarder) run(shutdown <-chan interface{}) {
+	for {
+		select {
+		case evt := <-f.queue:
+			f.publish(evt)
+		case <-shutdown:
+			return
+		}
+	}
+}
+
+func (f *eventForwarder) publish(evt *EventEnvelope) {
+	defer f.pending.Dec()
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventSinkTimeout)
+	defer cancel()
+	if err := f.sink.Publish(ctx, evt); err != nil {
+		log := logger.With(f.log, logger.Field{Key: "event", Value: evt.Name}, logger.Field{Key: "shard", Value: evt.ShardID})
+		log.Error("unable to publish event: ", err)
+	}
+	if dropped := f.dropped.Swap(0); dropped > 0 {
+		f.log.Error(strconv.FormatInt(dropped, 10) + " events were dropped, as the event sink could not keep up")
+	}
+}
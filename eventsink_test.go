@@ -0,0 +1,81 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+)
+
+type eventSinkFunc func(ctx context.Context, evt *EventEnvelope) error
+
+func (f eventSinkFunc) Publish(ctx context.Context, evt *EventEnvelope) error {
+	return f(ctx, evt)
+}
+
+func TestClient_EventSink(t *testing.T) {
+	published := make(chan *EventEnvelope, 3)
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(req, `{"id":"1"}`), nil
+		})},
+		EventSink: eventSinkFunc(func(ctx context.Context, evt *EventEnvelope) error {
+			published <- evt
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan struct{}, 3)
+	client.Gateway().MessageCreate(func(s Session, h *MessageCreate) {
+		handled <- struct{}{}
+	})
+	go client.demultiplexer(client.dispatcher, client.eventChan)
+
+	for _, id := range []string{"1", "2", "3"} {
+		client.eventChan <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"` + id + `","channel_id":"10"}`), ShardID: 2}
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		select {
+		case evt := <-published:
+			if expected := `{"id":"` + id + `","channel_id":"10"}`; string(evt.Data) != expected {
+				t.Errorf("expected the events in order. Got %s, wants %s", evt.Data, expected)
+			}
+			if evt.Name != EvtMessageCreate || evt.ShardID != 2 {
+				t.Errorf("unexpected event %s from shard %d", evt.Name, evt.ShardID)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected the event to be published")
+		}
+		select {
+		case <-handled:
+		case <-time.After(time.Second):
+			t.Fatal("expected the event to be dispatched as well")
+		}
+	}
+}
+
+func TestEventForwarder_Overflow(t *testing.T) {
+	release := make(chan struct{})
+	forwarder := newEventForwarder(eventSinkFunc(func(ctx context.Context, evt *EventEnvelope) error {
+		<-release
+		return nil
+	}), 1, nil)
+
+	forwarder.forward(&gateway.Event{Name: EvtMessageCreate})
+	forwarder.forward(&gateway.Event{Name: EvtMessageCreate})
+	if dropped := forwarder.dropped.Load(); dropped != 1 {
+		t.Errorf("expected one event to be dropped. Got %d", dropped)
+	}
+	if pending := forwarder.pending.Load(); pending != 1 {
+		t.Errorf("expected one event to be pending. Got %d", pending)
+	}
+	close(release)
+}
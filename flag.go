@@ -42,6 +42,6 @@ func mergeFlags(flags []Flag) (f Flag) {
 	return f
 }
 
-func ignoreCache(flags ...Flag) bool {
-	return (mergeFlags(flags) & IgnoreCache) > 0
+func ignoreCache(flags ...RequestOption) bool {
+	return newRequestOptions(flags).flags.Ignorecache()
 }
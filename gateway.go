@@ -24,6 +24,21 @@ type GatewayQueryBuilder interface {
 	BotReady(func())
 	BotGuildsReady(func())
 
+	// OnRaw registers a handler for every dispatched event, including events unknown to Disgord, before
+	// the payload is unmarshalled into the typed events. Raw handlers are called synchronously in the order
+	// the events are received, so they must not block. Events rejected with Config.RejectEvents are not seen,
+	// nor are unknown events when Config.UnknownEvents is UnknownEventsDrop.
+	OnRaw(handler HandlerRaw)
+
+	// OnUnknownEvent registers a handler for events unknown to disgord, such that new Discord events can be
+	// handled before they are supported. Requires Config.UnknownEvents to be UnknownEventsDispatch.
+	OnUnknownEvent(handler HandlerUnknownEvent)
+
+	// Use adds middlewares that wrap the dispatch of every event to the registered handlers, unlike
+	// WithMiddleware which only regards the handlers it is registered with. Middlewares are called in
+	// the order they are added, before any handler specific middlewares.
+	Use(first DispatchMiddleware, extra ...DispatchMiddleware)
+
 	Dispatch(name gatewayCmdName, payload gateway.CmdPayload) (unchandledGuildIDs []Snowflake, err error)
 
 	// Connect establishes a websocket connection to the discord API
@@ -63,19 +78,30 @@ func (g gatewayQueryBuilder) Connect() (err error) {
 		return err
 	}
 
+	intents, exactIntents, err := g.client.gatewayIntents()
+	if err != nil {
+		return err
+	}
+
 	shardMngrConf := gateway.ShardManagerConfig{
 		HTTPClient:   g.client.WebsocketHttpClient,
 		ShardConfig:  g.client.config.ShardConfig,
-		Logger:       g.client.config.Logger,
+		Logger:       g.client.subsystemLog(LogGateway),
 		ShutdownChan: g.client.config.shutdownChan,
 		IgnoreEvents: g.client.config.RejectEvents,
-		Intents:      g.client.config.DMIntents,
+		Intents:      intents,
+		ExactIntents: exactIntents,
 		EventChan:    g.client.eventChan,
 		DisgordInfo:  LibraryInfo(),
 		ProjectName:  g.client.config.ProjectName,
 		BotToken:     g.client.config.BotToken,
+		Compress:     g.client.config.GatewayCompression,
+		NewWebsocket: g.client.config.NewWebsocket,
 	}
 
+	rejected := g.client.config.RejectEvents
+	shardMngrConf.OnDisallowedIntents = g.client.disallowedIntents(effectiveIntents(intents, exactIntents, rejected))
+
 	if g.client.config.Presence != nil {
 		if g.client.config.Presence.Status == "" {
 			g.client.config.Presence.Status = StatusOnline // default
@@ -87,13 +113,13 @@ func (g gatewayQueryBuilder) Connect() (err error) {
 
 	g.client.setupConnectEnv()
 
-	g.client.log.Info("Connecting to discord Gateway")
+	g.client.subsystemLog(LogGateway).Info("Connecting to discord Gateway")
 	if err = sharding.Connect(); err != nil {
-		g.client.log.Info(err)
+		g.client.subsystemLog(LogGateway).Info(err)
 		return err
 	}
 
-	g.client.log.Info("Connected")
+	g.client.subsystemLog(LogGateway).Info("Connected")
 	g.client.shardManager = sharding
 	return nil
 }
@@ -101,14 +127,14 @@ func (g gatewayQueryBuilder) Connect() (err error) {
 // Disconnect closes the discord websocket connection
 func (g gatewayQueryBuilder) Disconnect() (err error) {
 	fmt.Println() // to keep ^C on it's own line
-	g.client.log.Info("Closing Discord gateway connection")
+	g.client.subsystemLog(LogGateway).Info("Closing Discord gateway connection")
 	close(g.client.dispatcher.shutdown)
 	if err = g.client.shardManager.Disconnect(); err != nil {
-		g.client.log.Error(err)
+		g.client.subsystemLog(LogGateway).Error(err)
 		return err
 	}
 	close(g.client.shutdownChan)
-	g.client.log.Info("Disconnected")
+	g.client.subsystemLog(LogGateway).Info("Disconnected")
 
 	return nil
 }
@@ -133,7 +159,7 @@ func (g gatewayQueryBuilder) StayConnectedUntilInterrupted() (err error) {
 	}
 
 	if err = g.Connect(); err != nil {
-		g.client.log.Error(err)
+		g.client.subsystemLog(LogGateway).Error(err)
 		return err
 	}
 
@@ -150,6 +176,18 @@ func (g gatewayQueryBuilder) StayConnectedUntilInterrupted() (err error) {
 	return g.Disconnect()
 }
 
+func (g gatewayQueryBuilder) OnRaw(handler HandlerRaw) {
+	g.client.dispatcher.addRawHandler(handler)
+}
+
+func (g gatewayQueryBuilder) OnUnknownEvent(handler HandlerUnknownEvent) {
+	g.client.dispatcher.addUnknownEventHandler(handler)
+}
+
+func (g gatewayQueryBuilder) Use(first DispatchMiddleware, extra ...DispatchMiddleware) {
+	g.client.dispatcher.use(append([]DispatchMiddleware{first}, extra...)...)
+}
+
 // BotReady triggers a given callback when all shards has gotten their first Ready event
 // Warning: Do not call Client.Connect before this.
 func (g gatewayQueryBuilder) BotReady(cb func()) {
@@ -4,6 +4,7 @@ go 1.14
 
 require (
 	github.com/andersfylling/snowflake/v5 v5.0.1
+	github.com/andybalholm/brotli v1.1.0
 	go.uber.org/atomic v1.7.0
 	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4
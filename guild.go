@@ -7,6 +7,8 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Vedza/disgord/json"
 
@@ -89,6 +91,9 @@ const (
 	PermissionManageEmojis
 )
 
+// PermissionModerateMembers allows for timing out users, see Member.Timeout
+const PermissionModerateMembers PermissionBit = 1 << 40
+
 // Constants for the different bit offsets of general permissions
 const (
 	PermissionCreateInstantInvite PermissionBit = 1 << iota
@@ -156,6 +161,7 @@ type Guild struct {
 	ExplicitContentFilter       ExplicitContentFilterLvl      `json:"explicit_content_filter"`
 	Roles                       []*Role                       `json:"roles"`
 	Emojis                      []*Emoji                      `json:"emojis"`
+	Stickers                    []*Sticker                    `json:"stickers,omitempty"`
 	Features                    []string                      `json:"features"`
 	MFALevel                    MFALvl                        `json:"mfa_level"`
 	WidgetEnabled               bool                          `json:"widget_enabled,omit_empty"`    //   |
@@ -170,6 +176,7 @@ type Guild struct {
 	VoiceStates []*VoiceState   `json:"voice_states,omitempty"` // ?*|
 	Members     []*Member       `json:"members,omitempty"`      // ?*|
 	Channels    []*Channel      `json:"channels,omitempty"`     // ?*|
+	Threads     []*Channel      `json:"threads,omitempty"`      // ?*|
 	Presences   []*UserPresence `json:"presences,omitempty"`    // ?*|
 }
 
@@ -190,6 +197,9 @@ func (g *Guild) updateInternals() {
 	for i := range g.Channels {
 		g.Channels[i].GuildID = g.ID
 	}
+	for i := range g.Threads {
+		g.Threads[i].GuildID = g.ID
+	}
 	for i := range g.Members {
 		g.Members[i].GuildID = g.ID
 		g.Members[i].updateInternals()
@@ -503,6 +513,9 @@ type Member struct {
 	Mute         bool        `json:"mute"`
 	Pending      bool        `json:"pending"`
 
+	// CommunicationDisabledUntil is set while the member is timed out
+	CommunicationDisabledUntil Time `json:"communication_disabled_until,omitempty"`
+
 	// custom
 	UserID Snowflake `json:"-"`
 }
@@ -536,15 +549,47 @@ type GuildQueryBuilderCaller interface {
 	Guild(id Snowflake) GuildQueryBuilder
 }
 
-func (m *Member) UpdateNick(ctx context.Context, client GuildQueryBuilderCaller, nickname string, flags ...Flag) error {
+func (m *Member) UpdateNick(ctx context.Context, client GuildQueryBuilderCaller, nickname string, flags ...RequestOption) error {
 	builder := client.Guild(m.GuildID).Member(m.UserID).WithContext(ctx).UpdateBuilder(flags...)
 	return builder.
 		SetNick(nickname).
 		Execute()
 }
 
+// MaxMemberTimeout is the longest duration a member can be timed out for.
+const MaxMemberTimeout = 28 * 24 * time.Hour
+
+// Timeout prevents the member from communicating in the guild for the given duration, which can be at
+// most MaxMemberTimeout. Requires the MODERATE_MEMBERS permission.
+//  err := member.Timeout(ctx, client, 10*time.Minute, "spamming")
+func (m *Member) Timeout(ctx context.Context, client GuildQueryBuilderCaller, duration time.Duration, reason string, flags ...RequestOption) error {
+	if duration <= 0 || duration > MaxMemberTimeout {
+		return errors.New("timeout duration must be positive and no more than 28 days")
+	}
+
+	builder := client.Guild(m.GuildID).Member(m.UserID).WithContext(ctx).UpdateBuilder(flags...)
+	return builder.
+		SetCommunicationDisabledUntil(Time{time.Now().UTC().Add(duration)}).
+		WithReason(reason).
+		Execute()
+}
+
+// RemoveTimeout lets a timed out member communicate in the guild again. Requires the MODERATE_MEMBERS permission.
+func (m *Member) RemoveTimeout(ctx context.Context, client GuildQueryBuilderCaller, reason string, flags ...RequestOption) error {
+	builder := client.Guild(m.GuildID).Member(m.UserID).WithContext(ctx).UpdateBuilder(flags...)
+	return builder.
+		RemoveTimeout().
+		WithReason(reason).
+		Execute()
+}
+
+// IsTimedOut reports whether the member is currently timed out.
+func (m *Member) IsTimedOut() bool {
+	return m.CommunicationDisabledUntil.After(time.Now())
+}
+
 // GetPermissions populates a uint64 with all the permission flags
-func (m *Member) GetPermissions(ctx context.Context, s GuildQueryBuilderCaller, flags ...Flag) (permissions PermissionBit, err error) {
+func (m *Member) GetPermissions(ctx context.Context, s GuildQueryBuilderCaller, flags ...RequestOption) (permissions PermissionBit, err error) {
 	// TODO: Don't deep copy channels for this in the future!
 	roles, err := s.Guild(m.GuildID).WithContext(ctx).GetRoles(flags...)
 	if err != nil {
@@ -623,7 +668,7 @@ type CreateGuildParams struct {
 //  Comment                 This endpoint. can be used only by bots in less than 10 Guilds. Creating channel
 //                          categories from this endpoint. is not supported.
 //							The params argument is optional.
-func (c clientQueryBuilder) CreateGuild(guildName string, params *CreateGuildParams, flags ...Flag) (ret *Guild, err error) {
+func (c clientQueryBuilder) CreateGuild(guildName string, params *CreateGuildParams, flags ...RequestOption) (ret *Guild, err error) {
 	// TODO: check if bot
 	// TODO-2: is bot in less than 10 Guilds?
 
@@ -658,62 +703,108 @@ type GuildQueryBuilder interface {
 	WithContext(ctx context.Context) GuildQueryBuilder
 
 	// TODO: Add more guild attribute things. Waiting for caching changes before then.
-	Get(flags ...Flag) (guild *Guild, err error)
+	Get(flags ...RequestOption) (guild *Guild, err error)
 	// TODO: For GetChannels, it might sense to have the option for a function to filter before each channel ends up deep copied.
 	// TODO-2: This could be much more performant in guilds with a large number of channels.
-	GetChannels(flags ...Flag) ([]*Channel, error)
+	GetChannels(flags ...RequestOption) ([]*Channel, error)
 	// TODO: For GetMembers, it might sense to have the option for a function to filter before each member ends up deep copied.
 	// TODO-2: This could be much more performant in larger guilds where this is needed.
-	GetMembers(params *GetMembersParams, flags ...Flag) ([]*Member, error)
-	UpdateBuilder(flags ...Flag) UpdateGuildBuilder
-	Delete(flags ...Flag) error
+	GetMembers(params *GetMembersParams, flags ...RequestOption) ([]*Member, error)
+	IterateMembers(params *GetMembersParams, flags ...RequestOption) *MemberIterator
+
+	// Presence Returns the cached presence of a member, see BasicCacheConfig.Presences.
+	Presence(userID Snowflake) (*Presence, error)
+	// Presences Returns the cached presences of the online members.
+	Presences() ([]*Presence, error)
+	UpdateBuilder(flags ...RequestOption) UpdateGuildBuilder
+	Delete(flags ...RequestOption) error
 
 	// Deprecated: Use UpdateBuilder
-	Update(flags ...Flag) UpdateGuildBuilder
+	Update(flags ...RequestOption) UpdateGuildBuilder
 
-	CreateChannel(name string, params *CreateGuildChannelParams, flags ...Flag) (*Channel, error)
-	UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...Flag) error
-	CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...Flag) (*Member, error)
+	CreateChannel(name string, params *CreateGuildChannelParams, flags ...RequestOption) (*Channel, error)
+	UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...RequestOption) error
+	CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...RequestOption) (*Member, error)
 	Member(userID Snowflake) GuildMemberQueryBuilder
 
 	KickVoiceParticipant(userID Snowflake) error
-	SetCurrentUserNick(nick string, flags ...Flag) (newNick string, err error)
-	GetBans(flags ...Flag) ([]*Ban, error)
-	GetBan(userID Snowflake, flags ...Flag) (*Ban, error)
-	UnbanUser(userID Snowflake, reason string, flags ...Flag) error
+	SetCurrentUserNick(nick string, flags ...RequestOption) (newNick string, err error)
+	GetBans(flags ...RequestOption) ([]*Ban, error)
+	IterateBans(flags ...RequestOption) *BanIterator
+	GetBan(userID Snowflake, flags ...RequestOption) (*Ban, error)
+	UnbanUser(userID Snowflake, reason string, flags ...RequestOption) error
+
+	// BulkBan Bans up to 200 users from the guild, and optionally deletes their recent messages.
+	BulkBan(params *BulkBanParams, flags ...RequestOption) (*BulkBanResponse, error)
 	// TODO: For GetRoles, it might sense to have the option for a function to filter before each role ends up deep copied.
 	// TODO-2: This could be much more performant in larger guilds where this is needed.
 	// TODO-3: Add GetRole.
-	GetRoles(flags ...Flag) ([]*Role, error)
-	UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...Flag) ([]*Role, error)
-	CreateRole(params *CreateGuildRoleParams, flags ...Flag) (*Role, error)
+	GetRoles(flags ...RequestOption) ([]*Role, error)
+	UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...RequestOption) ([]*Role, error)
+	CreateRole(params *CreateGuildRoleParams, flags ...RequestOption) (*Role, error)
 	Role(roleID Snowflake) GuildRoleQueryBuilder
 
-	EstimatePruneMembersCount(days int, flags ...Flag) (estimate int, err error)
-	PruneMembers(days int, reason string, flags ...Flag) error
-	GetVoiceRegions(flags ...Flag) ([]*VoiceRegion, error)
-	GetInvites(flags ...Flag) ([]*Invite, error)
-
-	GetIntegrations(flags ...Flag) ([]*Integration, error)
-	CreateIntegration(params *CreateGuildIntegrationParams, flags ...Flag) error
-	UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...Flag) error
-	DeleteIntegration(integrationID Snowflake, flags ...Flag) error
-	SyncIntegration(integrationID Snowflake, flags ...Flag) error
-
-	GetEmbed(flags ...Flag) (*GuildEmbed, error)
-	UpdateEmbedBuilder(flags ...Flag) UpdateGuildEmbedBuilder
-	GetVanityURL(flags ...Flag) (*PartialInvite, error)
-	GetAuditLogs(flags ...Flag) GuildAuditLogsBuilder
+	EstimatePruneMembersCount(days int, flags ...RequestOption) (estimate int, err error)
+	PruneMembers(days int, reason string, flags ...RequestOption) error
+
+	// GetPruneCount Returns the number of members that would be removed in a prune operation.
+	GetPruneCount(params *GetPruneCountParams, flags ...RequestOption) (int, error)
+
+	// Prune Begins a prune operation, the pruned count is nil unless ComputePruneCount is set.
+	Prune(params *PruneMembersParams, flags ...RequestOption) (pruned *int, err error)
+	GetVoiceRegions(flags ...RequestOption) ([]*VoiceRegion, error)
+	GetInvites(flags ...RequestOption) ([]*Invite, error)
+
+	GetIntegrations(flags ...RequestOption) ([]*Integration, error)
+	CreateIntegration(params *CreateGuildIntegrationParams, flags ...RequestOption) error
+	UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...RequestOption) error
+	DeleteIntegration(integrationID Snowflake, flags ...RequestOption) error
+	SyncIntegration(integrationID Snowflake, flags ...RequestOption) error
+
+	GetEmbed(flags ...RequestOption) (*GuildEmbed, error)
+	UpdateEmbedBuilder(flags ...RequestOption) UpdateGuildEmbedBuilder
+	GetVanityURL(flags ...RequestOption) (*PartialInvite, error)
+	GetWelcomeScreen(flags ...RequestOption) (*WelcomeScreen, error)
+	UpdateWelcomeScreen(params *UpdateWelcomeScreenParams, flags ...RequestOption) (*WelcomeScreen, error)
+	GetOnboarding(flags ...RequestOption) (*GuildOnboarding, error)
+	UpdateOnboarding(params *UpdateGuildOnboardingParams, flags ...RequestOption) (*GuildOnboarding, error)
+	GetPreview(flags ...RequestOption) (*GuildPreview, error)
+	GetWidgetSettings(flags ...RequestOption) (*GuildWidgetSettings, error)
+	UpdateWidgetSettings(params *UpdateGuildWidgetParams, flags ...RequestOption) (*GuildWidgetSettings, error)
+	GetWidget(flags ...RequestOption) (*GuildWidget, error)
+
+	GetTemplates(flags ...RequestOption) ([]*GuildTemplate, error)
+	CreateTemplate(params *CreateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error)
+	SyncTemplate(code string, flags ...RequestOption) (*GuildTemplate, error)
+	UpdateTemplate(code string, params *UpdateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error)
+	DeleteTemplate(code string, flags ...RequestOption) (*GuildTemplate, error)
+	GetAuditLogs(flags ...RequestOption) GuildAuditLogsBuilder
+	IterateAuditLogs(params *GetAuditLogsParams, flags ...RequestOption) *AuditLogIterator
 
 	VoiceChannel(channelID Snowflake) VoiceChannelQueryBuilder
 
 	// TODO: For GetEmojis, it might sense to have the option for a function to filter before each emoji ends up deep copied.
 	// TODO-2: This could be much more performant in guilds with a large number of channels.
-	GetEmojis(flags ...Flag) ([]*Emoji, error)
-	CreateEmoji(params *CreateGuildEmojiParams, flags ...Flag) (*Emoji, error)
+	GetEmojis(flags ...RequestOption) ([]*Emoji, error)
+	CreateEmoji(params *CreateGuildEmojiParams, flags ...RequestOption) (*Emoji, error)
 	Emoji(emojiID Snowflake) GuildEmojiQueryBuilder
 
-	GetWebhooks(flags ...Flag) (ret []*Webhook, err error)
+	GetWebhooks(flags ...RequestOption) (ret []*Webhook, err error)
+
+	// GetActiveThreads Returns all active threads in the guild, including public and private threads.
+	GetActiveThreads(flags ...RequestOption) (*ThreadList, error)
+
+	GetScheduledEvents(withUserCount bool, flags ...RequestOption) ([]*GuildScheduledEvent, error)
+	CreateScheduledEvent(params *CreateGuildScheduledEventParams, flags ...RequestOption) (*GuildScheduledEvent, error)
+	ScheduledEvent(eventID Snowflake) GuildScheduledEventQueryBuilder
+
+	GetStickers(flags ...RequestOption) ([]*Sticker, error)
+	CreateSticker(params *CreateGuildStickerParams, flags ...RequestOption) (*Sticker, error)
+	Sticker(stickerID Snowflake) GuildStickerQueryBuilder
+
+	GetAutoModerationRules(flags ...RequestOption) ([]*AutoModerationRule, error)
+	CreateAutoModerationRule(params *CreateAutoModerationRuleParams, flags ...RequestOption) (*AutoModerationRule, error)
+	AutoModerationRule(ruleID Snowflake) AutoModerationRuleQueryBuilder
 }
 
 // Guild is used to create a guild query builder.
@@ -735,7 +826,10 @@ func (g guildQueryBuilder) WithContext(ctx context.Context) GuildQueryBuilder {
 
 // Get is used to get the Guild struct containing all information from it.
 // Note that it's significantly quicker in most instances where you have the cache enabled (as is by default) to get the individual parts you need.
-func (g guildQueryBuilder) Get(flags ...Flag) (guild *Guild, err error) {
+//
+// A guild that is not cached is fetched and added to the cache. Concurrent lookups of the same guild
+// share a single request.
+func (g guildQueryBuilder) Get(flags ...RequestOption) (guild *Guild, err error) {
 	if !ignoreCache(flags...) {
 		if guild, _ = g.client.cache.GetGuild(g.gid); guild != nil {
 			return guild, nil
@@ -749,12 +843,24 @@ func (g guildQueryBuilder) Get(flags ...Flag) (guild *Guild, err error) {
 	r.factory = func() interface{} {
 		return &Guild{}
 	}
+	r.cacheFill = func(body []byte) {
+		_, _ = g.client.cache.GuildUpdate(body)
+	}
 
-	return getGuild(r.Execute)
+	v, shared, err := g.client.requests.do("guild:"+g.gid.String(), func() (interface{}, error) {
+		return getGuild(r.Execute)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if guild = v.(*Guild); shared {
+		guild = DeepCopy(guild).(*Guild)
+	}
+	return guild, nil
 }
 
 // Update is used to create a guild update builder.
-func (g guildQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildBuilder {
+func (g guildQueryBuilder) UpdateBuilder(flags ...RequestOption) UpdateGuildBuilder {
 	builder := &updateGuildBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Guild{}
@@ -771,7 +877,7 @@ func (g guildQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildBuilder {
 }
 
 // Delete is used to delete a guild.
-func (g guildQueryBuilder) Delete(flags ...Flag) error {
+func (g guildQueryBuilder) Delete(flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.Guild(g.gid),
@@ -783,7 +889,7 @@ func (g guildQueryBuilder) Delete(flags ...Flag) error {
 }
 
 // GetChannels is used to get a guilds channels.
-func (g guildQueryBuilder) GetChannels(flags ...Flag) ([]*Channel, error) {
+func (g guildQueryBuilder) GetChannels(flags ...RequestOption) ([]*Channel, error) {
 	if channels, _ := g.client.cache.GetGuildChannels(g.gid); channels != nil {
 		return channels, nil
 	}
@@ -802,7 +908,7 @@ func (g guildQueryBuilder) GetChannels(flags ...Flag) ([]*Channel, error) {
 
 // CreateChannel Create a new channel object for the guild. Requires the 'MANAGE_CHANNELS' permission.
 // Returns the new channel object on success. Fires a Channel Create Gateway event.
-func (g guildQueryBuilder) CreateChannel(name string, params *CreateGuildChannelParams, flags ...Flag) (*Channel, error) {
+func (g guildQueryBuilder) CreateChannel(name string, params *CreateGuildChannelParams, flags ...RequestOption) (*Channel, error) {
 	if name == "" && (params == nil || params.Name == "") {
 		return nil, errors.New("channel name is required")
 	}
@@ -835,7 +941,7 @@ func (g guildQueryBuilder) CreateChannel(name string, params *CreateGuildChannel
 // UpdateChannelPositions Modify the positions of a set of channel objects for the guild.
 // Requires 'MANAGE_CHANNELS' permission. Returns a 204 empty response on success. Fires multiple Channel Update
 // Gateway events.
-func (g guildQueryBuilder) UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...Flag) error {
+func (g guildQueryBuilder) UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...RequestOption) error {
 	var reason string
 	for i := range params {
 		if params[i].Reason != "" {
@@ -857,7 +963,7 @@ func (g guildQueryBuilder) UpdateChannelPositions(params []UpdateGuildChannelPos
 }
 
 // GetMembers uses the GetGuildMembers endpoint iteratively until your query params are met.
-func (g guildQueryBuilder) GetMembers(params *GetMembersParams, flags ...Flag) ([]*Member, error) {
+func (g guildQueryBuilder) GetMembers(params *GetMembersParams, flags ...RequestOption) ([]*Member, error) {
 	const QueryLimit uint32 = 1000
 
 	if params == nil {
@@ -923,7 +1029,7 @@ func (g guildQueryBuilder) GetMembers(params *GetMembersParams, flags ...Flag) (
 // the Guilds.join scope. Returns a 201 Created with the guild member as the body, or 204 No Content if the user is
 // already a member of the guild. Fires a Guild Member Add Gateway event. Requires the bot to have the
 // CREATE_INSTANT_INVITE permission.
-func (g guildQueryBuilder) CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...Flag) (*Member, error) {
+func (g guildQueryBuilder) CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...RequestOption) (*Member, error) {
 	if accessToken == "" && (params == nil || params.AccessToken == "") {
 		return nil, errors.New("access token is required")
 	}
@@ -967,7 +1073,7 @@ func (g guildQueryBuilder) CreateMember(userID Snowflake, accessToken string, pa
 
 // SetCurrentUserNick Modifies the nickname of the current user in a guild. Returns a 200
 // with the nickname on success. Fires a Guild Member Update Gateway event.
-func (g guildQueryBuilder) SetCurrentUserNick(nick string, flags ...Flag) (newNick string, err error) {
+func (g guildQueryBuilder) SetCurrentUserNick(nick string, flags ...RequestOption) (newNick string, err error) {
 	params := &updateCurrentUserNickParams{
 		Nick: nick,
 	}
@@ -987,9 +1093,26 @@ func (g guildQueryBuilder) SetCurrentUserNick(nick string, flags ...Flag) (newNi
 }
 
 // GetBans returns an array of ban objects for the Users banned from this guild. Requires the 'BAN_MEMBERS' permission.
-func (g guildQueryBuilder) GetBans(flags ...Flag) ([]*Ban, error) {
+func (g guildQueryBuilder) GetBans(flags ...RequestOption) ([]*Ban, error) {
+	return g.getBans(nil, flags...)
+}
+
+type getBansParams struct {
+	Before Snowflake `urlparam:"before,omitempty"`
+	After  Snowflake `urlparam:"after,omitempty"`
+	Limit  int       `urlparam:"limit,omitempty"` // 1-1000
+}
+
+var _ URLQueryStringer = (*getBansParams)(nil)
+
+func (g guildQueryBuilder) getBans(params *getBansParams, flags ...RequestOption) ([]*Ban, error) {
+	var query string
+	if params != nil {
+		query = params.URLQueryString()
+	}
+
 	r := g.client.newRESTRequest(&httd.Request{
-		Endpoint: endpoint.GuildBans(g.gid),
+		Endpoint: endpoint.GuildBans(g.gid) + query,
 		Ctx:      g.ctx,
 	}, flags)
 	r.factory = func() interface{} {
@@ -1011,7 +1134,7 @@ func (g guildQueryBuilder) GetBans(flags ...Flag) ([]*Ban, error) {
 
 // GetBan Returns a ban object for the given user or a 404 not found if the ban cannot be found.
 // Requires the 'BAN_MEMBERS' permission.
-func (g guildQueryBuilder) GetBan(userID Snowflake, flags ...Flag) (*Ban, error) {
+func (g guildQueryBuilder) GetBan(userID Snowflake, flags ...RequestOption) (*Ban, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildBan(g.gid, userID),
 		Ctx:      g.ctx,
@@ -1025,7 +1148,7 @@ func (g guildQueryBuilder) GetBan(userID Snowflake, flags ...Flag) (*Ban, error)
 
 // UnbanMember Remove the ban for a user. Requires the 'BAN_MEMBERS' permissions.
 // Returns a 204 empty response on success. Fires a Guild Ban Remove Gateway event.
-func (g guildQueryBuilder) UnbanUser(userID Snowflake, reason string, flags ...Flag) error {
+func (g guildQueryBuilder) UnbanUser(userID Snowflake, reason string, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.GuildBan(g.gid, userID),
@@ -1037,8 +1160,44 @@ func (g guildQueryBuilder) UnbanUser(userID Snowflake, reason string, flags ...F
 	return err
 }
 
+// BulkBan Ban up to 200 users from a guild, and optionally delete previous messages sent by the banned users.
+// Requires both the BAN_MEMBERS and MANAGE_GUILD permissions. Returns the users that were banned, and those
+// that could not be banned, such as users that were already banned. Fires a Guild Ban Add Gateway event for
+// each banned user.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/bulk-ban
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#bulk-guild-ban
+//  Reviewed                2024-06-01
+//  Comment                 Discord returns an error when none of the users could be banned.
+func (g guildQueryBuilder) BulkBan(params *BulkBanParams, flags ...RequestOption) (*BulkBanResponse, error) {
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildBulkBan(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &BulkBanResponse{}
+	}
+
+	v, err := r.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*BulkBanResponse), nil
+}
+
 // GetRoles Returns a list of role objects for the guild.
-func (g guildQueryBuilder) GetRoles(flags ...Flag) ([]*Role, error) {
+func (g guildQueryBuilder) GetRoles(flags ...RequestOption) ([]*Role, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: "/guilds/" + g.gid.String() + "/roles",
 		Ctx:      g.ctx,
@@ -1066,7 +1225,7 @@ type CreateGuildRoleParams struct {
 
 // CreateRole Create a new role for the guild. Requires the 'MANAGE_ROLES' permission.
 // Returns the new role object on success. Fires a Guild Role Create Gateway event.
-func (g guildQueryBuilder) CreateRole(params *CreateGuildRoleParams, flags ...Flag) (*Role, error) {
+func (g guildQueryBuilder) CreateRole(params *CreateGuildRoleParams, flags ...RequestOption) (*Role, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPost,
 		Ctx:         g.ctx,
@@ -1085,7 +1244,7 @@ func (g guildQueryBuilder) CreateRole(params *CreateGuildRoleParams, flags ...Fl
 // UpdateRolePositions Modify the positions of a set of role objects for the guild.
 // Requires the 'MANAGE_ROLES' permission. Returns a list of all of the guild's role objects on success.
 // Fires multiple Guild Role Update Gateway events.
-func (g guildQueryBuilder) UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...Flag) ([]*Role, error) {
+func (g guildQueryBuilder) UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...RequestOption) ([]*Role, error) {
 	var reason string
 	for i := range params {
 		if params[i].Reason != "" {
@@ -1112,17 +1271,32 @@ func (g guildQueryBuilder) UpdateRolePositions(params []UpdateGuildRolePositions
 
 // EstimatePruneMembersCount Returns an object with one 'pruned' key indicating the number of members that would be
 // removed in a prune operation. Requires the 'KICK_MEMBERS' permission.
-func (g guildQueryBuilder) EstimatePruneMembersCount(days int, flags ...Flag) (estimate int, err error) {
+func (g guildQueryBuilder) EstimatePruneMembersCount(days int, flags ...RequestOption) (estimate int, err error) {
+	return g.GetPruneCount(&GetPruneCountParams{Days: days}, flags...)
+}
+
+// GetPruneCount [REST] Returns the number of members that would be removed in a prune operation. Requires the
+// 'KICK_MEMBERS' permission. By default, prune will not remove users with roles. IncludeRoles can be used to
+// also count users with any of the given roles.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/prune
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-prune-count
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) GetPruneCount(params *GetPruneCountParams, flags ...RequestOption) (estimate int, err error) {
 	if g.gid.IsZero() {
 		return 0, errors.New("guildID can not be " + g.gid.String())
 	}
-	params := pruneMembersParams{Days: days}
-	if err = params.FindErrors(); err != nil {
+	if params == nil {
+		return 0, errors.New("params was nil")
+	}
+	query := pruneMembersParams{Days: params.Days, IncludeRoles: joinSnowflakes(params.IncludeRoles)}
+	if err = query.FindErrors(); err != nil {
 		return 0, err
 	}
 
 	r := g.client.newRESTRequest(&httd.Request{
-		Endpoint: endpoint.GuildPrune(g.gid) + params.URLQueryString(),
+		Endpoint: endpoint.GuildPrune(g.gid) + query.URLQueryString(),
 		Ctx:      g.ctx,
 	}, flags)
 	r.factory = func() interface{} {
@@ -1134,36 +1308,65 @@ func (g guildQueryBuilder) EstimatePruneMembersCount(days int, flags ...Flag) (e
 		return 0, err
 	}
 
-	if v == nil {
+	if v == nil || v.(*guildPruneCount).Pruned == nil {
 		return 0, nil
 	}
 
-	return v.(*guildPruneCount).Pruned, nil
+	return *v.(*guildPruneCount).Pruned, nil
 }
 
 // PruneMembers Kicks members from N day back. Requires the 'KICK_MEMBERS' permission.
 // The estimate of kicked people is not returned. Use EstimatePruneMembersCount before calling PruneMembers
 // if you need it. Fires multiple Guild Member Remove Gateway events.
-func (g guildQueryBuilder) PruneMembers(days int, reason string, flags ...Flag) (err error) {
-	params := pruneMembersParams{Days: days}
+func (g guildQueryBuilder) PruneMembers(days int, reason string, flags ...RequestOption) (err error) {
+	_, err = g.Prune(&PruneMembersParams{Days: days, Reason: reason}, flags...)
+	return err
+}
+
+// Prune [REST] Begin a prune operation. Requires the 'KICK_MEMBERS' permission. The number of pruned members is
+// only returned when ComputePruneCount is set, otherwise pruned is nil. Fires multiple Guild Member Remove
+// Gateway events.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/prune
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#begin-guild-prune
+//  Reviewed                2024-06-01
+//  Comment                 Computing the prune count is discouraged for large guilds.
+func (g guildQueryBuilder) Prune(params *PruneMembersParams, flags ...RequestOption) (pruned *int, err error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID can not be " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
 	if err = params.FindErrors(); err != nil {
-		return err
+		return nil, err
 	}
 
 	r := g.client.newRESTRequest(&httd.Request{
-		Method:   httd.MethodPost,
-		Endpoint: endpoint.GuildPrune(g.gid) + params.URLQueryString(),
-		Ctx:      g.ctx,
-		Reason:   reason,
+		Method:      httd.MethodPost,
+		Endpoint:    endpoint.GuildPrune(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Ctx:         g.ctx,
+		Reason:      params.Reason,
 	}, flags)
+	r.factory = func() interface{} {
+		return &guildPruneCount{}
+	}
 
-	_, err = r.Execute()
-	return err
+	var v interface{}
+	if v, err = r.Execute(); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+	return v.(*guildPruneCount).Pruned, nil
 }
 
 // GetVoiceRegions Returns a list of voice region objects for the guild. Unlike the similar /voice route,
 // this returns VIP servers when the guild is VIP-enabled.
-func (g guildQueryBuilder) GetVoiceRegions(flags ...Flag) ([]*VoiceRegion, error) {
+func (g guildQueryBuilder) GetVoiceRegions(flags ...RequestOption) ([]*VoiceRegion, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildRegions(g.gid),
 		Ctx:      g.ctx,
@@ -1178,7 +1381,7 @@ func (g guildQueryBuilder) GetVoiceRegions(flags ...Flag) ([]*VoiceRegion, error
 
 // GetInvites Returns a list of invite objects (with invite metadata) for the guild.
 // Requires the 'MANAGE_GUILD' permission.
-func (g guildQueryBuilder) GetInvites(flags ...Flag) ([]*Invite, error) {
+func (g guildQueryBuilder) GetInvites(flags ...RequestOption) ([]*Invite, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildInvites(g.gid),
 		Ctx:      g.ctx,
@@ -1193,7 +1396,7 @@ func (g guildQueryBuilder) GetInvites(flags ...Flag) ([]*Invite, error) {
 
 // GetIntegrations Returns a list of integration objects for the guild.
 // Requires the 'MANAGE_GUILD' permission.
-func (g guildQueryBuilder) GetIntegrations(flags ...Flag) ([]*Integration, error) {
+func (g guildQueryBuilder) GetIntegrations(flags ...RequestOption) ([]*Integration, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildIntegrations(g.gid),
 		Ctx:      g.ctx,
@@ -1209,7 +1412,7 @@ func (g guildQueryBuilder) GetIntegrations(flags ...Flag) ([]*Integration, error
 // CreateIntegration attaches an integration object from the current user to the guild.
 // Requires the 'MANAGE_GUILD' permission. Returns a 204 empty response on success.
 // Fires a Guild Integrations Update Gateway event.
-func (g guildQueryBuilder) CreateIntegration(params *CreateGuildIntegrationParams, flags ...Flag) error {
+func (g guildQueryBuilder) CreateIntegration(params *CreateGuildIntegrationParams, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPost,
 		Ctx:         g.ctx,
@@ -1225,7 +1428,7 @@ func (g guildQueryBuilder) CreateIntegration(params *CreateGuildIntegrationParam
 // UpdateIntegration Modify the behavior and settings of a integration object for the guild.
 // Requires the 'MANAGE_GUILD' permission. Returns a 204 empty response on success.
 // Fires a Guild Integrations Update Gateway event.
-func (g guildQueryBuilder) UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...Flag) error {
+func (g guildQueryBuilder) UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPatch,
 		Ctx:         g.ctx,
@@ -1241,7 +1444,7 @@ func (g guildQueryBuilder) UpdateIntegration(integrationID Snowflake, params *Up
 // DeleteIntegration Delete the attached integration object for the guild.
 // Requires the 'MANAGE_GUILD' permission. Returns a 204 empty response on success.
 // Fires a Guild Integrations Update Gateway event.
-func (g guildQueryBuilder) DeleteIntegration(integrationID Snowflake, flags ...Flag) error {
+func (g guildQueryBuilder) DeleteIntegration(integrationID Snowflake, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodDelete,
 		Ctx:         g.ctx,
@@ -1255,7 +1458,7 @@ func (g guildQueryBuilder) DeleteIntegration(integrationID Snowflake, flags ...F
 
 // SyncIntegration Sync an integration. Requires the 'MANAGE_GUILD' permission.
 // Returns a 204 empty response on success.
-func (g guildQueryBuilder) SyncIntegration(integrationID Snowflake, flags ...Flag) error {
+func (g guildQueryBuilder) SyncIntegration(integrationID Snowflake, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodPost,
 		Endpoint: endpoint.GuildIntegrationSync(g.gid, integrationID),
@@ -1267,7 +1470,7 @@ func (g guildQueryBuilder) SyncIntegration(integrationID Snowflake, flags ...Fla
 }
 
 // GetEmbed Returns the guild embed object. Requires the 'MANAGE_GUILD' permission.
-func (g guildQueryBuilder) GetEmbed(flags ...Flag) (*GuildEmbed, error) {
+func (g guildQueryBuilder) GetEmbed(flags ...RequestOption) (*GuildEmbed, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildEmbed(g.gid),
 		Ctx:      g.ctx,
@@ -1281,7 +1484,7 @@ func (g guildQueryBuilder) GetEmbed(flags ...Flag) (*GuildEmbed, error) {
 
 // UpdateEmbed Modify a guild embed object for the guild. All attributes may be passed in with JSON and
 // modified. Requires the 'MANAGE_GUILD' permission. Returns the updated guild embed object.
-func (g guildQueryBuilder) UpdateEmbedBuilder(flags ...Flag) UpdateGuildEmbedBuilder {
+func (g guildQueryBuilder) UpdateEmbedBuilder(flags ...RequestOption) UpdateGuildEmbedBuilder {
 	builder := &updateGuildEmbedBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &GuildEmbed{}
@@ -1299,7 +1502,7 @@ func (g guildQueryBuilder) UpdateEmbedBuilder(flags ...Flag) UpdateGuildEmbedBui
 
 // GetVanityURL Returns a partial invite object for Guilds with that feature enabled.
 // Requires the 'MANAGE_GUILD' permission.
-func (g guildQueryBuilder) GetVanityURL(flags ...Flag) (*PartialInvite, error) {
+func (g guildQueryBuilder) GetVanityURL(flags ...RequestOption) (*PartialInvite, error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildVanityURL(g.gid),
 		Ctx:      g.ctx,
@@ -1313,7 +1516,7 @@ func (g guildQueryBuilder) GetVanityURL(flags ...Flag) (*PartialInvite, error) {
 
 // GetAuditLogs Returns an audit log object for the guild. Requires the 'VIEW_AUDIT_LOG' permission.
 // Note that this request will _always_ send a REST request, regardless of you calling IgnoreCache or not.
-func (g guildQueryBuilder) GetAuditLogs(flags ...Flag) GuildAuditLogsBuilder {
+func (g guildQueryBuilder) GetAuditLogs(flags ...RequestOption) GuildAuditLogsBuilder {
 	builder := &guildAuditLogsBuilder{}
 	builder.r.itemFactory = auditLogFactory
 	builder.r.flags = flags
@@ -1327,7 +1530,7 @@ func (g guildQueryBuilder) GetAuditLogs(flags ...Flag) GuildAuditLogsBuilder {
 }
 
 // GetEmojis Returns a list of emoji objects for the given guild.
-func (g guildQueryBuilder) GetEmojis(flags ...Flag) ([]*Emoji, error) {
+func (g guildQueryBuilder) GetEmojis(flags ...RequestOption) ([]*Emoji, error) {
 	if emojis, _ := g.client.cache.GetGuildEmojis(g.gid); emojis != nil {
 		return emojis, nil
 	}
@@ -1365,7 +1568,7 @@ type CreateGuildEmojiParams struct {
 
 // CreateEmoji Create a new emoji for the guild. Requires the 'MANAGE_EMOJIS' permission.
 // Returns the new emoji object on success. Fires a Guild Emojis Update Gateway event.
-func (g guildQueryBuilder) CreateEmoji(params *CreateGuildEmojiParams, flags ...Flag) (*Emoji, error) {
+func (g guildQueryBuilder) CreateEmoji(params *CreateGuildEmojiParams, flags ...RequestOption) (*Emoji, error) {
 	if g.gid.IsZero() {
 		return nil, errors.New("guildID must be set, was " + g.gid.String())
 	}
@@ -1405,7 +1608,7 @@ func (g guildQueryBuilder) KickVoiceParticipant(userID Snowflake) error {
 }
 
 // GetWebhooks Returns a list of guild webhook objects. Requires the 'MANAGE_WEBHOOKS' permission.
-func (g guildQueryBuilder) GetWebhooks(flags ...Flag) (ret []*Webhook, err error) {
+func (g guildQueryBuilder) GetWebhooks(flags ...RequestOption) (ret []*Webhook, err error) {
 	r := g.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.GuildWebhooks(g.gid),
 		Ctx:      g.ctx,
@@ -1492,7 +1695,7 @@ func (g *getGuildMembersParams) FindErrors() error {
 //  Comment                 All parameters to this endpoint. are optional
 //  Comment#2               "List Guild Members"
 //  Comment#3               https://discord.com/developers/docs/resources/guild#list-guild-members-query-string-params
-func (g guildQueryBuilder) getGuildMembers(params *getGuildMembersParams, flags ...Flag) (ret []*Member, err error) {
+func (g guildQueryBuilder) getGuildMembers(params *getGuildMembersParams, flags ...RequestOption) (ret []*Member, err error) {
 	if params == nil {
 		params = &getGuildMembersParams{}
 	}
@@ -1541,44 +1744,140 @@ type AddGuildMemberParams struct {
 	Deaf        bool        `json:"deaf,omitempty"`
 }
 
+// MaxBanDeleteMessageSeconds is the longest period, in seconds, of messages that can be deleted when banning.
+const MaxBanDeleteMessageSeconds = 604800 // 7 days
+
+// BulkBanLimit is the maximum number of users that can be banned with a single bulk ban.
+const BulkBanLimit = 200
+
 // BanMemberParams ...
-// https://discord.com/developers/docs/resources/guild#create-guild-ban-query-string-params
+// https://discord.com/developers/docs/resources/guild#create-guild-ban-json-params
 type BanMemberParams struct {
-	DeleteMessageDays int    `urlparam:"delete_message_days,omitempty"` // number of days to delete messages for (0-7)
-	Reason            string `urlparam:"reason,omitempty"`              // reason for being banned
-}
+	// DeleteMessageSeconds number of seconds to delete messages for (0-604800)
+	DeleteMessageSeconds int `json:"delete_message_seconds,omitempty"`
+
+	// Deprecated: use DeleteMessageSeconds. When set, it is converted to seconds.
+	DeleteMessageDays int `json:"-"`
 
-var _ URLQueryStringer = (*BanMemberParams)(nil)
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
 
 func (b *BanMemberParams) FindErrors() error {
 	if !(0 <= b.DeleteMessageDays && b.DeleteMessageDays <= 7) {
 		return errors.New("DeleteMessageDays must be a value in the range of [0, 7], got " + strconv.Itoa(b.DeleteMessageDays))
 	}
+	if !(0 <= b.DeleteMessageSeconds && b.DeleteMessageSeconds <= MaxBanDeleteMessageSeconds) {
+		return errors.New("DeleteMessageSeconds must be a value in the range of [0, 604800], got " + strconv.Itoa(b.DeleteMessageSeconds))
+	}
 	return nil
 }
 
-// PruneMembersParams will delete members, this is the same as kicking.
-// https://discord.com/developers/docs/resources/guild#get-guild-prune-count-query-string-params
+func (b *BanMemberParams) prepare() *BanMemberParams {
+	if b.DeleteMessageDays == 0 || b.DeleteMessageSeconds != 0 {
+		return b
+	}
+	params := *b
+	params.DeleteMessageSeconds = b.DeleteMessageDays * 24 * 60 * 60
+	return &params
+}
+
+// BulkBanParams https://discord.com/developers/docs/resources/guild#bulk-guild-ban-json-params
+type BulkBanParams struct {
+	// UserIDs the users to ban, at most 200
+	UserIDs []Snowflake `json:"user_ids"`
+
+	// DeleteMessageSeconds number of seconds to delete messages for (0-604800)
+	DeleteMessageSeconds int `json:"delete_message_seconds,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (b *BulkBanParams) FindErrors() error {
+	if len(b.UserIDs) == 0 || len(b.UserIDs) > BulkBanLimit {
+		return errors.New("UserIDs must hold 1 to 200 users, got " + strconv.Itoa(len(b.UserIDs)))
+	}
+	if !(0 <= b.DeleteMessageSeconds && b.DeleteMessageSeconds <= MaxBanDeleteMessageSeconds) {
+		return errors.New("DeleteMessageSeconds must be a value in the range of [0, 604800], got " + strconv.Itoa(b.DeleteMessageSeconds))
+	}
+	return nil
+}
+
+// BulkBanResponse https://discord.com/developers/docs/resources/guild#bulk-guild-ban-bulk-ban-response
+type BulkBanResponse struct {
+	// BannedUsers the users that were banned
+	BannedUsers []Snowflake `json:"banned_users"`
+
+	// FailedUsers the users that could not be banned
+	FailedUsers []Snowflake `json:"failed_users"`
+}
+
+// MaxPruneDays is the largest number of inactive days a prune can be based on.
+const MaxPruneDays = 30
+
+func validPruneDays(days int) error {
+	if days < 1 || days > MaxPruneDays {
+		return errors.New("days must be in the range of [1, 30], got " + strconv.Itoa(days))
+	}
+	return nil
+}
+
+// GetPruneCountParams https://discord.com/developers/docs/resources/guild#get-guild-prune-count-query-string-params
+type GetPruneCountParams struct {
+	// Days number of days to count prune for (1-30)
+	Days int
+
+	// IncludeRoles roles to include, members with any of these roles are counted as well
+	IncludeRoles []Snowflake
+}
+
+// pruneMembersParams is the query string of GetPruneCountParams.
 type pruneMembersParams struct {
 	// Days number of days to count prune for (1 or more)
 	Days int `urlparam:"days"`
 
-	// ComputePruneCount whether 'pruned' is returned, discouraged for large Guilds
-	ComputePruneCount bool `urlparam:"compute_prune_count"`
+	// IncludeRoles comma separated role ids
+	IncludeRoles string `urlparam:"include_roles,omitempty"`
 }
 
 var _ URLQueryStringer = (*pruneMembersParams)(nil)
 
 func (d *pruneMembersParams) FindErrors() (err error) {
-	if d.Days < 1 {
-		err = errors.New("days must be at least 1, got " + strconv.Itoa(d.Days))
-	}
-	return
+	return validPruneDays(d.Days)
+}
+
+// PruneMembersParams will delete members, this is the same as kicking.
+// https://discord.com/developers/docs/resources/guild#begin-guild-prune-json-params
+type PruneMembersParams struct {
+	// Days number of days to prune (1-30)
+	Days int `json:"days"`
+
+	// ComputePruneCount whether 'pruned' is returned, discouraged for large Guilds
+	ComputePruneCount bool `json:"compute_prune_count"`
+
+	// IncludeRoles roles to include, members with any of these roles are pruned as well
+	IncludeRoles []Snowflake `json:"include_roles,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *PruneMembersParams) FindErrors() error {
+	return validPruneDays(p.Days)
 }
 
 // GuildPruneCount ...
 type guildPruneCount struct {
-	Pruned int `json:"pruned"`
+	Pruned *int `json:"pruned"`
+}
+
+func joinSnowflakes(ids []Snowflake) string {
+	strs := make([]string, len(ids))
+	for i := range ids {
+		strs[i] = ids[i].String()
+	}
+	return strings.Join(strs, ",")
 }
 
 // CreateGuildIntegrationParams ...
@@ -1645,3 +1944,22 @@ func (b *updateGuildMemberBuilder) DeleteNick() UpdateGuildMemberBuilder {
 	b.r.param("nick", "")
 	return b
 }
+
+// SetCommunicationDisabledUntil times out the member until the given time, which can be at most 28 days
+// in the future. Requires permission MODERATE_MEMBERS
+func (b *updateGuildMemberBuilder) SetCommunicationDisabledUntil(until Time) UpdateGuildMemberBuilder {
+	b.r.param("communication_disabled_until", Time{until.UTC()})
+	return b
+}
+
+// RemoveTimeout removes the timeout of the member. Requires permission MODERATE_MEMBERS
+func (b *updateGuildMemberBuilder) RemoveTimeout() UpdateGuildMemberBuilder {
+	b.r.param("communication_disabled_until", nil)
+	return b
+}
+
+// WithReason sets the reason that shows up in the audit log.
+func (b *updateGuildMemberBuilder) WithReason(reason string) UpdateGuildMemberBuilder {
+	b.r.headerReason = reason
+	return b
+}
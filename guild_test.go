@@ -4,7 +4,9 @@ package disgord
 
 import (
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Vedza/disgord/json"
 )
@@ -305,3 +307,68 @@ func TestPermissionBit(t *testing.T) {
 		}
 	})
 }
+
+func TestUpdateGuildScheduledEventParams(t *testing.T) {
+	end := Time{Time: time.Now().Add(time.Hour)}
+	params := &UpdateGuildScheduledEventParams{
+		EntityType:       GuildScheduledEventEntityExternal,
+		ScheduledEndTime: &end,
+	}
+	if err := params.FindErrors(); err == nil {
+		t.Error("expected an error when moving an event to an external location without a location")
+	}
+
+	params.EntityMetadata = &GuildScheduledEventEntityMetadata{Location: "the park"}
+	if err := params.FindErrors(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"channel_id":null`) {
+		t.Errorf("expected the channel id to be cleared, got %s", string(data))
+	}
+
+	params = &UpdateGuildScheduledEventParams{Name: "renamed"}
+	if data, err = json.Marshal(params); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"name":"renamed"}` {
+		t.Errorf("expected only the name to be sent, got %s", string(data))
+	}
+}
+
+func TestCreateAutoModerationRuleParams(t *testing.T) {
+	params := &CreateAutoModerationRuleParams{
+		Name:        "no spam",
+		EventType:   AutoModerationEventMessageSend,
+		TriggerType: AutoModerationTriggerSpam,
+		Actions: []*AutoModerationAction{
+			{Type: AutoModerationActionBlockMessage},
+		},
+	}
+	if err := params.FindErrors(); err != nil {
+		t.Fatal(err)
+	}
+
+	params.Actions = append(params.Actions, &AutoModerationAction{
+		Type:     AutoModerationActionTimeout,
+		Metadata: &AutoModerationActionMetadata{DurationSeconds: 60},
+	})
+	if err := params.FindErrors(); err == nil {
+		t.Error("timeout actions are not allowed for spam rules")
+	}
+
+	params.TriggerType = AutoModerationTriggerKeyword
+	params.TriggerMetadata = &AutoModerationTriggerMetadata{KeywordFilter: []string{"cat*"}}
+	if err := params.FindErrors(); err != nil {
+		t.Fatal(err)
+	}
+
+	params.Actions[1].Metadata.DurationSeconds = AutoModerationMaxTimeout + 1
+	if err := params.FindErrors(); err == nil {
+		t.Error("expected an error for a timeout longer than 4 weeks")
+	}
+}
@@ -0,0 +1,137 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// GuildMembersQuery selects the members of a guild members request, see Client.RequestGuildMembers.
+type GuildMembersQuery struct {
+	// Query matches the start of usernames. An empty query requests every member, which requires
+	// the GUILD_MEMBERS intent.
+	Query string
+
+	// Limit is the maximum number of members, 0 means no limit.
+	Limit uint
+
+	// UserIDs requests specific members, instead of using Query. At most 100 users.
+	UserIDs []Snowflake
+
+	// Presences includes the presence of every member, which requires the GUILD_PRESENCES intent.
+	Presences bool
+}
+
+// RequestedMember is a member received from a guild members request. Presence is nil unless presences
+// were requested, and the member is online.
+type RequestedMember struct {
+	*Member
+	Presence *PresenceUpdate
+}
+
+var memberRequestNonce atomic.Uint64
+
+// RequestGuildMembers requests guild members through the gateway, and returns a channel of the
+// members as the chunks from Discord arrive. The channel is closed once the last chunk has been
+// received, or the context is done. Members are not cached unless the cache handles GUILD_MEMBERS_CHUNK.
+//  ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//  defer cancel()
+//
+//  members, err := client.RequestGuildMembers(ctx, guildID, &disgord.GuildMembersQuery{Query: "anders"})
+//  if err != nil {
+//      return err
+//  }
+//  for member := range members {
+//      fmt.Println(member.User.Username)
+//  }
+func (c *Client) RequestGuildMembers(ctx context.Context, guildID Snowflake, query *GuildMembersQuery) (<-chan *RequestedMember, error) {
+	if guildID.IsZero() {
+		return nil, errors.New("missing guild id")
+	}
+	if query == nil {
+		query = &GuildMembersQuery{}
+	}
+
+	nonce := "disgord-" + strconv.FormatUint(memberRequestNonce.Inc(), 36)
+	members, cancel, err := c.collectMembers(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.Gateway().Dispatch(RequestGuildMembers, &RequestGuildMembersPayload{
+		GuildIDs:  []Snowflake{guildID},
+		Query:     query.Query,
+		Limit:     query.Limit,
+		Presences: query.Presences,
+		UserIDs:   query.UserIDs,
+		Nonce:     nonce,
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return members, nil
+}
+
+// collectMembers registers a temporary handler for the member chunks with the given nonce. The
+// handler is removed, and the channel closed, when every chunk has been received or the context is done.
+func (c *Client) collectMembers(ctx context.Context, nonce string) (<-chan *RequestedMember, context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	members := make(chan *RequestedMember)
+
+	var mu sync.Mutex
+	var closed bool
+	received := make(map[uint]bool)
+
+	filter := func(evt interface{}) interface{} {
+		if evt.(*GuildMembersChunk).Nonce != nonce {
+			return nil
+		}
+		return evt
+	}
+	handler := func(_ Session, chunk *GuildMembersChunk) {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+
+		presences := make(map[Snowflake]*PresenceUpdate, len(chunk.Presences))
+		for _, presence := range chunk.Presences {
+			if presence.User != nil {
+				presences[presence.User.ID] = presence
+			}
+		}
+		for _, member := range chunk.Members {
+			select {
+			case members <- &RequestedMember{Member: member, Presence: presences[member.UserID]}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		// chunks may be handled out of order
+		received[chunk.ChunkIndex] = true
+		if uint(len(received)) >= chunk.ChunkCount {
+			cancel()
+		}
+	}
+
+	err := c.dispatcher.register(EvtGuildMembersChunk, filter, handler, &Ctrl{Context: ctx})
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		mu.Lock()
+		closed = true
+		close(members)
+		mu.Unlock()
+	}()
+	return members, cancel, nil
+}
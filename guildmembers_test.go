@@ -0,0 +1,65 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+func TestClient_collectMembers(t *testing.T) {
+	c := &Client{dispatcher: newDispatcher(), log: &logger.Empty{}}
+	c.dispatcher.addSessionInstance(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	members, _, err := c.collectMembers(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		c.dispatcher.dispatch(EvtGuildMembersChunk, 0, &GuildMembersChunk{
+			Nonce:      "b",
+			ChunkCount: 1,
+			Members:    []*Member{{UserID: 9}},
+		})
+		c.dispatcher.dispatch(EvtGuildMembersChunk, 0, &GuildMembersChunk{
+			Nonce:      "a",
+			ChunkIndex: 1,
+			ChunkCount: 2,
+			Members:    []*Member{{UserID: 3}},
+		})
+		c.dispatcher.dispatch(EvtGuildMembersChunk, 0, &GuildMembersChunk{
+			Nonce:      "a",
+			ChunkIndex: 0,
+			ChunkCount: 2,
+			Members:    []*Member{{UserID: 1}, {UserID: 2}},
+			Presences:  []*PresenceUpdate{{User: &User{ID: 2}, Status: StatusOnline}},
+		})
+	}()
+
+	var userIDs []Snowflake
+	for member := range members {
+		userIDs = append(userIDs, member.UserID)
+		if member.UserID == 2 && (member.Presence == nil || member.Presence.Status != StatusOnline) {
+			t.Error("expected the presence of the member")
+		}
+	}
+	if ctx.Err() != nil {
+		t.Fatal("the channel was not closed after the last chunk")
+	}
+	if len(userIDs) != 3 {
+		t.Errorf("expected 3 members from the requested chunks. Got %v", userIDs)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	c.dispatcher.RLock()
+	defer c.dispatcher.RUnlock()
+	if len(c.dispatcher.handlerSpecs[EvtGuildMembersChunk]) != 0 {
+		t.Error("expected the temporary handler to be removed")
+	}
+}
@@ -0,0 +1,292 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// GuildTemplate is a snapshot of a guild, which can be used to create new guilds.
+// https://discord.com/developers/docs/resources/guild-template#guild-template-object
+type GuildTemplate struct {
+	Code                  string    `json:"code"`
+	Name                  string    `json:"name"`
+	Description           string    `json:"description"`
+	UsageCount            int       `json:"usage_count"`
+	CreatorID             Snowflake `json:"creator_id"`
+	Creator               *User     `json:"creator"`
+	CreatedAt             Time      `json:"created_at"`
+	UpdatedAt             Time      `json:"updated_at"`
+	SourceGuildID         Snowflake `json:"source_guild_id"`
+	SerializedSourceGuild *Guild    `json:"serialized_source_guild"`
+
+	// IsDirty is true when the guild has changes that are not synced to the template
+	IsDirty bool `json:"is_dirty"`
+}
+
+var _ Copier = (*GuildTemplate)(nil)
+var _ DeepCopier = (*GuildTemplate)(nil)
+
+// CreateGuildTemplateParams https://discord.com/developers/docs/resources/guild-template#create-guild-template-json-params
+type CreateGuildTemplateParams struct {
+	Name        string `json:"name"`                  // required, 1-100 characters
+	Description string `json:"description,omitempty"` // 0-120 characters
+}
+
+func (p *CreateGuildTemplateParams) FindErrors() error {
+	if l := len(p.Name); l < 1 || l > 100 {
+		return errors.New("template name must be 1-100 characters")
+	}
+	if len(p.Description) > 120 {
+		return errors.New("template description can be at most 120 characters")
+	}
+	return nil
+}
+
+// UpdateGuildTemplateParams https://discord.com/developers/docs/resources/guild-template#modify-guild-template-json-params
+// Fields left empty are not changed.
+type UpdateGuildTemplateParams struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateGuildFromTemplateParams https://discord.com/developers/docs/resources/guild-template#create-guild-from-guild-template-json-params
+type CreateGuildFromTemplateParams struct {
+	Name string `json:"name"` // required, 2-100 characters
+
+	// Icon is a data URI of the guild icon, see ImageData
+	Icon string `json:"icon,omitempty"`
+}
+
+func validTemplateCode(code string) error {
+	if code == "" {
+		return errors.New("template code must be set")
+	}
+	return nil
+}
+
+// GuildTemplateQueryBuilder uses a template code to fetch the template, or create a guild from it.
+type GuildTemplateQueryBuilder interface {
+	WithContext(ctx context.Context) GuildTemplateQueryBuilder
+
+	// Get Returns the guild template for the given code.
+	Get(flags ...RequestOption) (*GuildTemplate, error)
+
+	// CreateGuild Creates a new guild based on the template. Only for bots in less than 10 guilds.
+	CreateGuild(params *CreateGuildFromTemplateParams, flags ...RequestOption) (*Guild, error)
+}
+
+// GuildTemplate targets a guild template by its code, as found in the template link
+// https://discord.new/{code}.
+func (c clientQueryBuilder) GuildTemplate(code string) GuildTemplateQueryBuilder {
+	return &guildTemplateQueryBuilder{client: c.client, code: code}
+}
+
+type guildTemplateQueryBuilder struct {
+	ctx    context.Context
+	client *Client
+	code   string
+}
+
+func (t guildTemplateQueryBuilder) WithContext(ctx context.Context) GuildTemplateQueryBuilder {
+	t.ctx = ctx
+	return &t
+}
+
+// Get [REST] Returns a guild template object for the given code.
+//  Method                  GET
+//  Endpoint                /guilds/templates/{template.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#get-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (t guildTemplateQueryBuilder) Get(flags ...RequestOption) (*GuildTemplate, error) {
+	if err := validTemplateCode(t.code); err != nil {
+		return nil, err
+	}
+
+	r := t.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.Template(url.PathEscape(t.code)),
+		Ctx:      t.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildTemplate{}
+	}
+
+	return getGuildTemplate(r.Execute)
+}
+
+// CreateGuild [REST] Create a new guild based on a template. Returns a guild object on success. Fires a Guild
+// Create Gateway event.
+//  Method                  POST
+//  Endpoint                /guilds/templates/{template.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#create-guild-from-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 This endpoint can be used only by bots in less than 10 guilds.
+func (t guildTemplateQueryBuilder) CreateGuild(params *CreateGuildFromTemplateParams, flags ...RequestOption) (*Guild, error) {
+	if err := validTemplateCode(t.code); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if l := len(params.Name); l < 2 || l > 100 {
+		return nil, errors.New("guild name must be 2 or more characters and no more than 100 characters")
+	}
+
+	r := t.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         t.ctx,
+		Endpoint:    endpoint.Template(url.PathEscape(t.code)),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Guild{}
+	}
+
+	return getGuild(r.Execute)
+}
+
+// GetTemplates [REST] Returns an array of guild template objects. Requires the MANAGE_GUILD permission.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/templates
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#get-guild-templates
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) GetTemplates(flags ...RequestOption) ([]*GuildTemplate, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildTemplates(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*GuildTemplate, 0)
+		return &tmp
+	}
+
+	return getGuildTemplates(r.Execute)
+}
+
+// CreateTemplate [REST] Creates a template for the guild. Requires the MANAGE_GUILD permission. Returns the
+// created guild template object on success.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/templates
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#create-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 A guild can only have one template.
+func (g guildQueryBuilder) CreateTemplate(params *CreateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildTemplates(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildTemplate{}
+	}
+
+	return getGuildTemplate(r.Execute)
+}
+
+// SyncTemplate [REST] Syncs the template to the current state of the guild. Requires the MANAGE_GUILD
+// permission. Returns the guild template object on success.
+//  Method                  PUT
+//  Endpoint                /guilds/{guild.id}/templates/{template.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#sync-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) SyncTemplate(code string, flags ...RequestOption) (*GuildTemplate, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if err := validTemplateCode(code); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodPut,
+		Ctx:      g.ctx,
+		Endpoint: endpoint.GuildTemplate(g.gid, url.PathEscape(code)),
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildTemplate{}
+	}
+
+	return getGuildTemplate(r.Execute)
+}
+
+// UpdateTemplate [REST] Modifies the metadata of the template. Requires the MANAGE_GUILD permission. Returns
+// the guild template object on success.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/templates/{template.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#modify-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) UpdateTemplate(code string, params *UpdateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if err := validTemplateCode(code); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildTemplate(g.gid, url.PathEscape(code)),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildTemplate{}
+	}
+
+	return getGuildTemplate(r.Execute)
+}
+
+// DeleteTemplate [REST] Deletes the template. Requires the MANAGE_GUILD permission. Returns the deleted guild
+// template object on success.
+//  Method                  DELETE
+//  Endpoint                /guilds/{guild.id}/templates/{template.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-template#delete-guild-template
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) DeleteTemplate(code string, flags ...RequestOption) (*GuildTemplate, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if err := validTemplateCode(code); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      g.ctx,
+		Endpoint: endpoint.GuildTemplate(g.gid, url.PathEscape(code)),
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildTemplate{}
+	}
+
+	return getGuildTemplate(r.Execute)
+}
@@ -0,0 +1,84 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGuildTemplate(t *testing.T) {
+	var requests []string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/guilds"):]
+			requests = append(requests, req.Method+" "+path)
+			switch {
+			case strings.HasSuffix(path, "/templates") && req.Method == http.MethodGet:
+				return jsonResponse(req, `[{"code":"abc","name":"base","source_guild_id":"1"}]`), nil
+			case path == "/guilds/templates/abc" && req.Method == http.MethodPost:
+				return jsonResponse(req, `{"id":"2","name":"copy"}`), nil
+			}
+			return jsonResponse(req, `{"code":"abc","name":"base","source_guild_id":"1","is_dirty":true}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template, err := client.GuildTemplate("abc").Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Code != "abc" || template.SourceGuildID != 1 || !template.IsDirty {
+		t.Errorf("unexpected template %+v", template)
+	}
+
+	guild, err := client.GuildTemplate("abc").CreateGuild(&CreateGuildFromTemplateParams{Name: "copy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if guild.ID != 2 {
+		t.Errorf("unexpected guild %+v", guild)
+	}
+
+	templates, err := client.Guild(1).GetTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates) != 1 {
+		t.Errorf("expected one template. Got %d", len(templates))
+	}
+	if _, err = client.Guild(1).CreateTemplate(&CreateGuildTemplateParams{Name: "base"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Guild(1).SyncTemplate("abc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Guild(1).UpdateTemplate("abc", &UpdateGuildTemplateParams{Description: "v2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Guild(1).DeleteTemplate("abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"GET /guilds/templates/abc",
+		"POST /guilds/templates/abc",
+		"GET /guilds/1/templates",
+		"POST /guilds/1/templates",
+		"PUT /guilds/1/templates/abc",
+		"PATCH /guilds/1/templates/abc",
+		"DELETE /guilds/1/templates/abc",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	if _, err = client.Guild(1).CreateTemplate(&CreateGuildTemplateParams{}); err == nil {
+		t.Error("expected an error when the template has no name")
+	}
+}
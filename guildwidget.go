@@ -0,0 +1,203 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// GuildPreview the public information of a guild, available for discoverable guilds or guilds the bot is in.
+// https://discord.com/developers/docs/resources/guild#guild-preview-object
+type GuildPreview struct {
+	ID                       Snowflake  `json:"id"`
+	Name                     string     `json:"name"`
+	Icon                     string     `json:"icon"`
+	Splash                   string     `json:"splash"`
+	DiscoverySplash          string     `json:"discovery_splash"`
+	Emojis                   []*Emoji   `json:"emojis"`
+	Features                 []string   `json:"features"`
+	ApproximateMemberCount   int        `json:"approximate_member_count"`
+	ApproximatePresenceCount int        `json:"approximate_presence_count"`
+	Description              string     `json:"description"`
+	Stickers                 []*Sticker `json:"stickers"`
+}
+
+var _ Copier = (*GuildPreview)(nil)
+var _ DeepCopier = (*GuildPreview)(nil)
+
+// GuildWidgetSettings https://discord.com/developers/docs/resources/guild#guild-widget-settings-object
+type GuildWidgetSettings struct {
+	Enabled   bool      `json:"enabled"`
+	ChannelID Snowflake `json:"channel_id"`
+}
+
+var _ Copier = (*GuildWidgetSettings)(nil)
+var _ DeepCopier = (*GuildWidgetSettings)(nil)
+
+// GuildWidgetChannel a voice channel listed in the guild widget.
+type GuildWidgetChannel struct {
+	ID       Snowflake `json:"id"`
+	Name     string    `json:"name"`
+	Position int       `json:"position"`
+}
+
+var _ Copier = (*GuildWidgetChannel)(nil)
+var _ DeepCopier = (*GuildWidgetChannel)(nil)
+
+// GuildWidgetMember an online member listed in the guild widget. The IDs are anonymized, and
+// only unique within the widget.
+type GuildWidgetMember struct {
+	ID            Snowflake `json:"id"`
+	Username      string    `json:"username"`
+	Discriminator string    `json:"discriminator"`
+	Avatar        string    `json:"avatar"`
+	Status        string    `json:"status"`
+	AvatarURL     string    `json:"avatar_url"`
+}
+
+var _ Copier = (*GuildWidgetMember)(nil)
+var _ DeepCopier = (*GuildWidgetMember)(nil)
+
+// GuildWidget is the public widget of a guild, as used by status pages.
+// https://discord.com/developers/docs/resources/guild#guild-widget-object
+type GuildWidget struct {
+	ID            Snowflake             `json:"id"`
+	Name          string                `json:"name"`
+	InstantInvite string                `json:"instant_invite"` // empty when no invite channel is set
+	Channels      []*GuildWidgetChannel `json:"channels"`
+	Members       []*GuildWidgetMember  `json:"members"` // at most 100 members are listed
+	PresenceCount int                   `json:"presence_count"`
+}
+
+var _ Copier = (*GuildWidget)(nil)
+var _ DeepCopier = (*GuildWidget)(nil)
+
+// UpdateGuildWidgetParams https://discord.com/developers/docs/resources/guild#modify-guild-widget
+// Fields left nil are not changed.
+type UpdateGuildWidgetParams struct {
+	Enabled   *bool      `json:"enabled,omitempty"`
+	ChannelID *Snowflake `json:"channel_id,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+// GuildWidgetStyle decides the look of the widget image.
+// https://discord.com/developers/docs/resources/guild#get-guild-widget-image-widget-style-options
+type GuildWidgetStyle string
+
+const (
+	GuildWidgetStyleShield  GuildWidgetStyle = "shield"
+	GuildWidgetStyleBanner1 GuildWidgetStyle = "banner1"
+	GuildWidgetStyleBanner2 GuildWidgetStyle = "banner2"
+	GuildWidgetStyleBanner3 GuildWidgetStyle = "banner3"
+	GuildWidgetStyleBanner4 GuildWidgetStyle = "banner4"
+)
+
+// GuildWidgetImageURL returns a link to the PNG image of the guild widget, which can be embedded in web pages
+// as is. The widget must be enabled for the link to work. An empty style gives the shield style.
+func GuildWidgetImageURL(guildID Snowflake, style GuildWidgetStyle) string {
+	url := httd.BaseURL + endpoint.GuildWidgetImage(guildID)
+	if style != "" {
+		url += "?style=" + string(style)
+	}
+	return url
+}
+
+// GetPreview [REST] Returns the guild preview object. If the bot is not in the guild, the guild must be
+// discoverable.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/preview
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-preview
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) GetPreview(flags ...RequestOption) (*GuildPreview, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildPreview(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildPreview{}
+	}
+
+	return getGuildPreview(r.Execute)
+}
+
+// GetWidgetSettings [REST] Returns the guild widget settings. Requires the MANAGE_GUILD permission.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/widget
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-widget-settings
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) GetWidgetSettings(flags ...RequestOption) (*GuildWidgetSettings, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildWidgetSettings(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildWidgetSettings{}
+	}
+
+	return getGuildWidgetSettings(r.Execute)
+}
+
+// UpdateWidgetSettings [REST] Modify the guild widget settings. Requires the MANAGE_GUILD permission. Returns
+// the updated widget settings.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/widget
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#modify-guild-widget
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (g guildQueryBuilder) UpdateWidgetSettings(params *UpdateGuildWidgetParams, flags ...RequestOption) (*GuildWidgetSettings, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildWidgetSettings(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildWidgetSettings{}
+	}
+
+	return getGuildWidgetSettings(r.Execute)
+}
+
+// GetWidget [REST] Returns the public widget of the guild. The widget must be enabled.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/widget.json
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-widget
+//  Reviewed                2024-06-01
+//  Comment                 See GuildWidgetImageURL for the widget image.
+func (g guildQueryBuilder) GetWidget(flags ...RequestOption) (*GuildWidget, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildWidget(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildWidget{}
+	}
+
+	return getGuildWidget(r.Execute)
+}
@@ -0,0 +1,73 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGuildWidget(t *testing.T) {
+	var requests []string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/guilds"):]
+			requests = append(requests, req.Method+" "+path)
+			switch {
+			case strings.HasSuffix(path, "/preview"):
+				return jsonResponse(req, `{"id":"1","name":"test","features":["COMMUNITY"],"approximate_member_count":40}`), nil
+			case strings.HasSuffix(path, "/widget.json"):
+				return jsonResponse(req, `{"id":"1","name":"test","channels":[{"id":"2","name":"voice","position":0}],"members":[{"id":"0","username":"anna","status":"online"}],"presence_count":1}`), nil
+			}
+			return jsonResponse(req, `{"enabled":true,"channel_id":"2"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := client.Guild(1).GetPreview()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.ApproximateMemberCount != 40 || len(preview.Features) != 1 {
+		t.Errorf("unexpected preview %+v", preview)
+	}
+
+	settings, err := client.Guild(1).GetWidgetSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !settings.Enabled || settings.ChannelID != 2 {
+		t.Errorf("unexpected widget settings %+v", settings)
+	}
+	enabled := true
+	if _, err = client.Guild(1).UpdateWidgetSettings(&UpdateGuildWidgetParams{Enabled: &enabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	widget, err := client.Guild(1).GetWidget()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if widget.PresenceCount != 1 || len(widget.Channels) != 1 || len(widget.Members) != 1 {
+		t.Errorf("unexpected widget %+v", widget)
+	}
+
+	expected := []string{
+		"GET /guilds/1/preview",
+		"GET /guilds/1/widget",
+		"PATCH /guilds/1/widget",
+		"GET /guilds/1/widget.json",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	if url := GuildWidgetImageURL(1, GuildWidgetStyleBanner2); url != "https://discord.com/api/guilds/1/widget.png?style=banner2" {
+		t.Errorf("unexpected widget image url %s", url)
+	}
+}
@@ -97,6 +97,7 @@ func (a *Attachment) copyOverTo(other interface{}) error {
 	if dest, valid = other.(*Attachment); !valid {
 		return newErrorUnsupportedType("argument given is not a *Attachment type")
 	}
+	dest.ContentType = a.ContentType
 	dest.Filename = a.Filename
 	dest.Height = a.Height
 	dest.ID = a.ID
@@ -181,6 +182,44 @@ func (a *AuditLogOption) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (a *AutoModerationAction) copyOverTo(other interface{}) error {
+	var dest *AutoModerationAction
+	var valid bool
+	if dest, valid = other.(*AutoModerationAction); !valid {
+		return newErrorUnsupportedType("argument given is not a *AutoModerationAction type")
+	}
+	dest.Metadata = a.Metadata
+	dest.Type = a.Type
+
+	return nil
+}
+
+func (a *AutoModerationRule) copyOverTo(other interface{}) error {
+	var dest *AutoModerationRule
+	var valid bool
+	if dest, valid = other.(*AutoModerationRule); !valid {
+		return newErrorUnsupportedType("argument given is not a *AutoModerationRule type")
+	}
+	dest.Actions = make([]*AutoModerationAction, len(a.Actions))
+	for i := 0; i < len(a.Actions); i++ {
+		dest.Actions[i] = DeepCopy(a.Actions[i]).(*AutoModerationAction)
+	}
+	dest.CreatorID = a.CreatorID
+	dest.Enabled = a.Enabled
+	dest.EventType = a.EventType
+	dest.ExemptChannels = make([]Snowflake, len(a.ExemptChannels))
+	copy(dest.ExemptChannels, a.ExemptChannels)
+	dest.ExemptRoles = make([]Snowflake, len(a.ExemptRoles))
+	copy(dest.ExemptRoles, a.ExemptRoles)
+	dest.GuildID = a.GuildID
+	dest.ID = a.ID
+	dest.Name = a.Name
+	dest.TriggerMetadata = a.TriggerMetadata
+	dest.TriggerType = a.TriggerType
+
+	return nil
+}
+
 func (b *Ban) copyOverTo(other interface{}) error {
 	var dest *Ban
 	var valid bool
@@ -200,12 +239,27 @@ func (c *Channel) copyOverTo(other interface{}) error {
 		return newErrorUnsupportedType("argument given is not a *Channel type")
 	}
 	dest.ApplicationID = c.ApplicationID
+	dest.AppliedTags = make([]Snowflake, len(c.AppliedTags))
+	copy(dest.AppliedTags, c.AppliedTags)
+	dest.AvailableTags = make([]*ForumTag, len(c.AvailableTags))
+	for i := 0; i < len(c.AvailableTags); i++ {
+		dest.AvailableTags[i] = DeepCopy(c.AvailableTags[i]).(*ForumTag)
+	}
 	dest.Bitrate = c.Bitrate
+	dest.DefaultAutoArchiveDuration = c.DefaultAutoArchiveDuration
+	dest.DefaultForumLayout = c.DefaultForumLayout
+	dest.DefaultReactionEmoji = c.DefaultReactionEmoji
+	dest.DefaultSortOrder = c.DefaultSortOrder
+	dest.DefaultThreadRateLimitPerUser = c.DefaultThreadRateLimitPerUser
+	dest.Flags = c.Flags
 	dest.GuildID = c.GuildID
 	dest.Icon = c.Icon
 	dest.ID = c.ID
 	dest.LastMessageID = c.LastMessageID
 	dest.LastPinTimestamp = c.LastPinTimestamp
+	dest.Member = c.Member
+	dest.MemberCount = c.MemberCount
+	dest.MessageCount = c.MessageCount
 	dest.Name = c.Name
 	dest.NSFW = c.NSFW
 	dest.OwnerID = c.OwnerID
@@ -218,6 +272,8 @@ func (c *Channel) copyOverTo(other interface{}) error {
 	for i := 0; i < len(c.Recipients); i++ {
 		dest.Recipients[i] = DeepCopy(c.Recipients[i]).(*User)
 	}
+	dest.RTCRegion = c.RTCRegion
+	dest.ThreadMetadata = c.ThreadMetadata
 	dest.Topic = c.Topic
 	dest.Type = c.Type
 	dest.UserLimit = c.UserLimit
@@ -225,6 +281,18 @@ func (c *Channel) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (d *DefaultReaction) copyOverTo(other interface{}) error {
+	var dest *DefaultReaction
+	var valid bool
+	if dest, valid = other.(*DefaultReaction); !valid {
+		return newErrorUnsupportedType("argument given is not a *DefaultReaction type")
+	}
+	dest.EmojiID = d.EmojiID
+	dest.EmojiName = d.EmojiName
+
+	return nil
+}
+
 func (e *Embed) copyOverTo(other interface{}) error {
 	var dest *Embed
 	var valid bool
@@ -362,6 +430,41 @@ func (e *Emoji) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (e *Entitlement) copyOverTo(other interface{}) error {
+	var dest *Entitlement
+	var valid bool
+	if dest, valid = other.(*Entitlement); !valid {
+		return newErrorUnsupportedType("argument given is not a *Entitlement type")
+	}
+	dest.ApplicationID = e.ApplicationID
+	dest.Consumed = e.Consumed
+	dest.Deleted = e.Deleted
+	dest.EndsAt = e.EndsAt
+	dest.GuildID = e.GuildID
+	dest.ID = e.ID
+	dest.SKUID = e.SKUID
+	dest.StartsAt = e.StartsAt
+	dest.Type = e.Type
+	dest.UserID = e.UserID
+
+	return nil
+}
+
+func (f *ForumTag) copyOverTo(other interface{}) error {
+	var dest *ForumTag
+	var valid bool
+	if dest, valid = other.(*ForumTag); !valid {
+		return newErrorUnsupportedType("argument given is not a *ForumTag type")
+	}
+	dest.EmojiID = f.EmojiID
+	dest.EmojiName = f.EmojiName
+	dest.ID = f.ID
+	dest.Moderated = f.Moderated
+	dest.Name = f.Name
+
+	return nil
+}
+
 func (g *Guild) copyOverTo(other interface{}) error {
 	var dest *Guild
 	var valid bool
@@ -407,7 +510,15 @@ func (g *Guild) copyOverTo(other interface{}) error {
 		dest.Roles[i] = DeepCopy(g.Roles[i]).(*Role)
 	}
 	dest.Splash = g.Splash
+	dest.Stickers = make([]*Sticker, len(g.Stickers))
+	for i := 0; i < len(g.Stickers); i++ {
+		dest.Stickers[i] = DeepCopy(g.Stickers[i]).(*Sticker)
+	}
 	dest.SystemChannelID = g.SystemChannelID
+	dest.Threads = make([]*Channel, len(g.Threads))
+	for i := 0; i < len(g.Threads); i++ {
+		dest.Threads[i] = DeepCopy(g.Threads[i]).(*Channel)
+	}
 	dest.Unavailable = g.Unavailable
 	dest.VerificationLevel = g.VerificationLevel
 	dest.VoiceStates = make([]*VoiceState, len(g.VoiceStates))
@@ -432,6 +543,164 @@ func (g *GuildEmbed) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (g *GuildOnboarding) copyOverTo(other interface{}) error {
+	var dest *GuildOnboarding
+	var valid bool
+	if dest, valid = other.(*GuildOnboarding); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildOnboarding type")
+	}
+	dest.DefaultChannelIDs = make([]Snowflake, len(g.DefaultChannelIDs))
+	copy(dest.DefaultChannelIDs, g.DefaultChannelIDs)
+	dest.Enabled = g.Enabled
+	dest.GuildID = g.GuildID
+	dest.Mode = g.Mode
+	dest.Prompts = make([]*OnboardingPrompt, len(g.Prompts))
+	for i := 0; i < len(g.Prompts); i++ {
+		dest.Prompts[i] = DeepCopy(g.Prompts[i]).(*OnboardingPrompt)
+	}
+
+	return nil
+}
+
+func (g *GuildPreview) copyOverTo(other interface{}) error {
+	var dest *GuildPreview
+	var valid bool
+	if dest, valid = other.(*GuildPreview); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildPreview type")
+	}
+	dest.ApproximateMemberCount = g.ApproximateMemberCount
+	dest.ApproximatePresenceCount = g.ApproximatePresenceCount
+	dest.Description = g.Description
+	dest.DiscoverySplash = g.DiscoverySplash
+	dest.Emojis = make([]*Emoji, len(g.Emojis))
+	for i := 0; i < len(g.Emojis); i++ {
+		dest.Emojis[i] = DeepCopy(g.Emojis[i]).(*Emoji)
+	}
+	dest.Features = make([]string, len(g.Features))
+	copy(dest.Features, g.Features)
+	dest.Icon = g.Icon
+	dest.ID = g.ID
+	dest.Name = g.Name
+	dest.Splash = g.Splash
+	dest.Stickers = make([]*Sticker, len(g.Stickers))
+	for i := 0; i < len(g.Stickers); i++ {
+		dest.Stickers[i] = DeepCopy(g.Stickers[i]).(*Sticker)
+	}
+
+	return nil
+}
+
+func (g *GuildScheduledEvent) copyOverTo(other interface{}) error {
+	var dest *GuildScheduledEvent
+	var valid bool
+	if dest, valid = other.(*GuildScheduledEvent); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildScheduledEvent type")
+	}
+	dest.ChannelID = g.ChannelID
+	dest.Creator = g.Creator
+	dest.CreatorID = g.CreatorID
+	dest.Description = g.Description
+	dest.EntityID = g.EntityID
+	dest.EntityMetadata = g.EntityMetadata
+	dest.EntityType = g.EntityType
+	dest.GuildID = g.GuildID
+	dest.ID = g.ID
+	dest.Image = g.Image
+	dest.Name = g.Name
+	dest.PrivacyLevel = g.PrivacyLevel
+	dest.RecurrenceRule = g.RecurrenceRule
+	dest.ScheduledEndTime = g.ScheduledEndTime
+	dest.ScheduledStartTime = g.ScheduledStartTime
+	dest.Status = g.Status
+	dest.UserCount = g.UserCount
+
+	return nil
+}
+
+func (g *GuildTemplate) copyOverTo(other interface{}) error {
+	var dest *GuildTemplate
+	var valid bool
+	if dest, valid = other.(*GuildTemplate); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildTemplate type")
+	}
+	dest.Code = g.Code
+	dest.CreatedAt = g.CreatedAt
+	dest.Creator = g.Creator
+	dest.CreatorID = g.CreatorID
+	dest.Description = g.Description
+	dest.IsDirty = g.IsDirty
+	dest.Name = g.Name
+	dest.SerializedSourceGuild = g.SerializedSourceGuild
+	dest.SourceGuildID = g.SourceGuildID
+	dest.UpdatedAt = g.UpdatedAt
+	dest.UsageCount = g.UsageCount
+
+	return nil
+}
+
+func (g *GuildWidget) copyOverTo(other interface{}) error {
+	var dest *GuildWidget
+	var valid bool
+	if dest, valid = other.(*GuildWidget); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildWidget type")
+	}
+	dest.Channels = make([]*GuildWidgetChannel, len(g.Channels))
+	for i := 0; i < len(g.Channels); i++ {
+		dest.Channels[i] = DeepCopy(g.Channels[i]).(*GuildWidgetChannel)
+	}
+	dest.ID = g.ID
+	dest.InstantInvite = g.InstantInvite
+	dest.Members = make([]*GuildWidgetMember, len(g.Members))
+	for i := 0; i < len(g.Members); i++ {
+		dest.Members[i] = DeepCopy(g.Members[i]).(*GuildWidgetMember)
+	}
+	dest.Name = g.Name
+	dest.PresenceCount = g.PresenceCount
+
+	return nil
+}
+
+func (g *GuildWidgetChannel) copyOverTo(other interface{}) error {
+	var dest *GuildWidgetChannel
+	var valid bool
+	if dest, valid = other.(*GuildWidgetChannel); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildWidgetChannel type")
+	}
+	dest.ID = g.ID
+	dest.Name = g.Name
+	dest.Position = g.Position
+
+	return nil
+}
+
+func (g *GuildWidgetMember) copyOverTo(other interface{}) error {
+	var dest *GuildWidgetMember
+	var valid bool
+	if dest, valid = other.(*GuildWidgetMember); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildWidgetMember type")
+	}
+	dest.Avatar = g.Avatar
+	dest.AvatarURL = g.AvatarURL
+	dest.Discriminator = g.Discriminator
+	dest.ID = g.ID
+	dest.Status = g.Status
+	dest.Username = g.Username
+
+	return nil
+}
+
+func (g *GuildWidgetSettings) copyOverTo(other interface{}) error {
+	var dest *GuildWidgetSettings
+	var valid bool
+	if dest, valid = other.(*GuildWidgetSettings); !valid {
+		return newErrorUnsupportedType("argument given is not a *GuildWidgetSettings type")
+	}
+	dest.ChannelID = g.ChannelID
+	dest.Enabled = g.Enabled
+
+	return nil
+}
+
 func (i *Integration) copyOverTo(other interface{}) error {
 	var dest *Integration
 	var valid bool
@@ -475,11 +744,17 @@ func (i *Invite) copyOverTo(other interface{}) error {
 	dest.Channel = i.Channel
 	dest.Code = i.Code
 	dest.CreatedAt = i.CreatedAt
+	dest.ExpiresAt = i.ExpiresAt
 	dest.Guild = i.Guild
+	dest.GuildScheduledEvent = i.GuildScheduledEvent
 	dest.Inviter = i.Inviter
 	dest.MaxAge = i.MaxAge
 	dest.MaxUses = i.MaxUses
 	dest.Revoked = i.Revoked
+	dest.StageInstance = i.StageInstance
+	dest.TargetApplication = i.TargetApplication
+	dest.TargetType = i.TargetType
+	dest.TargetUser = i.TargetUser
 	dest.Temporary = i.Temporary
 	dest.Unique = i.Unique
 	dest.Uses = i.Uses
@@ -510,6 +785,7 @@ func (m *Member) copyOverTo(other interface{}) error {
 	if dest, valid = other.(*Member); !valid {
 		return newErrorUnsupportedType("argument given is not a *Member type")
 	}
+	dest.CommunicationDisabledUntil = m.CommunicationDisabledUntil
 	dest.Deaf = m.Deaf
 	dest.GuildID = m.GuildID
 	dest.JoinedAt = m.JoinedAt
@@ -581,8 +857,13 @@ func (m *Message) copyOverTo(other interface{}) error {
 		dest.Mentions[i] = DeepCopy(m.Mentions[i]).(*User)
 	}
 	dest.MessageReference = m.MessageReference
+	dest.MessageSnapshots = make([]*MessageSnapshot, len(m.MessageSnapshots))
+	for i := 0; i < len(m.MessageSnapshots); i++ {
+		dest.MessageSnapshots[i] = DeepCopy(m.MessageSnapshots[i]).(*MessageSnapshot)
+	}
 	dest.Nonce = m.Nonce
 	dest.Pinned = m.Pinned
+	dest.Poll = m.Poll
 	dest.Reactions = make([]*Reaction, len(m.Reactions))
 	for i := 0; i < len(m.Reactions); i++ {
 		dest.Reactions[i] = DeepCopy(m.Reactions[i]).(*Reaction)
@@ -590,6 +871,10 @@ func (m *Message) copyOverTo(other interface{}) error {
 	dest.ReferencedMessage = m.ReferencedMessage
 	dest.SpoilerTagAllAttachments = m.SpoilerTagAllAttachments
 	dest.SpoilerTagContent = m.SpoilerTagContent
+	dest.StickerItems = make([]*StickerItem, len(m.StickerItems))
+	for i := 0; i < len(m.StickerItems); i++ {
+		dest.StickerItems[i] = DeepCopy(m.StickerItems[i]).(*StickerItem)
+	}
 	dest.Stickers = make([]*MessageSticker, len(m.Stickers))
 	for i := 0; i < len(m.Stickers); i++ {
 		dest.Stickers[i] = DeepCopy(m.Stickers[i]).(*MessageSticker)
@@ -623,6 +908,17 @@ func (m *MessageComponent) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (m *MessageSnapshot) copyOverTo(other interface{}) error {
+	var dest *MessageSnapshot
+	var valid bool
+	if dest, valid = other.(*MessageSnapshot); !valid {
+		return newErrorUnsupportedType("argument given is not a *MessageSnapshot type")
+	}
+	dest.Message = m.Message
+
+	return nil
+}
+
 func (m *MessageSticker) copyOverTo(other interface{}) error {
 	var dest *MessageSticker
 	var valid bool
@@ -641,6 +937,118 @@ func (m *MessageSticker) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (o *OnboardingPrompt) copyOverTo(other interface{}) error {
+	var dest *OnboardingPrompt
+	var valid bool
+	if dest, valid = other.(*OnboardingPrompt); !valid {
+		return newErrorUnsupportedType("argument given is not a *OnboardingPrompt type")
+	}
+	dest.ID = o.ID
+	dest.InOnboarding = o.InOnboarding
+	dest.Options = make([]*OnboardingPromptOption, len(o.Options))
+	for i := 0; i < len(o.Options); i++ {
+		dest.Options[i] = DeepCopy(o.Options[i]).(*OnboardingPromptOption)
+	}
+	dest.Required = o.Required
+	dest.SingleSelect = o.SingleSelect
+	dest.Title = o.Title
+	dest.Type = o.Type
+
+	return nil
+}
+
+func (o *OnboardingPromptOption) copyOverTo(other interface{}) error {
+	var dest *OnboardingPromptOption
+	var valid bool
+	if dest, valid = other.(*OnboardingPromptOption); !valid {
+		return newErrorUnsupportedType("argument given is not a *OnboardingPromptOption type")
+	}
+	dest.ChannelIDs = make([]Snowflake, len(o.ChannelIDs))
+	copy(dest.ChannelIDs, o.ChannelIDs)
+	dest.Description = o.Description
+	dest.Emoji = o.Emoji
+	dest.EmojiAnimated = o.EmojiAnimated
+	dest.EmojiID = o.EmojiID
+	dest.EmojiName = o.EmojiName
+	dest.ID = o.ID
+	dest.RoleIDs = make([]Snowflake, len(o.RoleIDs))
+	copy(dest.RoleIDs, o.RoleIDs)
+	dest.Title = o.Title
+
+	return nil
+}
+
+func (p *Poll) copyOverTo(other interface{}) error {
+	var dest *Poll
+	var valid bool
+	if dest, valid = other.(*Poll); !valid {
+		return newErrorUnsupportedType("argument given is not a *Poll type")
+	}
+	dest.AllowMultiselect = p.AllowMultiselect
+	dest.Answers = make([]*PollAnswer, len(p.Answers))
+	for i := 0; i < len(p.Answers); i++ {
+		dest.Answers[i] = DeepCopy(p.Answers[i]).(*PollAnswer)
+	}
+	dest.Expiry = p.Expiry
+	dest.LayoutType = p.LayoutType
+	dest.Question = p.Question
+	dest.Results = p.Results
+
+	return nil
+}
+
+func (p *PollAnswer) copyOverTo(other interface{}) error {
+	var dest *PollAnswer
+	var valid bool
+	if dest, valid = other.(*PollAnswer); !valid {
+		return newErrorUnsupportedType("argument given is not a *PollAnswer type")
+	}
+	dest.AnswerID = p.AnswerID
+	dest.PollMedia = p.PollMedia
+
+	return nil
+}
+
+func (p *PollAnswerCount) copyOverTo(other interface{}) error {
+	var dest *PollAnswerCount
+	var valid bool
+	if dest, valid = other.(*PollAnswerCount); !valid {
+		return newErrorUnsupportedType("argument given is not a *PollAnswerCount type")
+	}
+	dest.Count = p.Count
+	dest.ID = p.ID
+	dest.MeVoted = p.MeVoted
+
+	return nil
+}
+
+func (p *PollMedia) copyOverTo(other interface{}) error {
+	var dest *PollMedia
+	var valid bool
+	if dest, valid = other.(*PollMedia); !valid {
+		return newErrorUnsupportedType("argument given is not a *PollMedia type")
+	}
+	dest.Emoji = p.Emoji
+	dest.Text = p.Text
+
+	return nil
+}
+
+func (p *PollResults) copyOverTo(other interface{}) error {
+	var dest *PollResults
+	var valid bool
+	if dest, valid = other.(*PollResults); !valid {
+		return newErrorUnsupportedType("argument given is not a *PollResults type")
+	}
+	dest.AnswerCounts = make([]*PollAnswerCount, len(p.AnswerCounts))
+	for i := 0; i < len(p.AnswerCounts); i++ {
+		dest.AnswerCounts[i] = DeepCopy(p.AnswerCounts[i]).(*PollAnswerCount)
+	}
+	dest.IsFinalized = p.IsFinalized
+
+	return nil
+}
+
 func (r *Reaction) copyOverTo(other interface{}) error {
 	var dest *Reaction
 	var valid bool
@@ -673,6 +1081,126 @@ func (r *Role) copyOverTo(other interface{}) error {
 	return nil
 }
 
+func (s *SKU) copyOverTo(other interface{}) error {
+	var dest *SKU
+	var valid bool
+	if dest, valid = other.(*SKU); !valid {
+		return newErrorUnsupportedType("argument given is not a *SKU type")
+	}
+	dest.ApplicationID = s.ApplicationID
+	dest.Flags = s.Flags
+	dest.ID = s.ID
+	dest.Name = s.Name
+	dest.Slug = s.Slug
+	dest.Type = s.Type
+
+	return nil
+}
+
+func (s *StageInstance) copyOverTo(other interface{}) error {
+	var dest *StageInstance
+	var valid bool
+	if dest, valid = other.(*StageInstance); !valid {
+		return newErrorUnsupportedType("argument given is not a *StageInstance type")
+	}
+	dest.ChannelID = s.ChannelID
+	dest.DiscoverableDisabled = s.DiscoverableDisabled
+	dest.GuildID = s.GuildID
+	dest.GuildScheduledEventID = s.GuildScheduledEventID
+	dest.ID = s.ID
+	dest.PrivacyLevel = s.PrivacyLevel
+	dest.Topic = s.Topic
+
+	return nil
+}
+
+func (s *Sticker) copyOverTo(other interface{}) error {
+	var dest *Sticker
+	var valid bool
+	if dest, valid = other.(*Sticker); !valid {
+		return newErrorUnsupportedType("argument given is not a *Sticker type")
+	}
+	dest.Available = s.Available
+	dest.Description = s.Description
+	dest.FormatType = s.FormatType
+	dest.GuildID = s.GuildID
+	dest.ID = s.ID
+	dest.Name = s.Name
+	dest.PackID = s.PackID
+	dest.SortValue = s.SortValue
+	dest.Tags = s.Tags
+	dest.Type = s.Type
+	dest.User = s.User
+
+	return nil
+}
+
+func (s *StickerItem) copyOverTo(other interface{}) error {
+	var dest *StickerItem
+	var valid bool
+	if dest, valid = other.(*StickerItem); !valid {
+		return newErrorUnsupportedType("argument given is not a *StickerItem type")
+	}
+	dest.FormatType = s.FormatType
+	dest.ID = s.ID
+	dest.Name = s.Name
+
+	return nil
+}
+
+func (s *Subscription) copyOverTo(other interface{}) error {
+	var dest *Subscription
+	var valid bool
+	if dest, valid = other.(*Subscription); !valid {
+		return newErrorUnsupportedType("argument given is not a *Subscription type")
+	}
+	dest.CanceledAt = s.CanceledAt
+	dest.Country = s.Country
+	dest.CurrentPeriodEnd = s.CurrentPeriodEnd
+	dest.CurrentPeriodStart = s.CurrentPeriodStart
+	dest.EntitlementIDs = make([]Snowflake, len(s.EntitlementIDs))
+	copy(dest.EntitlementIDs, s.EntitlementIDs)
+	dest.ID = s.ID
+	dest.RenewalSKUIDs = make([]Snowflake, len(s.RenewalSKUIDs))
+	copy(dest.RenewalSKUIDs, s.RenewalSKUIDs)
+	dest.SKUIDs = make([]Snowflake, len(s.SKUIDs))
+	copy(dest.SKUIDs, s.SKUIDs)
+	dest.Status = s.Status
+	dest.UserID = s.UserID
+
+	return nil
+}
+
+func (t *ThreadMember) copyOverTo(other interface{}) error {
+	var dest *ThreadMember
+	var valid bool
+	if dest, valid = other.(*ThreadMember); !valid {
+		return newErrorUnsupportedType("argument given is not a *ThreadMember type")
+	}
+	dest.Flags = t.Flags
+	dest.GuildID = t.GuildID
+	dest.ID = t.ID
+	dest.JoinTimestamp = t.JoinTimestamp
+	dest.UserID = t.UserID
+
+	return nil
+}
+
+func (t *ThreadMetadata) copyOverTo(other interface{}) error {
+	var dest *ThreadMetadata
+	var valid bool
+	if dest, valid = other.(*ThreadMetadata); !valid {
+		return newErrorUnsupportedType("argument given is not a *ThreadMetadata type")
+	}
+	dest.Archived = t.Archived
+	dest.ArchiveTimestamp = t.ArchiveTimestamp
+	dest.AutoArchiveDuration = t.AutoArchiveDuration
+	dest.Invitable = t.Invitable
+	dest.Locked = t.Locked
+
+	return nil
+}
+
 func (u *User) copyOverTo(other interface{}) error {
 	var dest *User
 	var valid bool
@@ -786,3 +1314,32 @@ func (w *Webhook) copyOverTo(other interface{}) error {
 
 	return nil
 }
+
+func (w *WelcomeScreen) copyOverTo(other interface{}) error {
+	var dest *WelcomeScreen
+	var valid bool
+	if dest, valid = other.(*WelcomeScreen); !valid {
+		return newErrorUnsupportedType("argument given is not a *WelcomeScreen type")
+	}
+	dest.Description = w.Description
+	dest.WelcomeChannels = make([]*WelcomeScreenChannel, len(w.WelcomeChannels))
+	for i := 0; i < len(w.WelcomeChannels); i++ {
+		dest.WelcomeChannels[i] = DeepCopy(w.WelcomeChannels[i]).(*WelcomeScreenChannel)
+	}
+
+	return nil
+}
+
+func (w *WelcomeScreenChannel) copyOverTo(other interface{}) error {
+	var dest *WelcomeScreenChannel
+	var valid bool
+	if dest, valid = other.(*WelcomeScreenChannel); !valid {
+		return newErrorUnsupportedType("argument given is not a *WelcomeScreenChannel type")
+	}
+	dest.ChannelID = w.ChannelID
+	dest.Description = w.Description
+	dest.EmojiID = w.EmojiID
+	dest.EmojiName = w.EmojiName
+
+	return nil
+}
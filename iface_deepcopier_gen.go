@@ -68,6 +68,18 @@ func (a *AuditLogOption) deepCopy() interface{} {
 	return cp
 }
 
+func (a *AutoModerationAction) deepCopy() interface{} {
+	cp := &AutoModerationAction{}
+	_ = DeepCopyOver(cp, a)
+	return cp
+}
+
+func (a *AutoModerationRule) deepCopy() interface{} {
+	cp := &AutoModerationRule{}
+	_ = DeepCopyOver(cp, a)
+	return cp
+}
+
 func (b *Ban) deepCopy() interface{} {
 	cp := &Ban{}
 	_ = DeepCopyOver(cp, b)
@@ -80,6 +92,12 @@ func (c *Channel) deepCopy() interface{} {
 	return cp
 }
 
+func (d *DefaultReaction) deepCopy() interface{} {
+	cp := &DefaultReaction{}
+	_ = DeepCopyOver(cp, d)
+	return cp
+}
+
 func (e *Embed) deepCopy() interface{} {
 	cp := &Embed{}
 	_ = DeepCopyOver(cp, e)
@@ -134,6 +152,18 @@ func (e *Emoji) deepCopy() interface{} {
 	return cp
 }
 
+func (e *Entitlement) deepCopy() interface{} {
+	cp := &Entitlement{}
+	_ = DeepCopyOver(cp, e)
+	return cp
+}
+
+func (f *ForumTag) deepCopy() interface{} {
+	cp := &ForumTag{}
+	_ = DeepCopyOver(cp, f)
+	return cp
+}
+
 func (g *Guild) deepCopy() interface{} {
 	cp := &Guild{}
 	_ = DeepCopyOver(cp, g)
@@ -146,6 +176,54 @@ func (g *GuildEmbed) deepCopy() interface{} {
 	return cp
 }
 
+func (g *GuildOnboarding) deepCopy() interface{} {
+	cp := &GuildOnboarding{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildPreview) deepCopy() interface{} {
+	cp := &GuildPreview{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildScheduledEvent) deepCopy() interface{} {
+	cp := &GuildScheduledEvent{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildTemplate) deepCopy() interface{} {
+	cp := &GuildTemplate{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildWidget) deepCopy() interface{} {
+	cp := &GuildWidget{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildWidgetChannel) deepCopy() interface{} {
+	cp := &GuildWidgetChannel{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildWidgetMember) deepCopy() interface{} {
+	cp := &GuildWidgetMember{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
+func (g *GuildWidgetSettings) deepCopy() interface{} {
+	cp := &GuildWidgetSettings{}
+	_ = DeepCopyOver(cp, g)
+	return cp
+}
+
 func (i *Integration) deepCopy() interface{} {
 	cp := &Integration{}
 	_ = DeepCopyOver(cp, i)
@@ -194,12 +272,60 @@ func (m *MessageComponent) deepCopy() interface{} {
 	return cp
 }
 
+func (m *MessageSnapshot) deepCopy() interface{} {
+	cp := &MessageSnapshot{}
+	_ = DeepCopyOver(cp, m)
+	return cp
+}
+
 func (m *MessageSticker) deepCopy() interface{} {
 	cp := &MessageSticker{}
 	_ = DeepCopyOver(cp, m)
 	return cp
 }
 
+func (o *OnboardingPrompt) deepCopy() interface{} {
+	cp := &OnboardingPrompt{}
+	_ = DeepCopyOver(cp, o)
+	return cp
+}
+
+func (o *OnboardingPromptOption) deepCopy() interface{} {
+	cp := &OnboardingPromptOption{}
+	_ = DeepCopyOver(cp, o)
+	return cp
+}
+
+func (p *Poll) deepCopy() interface{} {
+	cp := &Poll{}
+	_ = DeepCopyOver(cp, p)
+	return cp
+}
+
+func (p *PollAnswer) deepCopy() interface{} {
+	cp := &PollAnswer{}
+	_ = DeepCopyOver(cp, p)
+	return cp
+}
+
+func (p *PollAnswerCount) deepCopy() interface{} {
+	cp := &PollAnswerCount{}
+	_ = DeepCopyOver(cp, p)
+	return cp
+}
+
+func (p *PollMedia) deepCopy() interface{} {
+	cp := &PollMedia{}
+	_ = DeepCopyOver(cp, p)
+	return cp
+}
+
+func (p *PollResults) deepCopy() interface{} {
+	cp := &PollResults{}
+	_ = DeepCopyOver(cp, p)
+	return cp
+}
+
 func (r *Reaction) deepCopy() interface{} {
 	cp := &Reaction{}
 	_ = DeepCopyOver(cp, r)
@@ -212,6 +338,48 @@ func (r *Role) deepCopy() interface{} {
 	return cp
 }
 
+func (s *SKU) deepCopy() interface{} {
+	cp := &SKU{}
+	_ = DeepCopyOver(cp, s)
+	return cp
+}
+
+func (s *StageInstance) deepCopy() interface{} {
+	cp := &StageInstance{}
+	_ = DeepCopyOver(cp, s)
+	return cp
+}
+
+func (s *Sticker) deepCopy() interface{} {
+	cp := &Sticker{}
+	_ = DeepCopyOver(cp, s)
+	return cp
+}
+
+func (s *StickerItem) deepCopy() interface{} {
+	cp := &StickerItem{}
+	_ = DeepCopyOver(cp, s)
+	return cp
+}
+
+func (s *Subscription) deepCopy() interface{} {
+	cp := &Subscription{}
+	_ = DeepCopyOver(cp, s)
+	return cp
+}
+
+func (t *ThreadMember) deepCopy() interface{} {
+	cp := &ThreadMember{}
+	_ = DeepCopyOver(cp, t)
+	return cp
+}
+
+func (t *ThreadMetadata) deepCopy() interface{} {
+	cp := &ThreadMetadata{}
+	_ = DeepCopyOver(cp, t)
+	return cp
+}
+
 func (u *User) deepCopy() interface{} {
 	cp := &User{}
 	_ = DeepCopyOver(cp, u)
@@ -247,3 +415,15 @@ func (w *Webhook) deepCopy() interface{} {
 	_ = DeepCopyOver(cp, w)
 	return cp
 }
+
+func (w *WelcomeScreen) deepCopy() interface{} {
+	cp := &WelcomeScreen{}
+	_ = DeepCopyOver(cp, w)
+	return cp
+}
+
+func (w *WelcomeScreenChannel) deepCopy() interface{} {
+	cp := &WelcomeScreenChannel{}
+	_ = DeepCopyOver(cp, w)
+	return cp
+}
@@ -21,12 +21,23 @@ func (a *Activity) reset() {
 
 func (c *Channel) reset() {
 	c.ApplicationID = 0
+	c.AppliedTags = nil
+	c.AvailableTags = nil
 	c.Bitrate = 0
+	c.DefaultAutoArchiveDuration = 0
+	c.DefaultForumLayout = 0
+	c.DefaultReactionEmoji = nil
+	c.DefaultSortOrder = nil
+	c.DefaultThreadRateLimitPerUser = 0
+	c.Flags = 0
 	c.GuildID = 0
 	c.Icon = ""
 	c.ID = 0
 	c.LastMessageID = 0
 	c.LastPinTimestamp = Time{}
+	c.Member = nil
+	c.MemberCount = 0
+	c.MessageCount = 0
 	c.Name = ""
 	c.NSFW = false
 	c.OwnerID = 0
@@ -35,6 +46,8 @@ func (c *Channel) reset() {
 	c.Position = 0
 	c.RateLimitPerUser = 0
 	c.Recipients = nil
+	c.RTCRegion = ""
+	c.ThreadMetadata = nil
 	c.Topic = ""
 	c.Type = 0
 	c.UserLimit = 0
@@ -76,7 +89,9 @@ func (g *Guild) reset() {
 	g.Region = ""
 	g.Roles = nil
 	g.Splash = ""
+	g.Stickers = nil
 	g.SystemChannelID = 0
+	g.Threads = nil
 	g.Unavailable = false
 	g.VerificationLevel = 0
 	g.VoiceStates = nil
@@ -85,6 +100,7 @@ func (g *Guild) reset() {
 }
 
 func (m *Member) reset() {
+	m.CommunicationDisabledUntil = Time{}
 	m.Deaf = false
 	m.GuildID = 0
 	m.JoinedAt = Time{}
@@ -124,14 +140,17 @@ func (m *Message) reset() {
 	m.MentionRoles = nil
 	m.Mentions = nil
 	m.MessageReference = nil
+	m.MessageSnapshots = nil
 	m.Nonce = nil
 	m.Pinned = false
+	m.Poll = nil
 	m.Reactions = nil
 	if m.ReferencedMessage != nil {
 		Reset(m.ReferencedMessage)
 	}
 	m.SpoilerTagAllAttachments = false
 	m.SpoilerTagContent = false
+	m.StickerItems = nil
 	m.Stickers = nil
 	m.Timestamp = Time{}
 	m.Tts = false
@@ -24,9 +24,13 @@ func (g *GetMessagesParams) URLQueryString() string {
 	return params.URLQueryString()
 }
 
-func (g *getGuildMembersParams) URLQueryString() string {
+func (g *getBansParams) URLQueryString() string {
 	params := make(urlQuery)
 
+	if !(g.Before == 0) {
+		params["before"] = g.Before
+	}
+
 	if !(g.After == 0) {
 		params["after"] = g.After
 	}
@@ -38,15 +42,15 @@ func (g *getGuildMembersParams) URLQueryString() string {
 	return params.URLQueryString()
 }
 
-func (b *BanMemberParams) URLQueryString() string {
+func (g *getGuildMembersParams) URLQueryString() string {
 	params := make(urlQuery)
 
-	if !(b.DeleteMessageDays == 0) {
-		params["delete_message_days"] = b.DeleteMessageDays
+	if !(g.After == 0) {
+		params["after"] = g.After
 	}
 
-	if !(b.Reason == "") {
-		params["reason"] = b.Reason
+	if !(g.Limit == 0) {
+		params["limit"] = g.Limit
 	}
 
 	return params.URLQueryString()
@@ -57,16 +61,40 @@ func (p *pruneMembersParams) URLQueryString() string {
 
 	params["days"] = p.Days
 
-	params["compute_prune_count"] = p.ComputePruneCount
+	if !(p.IncludeRoles == "") {
+		params["include_roles"] = p.IncludeRoles
+	}
 
 	return params.URLQueryString()
 }
 
-func (g *getInviteParams) URLQueryString() string {
+func (g *GetInviteParams) URLQueryString() string {
 	params := make(urlQuery)
 
-	if !(g.WithMemberCount == false) {
-		params["with_count"] = g.WithMemberCount
+	if !(g.WithCounts == false) {
+		params["with_counts"] = g.WithCounts
+	}
+
+	if !(g.WithExpiration == false) {
+		params["with_expiration"] = g.WithExpiration
+	}
+
+	if !(g.GuildScheduledEventID == 0) {
+		params["guild_scheduled_event_id"] = g.GuildScheduledEventID
+	}
+
+	return params.URLQueryString()
+}
+
+func (g *GetPollAnswerVotersParams) URLQueryString() string {
+	params := make(urlQuery)
+
+	if !(g.After == 0) {
+		params["after"] = g.After
+	}
+
+	if !(g.Limit == 0) {
+		params["limit"] = g.Limit
 	}
 
 	return params.URLQueryString()
@@ -90,6 +118,60 @@ func (g *GetReactionURLParams) URLQueryString() string {
 	return params.URLQueryString()
 }
 
+func (g *getGuildScheduledEventsParams) URLQueryString() string {
+	params := make(urlQuery)
+
+	if !(g.WithUserCount == false) {
+		params["with_user_count"] = g.WithUserCount
+	}
+
+	return params.URLQueryString()
+}
+
+func (g *GetGuildScheduledEventUsersParams) URLQueryString() string {
+	params := make(urlQuery)
+
+	if !(g.Limit == 0) {
+		params["limit"] = g.Limit
+	}
+
+	if !(g.WithMember == false) {
+		params["with_member"] = g.WithMember
+	}
+
+	if !(g.Before == 0) {
+		params["before"] = g.Before
+	}
+
+	if !(g.After == 0) {
+		params["after"] = g.After
+	}
+
+	return params.URLQueryString()
+}
+
+func (g *GetSKUSubscriptionsParams) URLQueryString() string {
+	params := make(urlQuery)
+
+	if !(g.Before == 0) {
+		params["before"] = g.Before
+	}
+
+	if !(g.After == 0) {
+		params["after"] = g.After
+	}
+
+	if !(g.Limit == 0) {
+		params["limit"] = g.Limit
+	}
+
+	if !(g.UserID == 0) {
+		params["user_id"] = g.UserID
+	}
+
+	return params.URLQueryString()
+}
+
 func (g *GetCurrentUserGuildsParams) URLQueryString() string {
 	params := make(urlQuery)
 
@@ -113,5 +195,19 @@ func (e *execWebhookParams) URLQueryString() string {
 
 	params["wait"] = e.Wait
 
+	if !(e.ThreadID == 0) {
+		params["thread_id"] = e.ThreadID
+	}
+
+	return params.URLQueryString()
+}
+
+func (w *webhookMessageParams) URLQueryString() string {
+	params := make(urlQuery)
+
+	if !(w.ThreadID == 0) {
+		params["thread_id"] = w.ThreadID
+	}
+
 	return params.URLQueryString()
 }
@@ -0,0 +1,65 @@
+package disgord
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// MaxEmojiSize is the maximum size of an emoji image, in bytes.
+const MaxEmojiSize = 256 * 1024
+
+// imageDataContentTypes are the image formats Discord accepts as image data
+var imageDataContentTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ImageData reads an image and encodes it as a data URI, such as "data:image/png;base64,iVBOR...", which is
+// how endpoints like UpdateGuild, UpdateCurrentUser and CreateGuildEmoji accept images. The content type is
+// detected from the image itself, and must be png, jpeg, gif or webp. A maxSize above 0 rejects larger
+// images without reading past the limit.
+//  icon, err := disgord.ImageData(resp.Body, disgord.MaxEmojiSize)
+//  if err != nil {
+//      return err
+//  }
+//
+//  emoji, err := client.Guild(guildID).CreateEmoji(&disgord.CreateGuildEmojiParams{Name: "pepe", Image: icon})
+func ImageData(r io.Reader, maxSize int64) (string, error) {
+	if maxSize > 0 {
+		r = io.LimitReader(r, maxSize+1)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if len(data) == 0 {
+		return "", errors.New("image is empty")
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return "", fmt.Errorf("image exceeds the maximum size of %d bytes", maxSize)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !imageDataContentTypes[contentType] {
+		return "", fmt.Errorf("unsupported image type %s, must be png, jpeg, gif or webp", contentType)
+	}
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// ImageDataFromFile reads an image file and encodes it as a data URI, see ImageData.
+func ImageDataFromFile(path string, maxSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return ImageData(file, maxSize)
+}
@@ -0,0 +1,46 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestImageData(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 24)...)
+
+	data, err := ImageData(bytes.NewReader(png), MaxEmojiSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(data, "data:image/png;base64,iVBORw0KGgo") {
+		t.Errorf("unexpected data uri %s", data)
+	}
+
+	if _, err = ImageData(bytes.NewReader(png), 16); err == nil {
+		t.Error("expected an error when the image is too large")
+	}
+	if _, err = ImageData(strings.NewReader("not an image"), 0); err == nil {
+		t.Error("expected an error for unsupported content")
+	}
+
+	file, err := ioutil.TempFile("", "disgord-image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	_, _ = file.Write(png)
+	_ = file.Close()
+
+	fromFile, err := ImageDataFromFile(file.Name(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromFile != data {
+		t.Error("expected the file to be encoded like the reader")
+	}
+}
@@ -0,0 +1,271 @@
+package disgord
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+// IntentsBuilder combines intents with named helpers, see Config.GatewayIntents.
+//  intents := disgord.NewIntents().Guilds().GuildMessages().MessageContent().Build()
+type IntentsBuilder struct {
+	intents Intent
+}
+
+// NewIntents creates an intents builder, holding the given intents.
+func NewIntents(intents ...Intent) *IntentsBuilder {
+	return (&IntentsBuilder{}).Add(intents...)
+}
+
+// Add adds the given intents.
+func (b *IntentsBuilder) Add(intents ...Intent) *IntentsBuilder {
+	for _, intent := range intents {
+		b.intents |= intent
+	}
+	return b
+}
+
+// Remove removes the given intents.
+func (b *IntentsBuilder) Remove(intents ...Intent) *IntentsBuilder {
+	for _, intent := range intents {
+		b.intents &^= intent
+	}
+	return b
+}
+
+// Guilds adds the guild, role, channel, thread and stage instance events.
+func (b *IntentsBuilder) Guilds() *IntentsBuilder {
+	return b.Add(IntentGuilds)
+}
+
+// Members adds the member events. This is a privileged intent.
+func (b *IntentsBuilder) Members() *IntentsBuilder {
+	return b.Add(IntentGuildMembers)
+}
+
+// Presences adds the presence updates. This is a privileged intent.
+func (b *IntentsBuilder) Presences() *IntentsBuilder {
+	return b.Add(IntentGuildPresences)
+}
+
+// GuildMessages adds the message events of guild channels.
+func (b *IntentsBuilder) GuildMessages() *IntentsBuilder {
+	return b.Add(IntentGuildMessages)
+}
+
+// DirectMessages adds the message events of direct messages.
+func (b *IntentsBuilder) DirectMessages() *IntentsBuilder {
+	return b.Add(IntentDirectMessages)
+}
+
+// Messages adds the message events of both guild channels and direct messages.
+func (b *IntentsBuilder) Messages() *IntentsBuilder {
+	return b.Add(IntentGuildMessages, IntentDirectMessages)
+}
+
+// MessageContent populates the content, embeds, attachments and components of received messages. This is a
+// privileged intent.
+func (b *IntentsBuilder) MessageContent() *IntentsBuilder {
+	return b.Add(IntentMessageContent)
+}
+
+// Reactions adds the reaction events of both guild channels and direct messages.
+func (b *IntentsBuilder) Reactions() *IntentsBuilder {
+	return b.Add(IntentGuildMessageReactions, IntentDirectMessageReactions)
+}
+
+// Typing adds the typing events of both guild channels and direct messages.
+func (b *IntentsBuilder) Typing() *IntentsBuilder {
+	return b.Add(IntentGuildMessageTyping, IntentDirectMessageTyping)
+}
+
+// Polls adds the poll vote events of both guild channels and direct messages.
+func (b *IntentsBuilder) Polls() *IntentsBuilder {
+	return b.Add(IntentGuildMessagePolls, IntentDirectMessagePolls)
+}
+
+// VoiceStates adds the voice state updates, which are needed to join voice channels.
+func (b *IntentsBuilder) VoiceStates() *IntentsBuilder {
+	return b.Add(IntentGuildVoiceStates)
+}
+
+// Moderation adds the ban and auto moderation events.
+func (b *IntentsBuilder) Moderation() *IntentsBuilder {
+	return b.Add(IntentGuildBans, IntentAutoModerationConfiguration, IntentAutoModerationExecution)
+}
+
+// ScheduledEvents adds the guild scheduled event events.
+func (b *IntentsBuilder) ScheduledEvents() *IntentsBuilder {
+	return b.Add(IntentGuildScheduledEvents)
+}
+
+// ForEvents adds the intents needed to receive the given events, see IntentsForEvents.
+func (b *IntentsBuilder) ForEvents(events ...string) *IntentsBuilder {
+	return b.Add(IntentsForEvents(events...))
+}
+
+// Build returns the combined intents.
+func (b *IntentsBuilder) Build() Intent {
+	return b.intents
+}
+
+// IntentsForEvents returns the intents needed to receive the given events, from both guilds and direct messages.
+// The message content intent is included for message create and update events, as those are rarely useful
+// without it. Events that do not depend on any intent, such as EvtReady and EvtInteractionCreate, add nothing.
+func IntentsForEvents(events ...string) (intents Intent) {
+	for _, evt := range events {
+		intents |= eventIntents(evt)
+		if evt == EvtMessageCreate || evt == EvtMessageUpdate {
+			intents |= IntentMessageContent
+		}
+	}
+	return intents
+}
+
+// eventIntents returns the intents that enables the event, any one of them is enough.
+func eventIntents(evt string) Intent {
+	return gateway.EventToIntent(evt, false) | gateway.EventToIntent(evt, true)
+}
+
+// PrivilegedIntents must be enabled for the application in the developer portal before they can be used.
+// Discord closes the connection with the code 4014 otherwise, see IntentWarningDisallowedIntents.
+const PrivilegedIntents = IntentGuildMembers | IntentGuildPresences | IntentMessageContent
+
+// IntentWarningKind is the reason for an IntentWarning.
+type IntentWarningKind int
+
+const (
+	// IntentWarningMissingIntent a handler is registered for an event that none of the intents enables.
+	IntentWarningMissingIntent IntentWarningKind = iota
+
+	// IntentWarningRejectedEvent a handler is registered for an event listed in Config.RejectEvents.
+	IntentWarningRejectedEvent
+
+	// IntentWarningDisallowedIntents discord closed the connection as the application has not been approved
+	// for the privileged intents that were sent.
+	IntentWarningDisallowedIntents
+)
+
+// IntentWarning explains why handlers are not triggered. See Config.OnIntentWarning.
+type IntentWarning struct {
+	Kind IntentWarningKind
+
+	// Event is the event of the affected handlers, empty for IntentWarningDisallowedIntents
+	Event string
+
+	// Intents holds the intents that would enable the event, or the privileged intents that were sent
+	Intents Intent
+
+	Message string
+}
+
+func (w *IntentWarning) String() string {
+	return w.Message
+}
+
+// gatewayIntents decides the intents sent when connecting. exact is false when the guild intents are still
+// derived from Config.RejectEvents, as done before Config.GatewayIntents was added.
+func (c *Client) gatewayIntents() (intents Intent, exact bool, err error) {
+	conf := c.config
+	exact = conf.GatewayIntents > 0 || conf.DeriveIntents
+	if !exact {
+		intents = conf.DMIntents
+	} else {
+		intents = conf.GatewayIntents
+	}
+
+	events := c.dispatcher.registeredEvents()
+	if conf.DeriveIntents {
+		// the cache can not work without the guild events
+		intents |= IntentGuilds | IntentsForEvents(events...)
+	}
+
+	warnings := intentWarnings(events, effectiveIntents(intents, exact, conf.RejectEvents), conf.RejectEvents)
+	if conf.StrictIntents && len(warnings) > 0 {
+		problems := make([]string, 0, len(warnings))
+		for _, warning := range warnings {
+			problems = append(problems, warning.Message)
+		}
+		err = errors.New("handlers are registered for events that will never be received: " + strings.Join(problems, ", "))
+		return 0, false, err
+	}
+
+	for _, warning := range warnings {
+		c.intentWarning(warning)
+	}
+	return intents, exact, nil
+}
+
+// intentWarning logs the warning and passes it on to Config.OnIntentWarning.
+func (c *Client) intentWarning(warning *IntentWarning) {
+	log := logger.With(c.subsystemLog(LogGateway), logger.Field{Key: "intents", Value: warning.Intents.String()})
+	if warning.Event != "" {
+		log = logger.With(log, logger.Field{Key: "event", Value: warning.Event})
+	}
+	log.Error(warning.Message)
+
+	if c.config.OnIntentWarning != nil {
+		c.config.OnIntentWarning(warning)
+	}
+}
+
+// disallowedIntents reports the privileged intents of a connection closed with the code 4014.
+func (c *Client) disallowedIntents(intents Intent) func(reason string) {
+	return func(reason string) {
+		c.intentWarning(&IntentWarning{
+			Kind:    IntentWarningDisallowedIntents,
+			Intents: intents & PrivilegedIntents,
+			Message: "the application is not approved for the privileged intents, enable them in the developer portal: " + reason,
+		})
+	}
+}
+
+// effectiveIntents adds the guild intents that each shard derives from the events that are not rejected,
+// unless the intents are exact. See gateway.EvtConfig.ExactIntents.
+func effectiveIntents(intents Intent, exact bool, rejected []string) Intent {
+	if exact {
+		return intents
+	}
+	for _, evt := range AllEvents() {
+		if !containsEvent(rejected, evt) {
+			intents |= gateway.EventToIntent(evt, false)
+		}
+	}
+	return intents
+}
+
+// intentWarnings returns a warning for every event with handlers that can never be triggered.
+func intentWarnings(events []string, intents Intent, rejected []string) (warnings []*IntentWarning) {
+	sort.Strings(events)
+	for _, evt := range events {
+		required := eventIntents(evt)
+		if containsEvent(rejected, evt) {
+			warnings = append(warnings, &IntentWarning{
+				Kind:    IntentWarningRejectedEvent,
+				Event:   evt,
+				Intents: required,
+				Message: evt + " is rejected by Config.RejectEvents",
+			})
+		} else if required > 0 && intents&required == 0 {
+			warnings = append(warnings, &IntentWarning{
+				Kind:    IntentWarningMissingIntent,
+				Event:   evt,
+				Intents: required,
+				Message: evt + " requires one of the intents " + required.String(),
+			})
+		}
+	}
+	return warnings
+}
+
+func containsEvent(events []string, evt string) bool {
+	for i := range events {
+		if events[i] == evt {
+			return true
+		}
+	}
+	return false
+}
@@ -10,21 +10,27 @@ import (
 type Intent = gateway.Intent
 
 const (
-	IntentDirectMessageReactions = gateway.IntentDirectMessageReactions
-	IntentDirectMessageTyping    = gateway.IntentDirectMessageTyping
-	IntentDirectMessages         = gateway.IntentDirectMessages
-	IntentGuildBans              = gateway.IntentGuildBans
-	IntentGuildEmojis            = gateway.IntentGuildEmojis
-	IntentGuildIntegrations      = gateway.IntentGuildIntegrations
-	IntentGuildInvites           = gateway.IntentGuildInvites
-	IntentGuildMembers           = gateway.IntentGuildMembers
-	IntentGuildMessageReactions  = gateway.IntentGuildMessageReactions
-	IntentGuildMessageTyping     = gateway.IntentGuildMessageTyping
-	IntentGuildMessages          = gateway.IntentGuildMessages
-	IntentGuildPresences         = gateway.IntentGuildPresences
-	IntentGuildVoiceStates       = gateway.IntentGuildVoiceStates
-	IntentGuildWebhooks          = gateway.IntentGuildWebhooks
-	IntentGuilds                 = gateway.IntentGuilds
+	IntentAutoModerationConfiguration = gateway.IntentAutoModerationConfiguration
+	IntentAutoModerationExecution     = gateway.IntentAutoModerationExecution
+	IntentDirectMessagePolls          = gateway.IntentDirectMessagePolls
+	IntentDirectMessageReactions      = gateway.IntentDirectMessageReactions
+	IntentDirectMessageTyping         = gateway.IntentDirectMessageTyping
+	IntentDirectMessages              = gateway.IntentDirectMessages
+	IntentGuildBans                   = gateway.IntentGuildBans
+	IntentGuildEmojis                 = gateway.IntentGuildEmojis
+	IntentGuildIntegrations           = gateway.IntentGuildIntegrations
+	IntentGuildInvites                = gateway.IntentGuildInvites
+	IntentGuildMembers                = gateway.IntentGuildMembers
+	IntentGuildMessagePolls           = gateway.IntentGuildMessagePolls
+	IntentGuildMessageReactions       = gateway.IntentGuildMessageReactions
+	IntentGuildMessageTyping          = gateway.IntentGuildMessageTyping
+	IntentGuildMessages               = gateway.IntentGuildMessages
+	IntentGuildPresences              = gateway.IntentGuildPresences
+	IntentGuildScheduledEvents        = gateway.IntentGuildScheduledEvents
+	IntentGuildVoiceStates            = gateway.IntentGuildVoiceStates
+	IntentGuildWebhooks               = gateway.IntentGuildWebhooks
+	IntentGuilds                      = gateway.IntentGuilds
+	IntentMessageContent              = gateway.IntentMessageContent
 )
 
 func AllIntents() Intent {
@@ -33,21 +39,27 @@ func AllIntents() Intent {
 
 func AllIntentsExcept(exceptions ...Intent) Intent {
 	IntentsMap := map[Intent]int8{
-		IntentDirectMessageReactions: 0,
-		IntentDirectMessageTyping:    0,
-		IntentDirectMessages:         0,
-		IntentGuildBans:              0,
-		IntentGuildEmojis:            0,
-		IntentGuildIntegrations:      0,
-		IntentGuildInvites:           0,
-		IntentGuildMembers:           0,
-		IntentGuildMessageReactions:  0,
-		IntentGuildMessageTyping:     0,
-		IntentGuildMessages:          0,
-		IntentGuildPresences:         0,
-		IntentGuildVoiceStates:       0,
-		IntentGuildWebhooks:          0,
-		IntentGuilds:                 0,
+		IntentAutoModerationConfiguration: 0,
+		IntentAutoModerationExecution:     0,
+		IntentDirectMessagePolls:          0,
+		IntentDirectMessageReactions:      0,
+		IntentDirectMessageTyping:         0,
+		IntentDirectMessages:              0,
+		IntentGuildBans:                   0,
+		IntentGuildEmojis:                 0,
+		IntentGuildIntegrations:           0,
+		IntentGuildInvites:                0,
+		IntentGuildMembers:                0,
+		IntentGuildMessagePolls:           0,
+		IntentGuildMessageReactions:       0,
+		IntentGuildMessageTyping:          0,
+		IntentGuildMessages:               0,
+		IntentGuildPresences:              0,
+		IntentGuildScheduledEvents:        0,
+		IntentGuildVoiceStates:            0,
+		IntentGuildWebhooks:               0,
+		IntentGuilds:                      0,
+		IntentMessageContent:              0,
 	}
 
 	for i := range exceptions {
@@ -0,0 +1,99 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestIntentsBuilder(t *testing.T) {
+	intents := NewIntents(IntentGuildInvites).Guilds().Messages().MessageContent().Remove(IntentGuildInvites).Build()
+	expected := IntentGuilds | IntentGuildMessages | IntentDirectMessages | IntentMessageContent
+	if intents != expected {
+		t.Errorf("expected %s. Got %s", expected, intents)
+	}
+
+	intents = IntentsForEvents(EvtMessageCreate, EvtGuildBanAdd, EvtReady)
+	expected = IntentGuildMessages | IntentDirectMessages | IntentMessageContent | IntentGuildBans
+	if intents != expected {
+		t.Errorf("expected %s. Got %s", expected, intents)
+	}
+}
+
+func TestClient_gatewayIntents(t *testing.T) {
+	newClient := func(conf Config) *Client {
+		conf.BotToken = "testing"
+		client, err := NewClient(context.Background(), conf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.Gateway().MessageReactionAdd(func(s Session, h *MessageReactionAdd) {})
+		return client
+	}
+
+	intents, exact, err := newClient(Config{DeriveIntents: true, GatewayIntents: IntentGuildMembers}).gatewayIntents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := IntentGuilds | IntentGuildMembers | IntentGuildMessageReactions | IntentDirectMessageReactions
+	if !exact || intents != expected {
+		t.Errorf("expected the exact intents %s. Got %s, %t", expected, intents, exact)
+	}
+
+	intents, exact, err = newClient(Config{DMIntents: IntentDirectMessages}).gatewayIntents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exact || intents != IntentDirectMessages {
+		t.Errorf("expected the guild intents to still be derived. Got %s, %t", intents, exact)
+	}
+
+	_, _, err = newClient(Config{StrictIntents: true, GatewayIntents: IntentGuilds}).gatewayIntents()
+	if err == nil || !strings.Contains(err.Error(), EvtMessageReactionAdd) {
+		t.Errorf("expected an error for the reaction handler. Got %v", err)
+	}
+
+	_, _, err = newClient(Config{StrictIntents: true, RejectEvents: []string{EvtMessageReactionAdd}}).gatewayIntents()
+	if err == nil || !strings.Contains(err.Error(), "RejectEvents") {
+		t.Errorf("expected an error for the rejected event. Got %v", err)
+	}
+}
+
+func TestClient_intentWarnings(t *testing.T) {
+	var warnings []*IntentWarning
+	client, err := NewClient(context.Background(), Config{
+		BotToken:       "testing",
+		GatewayIntents: IntentGuilds | IntentGuildMembers,
+		RejectEvents:   []string{EvtTypingStart},
+		OnIntentWarning: func(warning *IntentWarning) {
+			warnings = append(warnings, warning)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Gateway().GuildMemberAdd(func(s Session, h *GuildMemberAdd) {})
+	client.Gateway().MessageReactionAdd(func(s Session, h *MessageReactionAdd) {})
+	client.Gateway().TypingStart(func(s Session, h *TypingStart) {})
+
+	if _, _, err = client.gatewayIntents(); err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings. Got %d", len(warnings))
+	}
+	if w := warnings[0]; w.Kind != IntentWarningMissingIntent || w.Event != EvtMessageReactionAdd {
+		t.Errorf("expected a missing intent for reactions. Got %+v", w)
+	}
+	if w := warnings[1]; w.Kind != IntentWarningRejectedEvent || w.Event != EvtTypingStart {
+		t.Errorf("expected the typing event to be rejected. Got %+v", w)
+	}
+
+	warnings = nil
+	client.disallowedIntents(IntentGuilds | IntentGuildMembers)("Disallowed intent(s).")
+	if len(warnings) != 1 || warnings[0].Kind != IntentWarningDisallowedIntents || warnings[0].Intents != IntentGuildMembers {
+		t.Errorf("expected the members intent to be disallowed. Got %+v", warnings)
+	}
+}
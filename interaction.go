@@ -1,5 +1,7 @@
 package disgord
 
+import "fmt"
+
 type InteractionType = int
 
 const (
@@ -24,6 +26,73 @@ const (
 	MENTIONABLE
 )
 
+// Locale is a language identifier as used by the Discord client.
+// https://discord.com/developers/docs/reference#locales
+type Locale = string
+
+const (
+	LocaleIndonesian   Locale = "id"
+	LocaleDanish       Locale = "da"
+	LocaleGerman       Locale = "de"
+	LocaleEnglishGB    Locale = "en-GB"
+	LocaleEnglishUS    Locale = "en-US"
+	LocaleSpanish      Locale = "es-ES"
+	LocaleSpanishLATAM Locale = "es-419"
+	LocaleFrench       Locale = "fr"
+	LocaleCroatian     Locale = "hr"
+	LocaleItalian      Locale = "it"
+	LocaleLithuanian   Locale = "lt"
+	LocaleHungarian    Locale = "hu"
+	LocaleDutch        Locale = "nl"
+	LocaleNorwegian    Locale = "no"
+	LocalePolish       Locale = "pl"
+	LocalePortugueseBR Locale = "pt-BR"
+	LocaleRomanian     Locale = "ro"
+	LocaleFinnish      Locale = "fi"
+	LocaleSwedish      Locale = "sv-SE"
+	LocaleVietnamese   Locale = "vi"
+	LocaleTurkish      Locale = "tr"
+	LocaleCzech        Locale = "cs"
+	LocaleGreek        Locale = "el"
+	LocaleBulgarian    Locale = "bg"
+	LocaleRussian      Locale = "ru"
+	LocaleUkrainian    Locale = "uk"
+	LocaleHindi        Locale = "hi"
+	LocaleThai         Locale = "th"
+	LocaleChineseCN    Locale = "zh-CN"
+	LocaleJapanese     Locale = "ja"
+	LocaleChineseTW    Locale = "zh-TW"
+	LocaleKorean       Locale = "ko"
+)
+
+var locales = map[Locale]struct{}{
+	LocaleIndonesian: {}, LocaleDanish: {}, LocaleGerman: {}, LocaleEnglishGB: {}, LocaleEnglishUS: {},
+	LocaleSpanish: {}, LocaleSpanishLATAM: {}, LocaleFrench: {}, LocaleCroatian: {}, LocaleItalian: {},
+	LocaleLithuanian: {}, LocaleHungarian: {}, LocaleDutch: {}, LocaleNorwegian: {}, LocalePolish: {},
+	LocalePortugueseBR: {}, LocaleRomanian: {}, LocaleFinnish: {}, LocaleSwedish: {}, LocaleVietnamese: {},
+	LocaleTurkish: {}, LocaleCzech: {}, LocaleGreek: {}, LocaleBulgarian: {}, LocaleRussian: {},
+	LocaleUkrainian: {}, LocaleHindi: {}, LocaleThai: {}, LocaleChineseCN: {}, LocaleJapanese: {},
+	LocaleChineseTW: {}, LocaleKorean: {},
+}
+
+// ValidLocale checks if the given locale is supported by Discord.
+func ValidLocale(locale Locale) bool {
+	_, ok := locales[locale]
+	return ok
+}
+
+// Localizations maps a locale to the localized text for that locale.
+type Localizations = map[Locale]string
+
+func validateLocalizations(field string, localizations Localizations) error {
+	for locale := range localizations {
+		if !ValidLocale(locale) {
+			return fmt.Errorf("%s contains an unsupported locale %q", field, locale)
+		}
+	}
+	return nil
+}
+
 type InteractionCallbackType = int
 
 const (
@@ -35,6 +104,11 @@ const (
 	DeferredChannelMessageWithSource
 	DeferredUpdateMessage
 	UpdateMessage
+	_ // 8 is the autocomplete result
+	_ // 9 is the modal
+
+	// PremiumRequired responds to an interaction with an upgrade button, only available for apps with monetization enabled
+	PremiumRequired
 )
 
 //TODO ApplicationCommandInteractionDataResolved https://discord.com/developers/docs/interactions/slash-commands#interaction-applicationcommandinteractiondataresolved
@@ -44,6 +118,7 @@ type ApplicationCommandInteractionDataResolved struct {
 type ApplicationCommandInteractionDataOption struct {
 	Name    string                                     `json:"name"`
 	Type    OptionType                                 `json:"type"`
+	Value   interface{}                                `json:"value"`
 	Options []*ApplicationCommandInteractionDataOption `json:"options"`
 }
 
@@ -75,3 +150,83 @@ type InteractionResponse struct {
 	Type InteractionCallbackType                    `json:"type"`
 	Data *InteractionApplicationCommandCallbackData `json:"data"`
 }
+
+type ApplicationCommandType = int
+
+const (
+	_ ApplicationCommandType = iota
+	ApplicationCommandChatInput
+	ApplicationCommandUser
+	ApplicationCommandMessage
+)
+
+// ApplicationCommand https://discord.com/developers/docs/interactions/application-commands#application-command-object
+type ApplicationCommand struct {
+	ID                       Snowflake                   `json:"id,omitempty"`
+	Type                     ApplicationCommandType      `json:"type,omitempty"`
+	ApplicationID            Snowflake                   `json:"application_id,omitempty"`
+	GuildID                  Snowflake                   `json:"guild_id,omitempty"`
+	Name                     string                      `json:"name"`
+	NameLocalizations        Localizations               `json:"name_localizations,omitempty"`
+	Description              string                      `json:"description"`
+	DescriptionLocalizations Localizations               `json:"description_localizations,omitempty"`
+	Options                  []*ApplicationCommandOption `json:"options,omitempty"`
+	DefaultPermission        *bool                       `json:"default_permission,omitempty"`
+	Version                  Snowflake                   `json:"version,omitempty"`
+}
+
+// Validate checks that every localization map, including those of the options and choices, only
+// uses locales supported by Discord.
+func (c *ApplicationCommand) Validate() error {
+	if err := validateLocalizations("name_localizations", c.NameLocalizations); err != nil {
+		return err
+	}
+	if err := validateLocalizations("description_localizations", c.DescriptionLocalizations); err != nil {
+		return err
+	}
+	for _, opt := range c.Options {
+		if err := opt.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplicationCommandOption https://discord.com/developers/docs/interactions/application-commands#application-command-object-application-command-option-structure
+type ApplicationCommandOption struct {
+	Type                     OptionType                        `json:"type"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        Localizations                     `json:"name_localizations,omitempty"`
+	Description              string                            `json:"description"`
+	DescriptionLocalizations Localizations                     `json:"description_localizations,omitempty"`
+	Required                 bool                              `json:"required,omitempty"`
+	Choices                  []*ApplicationCommandOptionChoice `json:"choices,omitempty"`
+	Options                  []*ApplicationCommandOption       `json:"options,omitempty"`
+}
+
+func (o *ApplicationCommandOption) validate() error {
+	if err := validateLocalizations("option "+o.Name+": name_localizations", o.NameLocalizations); err != nil {
+		return err
+	}
+	if err := validateLocalizations("option "+o.Name+": description_localizations", o.DescriptionLocalizations); err != nil {
+		return err
+	}
+	for _, choice := range o.Choices {
+		if err := validateLocalizations("choice "+choice.Name+": name_localizations", choice.NameLocalizations); err != nil {
+			return err
+		}
+	}
+	for _, opt := range o.Options {
+		if err := opt.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplicationCommandOptionChoice https://discord.com/developers/docs/interactions/application-commands#application-command-object-application-command-option-choice-structure
+type ApplicationCommandOptionChoice struct {
+	Name              string        `json:"name"`
+	NameLocalizations Localizations `json:"name_localizations,omitempty"`
+	Value             interface{}   `json:"value"`
+}
@@ -0,0 +1,36 @@
+// +build !integration
+
+package disgord
+
+import "testing"
+
+func TestApplicationCommand_Validate(t *testing.T) {
+	cmd := &ApplicationCommand{
+		Name:              "ping",
+		NameLocalizations: Localizations{LocaleGerman: "ping", LocaleSpanishLATAM: "ping"},
+		Options: []*ApplicationCommandOption{
+			{
+				Type:                     STRING,
+				Name:                     "target",
+				DescriptionLocalizations: Localizations{LocaleNorwegian: "mål"},
+				Choices: []*ApplicationCommandOptionChoice{
+					{Name: "all", NameLocalizations: Localizations{LocaleFrench: "tous"}, Value: "all"},
+				},
+			},
+		},
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd.Options[0].Choices[0].NameLocalizations["en"] = "all"
+	if err := cmd.Validate(); err == nil {
+		t.Error("expected nested choice with unsupported locale to fail validation")
+	}
+
+	cmd.Options = nil
+	cmd.DescriptionLocalizations = Localizations{"de-DE": "ping"}
+	if err := cmd.Validate(); err == nil {
+		t.Error("expected unsupported locale to fail validation")
+	}
+}
@@ -0,0 +1,33 @@
+package endpoint
+
+import "fmt"
+
+// Application /applications/{application.id}
+func Application(id fmt.Stringer) string {
+	return applications + "/" + id.String()
+}
+
+// ApplicationRoleConnectionMetadata /applications/{application.id}/role-connections/metadata
+func ApplicationRoleConnectionMetadata(id fmt.Stringer) string {
+	return Application(id) + roleConnections + metadata
+}
+
+// UserMeApplicationRoleConnection /users/@me/applications/{application.id}/role-connection
+func UserMeApplicationRoleConnection(id fmt.Stringer) string {
+	return UserMe() + Application(id) + roleConnection
+}
+
+// ApplicationSKUs /applications/{application.id}/skus
+func ApplicationSKUs(id fmt.Stringer) string {
+	return Application(id) + skus
+}
+
+// ApplicationEntitlements /applications/{application.id}/entitlements
+func ApplicationEntitlements(id fmt.Stringer) string {
+	return Application(id) + entitlements
+}
+
+// ApplicationEntitlement /applications/{application.id}/entitlements/{entitlement.id}
+func ApplicationEntitlement(applicationID, entitlementID fmt.Stringer) string {
+	return ApplicationEntitlements(applicationID) + "/" + entitlementID.String()
+}
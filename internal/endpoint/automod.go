@@ -0,0 +1,13 @@
+package endpoint
+
+import "fmt"
+
+// GuildAutoModerationRules /guilds/{guild.id}/auto-moderation/rules
+func GuildAutoModerationRules(id fmt.Stringer) string {
+	return Guild(id) + autoModeration + rules
+}
+
+// GuildAutoModerationRule /guilds/{guild.id}/auto-moderation/rules/{auto_moderation_rule.id}
+func GuildAutoModerationRule(guildID, ruleID fmt.Stringer) string {
+	return GuildAutoModerationRules(guildID) + "/" + ruleID.String()
+}
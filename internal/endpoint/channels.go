@@ -90,3 +90,43 @@ func ChannelMessageReactionMe(channelID, messageID fmt.Stringer, emoji string) s
 func ChannelMessageReactionUser(channelID, messageID fmt.Stringer, emoji string, userID fmt.Stringer) string {
 	return ChannelMessage(channelID, messageID) + reactions + "/" + emoji + "/" + userID.String()
 }
+
+// ChannelMessageThreads /channels/{channel.id}/messages/{message.id}/threads
+func ChannelMessageThreads(channelID, messageID fmt.Stringer) string {
+	return ChannelMessage(channelID, messageID) + threads
+}
+
+// ChannelThreads /channels/{channel.id}/threads
+func ChannelThreads(channelID fmt.Stringer) string {
+	return Channel(channelID) + threads
+}
+
+// ChannelThreadMembers /channels/{channel.id}/thread-members
+func ChannelThreadMembers(channelID fmt.Stringer) string {
+	return Channel(channelID) + threadMembers
+}
+
+// ChannelThreadMember /channels/{channel.id}/thread-members/{user.id}
+func ChannelThreadMember(channelID, userID fmt.Stringer) string {
+	return ChannelThreadMembers(channelID) + "/" + userID.String()
+}
+
+// ChannelThreadMemberMe /channels/{channel.id}/thread-members/@me
+func ChannelThreadMemberMe(channelID fmt.Stringer) string {
+	return ChannelThreadMembers(channelID) + me
+}
+
+// ChannelThreadsArchivedPublic /channels/{channel.id}/threads/archived/public
+func ChannelThreadsArchivedPublic(channelID fmt.Stringer) string {
+	return ChannelThreads(channelID) + archived + public
+}
+
+// ChannelThreadsArchivedPrivate /channels/{channel.id}/threads/archived/private
+func ChannelThreadsArchivedPrivate(channelID fmt.Stringer) string {
+	return ChannelThreads(channelID) + archived + private
+}
+
+// ChannelUsersMeThreadsArchivedPrivate /channels/{channel.id}/users/@me/threads/archived/private
+func ChannelUsersMeThreadsArchivedPrivate(channelID fmt.Stringer) string {
+	return Channel(channelID) + users + me + threads + archived + private
+}
@@ -11,3 +11,13 @@ func GuildEmojis(id fmt.Stringer) string {
 func GuildEmoji(guildID, emojiID fmt.Stringer) string {
 	return GuildEmojis(guildID) + "/" + emojiID.String()
 }
+
+// ApplicationEmojis /applications/{application.id}/emojis
+func ApplicationEmojis(id fmt.Stringer) string {
+	return Application(id) + emojis
+}
+
+// ApplicationEmoji /applications/{application.id}/emojis/{emoji.id}
+func ApplicationEmoji(applicationID, emojiID fmt.Stringer) string {
+	return ApplicationEmojis(applicationID) + "/" + emojiID.String()
+}
@@ -29,6 +29,7 @@ const (
 	nick         = "/nick"
 	roles        = "/roles"
 	bans         = "/bans"
+	bulkBan      = "/bulk-ban"
 	prune        = "/prune"
 	integrations = "/integrations"
 	sync         = "/sync"
@@ -36,4 +37,35 @@ const (
 	vanityURL    = "/vanity-url"
 	gateway      = "/gateway"
 	version      = "/v"
+
+	threads       = "/threads"
+	threadMembers = "/thread-members"
+	archived      = "/archived"
+	public        = "/public"
+	private       = "/private"
+	active        = "/active"
+
+	stageInstances  = "/stage-instances"
+	scheduledEvents = "/scheduled-events"
+	stickers        = "/stickers"
+	stickerPacks    = "/sticker-packs"
+	autoModeration  = "/auto-moderation"
+	rules           = "/rules"
+	welcomeScreen   = "/welcome-screen"
+	preview         = "/preview"
+	widget          = "/widget"
+	widgetJSON      = "/widget.json"
+	widgetPNG       = "/widget.png"
+	onboarding      = "/onboarding"
+	templates       = "/templates"
+	applications    = "/applications"
+	roleConnections = "/role-connections"
+	roleConnection  = "/role-connection"
+	metadata        = "/metadata"
+	polls           = "/polls"
+	answers         = "/answers"
+	expire          = "/expire"
+	skus            = "/skus"
+	entitlements    = "/entitlements"
+	subscriptions   = "/subscriptions"
 )
@@ -22,6 +22,11 @@ func GuildChannel(guildID, channelID fmt.Stringer) string {
 	return Guild(guildID) + Channel(channelID)
 }
 
+// GuildThreadsActive /guilds/{guild.id}/threads/active
+func GuildThreadsActive(id fmt.Stringer) string {
+	return Guild(id) + threads + active
+}
+
 // GuildMembers /guilds/{guild.id}/members
 func GuildMembers(id fmt.Stringer) string {
 	return Guild(id) + members
@@ -52,6 +57,11 @@ func GuildBan(guildID, userID fmt.Stringer) string {
 	return Guild(guildID) + bans + "/" + userID.String()
 }
 
+// GuildBulkBan /guilds/{guild.id}/bulk-ban
+func GuildBulkBan(id fmt.Stringer) string {
+	return Guild(id) + bulkBan
+}
+
 // GuildRoles /guilds/{guild.id}/roles
 func GuildRoles(id fmt.Stringer) string {
 	return Guild(id) + roles
@@ -101,3 +111,63 @@ func GuildEmbed(id fmt.Stringer) string {
 func GuildVanityURL(id fmt.Stringer) string {
 	return Guild(id) + vanityURL
 }
+
+// GuildWelcomeScreen /guilds/{guild.id}/welcome-screen
+func GuildWelcomeScreen(id fmt.Stringer) string {
+	return Guild(id) + welcomeScreen
+}
+
+// GuildPreview /guilds/{guild.id}/preview
+func GuildPreview(id fmt.Stringer) string {
+	return Guild(id) + preview
+}
+
+// GuildWidgetSettings /guilds/{guild.id}/widget
+func GuildWidgetSettings(id fmt.Stringer) string {
+	return Guild(id) + widget
+}
+
+// GuildWidget /guilds/{guild.id}/widget.json
+func GuildWidget(id fmt.Stringer) string {
+	return Guild(id) + widgetJSON
+}
+
+// GuildWidgetImage /guilds/{guild.id}/widget.png
+func GuildWidgetImage(id fmt.Stringer) string {
+	return Guild(id) + widgetPNG
+}
+
+// GuildOnboarding /guilds/{guild.id}/onboarding
+func GuildOnboarding(id fmt.Stringer) string {
+	return Guild(id) + onboarding
+}
+
+// GuildTemplates /guilds/{guild.id}/templates
+func GuildTemplates(id fmt.Stringer) string {
+	return Guild(id) + templates
+}
+
+// GuildTemplate /guilds/{guild.id}/templates/{template.code}
+func GuildTemplate(id fmt.Stringer, code string) string {
+	return GuildTemplates(id) + "/" + code
+}
+
+// Template /guilds/templates/{template.code}
+func Template(code string) string {
+	return guilds + templates + "/" + code
+}
+
+// GuildScheduledEvents /guilds/{guild.id}/scheduled-events
+func GuildScheduledEvents(id fmt.Stringer) string {
+	return Guild(id) + scheduledEvents
+}
+
+// GuildScheduledEvent /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}
+func GuildScheduledEvent(guildID, eventID fmt.Stringer) string {
+	return GuildScheduledEvents(guildID) + "/" + eventID.String()
+}
+
+// GuildScheduledEventUsers /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}/users
+func GuildScheduledEventUsers(guildID, eventID fmt.Stringer) string {
+	return GuildScheduledEvent(guildID, eventID) + users
+}
@@ -0,0 +1,21 @@
+package endpoint
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ChannelPoll /channels/{channel.id}/polls/{message.id}
+func ChannelPoll(channelID, messageID fmt.Stringer) string {
+	return Channel(channelID) + polls + "/" + messageID.String()
+}
+
+// ChannelPollAnswer /channels/{channel.id}/polls/{message.id}/answers/{answer_id}
+func ChannelPollAnswer(channelID, messageID fmt.Stringer, answerID int) string {
+	return ChannelPoll(channelID, messageID) + answers + "/" + strconv.Itoa(answerID)
+}
+
+// ChannelPollExpire /channels/{channel.id}/polls/{message.id}/expire
+func ChannelPollExpire(channelID, messageID fmt.Stringer) string {
+	return ChannelPoll(channelID, messageID) + expire
+}
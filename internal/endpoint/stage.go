@@ -0,0 +1,13 @@
+package endpoint
+
+import "fmt"
+
+// StageInstances /stage-instances
+func StageInstances() string {
+	return stageInstances
+}
+
+// StageInstance /stage-instances/{channel.id}
+func StageInstance(channelID fmt.Stringer) string {
+	return StageInstances() + "/" + channelID.String()
+}
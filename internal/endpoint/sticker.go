@@ -0,0 +1,23 @@
+package endpoint
+
+import "fmt"
+
+// Sticker /stickers/{sticker.id}
+func Sticker(id fmt.Stringer) string {
+	return stickers + "/" + id.String()
+}
+
+// StickerPacks /sticker-packs
+func StickerPacks() string {
+	return stickerPacks
+}
+
+// GuildStickers /guilds/{guild.id}/stickers
+func GuildStickers(id fmt.Stringer) string {
+	return Guild(id) + stickers
+}
+
+// GuildSticker /guilds/{guild.id}/stickers/{sticker.id}
+func GuildSticker(guildID, stickerID fmt.Stringer) string {
+	return GuildStickers(guildID) + "/" + stickerID.String()
+}
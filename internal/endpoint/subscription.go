@@ -0,0 +1,13 @@
+package endpoint
+
+import "fmt"
+
+// SKUSubscriptions /skus/{sku.id}/subscriptions
+func SKUSubscriptions(id fmt.Stringer) string {
+	return skus + "/" + id.String() + subscriptions
+}
+
+// SKUSubscription /skus/{sku.id}/subscriptions/{subscription.id}
+func SKUSubscription(skuID, subscriptionID fmt.Stringer) string {
+	return SKUSubscriptions(skuID) + "/" + subscriptionID.String()
+}
@@ -23,6 +23,33 @@ const ChannelDelete = "CHANNEL_DELETE"
 // ChannelPinsUpdate Sent when a message is pinned or unpinned in a text channel. This is not sent when a pinned message is deleted.
 const ChannelPinsUpdate = "CHANNEL_PINS_UPDATE"
 
+// ThreadCreate Sent when a thread is created, relevant to the current user, or when the current user is added to a thread.
+const ThreadCreate = "THREAD_CREATE"
+
+// ThreadUpdate Sent when a thread is updated.
+const ThreadUpdate = "THREAD_UPDATE"
+
+// ThreadDelete Sent when a thread relevant to the current user is deleted.
+const ThreadDelete = "THREAD_DELETE"
+
+// ThreadListSync Sent when the current user gains access to a channel. Holds all the active threads of the channel(s).
+const ThreadListSync = "THREAD_LIST_SYNC"
+
+// ThreadMemberUpdate Sent when the thread member object for the current user is updated.
+const ThreadMemberUpdate = "THREAD_MEMBER_UPDATE"
+
+// ThreadMembersUpdate Sent when anyone is added to or removed from a thread.
+const ThreadMembersUpdate = "THREAD_MEMBERS_UPDATE"
+
+// StageInstanceCreate Sent when a stage instance is created, i.e. the stage is now live.
+const StageInstanceCreate = "STAGE_INSTANCE_CREATE"
+
+// StageInstanceUpdate Sent when a stage instance has been updated.
+const StageInstanceUpdate = "STAGE_INSTANCE_UPDATE"
+
+// StageInstanceDelete Sent when a stage instance has been deleted, i.e. the stage has been closed.
+const StageInstanceDelete = "STAGE_INSTANCE_DELETE"
+
 // TypingStart Sent when a user starts typing in a channel.
 const TypingStart = "TYPING_START"
 
@@ -55,9 +82,18 @@ const MessageReactionRemoveAll = "MESSAGE_REACTION_REMOVE_ALL"
 // MessageReactionRemoveEmoji Sent when a bot removes all instances of a given emoji from the reactions of a message.
 const MessageReactionRemoveEmoji = "MESSAGE_REACTION_REMOVE_EMOJI"
 
+// MessagePollVoteAdd Sent when a user votes on a poll. Polls with multi-select send one event per answer.
+const MessagePollVoteAdd = "MESSAGE_POLL_VOTE_ADD"
+
+// MessagePollVoteRemove Sent when a user removes their vote on a poll.
+const MessagePollVoteRemove = "MESSAGE_POLL_VOTE_REMOVE"
+
 // GuildEmojisUpdate Sent when a guild's emojis have been updated.
 const GuildEmojisUpdate = "GUILD_EMOJIS_UPDATE"
 
+// GuildStickersUpdate Sent when a guild's stickers have been updated.
+const GuildStickersUpdate = "GUILD_STICKERS_UPDATE"
+
 // GuildCreate This event can be sent in three different scenarios:
 //  1. When a user is initially connecting, to lazily load and backfill information for all unavailable guilds
 //     sent in the Ready event.
@@ -103,6 +139,51 @@ const GuildRoleUpdate = "GUILD_ROLE_UPDATE"
 // GuildRoleDelete Sent when a guild role is created.
 const GuildRoleDelete = "GUILD_ROLE_DELETE"
 
+// GuildScheduledEventCreate Sent when a guild scheduled event is created.
+const GuildScheduledEventCreate = "GUILD_SCHEDULED_EVENT_CREATE"
+
+// GuildScheduledEventUpdate Sent when a guild scheduled event is updated.
+const GuildScheduledEventUpdate = "GUILD_SCHEDULED_EVENT_UPDATE"
+
+// GuildScheduledEventDelete Sent when a guild scheduled event is deleted.
+const GuildScheduledEventDelete = "GUILD_SCHEDULED_EVENT_DELETE"
+
+// GuildScheduledEventUserAdd Sent when a user has subscribed to a guild scheduled event.
+const GuildScheduledEventUserAdd = "GUILD_SCHEDULED_EVENT_USER_ADD"
+
+// GuildScheduledEventUserRemove Sent when a user has unsubscribed from a guild scheduled event.
+const GuildScheduledEventUserRemove = "GUILD_SCHEDULED_EVENT_USER_REMOVE"
+
+// AutoModerationRuleCreate Sent when an auto moderation rule is created.
+const AutoModerationRuleCreate = "AUTO_MODERATION_RULE_CREATE"
+
+// AutoModerationRuleUpdate Sent when an auto moderation rule is updated.
+const AutoModerationRuleUpdate = "AUTO_MODERATION_RULE_UPDATE"
+
+// AutoModerationRuleDelete Sent when an auto moderation rule is deleted.
+const AutoModerationRuleDelete = "AUTO_MODERATION_RULE_DELETE"
+
+// AutoModerationActionExecution Sent when a rule is triggered and an action is executed (e.g. when a message is blocked).
+const AutoModerationActionExecution = "AUTO_MODERATION_ACTION_EXECUTION"
+
+// EntitlementCreate Sent when an entitlement is created, eg. when a user subscribes to a SKU.
+const EntitlementCreate = "ENTITLEMENT_CREATE"
+
+// EntitlementUpdate Sent when an entitlement is updated, eg. when a subscription renews for the next billing period.
+const EntitlementUpdate = "ENTITLEMENT_UPDATE"
+
+// EntitlementDelete Sent when an entitlement is deleted, eg. when Discord issues a refund or a test entitlement is removed.
+const EntitlementDelete = "ENTITLEMENT_DELETE"
+
+// SubscriptionCreate Sent when a subscription for a premium offering is created.
+const SubscriptionCreate = "SUBSCRIPTION_CREATE"
+
+// SubscriptionUpdate Sent when a subscription is updated, eg. when it renews or is canceled.
+const SubscriptionUpdate = "SUBSCRIPTION_UPDATE"
+
+// SubscriptionDelete Sent when a subscription is deleted.
+const SubscriptionDelete = "SUBSCRIPTION_DELETE"
+
 // PresenceUpdate A user's presence is their current state on a guild. This event is sent when a user's presence is updated for a guild.
 const PresenceUpdate = "PRESENCE_UPDATE"
 
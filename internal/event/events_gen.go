@@ -11,43 +11,70 @@ func All() []string {
 
 func AllExcept(except ...string) []string {
 	evtsMap := map[string]int8{
-		ChannelCreate:              0,
-		ChannelDelete:              0,
-		ChannelPinsUpdate:          0,
-		ChannelUpdate:              0,
-		GuildBanAdd:                0,
-		GuildBanRemove:             0,
-		GuildCreate:                0,
-		GuildDelete:                0,
-		GuildEmojisUpdate:          0,
-		GuildIntegrationsUpdate:    0,
-		GuildMemberAdd:             0,
-		GuildMemberRemove:          0,
-		GuildMemberUpdate:          0,
-		GuildMembersChunk:          0,
-		GuildRoleCreate:            0,
-		GuildRoleDelete:            0,
-		GuildRoleUpdate:            0,
-		GuildUpdate:                0,
-		InteractionCreate:          0,
-		InviteCreate:               0,
-		InviteDelete:               0,
-		MessageCreate:              0,
-		MessageDelete:              0,
-		MessageDeleteBulk:          0,
-		MessageReactionAdd:         0,
-		MessageReactionRemove:      0,
-		MessageReactionRemoveAll:   0,
-		MessageReactionRemoveEmoji: 0,
-		MessageUpdate:              0,
-		PresenceUpdate:             0,
-		Ready:                      0,
-		Resumed:                    0,
-		TypingStart:                0,
-		UserUpdate:                 0,
-		VoiceServerUpdate:          0,
-		VoiceStateUpdate:           0,
-		WebhooksUpdate:             0,
+		AutoModerationActionExecution: 0,
+		AutoModerationRuleCreate:      0,
+		AutoModerationRuleDelete:      0,
+		AutoModerationRuleUpdate:      0,
+		ChannelCreate:                 0,
+		ChannelDelete:                 0,
+		ChannelPinsUpdate:             0,
+		ChannelUpdate:                 0,
+		EntitlementCreate:             0,
+		EntitlementDelete:             0,
+		EntitlementUpdate:             0,
+		GuildBanAdd:                   0,
+		GuildBanRemove:                0,
+		GuildCreate:                   0,
+		GuildDelete:                   0,
+		GuildEmojisUpdate:             0,
+		GuildIntegrationsUpdate:       0,
+		GuildMemberAdd:                0,
+		GuildMemberRemove:             0,
+		GuildMemberUpdate:             0,
+		GuildMembersChunk:             0,
+		GuildRoleCreate:               0,
+		GuildRoleDelete:               0,
+		GuildRoleUpdate:               0,
+		GuildScheduledEventCreate:     0,
+		GuildScheduledEventDelete:     0,
+		GuildScheduledEventUpdate:     0,
+		GuildScheduledEventUserAdd:    0,
+		GuildScheduledEventUserRemove: 0,
+		GuildStickersUpdate:           0,
+		GuildUpdate:                   0,
+		InteractionCreate:             0,
+		InviteCreate:                  0,
+		InviteDelete:                  0,
+		MessageCreate:                 0,
+		MessageDelete:                 0,
+		MessageDeleteBulk:             0,
+		MessagePollVoteAdd:            0,
+		MessagePollVoteRemove:         0,
+		MessageReactionAdd:            0,
+		MessageReactionRemove:         0,
+		MessageReactionRemoveAll:      0,
+		MessageReactionRemoveEmoji:    0,
+		MessageUpdate:                 0,
+		PresenceUpdate:                0,
+		Ready:                         0,
+		Resumed:                       0,
+		StageInstanceCreate:           0,
+		StageInstanceDelete:           0,
+		StageInstanceUpdate:           0,
+		SubscriptionCreate:            0,
+		SubscriptionDelete:            0,
+		SubscriptionUpdate:            0,
+		ThreadCreate:                  0,
+		ThreadDelete:                  0,
+		ThreadListSync:                0,
+		ThreadMemberUpdate:            0,
+		ThreadMembersUpdate:           0,
+		ThreadUpdate:                  0,
+		TypingStart:                   0,
+		UserUpdate:                    0,
+		VoiceServerUpdate:             0,
+		VoiceStateUpdate:              0,
+		WebhooksUpdate:                0,
 	}
 
 	for i := range except {
@@ -45,7 +45,7 @@ type discordErrListener = func(code int, reason string)
 func newClient(shardID uint, conf *config, connect connectSignature) (c *client, err error) {
 	var ws Conn
 	if conf.conn == nil {
-		ws, err = newConn(conf.HTTPClient)
+		ws, err = newConn(conf.HTTPClient, conf.NewWebsocket)
 		if err != nil {
 			return nil, err
 		}
@@ -69,7 +69,7 @@ func newClient(shardID uint, conf *config, connect connectSignature) (c *client,
 		conn:              ws,
 		ratelimit:         newRatelimiter(),
 		timeoutMultiplier: 1,
-		log:               conf.Logger,
+		log:               logger.With(conf.Logger, logger.Field{Key: "shard_id", Value: shardID}),
 		behaviors:         map[string]*behavior{},
 		poolDiscordPkt:    conf.DiscordPktPool,
 		onceChannels:      newOnceChannels(),
@@ -90,6 +90,9 @@ type config struct {
 	// for testing only
 	conn Conn
 
+	// NewWebsocket creates the websocket implementation, defaults to nhooyr.io/websocket
+	NewWebsocket func() WebsocketConn
+
 	// Endpoint for establishing socket connection. Either endpoints, `Gateway` or `Gateway Bot`, is used to retrieve
 	// a valid socket endpoint from Discord
 	Endpoint string
@@ -100,6 +103,9 @@ type config struct {
 
 	discordErrListener discordErrListener
 
+	// latencyListener is called every time the heartbeat latency has been measured
+	latencyListener func(latency time.Duration)
+
 	// messageQueueLimit number of outgoing messages that can be queued and sent correctly.
 	messageQueueLimit uint
 
@@ -266,6 +272,11 @@ func (c *client) IsDisconnected() bool {
 }
 
 func (c *client) disconnect() (err error) {
+	return c.disconnectConn(false)
+}
+
+// disconnectConn closes the connection. A resumable close keeps the Discord session alive.
+func (c *client) disconnectConn(resumable bool) (err error) {
 	c.Lock()
 	defer c.Unlock()
 	alreadyDisconnected := c.conn.Disconnected() || !c.haveConnectedOnce.Load() || c.cancel == nil
@@ -277,7 +288,11 @@ func (c *client) disconnect() (err error) {
 	}
 
 	// use the emitter to dispatch the close message
-	err = c.conn.Close()
+	if resumable {
+		err = c.conn.CloseResumable()
+	} else {
+		err = c.conn.Close()
+	}
 	// a typical err here is that the pipe is closed. Err is returned later
 
 	// c.Emit(event.Close, nil)
@@ -555,6 +570,9 @@ func (c *client) receiver(ctx context.Context) {
 						_ = c.Disconnect()
 						close(c.receiveChan) // notify client
 						reconnect = false
+					case 4015:
+						// the voice server crashed, the session should be resumed
+						c.log.Debug(c.getLogPrefix(), "discord sent a 4015 websocket code and the bot will now try to resume")
 					default:
 					}
 				}
@@ -674,10 +692,14 @@ func (c *client) pulsate(ctx context.Context) {
 		}
 
 		// update heartbeat latency record & send new heartbeat signal
+		latency := lastAck.Sub(lastSent)
 		c.Lock()
-		c.heartbeatLatency = lastAck.Sub(lastSent)
+		c.heartbeatLatency = latency
 		c.lastHeartbeatSent = time.Now()
 		c.Unlock()
+		if c.conf.latencyListener != nil && latency > 0 {
+			c.conf.latencyListener(latency)
+		}
 		if err := c.behaviors[heartbeating].actions[sendHeartbeat](nil); err != nil {
 			c.log.Error(c.getLogPrefix(), err)
 		} else {
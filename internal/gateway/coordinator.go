@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const coordinatorHealthInterval = 15 * time.Second
+
+// ShardHealth is the state of a local shard as reported to a ShardCoordinator.
+type ShardHealth struct {
+	ShardID   uint
+	Connected bool
+
+	// Latency is 0 until the first heartbeat has been acknowledged
+	Latency time.Duration
+}
+
+// ShardCoordinator divides the shards of a bot between multiple processes, such that every replica
+// of a bot can be started with the same configuration.
+//
+// Claim is called before connecting, when no ShardIDs have been specified. ReportHealth is called
+// periodically while connected and must be used to keep the claims alive; a claim should expire
+// when a process stops reporting, such that another replica can take over the shards. Release is
+// called on shutdown.
+type ShardCoordinator interface {
+	// Claim returns the shard ids this process should run, given the total number of shards.
+	Claim(ctx context.Context, shardCount uint) (shardIDs []uint, err error)
+
+	// ReportHealth reports the health of the claimed shards and renews the claims.
+	ReportHealth(ctx context.Context, health []ShardHealth) error
+
+	// Release releases the given shards, such that they can be claimed by another process.
+	Release(ctx context.Context, shardIDs []uint) error
+}
+
+func claimShards(ctx context.Context, coordinator ShardCoordinator, conf *ShardConfig) error {
+	shardIDs, err := coordinator.Claim(ctx, conf.ShardCount)
+	if err != nil {
+		return err
+	}
+	if len(shardIDs) == 0 {
+		return errors.New("shard coordinator did not assign any shards")
+	}
+	for _, id := range shardIDs {
+		if id >= conf.ShardCount {
+			return errors.New("shard coordinator assigned a shard id outside the shard count")
+		}
+	}
+
+	conf.ShardIDs = shardIDs
+	conf.DisableAutoScaling = true
+	return nil
+}
+
+// coordinate reports the shard health to the coordinator until shutdown, and then releases the shards.
+func (s *shardMngr) coordinate() {
+	ticker := time.NewTicker(coordinatorHealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.conf.ShutdownChan:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			s.mu.RLock()
+			shardIDs := s.ShardIDs()
+			s.mu.RUnlock()
+			if err := s.conf.Coordinator.Release(ctx, shardIDs); err != nil {
+				s.conf.Logger.Error("[shardCoordinator]", "unable to release shards:", err)
+			}
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), coordinatorHealthInterval)
+		err := s.conf.Coordinator.ReportHealth(ctx, s.health())
+		cancel()
+		if err != nil {
+			s.conf.Logger.Error("[shardCoordinator]", "unable to report shard health:", err)
+		}
+	}
+}
+
+func (s *shardMngr) health() []ShardHealth {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	health := make([]ShardHealth, 0, len(s.shards))
+	for id, shard := range s.shards {
+		latency, _ := shard.HeartbeatLatency()
+		health = append(health, ShardHealth{
+			ShardID:   id,
+			Connected: shard.isConnected.Load(),
+			Latency:   latency,
+		})
+	}
+	return health
+}
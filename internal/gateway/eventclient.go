@@ -34,20 +34,22 @@ func NewEventClient(shardID uint, conf *EvtConfig) (client *EvtClient, err error
 		return nil, err
 	}
 
-	// figure out intents
-	for _, e := range evt.All() {
-		var exists bool
-		for _, e2 := range conf.IgnoreEvents {
-			if e == e2 {
-				exists = true
-				break
+	// figure out intents, unless they are given as is
+	if !conf.ExactIntents {
+		for _, e := range evt.All() {
+			var exists bool
+			for _, e2 := range conf.IgnoreEvents {
+				if e == e2 {
+					exists = true
+					break
+				}
+			}
+			if exists {
+				continue
 			}
-		}
-		if exists {
-			continue
-		}
 
-		conf.Intents |= EventToIntent(e, false)
+			conf.Intents |= EventToIntent(e, false)
+		}
 	}
 
 	conf.Logger.Debug(fmt.Sprintf("shard %d intents: %s", shardID, conf.Intents.String()))
@@ -58,14 +60,20 @@ func NewEventClient(shardID uint, conf *EvtConfig) (client *EvtClient, err error
 		ignoreEvents: conf.IgnoreEvents,
 		eventChan:    eChan,
 	}
+	var latencyListener func(time.Duration)
+	if monitor := newLatencyMonitor(shardID, conf); monitor != nil {
+		latencyListener = monitor.update
+	}
 	client.client, err = newClient(shardID, &config{
 		Logger:            conf.Logger,
 		Endpoint:          conf.Endpoint,
 		DiscordPktPool:    conf.DiscordPktPool,
 		HTTPClient:        conf.HTTPClient,
 		conn:              conf.conn,
+		NewWebsocket:      conf.NewWebsocket,
 		messageQueueLimit: conf.MessageQueueLimit,
 		SystemShutdown:    conf.SystemShutdown,
+		latencyListener:   latencyListener,
 	}, client.internalConnect)
 	if err != nil {
 		return nil, err
@@ -95,9 +103,11 @@ func NewEventClient(shardID uint, conf *EvtConfig) (client *EvtClient, err error
 // Event is dispatched by the socket layer after parsing and extracting Discord data from a incoming packet.
 // This is the data structure used by Disgord for triggering handlers and channels with an event.
 type Event struct {
-	Name    string
-	Data    []byte
-	ShardID uint
+	Name     string
+	Data     []byte
+	ShardID  uint
+	Sequence uint
+	Received time.Time
 }
 
 // EvtConfig ws
@@ -109,11 +119,27 @@ type EvtConfig struct {
 	// for testing only
 	conn Conn
 
+	// NewWebsocket creates the websocket implementation, defaults to nhooyr.io/websocket
+	NewWebsocket func() WebsocketConn
+
+	// SessionStore persists the session, such that it can be resumed after a restart
+	SessionStore SessionStore
+
+	// IgnoreResumeURL keeps resuming on Endpoint, for gateway proxies
+	IgnoreResumeURL bool
+
+	// OnLatencyChange is called when the heartbeat latency crosses LatencyThreshold
+	LatencyThreshold time.Duration
+	OnLatencyChange  func(shardID uint, latency time.Duration, exceeded bool)
+
 	// IgnoreEvents holds a list of predetermined events that should be ignored.
 	IgnoreEvents []string
 
 	Intents Intent
 
+	// ExactIntents sends Intents as is, instead of adding the intents of every event that is not ignored.
+	ExactIntents bool
+
 	// EventChan can be used to inject a channel instead of letting the ws client construct one
 	// useful in sharding to avoid complicated patterns to handle N channels.
 	EventChan chan<- *Event
@@ -169,11 +195,17 @@ type EvtClient struct {
 	ignoreEvents []string
 
 	sessionID      string
+	resumeURL      string
 	sequenceNumber atomic.Uint32
 
+	// sessionMu orders the saves to the SessionStore, and savedSequence is the last sequence number saved
+	sessionMu     sync.Mutex
+	savedSequence atomic.Uint32
+
 	rdyPool *sync.Pool
 
 	identity *evtIdentity
+	presence CmdPayload // last presence update, re-sent after resuming
 	idMu     sync.RWMutex
 }
 
@@ -195,6 +227,9 @@ func (c *EvtClient) Emit(command string, data CmdPayload) (err error) {
 		if err = c.SetPresence(data); err != nil {
 			return err
 		}
+		c.idMu.Lock()
+		c.presence = data
+		c.idMu.Unlock()
 	}
 	return c.client.queueRequest(command, data)
 }
@@ -244,6 +279,12 @@ func (c *EvtClient) synchronizeSnr(p *DiscordPacket) (err error) {
 	c.Lock()
 	defer c.Unlock()
 
+	// a gateway proxy may answer with an existing session, whose sequence number is already ahead
+	if p.EventName == event.Ready {
+		c.sequenceNumber.Store(p.SequenceNumber)
+		return nil
+	}
+
 	// validate the sequence numbers
 	// ws/tcp only
 	if p.SequenceNumber != c.sequenceNumber.Load()+1 {
@@ -273,8 +314,13 @@ func (c *EvtClient) onReady(v interface{}) (err error) {
 
 	c.Lock()
 	c.sessionID = ready.SessionID
+	c.resumeURL = ready.ResumeGatewayURL
 	c.ReadyCounter++
 	c.Unlock()
+	go c.saveSession()
+
+	// a gateway proxy can answer with READY right away, which completes the connect as well
+	c.signalIdentifyResume()
 
 	//if ch := c.onceChannels.Acquire(opcode.EventReadyResumed); ch != nil {
 	//	ch <- ready
@@ -283,6 +329,23 @@ func (c *EvtClient) onReady(v interface{}) (err error) {
 	return nil
 }
 
+// onResumed re-sends the last presence update, as presence updates sent while the connection was
+// down are lost. A new identify already includes the presence.
+func (c *EvtClient) onResumed() {
+	c.idMu.RLock()
+	presence := c.presence
+	c.idMu.RUnlock()
+	if presence == nil {
+		return
+	}
+
+	go func() {
+		if err := c.client.queueRequest(cmd.UpdateStatus, presence); err != nil {
+			c.log.Error(c.getLogPrefix(), "unable to re-send presence after resuming:", err)
+		}
+	}()
+}
+
 func (c *EvtClient) onDiscordEvent(v interface{}) (err error) {
 	p := v.(*DiscordPacket)
 
@@ -294,6 +357,8 @@ func (c *EvtClient) onDiscordEvent(v interface{}) (err error) {
 		if err = c.onReady(p); err != nil {
 			return err
 		}
+	} else if p.EventName == event.Resumed {
+		c.onResumed()
 	}
 	//} else if p.EventName == event.Resumed {
 	//	if ch := c.onceChannels.Acquire(opcode.EventReadyResumed); ch != nil {
@@ -309,9 +374,11 @@ func (c *EvtClient) onDiscordEvent(v interface{}) (err error) {
 
 	// dispatch event through out the Disgord system
 	c.eventChan <- &Event{
-		Name:    p.EventName,
-		Data:    p.Data,
-		ShardID: c.ShardID,
+		Name:     p.EventName,
+		Data:     p.Data,
+		ShardID:  c.ShardID,
+		Sequence: uint(p.SequenceNumber),
+		Received: time.Now(),
 	}
 
 	return nil
@@ -401,6 +468,36 @@ func (c *EvtClient) Connect() (err error) {
 	return
 }
 
+// Disconnect disconnects the socket connection. With a session store, the session is saved and the
+// connection is closed such that the session can be resumed later on.
+func (c *EvtClient) Disconnect() (err error) {
+	if c.evtConf.SessionStore == nil {
+		return c.client.Disconnect()
+	}
+	return c.DisconnectResumable()
+}
+
+// setToken replaces the bot token used to identify and resume.
+func (c *EvtClient) setToken(token string) {
+	c.Lock()
+	c.evtConf.BotToken = token
+	c.Unlock()
+
+	c.idMu.Lock()
+	c.identity.Token = token
+	c.idMu.Unlock()
+}
+
+// DisconnectResumable closes the connection without invalidating the Discord session, such that it can be
+// resumed later. The session is saved to the SessionStore, if any.
+func (c *EvtClient) DisconnectResumable() (err error) {
+	if c.evtConf.SessionStore != nil && c.isConnected.Load() {
+		c.saveSession()
+	}
+	c.requestedDisconnect.Store(true)
+	return c.disconnectConn(true)
+}
+
 func (c *EvtClient) internalConnect() (evt interface{}, err error) {
 	if c.isConnected.Load() {
 		err = errors.New("cannot Connect while a connection already exist")
@@ -412,11 +509,13 @@ func (c *EvtClient) internalConnect() (evt interface{}, err error) {
 		return nil, err
 	}
 
+	c.loadSession()
+
 	var sessionCtx context.Context
 	sessionCtx, c.cancel = context.WithCancel(context.Background())
 
 	err = c.evtConf.connectQueue(c.ShardID, func() error {
-		sentIdentifyResume := make(chan interface{})
+		sentIdentifyResume := make(chan interface{}, 1)
 		c.onceChannels.Add(opcode.EventIdentify, sentIdentifyResume)
 		c.onceChannels.Add(opcode.EventResume, sentIdentifyResume)
 		defer func() {
@@ -447,7 +546,7 @@ func (c *EvtClient) internalConnect() (evt interface{}, err error) {
 
 func (c *EvtClient) openConnection(ctx context.Context) error {
 	// establish ws connection
-	if err := c.conn.Open(ctx, c.conf.Endpoint, nil); err != nil {
+	if err := c.conn.Open(ctx, c.endpoint(), nil); err != nil {
 		return err
 	}
 
@@ -458,6 +557,9 @@ func (c *EvtClient) openConnection(ctx context.Context) error {
 	go c.emitter(ctx)
 	go c.startBehaviors(ctx)
 	go c.prepareHeartbeating(ctx)
+	if c.evtConf.SessionStore != nil {
+		go c.persistSession(ctx)
+	}
 	go func() {
 		select {
 		case <-ctx.Done():
@@ -490,11 +592,23 @@ func (c *EvtClient) sendHelloPacket() {
 		c.log.Error(c.getLogPrefix(), err)
 	}
 
-	c.log.Debug(c.getLogPrefix(), "sendHelloPacket is acquiring once channel")
-	channel := c.onceChannels.Acquire(opcode.EventResume)
-	c.log.Debug(c.getLogPrefix(), "writing to once channel", channel)
-	channel <- true
-	c.log.Debug(c.getLogPrefix(), "finished writing to once channel", channel)
+	c.signalIdentifyResume()
+}
+
+// signalIdentifyResume notifies a pending connect that the identify or resume has been sent. The channel
+// is buffered, such that a connect that already completed does not block the caller.
+func (c *EvtClient) signalIdentifyResume() {
+	identify := c.onceChannels.Acquire(opcode.EventIdentify)
+	resume := c.onceChannels.Acquire(opcode.EventResume)
+	for _, channel := range []chan interface{}{identify, resume} {
+		if channel == nil {
+			continue
+		}
+		select {
+		case channel <- true:
+		default:
+		}
+	}
 }
 
 func sendIdentityPacket(invalidSession bool, c *EvtClient) (err error) {
@@ -506,11 +620,7 @@ func sendIdentityPacket(invalidSession bool, c *EvtClient) (err error) {
 	err = c.emit(event.Identify, id)
 
 	if !invalidSession {
-		c.log.Debug(c.getLogPrefix(), "sendIdentityPacket is acquiring once channel")
-		channel := c.onceChannels.Acquire(opcode.EventIdentify)
-		c.log.Debug(c.getLogPrefix(), "writing to once channel", channel)
-		channel <- true
-		c.log.Debug(c.getLogPrefix(), "finished writing to once channel", channel)
+		c.signalIdentifyResume()
 	}
 	return
 }
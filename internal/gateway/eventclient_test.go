@@ -17,6 +17,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/Vedza/disgord/internal/constant"
+	"github.com/Vedza/disgord/internal/event"
 	"github.com/Vedza/disgord/internal/gateway/cmd"
 	"github.com/Vedza/disgord/internal/gateway/opcode"
 	"github.com/Vedza/disgord/internal/logger"
@@ -47,6 +48,10 @@ func (g *testWS) Close() (err error) {
 	return
 }
 
+func (g *testWS) CloseResumable() error {
+	return g.Close()
+}
+
 func (g *testWS) Read(ctx context.Context) (packet []byte, err error) {
 loop:
 	for {
@@ -293,3 +298,169 @@ func TestEvtClient_communication(t *testing.T) {
 
 	<-time.After(10 * time.Millisecond)
 }
+
+func TestEvtClient_ProxyReady(t *testing.T) {
+	c := &EvtClient{client: &client{onceChannels: newOnceChannels()}, evtConf: &EvtConfig{}}
+	c.sequenceNumber.Store(3)
+
+	// the proxy answers with the READY of an existing session
+	ready := &DiscordPacket{EventName: event.Ready, SequenceNumber: 42, Data: []byte(`{"session_id":"abc"}`)}
+	if err := c.synchronizeSnr(ready); err != nil {
+		t.Fatal(err)
+	}
+	if snr := c.sequenceNumber.Load(); snr != 42 {
+		t.Errorf("expected the sequence number of the ready event. Got %d", snr)
+	}
+
+	connected := make(chan interface{}, 1)
+	c.onceChannels.Add(opcode.EventIdentify, connected)
+	c.onceChannels.Add(opcode.EventResume, connected)
+	if err := c.onReady(ready); err != nil {
+		t.Fatal(err)
+	}
+	if c.sessionID != "abc" {
+		t.Errorf("expected the session id to be stored. Got %s", c.sessionID)
+	}
+	select {
+	case <-connected:
+	default:
+		t.Error("ready should complete a pending connect")
+	}
+
+	// identify being sent afterwards must not block
+	c.signalIdentifyResume()
+}
+
+type sessionStoreMock struct {
+	sync.Mutex
+	session *Session
+}
+
+func (s *sessionStoreMock) Load(_ context.Context, shardID uint) (*Session, error) {
+	s.Lock()
+	defer s.Unlock()
+	return s.session, nil
+}
+
+func (s *sessionStoreMock) Save(_ context.Context, session *Session) error {
+	s.Lock()
+	defer s.Unlock()
+	s.session = session
+	return nil
+}
+
+func TestEvtClient_SessionStore(t *testing.T) {
+	store := &sessionStoreMock{session: &Session{
+		ShardID:    1,
+		ShardCount: 2,
+		SessionID:  "abc",
+		ResumeURL:  "wss://resume.discord.gg",
+		Sequence:   42,
+	}}
+	c := &EvtClient{
+		client: &client{
+			ShardID: 1,
+			log:     &logger.Empty{},
+			conf:    &config{Endpoint: "wss://gateway.discord.gg/?v=10&encoding=json"},
+		},
+		evtConf: &EvtConfig{SessionStore: store, ShardCount: 2},
+	}
+
+	c.loadSession()
+	if c.sessionID != "abc" || c.sequenceNumber.Load() != 42 {
+		t.Fatalf("expected the stored session to be restored. Got %s at %d", c.sessionID, c.sequenceNumber.Load())
+	}
+	if endpoint := c.endpoint(); endpoint != "wss://resume.discord.gg/?v=10&encoding=json" {
+		t.Errorf("expected the resume url with the endpoint query. Got %s", endpoint)
+	}
+
+	c.sequenceNumber.Store(50)
+	c.saveSession()
+	if store.session.Sequence != 50 || store.session.ResumeURL != "wss://resume.discord.gg" {
+		t.Errorf("expected the session to be saved. Got %+v", store.session)
+	}
+
+	// sessions can not be resumed with a different number of shards
+	c = &EvtClient{
+		client:  &client{ShardID: 1, log: &logger.Empty{}},
+		evtConf: &EvtConfig{SessionStore: store, ShardCount: 4},
+	}
+	c.loadSession()
+	if c.sessionID != "" {
+		t.Error("sessions from a different shard count should be ignored")
+	}
+}
+
+func TestEvtClient_SessionStore_Sequence(t *testing.T) {
+	defer func(interval time.Duration) {
+		sessionSaveInterval = interval
+	}(sessionSaveInterval)
+	sessionSaveInterval = time.Millisecond
+
+	store := &sessionStoreMock{}
+	newShard := func() *EvtClient {
+		return &EvtClient{
+			client: &client{
+				ShardID: 1,
+				log:     &logger.Empty{},
+				conf:    &config{Endpoint: "wss://gateway.discord.gg/?v=10&encoding=json"},
+			},
+			evtConf: &EvtConfig{SessionStore: store, ShardCount: 2},
+		}
+	}
+
+	c := newShard()
+	c.sessionID = "abc"
+	c.sequenceNumber.Store(1)
+	c.saveSession() // READY
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.persistSession(ctx)
+	for i := 0; i < 56; i++ {
+		c.sequenceNumber.Inc()
+	}
+
+	// the process crashes, without disconnecting
+	deadline := time.Now().Add(time.Second)
+	for {
+		session, _ := store.Load(ctx, 1)
+		if session.Sequence == 57 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the sequence number to be saved as it advances. Got %d", session.Sequence)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	restarted := newShard()
+	restarted.loadSession()
+	if restarted.sessionID != "abc" || restarted.sequenceNumber.Load() != 57 {
+		t.Errorf("expected the restart to resume from the last sequence number. Got %s at %d", restarted.sessionID, restarted.sequenceNumber.Load())
+	}
+}
+
+func TestEvtClient_SessionStore_Disconnect(t *testing.T) {
+	store := &sessionStoreMock{}
+	c := &EvtClient{
+		client:  &client{ShardID: 1, log: &logger.Empty{}},
+		evtConf: &EvtConfig{SessionStore: store, ShardCount: 2},
+	}
+	c.sessionID = "abc"
+	c.sequenceNumber.Store(5)
+
+	// the connection is lost before the next save
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.persistSession(ctx)
+		close(done)
+	}()
+	cancel()
+	<-done
+
+	if session, _ := store.Load(ctx, 1); session == nil || session.Sequence != 5 {
+		t.Errorf("expected the session to be saved when the connection is lost. Got %+v", session)
+	}
+}
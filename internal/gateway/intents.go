@@ -38,12 +38,22 @@ const (
 	// - CHANNEL_UPDATE
 	// - CHANNEL_DELETE
 	// - CHANNEL_PINS_UPDATE
+	// - THREAD_CREATE
+	// - THREAD_UPDATE
+	// - THREAD_DELETE
+	// - THREAD_LIST_SYNC
+	// - THREAD_MEMBER_UPDATE
+	// - THREAD_MEMBERS_UPDATE
+	// - STAGE_INSTANCE_CREATE
+	// - STAGE_INSTANCE_UPDATE
+	// - STAGE_INSTANCE_DELETE
 	IntentGuilds Intent = 1 << iota
 
 	// IntentGuildMembers
 	// - GUILD_MEMBER_ADD
 	// - GUILD_MEMBER_UPDATE
 	// - GUILD_MEMBER_REMOVE
+	// THREAD_MEMBERS_UPDATE includes every member that was added or removed when this intent is set.
 	IntentGuildMembers
 
 	// IntentGuildBans
@@ -53,6 +63,7 @@ const (
 
 	// IntentGuildEmojis
 	// - GUILD_EMOJIS_UPDATE
+	// - GUILD_STICKERS_UPDATE
 	IntentGuildEmojis
 
 	// IntentGuildIntegrations
@@ -69,6 +80,45 @@ const (
 	IntentDirectMessages
 	IntentDirectMessageReactions
 	IntentDirectMessageTyping
+
+	// IntentMessageContent is required to receive the content, embeds, attachments and components of
+	// messages. It does not enable any events by itself, and is a privileged intent.
+	IntentMessageContent
+
+	// IntentGuildScheduledEvents
+	// - GUILD_SCHEDULED_EVENT_CREATE
+	// - GUILD_SCHEDULED_EVENT_UPDATE
+	// - GUILD_SCHEDULED_EVENT_DELETE
+	// - GUILD_SCHEDULED_EVENT_USER_ADD
+	// - GUILD_SCHEDULED_EVENT_USER_REMOVE
+	IntentGuildScheduledEvents
+
+	_
+	_
+	_
+
+	// IntentAutoModerationConfiguration
+	// - AUTO_MODERATION_RULE_CREATE
+	// - AUTO_MODERATION_RULE_UPDATE
+	// - AUTO_MODERATION_RULE_DELETE
+	IntentAutoModerationConfiguration
+
+	// IntentAutoModerationExecution
+	// - AUTO_MODERATION_ACTION_EXECUTION
+	IntentAutoModerationExecution
+
+	_
+	_
+
+	// IntentGuildMessagePolls
+	// - MESSAGE_POLL_VOTE_ADD
+	// - MESSAGE_POLL_VOTE_REMOVE
+	IntentGuildMessagePolls
+
+	// IntentDirectMessagePolls
+	// - MESSAGE_POLL_VOTE_ADD
+	// - MESSAGE_POLL_VOTE_REMOVE
+	IntentDirectMessagePolls
 )
 
 func intentName(intent Intent) string {
@@ -103,6 +153,18 @@ func intentName(intent Intent) string {
 		return "DirectMessageReactions"
 	case IntentDirectMessageTyping:
 		return "DirectMessageTyping"
+	case IntentMessageContent:
+		return "MessageContent"
+	case IntentGuildScheduledEvents:
+		return "GuildScheduledEvents"
+	case IntentAutoModerationConfiguration:
+		return "AutoModerationConfiguration"
+	case IntentAutoModerationExecution:
+		return "AutoModerationExecution"
+	case IntentGuildMessagePolls:
+		return "GuildMessagePolls"
+	case IntentDirectMessagePolls:
+		return "DirectMessagePolls"
 	default:
 		return ""
 	}
@@ -131,6 +193,10 @@ func EventToIntent(evt string, direct bool) Intent {
 		// 	intent = IntentDirectMessageReactions
 		case event.TypingStart:
 			intent = IntentDirectMessageTyping
+		case event.MessagePollVoteAdd:
+			intent = IntentDirectMessagePolls
+		case event.MessagePollVoteRemove:
+			intent = IntentDirectMessagePolls
 		}
 	} else {
 		switch evt {
@@ -154,6 +220,24 @@ func EventToIntent(evt string, direct bool) Intent {
 			intent = IntentGuilds
 		case event.ChannelPinsUpdate:
 			intent = IntentGuilds
+		case event.ThreadCreate:
+			intent = IntentGuilds
+		case event.ThreadUpdate:
+			intent = IntentGuilds
+		case event.ThreadDelete:
+			intent = IntentGuilds
+		case event.ThreadListSync:
+			intent = IntentGuilds
+		case event.ThreadMemberUpdate:
+			intent = IntentGuilds
+		case event.ThreadMembersUpdate:
+			intent = IntentGuilds
+		case event.StageInstanceCreate:
+			intent = IntentGuilds
+		case event.StageInstanceUpdate:
+			intent = IntentGuilds
+		case event.StageInstanceDelete:
+			intent = IntentGuilds
 		case event.GuildMemberAdd:
 			intent = IntentGuildMembers
 		case event.GuildMemberUpdate:
@@ -166,6 +250,8 @@ func EventToIntent(evt string, direct bool) Intent {
 			intent = IntentGuildBans
 		case event.GuildEmojisUpdate:
 			intent = IntentGuildEmojis
+		case event.GuildStickersUpdate:
+			intent = IntentGuildEmojis
 		case event.GuildIntegrationsUpdate:
 			intent = IntentGuildIntegrations
 		case event.WebhooksUpdate:
@@ -196,6 +282,28 @@ func EventToIntent(evt string, direct bool) Intent {
 			intent = IntentGuildMessageReactions
 		case event.TypingStart:
 			intent = IntentGuildMessageTyping
+		case event.GuildScheduledEventCreate:
+			intent = IntentGuildScheduledEvents
+		case event.GuildScheduledEventUpdate:
+			intent = IntentGuildScheduledEvents
+		case event.GuildScheduledEventDelete:
+			intent = IntentGuildScheduledEvents
+		case event.GuildScheduledEventUserAdd:
+			intent = IntentGuildScheduledEvents
+		case event.GuildScheduledEventUserRemove:
+			intent = IntentGuildScheduledEvents
+		case event.AutoModerationRuleCreate:
+			intent = IntentAutoModerationConfiguration
+		case event.AutoModerationRuleUpdate:
+			intent = IntentAutoModerationConfiguration
+		case event.AutoModerationRuleDelete:
+			intent = IntentAutoModerationConfiguration
+		case event.AutoModerationActionExecution:
+			intent = IntentAutoModerationExecution
+		case event.MessagePollVoteAdd:
+			intent = IntentGuildMessagePolls
+		case event.MessagePollVoteRemove:
+			intent = IntentGuildMessagePolls
 		}
 	}
 
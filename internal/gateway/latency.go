@@ -0,0 +1,39 @@
+package gateway
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyMonitor notifies the listener when the heartbeat latency of a shard goes above, or
+// falls back below, the threshold.
+type latencyMonitor struct {
+	sync.Mutex
+	shardID   uint
+	threshold time.Duration
+	listener  func(shardID uint, latency time.Duration, exceeded bool)
+	exceeded  bool
+}
+
+func newLatencyMonitor(shardID uint, conf *EvtConfig) *latencyMonitor {
+	if conf.OnLatencyChange == nil || conf.LatencyThreshold <= 0 {
+		return nil
+	}
+	return &latencyMonitor{
+		shardID:   shardID,
+		threshold: conf.LatencyThreshold,
+		listener:  conf.OnLatencyChange,
+	}
+}
+
+func (m *latencyMonitor) update(latency time.Duration) {
+	m.Lock()
+	exceeded := latency > m.threshold
+	changed := exceeded != m.exceeded
+	m.exceeded = exceeded
+	m.Unlock()
+
+	if changed {
+		m.listener(m.shardID, latency, exceeded)
+	}
+}
@@ -0,0 +1,39 @@
+// +build !integration
+
+package gateway
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLatencyMonitor(t *testing.T) {
+	if newLatencyMonitor(0, &EvtConfig{LatencyThreshold: time.Second}) != nil {
+		t.Error("expected no monitor without a listener")
+	}
+
+	var changes []bool
+	monitor := newLatencyMonitor(3, &EvtConfig{
+		LatencyThreshold: 100 * time.Millisecond,
+		OnLatencyChange: func(shardID uint, latency time.Duration, exceeded bool) {
+			if shardID != 3 {
+				t.Errorf("expected shard id 3, got %d", shardID)
+			}
+			changes = append(changes, exceeded)
+		},
+	})
+
+	for _, latency := range []time.Duration{50, 200, 300, 80, 90, 150} {
+		monitor.update(latency * time.Millisecond)
+	}
+
+	expected := []bool{true, false, true}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d notifications, got %d", len(expected), len(changes))
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("notification %d: expected exceeded=%t", i, expected[i])
+		}
+	}
+}
@@ -80,13 +80,43 @@ type VoiceSelectProtocolParams struct {
 type VoiceSessionDescription struct {
 	Mode      string   `json:"mode"`
 	SecretKey [32]byte `json:"secret_key"`
+
+	// DAVEProtocolVersion is the end-to-end encryption protocol version negotiated for the session,
+	// 0 means that end-to-end encryption is not used.
+	DAVEProtocolVersion uint `json:"dave_protocol_version"`
+}
+
+// VoiceSpeaking is sent by Discord when a user starts or stops speaking. It is the only way to tell which
+// user an incoming RTP packet (SSRC) belongs to.
+type VoiceSpeaking struct {
+	UserID   Snowflake `json:"user_id"`
+	SSRC     uint32    `json:"ssrc"`
+	Speaking uint      `json:"speaking"`
+}
+
+// VoiceClientDisconnect is sent by Discord when a user leaves the voice channel.
+type VoiceClientDisconnect struct {
+	UserID Snowflake `json:"user_id"`
 }
 
 type voiceIdentify struct {
-	GuildID   Snowflake `json:"server_id"` // Yay for inconsistency
-	UserID    Snowflake `json:"user_id"`
+	GuildID                Snowflake `json:"server_id"` // Yay for inconsistency
+	UserID                 Snowflake `json:"user_id"`
+	SessionID              string    `json:"session_id"`
+	Token                  string    `json:"token"`
+	MaxDAVEProtocolVersion uint      `json:"max_dave_protocol_version"`
+}
+
+type voiceResume struct {
+	GuildID   Snowflake `json:"server_id"`
 	SessionID string    `json:"session_id"`
 	Token     string    `json:"token"`
+	SeqAck    int64     `json:"seq_ack"`
+}
+
+type voiceHeartbeat struct {
+	Nonce  uint64 `json:"t"`
+	SeqAck int64  `json:"seq_ack"`
 }
 
 //////////////////////////////////////////////////////
@@ -96,7 +126,8 @@ type voiceIdentify struct {
 //////////////////////////////////////////////////////
 
 type evtReadyPacket struct {
-	SessionID string `json:"session_id"`
+	SessionID        string `json:"session_id"`
+	ResumeGatewayURL string `json:"resume_gateway_url"`
 }
 
 type evtIdentity struct {
@@ -198,9 +229,10 @@ type GatewayBot struct {
 	Gateway
 	Shards            uint `json:"shards"`
 	SessionStartLimit struct {
-		Total      uint `json:"total"`
-		Remaining  uint `json:"remaining"`
-		ResetAfter uint `json:"reset_after"`
+		Total          uint `json:"total"`
+		Remaining      uint `json:"remaining"`
+		ResetAfter     uint `json:"reset_after"`
+		MaxConcurrency uint `json:"max_concurrency"`
 	} `json:"session_start_limit"`
 }
 
@@ -217,10 +249,11 @@ type helloPacket struct {
 
 // discordPacketJSON is used when we need to fall back on the unmarshaler logic
 type discordPacketJSON struct {
-	Op             opcode.OpCode `json:"op"`
-	Data           []byte        `json:"d"`
-	SequenceNumber uint32        `json:"s"`
-	EventName      string        `json:"t"`
+	Op                  opcode.OpCode `json:"op"`
+	Data                []byte        `json:"d"`
+	SequenceNumber      uint32        `json:"s"`
+	EventName           string        `json:"t"`
+	VoiceSequenceNumber int64         `json:"seq"`
 }
 
 func (p *discordPacketJSON) CopyOverTo(packet *DiscordPacket) {
@@ -228,6 +261,7 @@ func (p *discordPacketJSON) CopyOverTo(packet *DiscordPacket) {
 	packet.Data = p.Data
 	packet.SequenceNumber = p.SequenceNumber
 	packet.EventName = p.EventName
+	packet.VoiceSequenceNumber = p.VoiceSequenceNumber
 }
 
 // DiscordPacket is packets sent by Discord over the socket connection
@@ -236,11 +270,16 @@ type DiscordPacket struct {
 	Data           json.RawMessage `json:"d"`
 	SequenceNumber uint32          `json:"s,omitempty"`
 	EventName      string          `json:"t,omitempty"`
+
+	// VoiceSequenceNumber is only sent by the voice gateway, from version 8, and must be acknowledged
+	// in heartbeats and when resuming.
+	VoiceSequenceNumber int64 `json:"seq,omitempty"`
 }
 
 func (p *DiscordPacket) reset() {
 	p.Op = 0
 	p.SequenceNumber = 0
+	p.VoiceSequenceNumber = 0
 	// TODO: re-use data slice in unmarshal ?
 	p.Data = nil
 	p.EventName = ""
@@ -0,0 +1,134 @@
+package gateway
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WebsocketStatusResumable is the close code used when disconnecting with a session store. Closing with
+// 1000 or 1001 invalidates the session, any other code keeps it resumable.
+const WebsocketStatusResumable = 4000
+
+// Session holds what is needed to resume a gateway session.
+type Session struct {
+	ShardID    uint   `json:"shard_id"`
+	ShardCount uint   `json:"shard_count"`
+	SessionID  string `json:"session_id"`
+	ResumeURL  string `json:"resume_gateway_url"`
+	Sequence   uint32 `json:"seq"`
+}
+
+// SessionStore persists gateway sessions, such that a restarted bot can resume the sessions of its
+// shards instead of identifying again and receiving every guild create.
+//
+// Sessions are saved when READY is received, every few seconds while the sequence number advances, and
+// when disconnecting. They are loaded the first time a shard connects. Load must return a nil session when
+// there is none.
+type SessionStore interface {
+	Load(ctx context.Context, shardID uint) (*Session, error)
+	Save(ctx context.Context, session *Session) error
+}
+
+// sessionSaveInterval is how often the sequence number is saved while connected, such that a crashed
+// process resumes close to where it stopped instead of from READY.
+var sessionSaveInterval = 5 * time.Second
+
+// loadSession restores a stored session before the first connect. Sessions from a different number of
+// shards are ignored, as they can not be resumed.
+func (c *EvtClient) loadSession() {
+	store := c.evtConf.SessionStore
+	if store == nil || c.haveConnectedOnce.Load() || !c.virginConnection() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	session, err := store.Load(ctx, c.ShardID)
+	if err != nil {
+		c.log.Error(c.getLogPrefix(), "unable to load session:", err)
+		return
+	}
+	if session == nil || session.SessionID == "" || session.ShardCount != c.evtConf.ShardCount {
+		return
+	}
+
+	c.Lock()
+	c.sessionID = session.SessionID
+	c.resumeURL = session.ResumeURL
+	c.sequenceNumber.Store(session.Sequence)
+	c.Unlock()
+	c.log.Info(c.getLogPrefix(), "restored session, resuming from sequence", session.Sequence)
+}
+
+func (c *EvtClient) saveSession() {
+	store := c.evtConf.SessionStore
+	if store == nil {
+		return
+	}
+
+	// saves are done one at a time, such that an older sequence number never overwrites a newer one
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	c.RLock()
+	session := &Session{
+		ShardID:    c.ShardID,
+		ShardCount: c.evtConf.ShardCount,
+		SessionID:  c.sessionID,
+		ResumeURL:  c.resumeURL,
+		Sequence:   c.sequenceNumber.Load(),
+	}
+	c.RUnlock()
+	if session.SessionID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := store.Save(ctx, session); err != nil {
+		c.log.Error(c.getLogPrefix(), "unable to save session:", err)
+		return
+	}
+	c.savedSequence.Store(session.Sequence)
+}
+
+// persistSession saves the session while the sequence number advances, until the connection is closed.
+// The session is saved once more when the connection is lost, unless it was closed on purpose.
+func (c *EvtClient) persistSession(ctx context.Context) {
+	ticker := time.NewTicker(sessionSaveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if !c.requestedDisconnect.Load() && c.sequenceNumber.Load() != c.savedSequence.Load() {
+				c.saveSession()
+			}
+			return
+		case <-ticker.C:
+			if c.sequenceNumber.Load() != c.savedSequence.Load() {
+				c.saveSession()
+			}
+		}
+	}
+}
+
+// endpoint returns the resume gateway url, with the query of the configured endpoint, when there is a
+// session to resume.
+func (c *EvtClient) endpoint() string {
+	c.RLock()
+	resumeURL, sessionID := c.resumeURL, c.sessionID
+	c.RUnlock()
+	if resumeURL == "" || sessionID == "" || c.evtConf.IgnoreResumeURL {
+		return c.conf.Endpoint
+	}
+
+	u, err := url.Parse(c.conf.Endpoint)
+	if err != nil || u.RawQuery == "" {
+		return resumeURL
+	}
+	return strings.TrimSuffix(resumeURL, "/") + "/?" + u.RawQuery
+}
@@ -10,10 +10,21 @@ import (
 const DefaultIdentifyRateLimit = 1000
 
 func newShardSync(conf *ShardConfig, l logger.Logger, lPrefix string, shutdownChan chan interface{}) *shardSync {
+	maxConcurrency := conf.MaxConcurrency
+	if maxConcurrency == 0 {
+		maxConcurrency = 1
+	}
+
+	// every bucket has its own queue, such that identifies in different buckets can run in parallel
+	buckets := make([]chan *shardSyncQueueItem, maxConcurrency)
+	for i := range buckets {
+		buckets[i] = make(chan *shardSyncQueueItem, 100) // it's just pointers anyways
+	}
+
 	return &shardSync{
 		identifiesPer24H: conf.IdentifiesPer24H,
 		timeout:          conf.ShardRateLimit,
-		queue:            make(chan *shardSyncQueueItem, 100), // it's just pointers anyways
+		buckets:          buckets,
 		logger:           l,
 		lpre:             lPrefix,
 		shutdownChan:     shutdownChan,
@@ -32,7 +43,7 @@ type shardSync struct {
 
 	identifiesPer24H uint
 	timeout          time.Duration
-	queue            chan *shardSyncQueueItem
+	buckets          []chan *shardSyncQueueItem
 	logger           logger.Logger
 	lpre             string
 	shutdownChan     chan interface{}
@@ -47,8 +58,9 @@ func (s *shardSync) queueShard(shardID uint, cb func() error) (err error) {
 
 	start := time.Now()
 
-	s.logger.Debug(s.lpre, "shard", shardID, "is waiting to identify")
-	s.queue <- &shardSyncQueueItem{
+	bucket := shardID % uint(len(s.buckets))
+	s.logger.Debug(s.lpre, "shard", shardID, "is waiting to identify in bucket", bucket)
+	s.buckets[bucket] <- &shardSyncQueueItem{
 		ShardID: shardID,
 		run:     cb,
 		errChan: errChan,
@@ -63,7 +75,22 @@ func (s *shardSync) queueShard(shardID uint, cb func() error) (err error) {
 	return err
 }
 
+// process handles the identify requests of every bucket until shutdown.
 func (s *shardSync) process() {
+	wg := sync.WaitGroup{}
+	for i := range s.buckets {
+		wg.Add(1)
+		go func(queue chan *shardSyncQueueItem) {
+			defer wg.Done()
+			s.processBucket(queue)
+		}(s.buckets[i])
+	}
+	wg.Wait()
+}
+
+// processBucket runs one identify at the time for the given bucket queue. The 24h identify
+// limit is shared across all the buckets.
+func (s *shardSync) processBucket(queue chan *shardSyncQueueItem) {
 	for {
 		var item *shardSyncQueueItem
 		var open bool
@@ -73,7 +100,7 @@ func (s *shardSync) process() {
 		case <-s.shutdownChan:
 			s.logger.Debug(s.lpre, "shard identify-rate-limiter got shutdown signal")
 			return
-		case item, open = <-s.queue:
+		case item, open = <-queue:
 			if !open {
 				s.logger.Error(s.lpre, "queue unexpectly closed - shards can no longer identify")
 				return
@@ -89,16 +116,8 @@ func (s *shardSync) process() {
 			continue
 		}
 
-		s.metric.Lock()
-		s.metric.Reconnects = append(s.metric.Reconnects, time.Now())
-		s.metric.Unlock()
-
 		// 1000 identify / 24 hours rate limit check
-		if s.metric.ReconnectsSince(24*time.Hour) > (s.identifiesPer24H - 1) {
-			s.metric.Lock()
-			oldest := s.metric.Reconnects[len(s.metric.Reconnects)-int(s.identifiesPer24H)]
-			s.metric.Unlock()
-
+		if oldest, limited := s.registerIdentify(); limited {
 			penalty = (24 * time.Hour) - time.Since(oldest)
 			s.logger.Info(s.lpre, "shard identifying hit 1k rate limit and connections are halted for", penalty)
 		}
@@ -111,3 +130,22 @@ func (s *shardSync) process() {
 		}
 	}
 }
+
+// registerIdentify records a successful identify. If the 24h identify limit has been reached, the
+// time of the oldest identify within the limit is returned.
+func (s *shardSync) registerIdentify() (oldest time.Time, limited bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.metric.Lock()
+	s.metric.Reconnects = append(s.metric.Reconnects, time.Now())
+	s.metric.Unlock()
+
+	if s.metric.ReconnectsSince(24*time.Hour) > (s.identifiesPer24H - 1) {
+		s.metric.Lock()
+		oldest = s.metric.Reconnects[len(s.metric.Reconnects)-int(s.identifiesPer24H)]
+		s.metric.Unlock()
+		return oldest, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Environment variables used as defaults for the ShardConfig, see ShardConfig.IgnoreEnv.
+const (
+	// EnvShardIDs holds the shard ids of the process, as a comma separated list of ids and ranges. eg. "0-3,8"
+	EnvShardIDs = "DISGORD_SHARD_ID"
+
+	// EnvShardCount holds the total number of shards across every process.
+	EnvShardCount = "DISGORD_SHARD_COUNT"
+
+	// EnvReplica holds the index of the process, or a name ending with the index such as the hostname of a
+	// Kubernetes StatefulSet pod, eg. "bot-2".
+	EnvReplica = "DISGORD_REPLICA"
+
+	// EnvReplicas holds the number of processes.
+	EnvReplicas = "DISGORD_REPLICAS"
+)
+
+var getenv = os.Getenv
+
+// applyShardEnv populates the shard distribution from the environment variables, unless it is configured
+// in code.
+func applyShardEnv(conf *ShardConfig) (err error) {
+	if conf.IgnoreEnv || len(conf.ShardIDs) > 0 || conf.ShardCount > 0 || conf.Replicas > 0 || conf.Coordinator != nil {
+		return nil
+	}
+
+	if v := getenv(EnvShardIDs); v != "" {
+		if conf.ShardIDs, err = parseShardIDs(v); err != nil {
+			return fmt.Errorf("%s: %w", EnvShardIDs, err)
+		}
+	}
+	if v := getenv(EnvShardCount); v != "" {
+		if conf.ShardCount, err = parseUint(v); err != nil {
+			return fmt.Errorf("%s: %w", EnvShardCount, err)
+		}
+	}
+	if v := getenv(EnvReplicas); v != "" {
+		if conf.Replicas, err = parseUint(v); err != nil {
+			return fmt.Errorf("%s: %w", EnvReplicas, err)
+		}
+	}
+	if v := getenv(EnvReplica); v != "" {
+		// the hostname of a StatefulSet pod ends with its ordinal
+		if i := strings.LastIndex(v, "-"); i >= 0 {
+			v = v[i+1:]
+		}
+		if conf.Replica, err = parseUint(v); err != nil {
+			return fmt.Errorf("%s: %w", EnvReplica, err)
+		}
+	}
+	return nil
+}
+
+// replicaShardIDs divides the shards between the replicas, such that replica N owns the shards where
+// shard_id % replicas == N.
+func replicaShardIDs(replica, replicas, shardCount uint) ([]uint, error) {
+	if replica >= replicas {
+		return nil, fmt.Errorf("replica %d must be lower than the number of replicas, %d", replica, replicas)
+	}
+
+	var ids []uint
+	for id := replica; id < shardCount; id += replicas {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("replica %d has no shards, as there are only %d shards", replica, shardCount)
+	}
+	return ids, nil
+}
+
+// parseShardIDs parses a comma separated list of shard ids and ranges, eg. "0-3,8".
+func parseShardIDs(s string) (ids []uint, err error) {
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		from, to := part, part
+		if i := strings.Index(part, "-"); i > 0 {
+			from, to = part[:i], part[i+1:]
+		}
+
+		var first, last uint
+		if first, err = parseUint(from); err != nil {
+			return nil, err
+		}
+		if last, err = parseUint(to); err != nil {
+			return nil, err
+		}
+		if last < first {
+			return nil, errors.New("invalid shard range " + part)
+		}
+		for id := first; id <= last; id++ {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func parseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	return uint(v), err
+}
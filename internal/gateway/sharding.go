@@ -15,6 +15,7 @@ import (
 
 const defaultShardRateLimit time.Duration = 5*time.Second + 100*time.Millisecond
 const discordErrShardScalingRequired = 4011
+const discordErrDisallowedIntents = 4014
 
 type shardID = uint
 
@@ -28,15 +29,49 @@ func GetShardForGuildID(guildID Snowflake, shardCount uint) (shardID uint) {
 }
 
 func ConfigureShardConfig(ctx context.Context, client GatewayBotGetter, conf *ShardConfig) error {
-	if len(conf.ShardIDs) == 0 && conf.ShardCount != 0 {
+	if err := applyShardEnv(conf); err != nil {
+		return err
+	}
+
+	if len(conf.ShardIDs) == 0 && conf.ShardCount != 0 && conf.Coordinator == nil && conf.Replicas == 0 && !conf.DisableGatewayBotRequest {
 		return errors.New("ShardCount should only be set when you use distributed bots and have set the ShardIDs field - ShardCount is an optional field")
 	}
 
-	data, err := client.GetGatewayBot(ctx)
-	if err != nil {
+	var data *GatewayBot
+	var err error
+	if conf.DisableGatewayBotRequest {
+		if conf.URL == "" {
+			return errors.New("URL must be set when DisableGatewayBotRequest is true")
+		}
+
+		// there is no recommended number of shards to scale to
+		conf.DisableAutoScaling = true
+		data = &GatewayBot{Gateway: Gateway{URL: conf.URL}, Shards: conf.ShardCount}
+		if data.Shards == 0 {
+			data.Shards = 1
+		}
+	} else if data, err = client.GetGatewayBot(ctx); err != nil {
 		return err
 	}
 
+	if len(conf.ShardIDs) == 0 && conf.Replicas > 0 {
+		if conf.ShardCount == 0 {
+			conf.ShardCount = data.Shards
+		}
+		if conf.ShardIDs, err = replicaShardIDs(conf.Replica, conf.Replicas, conf.ShardCount); err != nil {
+			return err
+		}
+	}
+
+	if len(conf.ShardIDs) == 0 && conf.Coordinator != nil {
+		if conf.ShardCount == 0 {
+			conf.ShardCount = data.Shards
+		}
+		if err = claimShards(ctx, conf.Coordinator, conf); err != nil {
+			return fmt.Errorf("unable to claim shards: %w", err)
+		}
+	}
+
 	if len(conf.ShardIDs) > 0 || conf.ShardCount > 0 {
 		conf.DisableAutoScaling = true
 	}
@@ -62,6 +97,13 @@ func ConfigureShardConfig(ctx context.Context, client GatewayBotGetter, conf *Sh
 		conf.ShardRateLimit = defaultShardRateLimit
 	}
 
+	if conf.MaxConcurrency == 0 {
+		conf.MaxConcurrency = data.SessionStartLimit.MaxConcurrency
+	}
+	if conf.MaxConcurrency == 0 {
+		conf.MaxConcurrency = 1
+	}
+
 	return nil
 }
 
@@ -83,6 +125,9 @@ func NewShardMngr(conf ShardManagerConfig) *shardMngr {
 	} else {
 		mngr.connectQueue = conf.ConnectQueue
 	}
+	if conf.Coordinator != nil {
+		go mngr.coordinate()
+	}
 
 	return mngr
 }
@@ -91,6 +136,8 @@ func NewShardMngr(conf ShardManagerConfig) *shardMngr {
 type ShardManager interface {
 	Connect() error
 	Disconnect() error
+	DisconnectResumable() error
+	UpdateToken(token string) error
 	Emit(string, CmdPayload) (unhandledGuildIDs []Snowflake, err error)
 	LocalShardCount() uint
 	ShardCount() uint
@@ -120,6 +167,20 @@ type ShardConfig struct {
 	// defaults to len(shardIDs) if 0
 	ShardCount uint
 
+	// Replica and Replicas divide the shards evenly between processes when ShardIDs is empty, such that
+	// each replica of a Kubernetes StatefulSet can own a slice of the shards. Replica N runs the shards
+	// where shard_id % Replicas == N. Every replica must agree on the ShardCount, which is the recommended
+	// number of shards from Discord if 0.
+	Replica  uint
+	Replicas uint
+
+	// IgnoreEnv disables the environment variables DISGORD_SHARD_ID, DISGORD_SHARD_COUNT, DISGORD_REPLICA
+	// and DISGORD_REPLICAS. These are used as defaults for ShardIDs, ShardCount, Replica and Replicas,
+	// unless any of those or the Coordinator are set.
+	//  DISGORD_SHARD_ID=0-3,8 DISGORD_SHARD_COUNT=16
+	//  DISGORD_REPLICA=$(HOSTNAME) DISGORD_REPLICAS=4
+	IgnoreEnv bool
+
 	// Large bots only. If Discord did not give you a custom rate limit, do not touch this.
 	ShardRateLimit time.Duration
 
@@ -128,6 +189,16 @@ type ShardConfig struct {
 	// every five seconds. The default implementation can be found in shard_sync.go.
 	ConnectQueue connectQueue
 
+	// SessionStore persists the shard sessions, such that they can be resumed after a restart instead
+	// of identifying again. Disconnecting keeps the sessions resumable when set.
+	SessionStore SessionStore
+
+	// Coordinator divides the shards between multiple processes of the same bot. When set, and the
+	// ShardIDs field is empty, the shards to run are claimed from the coordinator before connecting.
+	// ShardCount can be set to fix the total number of shards across processes, otherwise the
+	// recommended number of shards from Discord is used.
+	Coordinator ShardCoordinator
+
 	// DisableAutoScaling is triggered when at least one shard gets a 4011 websocket
 	// error from Discord. This causes all the shards to disconnect and new ones are created.
 	//
@@ -154,14 +225,36 @@ type ShardConfig struct {
 	// TODO: return a list of outgoing requests instead such that people can re-trigger these on other instances.
 	OnScalingDiscardedRequests func(unhandledGuildIDs []Snowflake)
 
+	// LatencyThreshold is the heartbeat latency at which OnLatencyChange is triggered. Note that a
+	// heartbeat is usually sent every 40 seconds.
+	LatencyThreshold time.Duration
+
+	// OnLatencyChange is called when the heartbeat latency of a shard goes above LatencyThreshold, and
+	// again when it falls back below, such that alerts and health checks can be driven by the gateway
+	// round trip time. Both LatencyThreshold and OnLatencyChange must be set.
+	OnLatencyChange func(shardID uint, latency time.Duration, exceeded bool)
+
 	// IdentifiesPer24H regards how many identify packets a bot can send per a 24h period. Normally this
 	// is 1000, but in some cases discord might allow you to increase it.
 	//
 	// Setting it to 0 will default it to 1000.
 	IdentifiesPer24H uint
 
-	// URL is fetched from the gateway before initialising a connection
+	// MaxConcurrency is the number of identify buckets, where each bucket allows one identify per
+	// ShardRateLimit. A shard belongs to the bucket shard_id % MaxConcurrency, and the buckets
+	// identify in parallel.
+	//
+	// Fetched from the gateway if 0, which is 1 for most bots.
+	MaxConcurrency uint
+
+	// URL is fetched from the gateway before initialising a connection, unless specified. Set it to
+	// connect through a gateway proxy.
 	URL string
+
+	// DisableGatewayBotRequest skips the GET /gateway/bot request before connecting, for when the
+	// connection goes through a gateway proxy that holds the sessions. URL must be set, and the
+	// number of shards defaults to ShardCount, or 1. Auto scaling is disabled.
+	DisableGatewayBotRequest bool
 }
 
 // ShardManagerConfig all fields, except proxy.Dialer, is required
@@ -174,9 +267,17 @@ type ShardManagerConfig struct {
 	ShutdownChan chan interface{}
 	conn         Conn
 
+	// NewWebsocket creates the websocket implementation for every shard, defaults to nhooyr.io/websocket
+	NewWebsocket func() WebsocketConn
+
 	// ...
 	IgnoreEvents []string
 	Intents      Intent
+	ExactIntents bool // see EvtConfig.ExactIntents
+
+	// OnDisallowedIntents is called when discord closes a shard as the application is not approved for
+	// the privileged intents that were sent. The shard does not reconnect.
+	OnDisallowedIntents func(reason string)
 
 	// sync ---
 	EventChan chan<- *Event
@@ -186,6 +287,9 @@ type ShardManagerConfig struct {
 	// user specific
 	DefaultBotPresence *UpdateStatusPayload
 	ProjectName        string
+
+	// Compress enables zlib-stream transport compression
+	Compress bool
 }
 
 type shardMngr struct {
@@ -201,6 +305,14 @@ type shardMngr struct {
 var _ ShardManager = (*shardMngr)(nil)
 
 func (s *shardMngr) initShards() error {
+	var err error
+	endpoint := s.conf.URL
+	if s.conf.Compress {
+		if endpoint, err = setEndpointQuery(endpoint, "compress", zlibStreamCompression); err != nil {
+			return err
+		}
+	}
+
 	baseConfig := EvtConfig{ // TODO: not nicely grouped, feel free to adjust
 		// identity
 		Browser:             s.conf.DisgordInfo,
@@ -212,10 +324,11 @@ func (s *shardMngr) initShards() error {
 		// lib specific
 		Version:        constant.DiscordVersion,
 		Encoding:       constant.JSONEncoding,
-		Endpoint:       s.conf.URL,
+		Endpoint:       endpoint,
 		Logger:         s.conf.Logger,
 		IgnoreEvents:   s.conf.IgnoreEvents,
 		Intents:        s.conf.Intents,
+		ExactIntents:   s.conf.ExactIntents,
 		DiscordPktPool: s.DiscordPktPool,
 
 		// synchronization
@@ -229,6 +342,9 @@ func (s *shardMngr) initShards() error {
 		// other
 		SystemShutdown: s.conf.ShutdownChan,
 		discordErrListener: func(code int, reason string) {
+			if code == discordErrDisallowedIntents && s.conf.OnDisallowedIntents != nil {
+				s.conf.OnDisallowedIntents(reason)
+			}
 			if code != discordErrShardScalingRequired {
 				return
 			}
@@ -258,7 +374,13 @@ func (s *shardMngr) initShards() error {
 				s.conf.Logger.Info("scaling", "connected")
 			}
 		},
-		conn: s.conf.conn,
+		conn:            s.conf.conn,
+		NewWebsocket:    s.conf.NewWebsocket,
+		SessionStore:    s.conf.SessionStore,
+		IgnoreResumeURL: s.conf.DisableGatewayBotRequest,
+
+		LatencyThreshold: s.conf.LatencyThreshold,
+		OnLatencyChange:  s.conf.OnLatencyChange,
 	}
 
 	for _, id := range s.conf.ShardIDs {
@@ -292,12 +414,19 @@ func (s *shardMngr) Connect() (err error) {
 		}
 	}
 
+	// shards are connected in parallel, the connect queue decides how many can identify at once
+	wg := sync.WaitGroup{}
 	for _, shard := range s.shards {
-		err := shard.reconnectLoop()
-		if err != nil {
-			s.conf.Logger.Error(err)
-		}
+		shard.requestedDisconnect.Store(false)
+		wg.Add(1)
+		go func(shard *EvtClient) {
+			defer wg.Done()
+			if err := shard.reconnectLoop(); err != nil {
+				s.conf.Logger.Error(err)
+			}
+		}(shard)
 	}
+	wg.Wait()
 	return nil
 }
 func (s *shardMngr) Disconnect() error {
@@ -318,6 +447,41 @@ func (s *shardMngr) Disconnect() error {
 	return nil
 }
 
+// UpdateToken replaces the bot token, and reconnects the shards such that they identify with the new token.
+// The sessions of the old token are not resumed.
+func (s *shardMngr) UpdateToken(token string) error {
+	s.mu.Lock()
+	s.conf.BotToken = token
+	for _, shard := range s.shards {
+		shard.setToken(token)
+
+		// a normal close ends the session, and the stored session must not be loaded again
+		if err := shard.client.Disconnect(); err != nil {
+			s.conf.Logger.Error("Disconnect error (trivial):", err)
+		}
+		shard.Lock()
+		shard.sessionID = ""
+		shard.Unlock()
+		shard.sequenceNumber.Store(0)
+	}
+	s.mu.Unlock()
+
+	return s.Connect()
+}
+
+// DisconnectResumable closes the shards without invalidating their sessions, see EvtClient.DisconnectResumable.
+func (s *shardMngr) DisconnectResumable() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, shard := range s.shards {
+		if err := shard.DisconnectResumable(); err != nil {
+			s.conf.Logger.Error("Disconnect error (trivial):", err)
+		}
+	}
+	return nil
+}
+
 func (s *shardMngr) LocalShardCount() uint {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -4,6 +4,7 @@ package gateway
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -208,7 +209,7 @@ func TestIdentifyRateLimiting(t *testing.T) {
 		t.Fatal("should not be able to connect")
 	case <-time.After(100 * time.Millisecond): // TODO: remove timeout, just don't know how yet
 		select {
-		case item, ok := <-mngr.sync.queue:
+		case item, ok := <-mngr.sync.buckets[0]:
 			if !ok {
 				t.Fatal("queue was closed somehow")
 			}
@@ -219,3 +220,180 @@ func TestIdentifyRateLimiting(t *testing.T) {
 		}
 	}
 }
+
+func TestIdentifyConcurrency(t *testing.T) {
+	shutdown := make(chan interface{})
+	defer close(shutdown)
+
+	conf := &ShardConfig{
+		ShardRateLimit:   time.Hour,
+		IdentifiesPer24H: DefaultIdentifyRateLimit,
+		MaxConcurrency:   2,
+	}
+	s := newShardSync(conf, &logger.Empty{}, "", shutdown)
+	go s.process()
+
+	identified := make(chan uint, 3)
+	for _, id := range []uint{0, 1, 2} {
+		go s.queueShard(id, func(id uint) func() error {
+			return func() error {
+				identified <- id
+				return nil
+			}
+		}(id))
+		time.Sleep(10 * time.Millisecond) // keep the queue order predictable
+	}
+
+	buckets := map[uint]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case id := <-identified:
+			buckets[id%conf.MaxConcurrency] = true
+		case <-time.After(time.Second):
+			t.Fatal("shards in different buckets should identify in parallel")
+		}
+	}
+	if len(buckets) != 2 {
+		t.Errorf("expected one identify per bucket. Got %v", buckets)
+	}
+
+	select {
+	case id := <-identified:
+		t.Errorf("shard %d identified before the rate limit of its bucket", id)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+type shardCoordinatorMock struct {
+	shardIDs []uint
+	count    uint
+}
+
+func (c *shardCoordinatorMock) Claim(_ context.Context, shardCount uint) ([]uint, error) {
+	c.count = shardCount
+	return c.shardIDs, nil
+}
+
+func (c *shardCoordinatorMock) ReportHealth(_ context.Context, _ []ShardHealth) error {
+	return nil
+}
+
+func (c *shardCoordinatorMock) Release(_ context.Context, _ []uint) error {
+	return nil
+}
+
+func TestConfigureShardConfig_Coordinator(t *testing.T) {
+	mock := &GatewayBotGetterMock{
+		get: func() (gateway *GatewayBot, err error) {
+			return &GatewayBot{Shards: 4, Gateway: Gateway{"localhost:6060"}}, nil
+		},
+	}
+
+	coordinator := &shardCoordinatorMock{shardIDs: []uint{2, 3}}
+	conf := ShardConfig{Coordinator: coordinator}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if coordinator.count != 4 || conf.ShardCount != 4 {
+		t.Errorf("expected the recommended shard count to be used. Got %d", coordinator.count)
+	}
+	if len(conf.ShardIDs) != 2 || conf.ShardIDs[0] != 2 || conf.ShardIDs[1] != 3 {
+		t.Errorf("expected the claimed shard ids. Got %v", conf.ShardIDs)
+	}
+	if !conf.DisableAutoScaling {
+		t.Error("DisableAutoScaling should be true")
+	}
+
+	coordinator = &shardCoordinatorMock{shardIDs: []uint{7}}
+	conf = ShardConfig{Coordinator: coordinator, ShardCount: 6}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err == nil {
+		t.Error("expected an error for shard ids outside the shard count")
+	}
+}
+
+func TestConfigureShardConfig_DisableGatewayBotRequest(t *testing.T) {
+	mock := &GatewayBotGetterMock{
+		get: func() (gateway *GatewayBot, err error) {
+			t.Fatal("the gateway bot endpoint should not be requested")
+			return nil, nil
+		},
+	}
+
+	conf := ShardConfig{DisableGatewayBotRequest: true}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err == nil {
+		t.Error("expected an error when the URL is missing")
+	}
+
+	conf = ShardConfig{DisableGatewayBotRequest: true, URL: "ws://proxy:7878", ShardCount: 2}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.URL != "ws://proxy:7878" {
+		t.Errorf("expected the proxy url. Got %s", conf.URL)
+	}
+	if len(conf.ShardIDs) != 2 || conf.ShardCount != 2 || conf.MaxConcurrency != 1 {
+		t.Errorf("incorrect shard config. Got %+v", conf)
+	}
+	if !conf.DisableAutoScaling {
+		t.Error("DisableAutoScaling should be true")
+	}
+}
+
+func TestConfigureShardConfig_Replicas(t *testing.T) {
+	mock := &GatewayBotGetterMock{
+		get: func() (gateway *GatewayBot, err error) {
+			return &GatewayBot{Shards: 10, Gateway: Gateway{"localhost:6060"}}, nil
+		},
+	}
+
+	conf := ShardConfig{Replica: 1, Replicas: 4}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.ShardCount != 10 || len(conf.ShardIDs) != 3 || conf.ShardIDs[0] != 1 || conf.ShardIDs[2] != 9 {
+		t.Errorf("expected every fourth shard of the recommended count. Got %d, %v", conf.ShardCount, conf.ShardIDs)
+	}
+	if !conf.DisableAutoScaling {
+		t.Error("DisableAutoScaling should be true")
+	}
+
+	conf = ShardConfig{Replica: 4, Replicas: 4, ShardCount: 16}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err == nil {
+		t.Error("expected an error for a replica outside the number of replicas")
+	}
+
+	env := map[string]string{
+		EnvShardIDs:   "0-2, 8",
+		EnvShardCount: "16",
+	}
+	getenv = func(key string) string { return env[key] }
+	defer func() { getenv = os.Getenv }()
+
+	conf = ShardConfig{}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if conf.ShardCount != 16 || len(conf.ShardIDs) != 4 || conf.ShardIDs[3] != 8 {
+		t.Errorf("expected the shards from the environment. Got %d, %v", conf.ShardCount, conf.ShardIDs)
+	}
+
+	env = map[string]string{
+		EnvReplica:  "bot-2",
+		EnvReplicas: "3",
+	}
+	conf = ShardConfig{}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.ShardIDs) != 3 || conf.ShardIDs[0] != 2 || conf.ShardIDs[2] != 8 {
+		t.Errorf("expected the shards of the pod ordinal. Got %v", conf.ShardIDs)
+	}
+
+	conf = ShardConfig{IgnoreEnv: true}
+	if err := ConfigureShardConfig(context.Background(), mock, &conf); err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.ShardIDs) != 10 {
+		t.Errorf("expected the environment to be ignored. Got %v", conf.ShardIDs)
+	}
+}
@@ -3,7 +3,6 @@ package gateway
 import (
 	"context"
 	"errors"
-	"math/rand"
 	"net/http"
 	"strconv"
 	"sync"
@@ -39,9 +38,31 @@ type VoiceConfig struct {
 	// MessageQueueLimit number of outgoing messages that can be queued and sent correctly.
 	MessageQueueLimit uint
 
+	// MaxDAVEProtocolVersion is the highest end-to-end encryption (DAVE) protocol version supported.
+	// Disgord does not implement DAVE, so this should be left at 0 unless the audio is handled elsewhere.
+	MaxDAVEProtocolVersion uint
+
 	Logger logger.Logger
 
+	// NewWebsocket creates the websocket implementation, defaults to nhooyr.io/websocket
+	NewWebsocket func() WebsocketConn
+
 	SystemShutdown chan interface{}
+
+	// OnSpeaking is called whenever a user starts or stops speaking in the voice channel
+	OnSpeaking func(speaking *VoiceSpeaking)
+
+	// OnClientDisconnect is called whenever a user disconnects from the voice channel
+	OnClientDisconnect func(disconnect *VoiceClientDisconnect)
+
+	// OnResumed is called when the session was resumed after the connection was lost, such as when
+	// the voice server crashed (4015).
+	OnResumed func()
+
+	// OnDisconnect is called with the close code when Discord closes the connection and the session can not be
+	// resumed. Such as when the voice server is changed or the bot was kicked (4014), or the session is no
+	// longer valid (4006). The voice client will not try to reconnect on its own.
+	OnDisconnect func(code int)
 }
 
 func (conf *VoiceConfig) validate() {
@@ -56,6 +77,9 @@ type VoiceClient struct {
 
 	haveIdentifiedOnce atomic.Bool
 
+	// seqAck is the last sequence number received from Discord, -1 when nothing has been received yet
+	seqAck atomic.Int64
+
 	active         chan interface{}
 	SystemShutdown chan interface{}
 }
@@ -66,10 +90,12 @@ func NewVoiceClient(conf *VoiceConfig) (client *VoiceClient, err error) {
 	client = &VoiceClient{
 		conf: conf,
 	}
+	client.seqAck.Store(-1)
 	client.client, err = newClient(0, &config{
-		Logger:     conf.Logger,
-		Endpoint:   conf.Endpoint,
-		HTTPClient: conf.HTTPClient,
+		Logger:       conf.Logger,
+		Endpoint:     conf.Endpoint,
+		HTTPClient:   conf.HTTPClient,
+		NewWebsocket: conf.NewWebsocket,
 		DiscordPktPool: &sync.Pool{
 			New: func() interface{} {
 				return &DiscordPacket{}
@@ -104,25 +130,29 @@ func (c *VoiceClient) setupBehaviors() {
 
 	// operation handlers
 	// we manually link event methods instead of using reflection
+	operations := behaviorActions{
+		opcode.VoiceReady:              c.onReady,
+		opcode.VoiceResumed:            c.onResumed,
+		opcode.VoiceHeartbeat:          c.onHeartbeatRequest,
+		opcode.VoiceHeartbeatAck:       c.onHeartbeatAck,
+		opcode.VoiceHello:              c.onHello,
+		opcode.VoiceSessionDescription: c.onVoiceSessionDescription,
+		opcode.VoiceSpeaking:           c.onSpeaking,
+		opcode.VoiceClientDisconnect:   c.onClientDisconnect,
+
+		// undocumented operations
+		opcode.OpCode(10): nop,
+		opcode.OpCode(11): nop,
+		opcode.OpCode(12): nop,
+		opcode.OpCode(14): nop,
+		opcode.OpCode(15): nop,
+	}
+	for op, action := range operations {
+		operations[op] = c.acknowledgeSequence(action)
+	}
 	c.addBehavior(&behavior{
 		addresses: discordOperations,
-		actions: behaviorActions{
-			opcode.VoiceReady:              c.onReady,
-			opcode.VoiceResumed:            c.onResumed,
-			opcode.VoiceHeartbeat:          c.onHeartbeatRequest,
-			opcode.VoiceHeartbeatAck:       c.onHeartbeatAck,
-			opcode.VoiceHello:              c.onHello,
-			opcode.VoiceSessionDescription: c.onVoiceSessionDescription,
-			opcode.VoiceSpeaking:           nop,
-			opcode.VoiceClientDisconnect:   nop,
-
-			// undocumented operations
-			opcode.OpCode(10): nop,
-			opcode.OpCode(11): nop,
-			opcode.OpCode(12): nop,
-			opcode.OpCode(14): nop,
-			opcode.OpCode(15): nop,
-		},
+		actions:   operations,
 	})
 
 	c.addBehavior(&behavior{
@@ -137,12 +167,17 @@ func (c *VoiceClient) setupBehaviors() {
 		actions: behaviorActions{
 			4006: func(e interface{}) error {
 				c.haveIdentifiedOnce.Store(false)
+				c.notifyDisconnect(4006)
 				return nil
 			},
 			4009: func(e interface{}) error {
 				c.haveIdentifiedOnce.Store(false)
 				return nil
 			},
+			4014: func(e interface{}) error {
+				c.notifyDisconnect(4014)
+				return nil
+			},
 		},
 	})
 }
@@ -153,6 +188,23 @@ func (c *VoiceClient) setupBehaviors() {
 //
 //////////////////////////////////////////////////////
 
+func (c *VoiceClient) notifyDisconnect(code int) {
+	if c.conf.OnDisconnect != nil {
+		go c.conf.OnDisconnect(code)
+	}
+}
+
+// acknowledgeSequence keeps track of the last sequence number sent by Discord. Heartbeats and resumes
+// acknowledges this such that Discord can re-send any messages that were missed during a resume.
+func (c *VoiceClient) acknowledgeSequence(action actionFunc) actionFunc {
+	return func(v interface{}) error {
+		if p, ok := v.(*DiscordPacket); ok && p.VoiceSequenceNumber > c.seqAck.Load() {
+			c.seqAck.Store(p.VoiceSequenceNumber)
+		}
+		return action(v)
+	}
+}
+
 func (c *VoiceClient) onReady(v interface{}) (err error) {
 	p := v.(*DiscordPacket)
 
@@ -183,12 +235,16 @@ func (c *VoiceClient) onResumed(v interface{}) (err error) {
 	} else {
 		panic("once channel for Resumed was missing")
 	}
+
+	if c.conf.OnResumed != nil {
+		go c.conf.OnResumed()
+	}
 	return nil
 }
 
 func (c *VoiceClient) onHeartbeatRequest(v interface{}) error {
 	// https://discord.com/developers/docs/topics/gateway#heartbeating
-	return c.emit(cmd.VoiceHeartbeat, nil)
+	return c.sendHeartbeat(nil)
 }
 
 func (c *VoiceClient) onHeartbeatAck(v interface{}) error {
@@ -243,6 +299,36 @@ func (c *VoiceClient) onVoiceSessionDescription(v interface{}) (err error) {
 	return nil
 }
 
+func (c *VoiceClient) onSpeaking(v interface{}) (err error) {
+	if c.conf.OnSpeaking == nil {
+		return nil
+	}
+	p := v.(*DiscordPacket)
+
+	speakingPk := &VoiceSpeaking{}
+	if err = json.Unmarshal(p.Data, speakingPk); err != nil {
+		return err
+	}
+
+	c.conf.OnSpeaking(speakingPk)
+	return nil
+}
+
+func (c *VoiceClient) onClientDisconnect(v interface{}) (err error) {
+	if c.conf.OnClientDisconnect == nil {
+		return nil
+	}
+	p := v.(*DiscordPacket)
+
+	disconnectPk := &VoiceClientDisconnect{}
+	if err = json.Unmarshal(p.Data, disconnectPk); err != nil {
+		return err
+	}
+
+	c.conf.OnClientDisconnect(disconnectPk)
+	return nil
+}
+
 //////////////////////////////////////////////////////
 //
 // BEHAVIOR: heartbeat
@@ -250,8 +336,11 @@ func (c *VoiceClient) onVoiceSessionDescription(v interface{}) (err error) {
 //////////////////////////////////////////////////////
 
 func (c *VoiceClient) sendHeartbeat(i interface{}) error {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return c.emit(cmd.VoiceHeartbeat, r.Uint32())
+	// https://discord.com/developers/docs/topics/voice-connections#heartbeating
+	return c.emit(cmd.VoiceHeartbeat, &voiceHeartbeat{
+		Nonce:  uint64(time.Now().UnixNano() / int64(time.Millisecond)),
+		SeqAck: c.seqAck.Load(),
+	})
 }
 
 //////////////////////////////////////////////////////
@@ -358,21 +447,22 @@ func (c *VoiceClient) sendVoiceHelloPacket() {
 		return
 	}
 
-	resumeData := struct {
-		GuildID   Snowflake `json:"server_id"`
-		SessionID string    `json:"session_id"`
-		Token     string    `json:"token"`
-	}{c.conf.GuildID, c.conf.SessionID, c.conf.Token}
-	_ = c.emit(cmd.VoiceResume, &resumeData)
+	_ = c.emit(cmd.VoiceResume, &voiceResume{
+		GuildID:   c.conf.GuildID,
+		SessionID: c.conf.SessionID,
+		Token:     c.conf.Token,
+		SeqAck:    c.seqAck.Load(),
+	})
 }
 
 func sendVoiceIdentityPacket(m *VoiceClient) (err error) {
 	// https://discord.com/developers/docs/topics/gateway#identify
 	err = m.emit(cmd.VoiceIdentify, &voiceIdentify{
-		GuildID:   m.conf.GuildID,
-		UserID:    m.conf.UserID,
-		SessionID: m.conf.SessionID,
-		Token:     m.conf.Token,
+		GuildID:                m.conf.GuildID,
+		UserID:                 m.conf.UserID,
+		SessionID:              m.conf.SessionID,
+		Token:                  m.conf.Token,
+		MaxDAVEProtocolVersion: m.conf.MaxDAVEProtocolVersion,
 	})
 
 	m.haveIdentifiedOnce.Store(true)
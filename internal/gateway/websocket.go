@@ -2,15 +2,53 @@ package gateway
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
+
+	"go.uber.org/atomic"
 
 	"github.com/Vedza/disgord/internal/util"
+	"github.com/Vedza/disgord/json"
 )
 
 type Snowflake = util.Snowflake
 
+// WebsocketMessageType is the type of a websocket data message. The values match RFC 6455 opcodes.
+type WebsocketMessageType int
+
+const (
+	WebsocketMessageText   WebsocketMessageType = 1
+	WebsocketMessageBinary WebsocketMessageType = 2
+)
+
+// WebsocketConn is a websocket implementation, used by both the gateway and the voice connections. Open is called
+// again on the same instance, after Close, when reconnecting. Reads and writes may happen concurrently.
+type WebsocketConn interface {
+	Open(ctx context.Context, endpoint string, requestHeader http.Header) error
+
+	// Read blocks until a data message is received. When the connection is closed by Discord, a
+	// *WebsocketCloseError holding the close code must be returned.
+	Read(ctx context.Context) (messageType WebsocketMessageType, data []byte, err error)
+	Write(ctx context.Context, messageType WebsocketMessageType, data []byte) error
+
+	// Close sends a close frame with the given code and closes the connection.
+	Close(code int, reason string) error
+}
+
+// WebsocketCloseError is returned by WebsocketConn.Read when the connection was closed with a close frame.
+type WebsocketCloseError struct {
+	Code   int
+	Reason string
+}
+
+func (e *WebsocketCloseError) Error() string {
+	return e.Reason
+}
+
 type Conn interface {
 	Close() error
+	CloseResumable() error
 	Open(ctx context.Context, endpoint string, requestHeader http.Header) error
 	WriteJSON(v interface{}) error
 	Read(ctx context.Context) (packet []byte, err error)
@@ -18,6 +56,9 @@ type Conn interface {
 	Disconnected() bool
 }
 
+// WebsocketStatusNormalClosure is the close code used when disconnecting.
+const WebsocketStatusNormalClosure = 1000
+
 type CloseErr struct {
 	code int
 	info string
@@ -40,3 +81,141 @@ func (e *WebsocketErr) Error() string {
 const (
 	encodingJSON = "json"
 )
+
+// setEndpointQuery sets a query parameter of a gateway endpoint, such as the encoding or compression.
+func setEndpointQuery(endpoint, key, value string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint, err
+	}
+
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// endpointQuery returns a query parameter of a gateway endpoint.
+func endpointQuery(endpoint, key string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get(key)
+}
+
+func newConn(httpClient *http.Client, newWebsocket func() WebsocketConn) (Conn, error) {
+	var ws WebsocketConn
+	if newWebsocket != nil {
+		ws = newWebsocket()
+	} else {
+		ws = newNhooyrWebsocket(httpClient)
+	}
+	if ws == nil {
+		return nil, errors.New("websocket factory did not return a websocket implementation")
+	}
+
+	return &conn{ws: ws}, nil
+}
+
+// conn handles the Discord specifics on top of the websocket implementation; compression and payload encoding.
+type conn struct {
+	ws          WebsocketConn
+	isConnected atomic.Bool
+
+	// zlib-stream transport compression, only used when requested in the endpoint
+	stream  *zlibStream
+	message zlibMessage
+}
+
+func (g *conn) Open(ctx context.Context, endpoint string, requestHeader http.Header) (err error) {
+	if err = g.ws.Open(ctx, endpoint, requestHeader); err != nil {
+		return err
+	}
+	g.isConnected.Store(true)
+
+	// every connection is a new zlib stream
+	if g.stream != nil {
+		g.stream.Close()
+		g.stream = nil
+	}
+	g.message.buffer.Reset()
+	if endpointQuery(endpoint, "compress") == zlibStreamCompression {
+		g.stream = newZlibStream()
+	}
+	return nil
+}
+
+func (g *conn) WriteJSON(v interface{}) (err error) {
+	// TODO: implement custom json handler - switch to new gateway project
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return g.ws.Write(context.Background(), WebsocketMessageText, data)
+}
+
+func (g *conn) Close() error {
+	return g.close(WebsocketStatusNormalClosure, "Bot is shutting down")
+}
+
+func (g *conn) CloseResumable() error {
+	return g.close(WebsocketStatusResumable, "Bot is restarting")
+}
+
+func (g *conn) close(code int, reason string) (err error) {
+	if g.stream != nil {
+		g.stream.Close()
+	}
+	err = g.ws.Close(code, reason)
+	if !g.isConnected.Load() {
+		err = nil // discard error if we're already closed, should be a noop anyways
+	}
+	g.isConnected.Store(false)
+	return err
+}
+
+func (g *conn) Read(ctx context.Context) (packet []byte, err error) {
+	for {
+		var messageType WebsocketMessageType
+		if messageType, packet, err = g.read(ctx); err != nil || messageType != WebsocketMessageBinary {
+			return packet, err
+		}
+
+		if g.stream == nil {
+			return decompressBytes(packet)
+		}
+		if message := g.message.add(packet); message != nil {
+			return g.stream.Decompress(message)
+		}
+		// the message continues in the next frame
+	}
+}
+
+func (g *conn) read(ctx context.Context) (messageType WebsocketMessageType, packet []byte, err error) {
+	messageType, packet, err = g.ws.Read(ctx)
+	if err != nil {
+		// Cancelling Read by ctx results in closed WS, see issue
+		// https://github.com/nhooyr/websocket/issues/242
+		if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+			g.isConnected.Store(false)
+			return messageType, nil, context.Canceled
+		}
+		var closeErr *WebsocketCloseError
+		if errors.As(err, &closeErr) {
+			g.isConnected.Store(false)
+			err = &CloseErr{
+				code: closeErr.Code,
+				info: closeErr.Reason,
+			}
+		}
+		return messageType, nil, err
+	}
+	return messageType, packet, nil
+}
+
+func (g *conn) Disconnected() bool {
+	return !g.isConnected.Load()
+}
+
+var _ Conn = (*conn)(nil)
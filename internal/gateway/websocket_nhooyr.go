@@ -1,30 +1,23 @@
 package gateway
 
-// TODO: merge websocket_nhooyr.go with the client.go. Figure out mocking for the client as well.
-
 import (
 	"context"
 	"errors"
-	"io"
 	"net/http"
 
-	"github.com/Vedza/disgord/json"
-
-	"go.uber.org/atomic"
-
 	"nhooyr.io/websocket"
 )
 
-func newConn(httpClient *http.Client) (Conn, error) {
+// newNhooyrWebsocket is the default websocket implementation.
+func newNhooyrWebsocket(httpClient *http.Client) WebsocketConn {
 	return &nhooyr{
 		httpClient: httpClient,
-	}, nil
+	}
 }
 
 type nhooyr struct {
-	c           *websocket.Conn
-	httpClient  *http.Client
-	isConnected atomic.Bool
+	c          *websocket.Conn
+	httpClient *http.Client
 }
 
 func (g *nhooyr) Open(ctx context.Context, endpoint string, requestHeader http.Header) (err error) {
@@ -35,71 +28,39 @@ func (g *nhooyr) Open(ctx context.Context, endpoint string, requestHeader http.H
 	})
 	if err != nil {
 		if g.c != nil {
-			_ = g.Close()
+			_ = g.c.Close(websocket.StatusNormalClosure, "")
 		}
 		return err
 	}
-	g.isConnected.Store(true)
 
 	g.c.SetReadLimit(32768 * 10000) // discord.. Can we add stream support?
 	return
 }
 
-func (g *nhooyr) WriteJSON(v interface{}) (err error) {
-	// TODO: move unmarshalling out of here?
-	var w io.WriteCloser
-	w, err = g.c.Writer(context.Background(), websocket.MessageText)
-	if err != nil {
-		return err
-	}
-
-	// TODO: implement custom json handler - switch to new gateway project
-	err1 := json.NewEncoder(w).Encode(v)
-	err2 := w.Close()
-	if err1 != nil {
-		return err1
-	}
-	return err2
+func (g *nhooyr) Write(ctx context.Context, messageType WebsocketMessageType, data []byte) error {
+	return g.c.Write(ctx, websocket.MessageType(messageType), data)
 }
 
-func (g *nhooyr) Close() (err error) {
-	err = g.c.Close(websocket.StatusNormalClosure, "Bot is shutting down")
-	if !g.isConnected.Load() {
-		err = nil // discard error if we're already closed, should be a noop anyways
+func (g *nhooyr) Close(code int, reason string) error {
+	if g.c == nil {
+		return errors.New("websocket connection was never opened")
 	}
-	g.isConnected.Store(false)
-	return err
+	return g.c.Close(websocket.StatusCode(code), reason)
 }
 
-func (g *nhooyr) Read(ctx context.Context) (packet []byte, err error) {
-	var messageType websocket.MessageType
-	messageType, packet, err = g.c.Read(ctx)
+func (g *nhooyr) Read(ctx context.Context) (WebsocketMessageType, []byte, error) {
+	messageType, packet, err := g.c.Read(ctx)
 	if err != nil {
-		// Cancelling Read by ctx results in closed WS, see issue
-		// https://github.com/nhooyr/websocket/issues/242
-		if ctx.Err() != nil && errors.Is(err, context.Canceled) {
-			g.isConnected.Store(false)
-			return nil, context.Canceled
-		}
 		var closeErr websocket.CloseError
 		if errors.As(err, &closeErr) {
-			g.isConnected.Store(false)
-			err = &CloseErr{
-				code: int(closeErr.Code),
-				info: closeErr.Error(),
+			err = &WebsocketCloseError{
+				Code:   int(closeErr.Code),
+				Reason: closeErr.Error(),
 			}
 		}
-		return nil, err
+		return 0, nil, err
 	}
-
-	if messageType == websocket.MessageBinary {
-		packet, err = decompressBytes(packet)
-	}
-	return packet, nil
-}
-
-func (g *nhooyr) Disconnected() bool {
-	return !g.isConnected.Load()
+	return WebsocketMessageType(messageType), packet, nil
 }
 
-var _ Conn = (*nhooyr)(nil)
+var _ WebsocketConn = (*nhooyr)(nil)
@@ -0,0 +1,78 @@
+// +build !integration
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type fakeWebsocket struct {
+	reads  chan []byte
+	writes [][]byte
+	types  []WebsocketMessageType
+}
+
+func (f *fakeWebsocket) Open(_ context.Context, _ string, _ http.Header) error {
+	return nil
+}
+
+func (f *fakeWebsocket) Read(ctx context.Context) (WebsocketMessageType, []byte, error) {
+	data, open := <-f.reads
+	if !open {
+		return 0, nil, &WebsocketCloseError{Code: 4014, Reason: "disconnected"}
+	}
+	return WebsocketMessageText, data, nil
+}
+
+func (f *fakeWebsocket) Write(_ context.Context, messageType WebsocketMessageType, data []byte) error {
+	f.types = append(f.types, messageType)
+	f.writes = append(f.writes, data)
+	return nil
+}
+
+func (f *fakeWebsocket) Close(_ int, _ string) error {
+	return nil
+}
+
+func TestConn_CustomWebsocket(t *testing.T) {
+	ws := &fakeWebsocket{reads: make(chan []byte, 1)}
+	c, err := newConn(nil, func() WebsocketConn {
+		return ws
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Open(context.Background(), "wss://gateway.discord.gg/?encoding=json", nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.Disconnected() {
+		t.Error("expected connection to be open")
+	}
+
+	if err = c.WriteJSON(map[string]int{"op": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if string(ws.writes[0]) != `{"op":1}` || ws.types[0] != WebsocketMessageText {
+		t.Errorf("unexpected write %s of type %d", ws.writes[0], ws.types[0])
+	}
+
+	ws.reads <- []byte(`{"op":11}`)
+	packet, err := c.Read(context.Background())
+	if err != nil || string(packet) != `{"op":11}` {
+		t.Errorf("unexpected read %s, %v", packet, err)
+	}
+
+	close(ws.reads)
+	_, err = c.Read(context.Background())
+	var closeErr *CloseErr
+	if !errors.As(err, &closeErr) || closeErr.code != 4014 {
+		t.Errorf("expected a close error with code 4014. Got %v", err)
+	}
+	if !c.Disconnected() {
+		t.Error("expected connection to be closed")
+	}
+}
@@ -0,0 +1,162 @@
+package gateway
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+)
+
+// zlibSuffix ends every complete message of a zlib-stream compressed connection (Z_SYNC_FLUSH)
+var zlibSuffix = []byte{0x00, 0x00, 0xff, 0xff}
+
+const zlibStreamCompression = "zlib-stream"
+
+type zlibChunk struct {
+	data []byte
+	err  error
+
+	// end marks that every message written so far has been decompressed
+	end bool
+}
+
+// zlibStream decompresses a zlib-stream compressed connection. The whole connection is a single zlib
+// stream, so the inflater, and its dictionary, must be kept between messages. A new stream must be
+// created for every new connection.
+//
+// The inflater runs in its own go routine and blocks while waiting for more data, as the inflater
+// can not recover after reaching the end of its input.
+type zlibStream struct {
+	input  chan []byte
+	output chan zlibChunk
+	done   chan struct{}
+
+	// only accessed by the inflater go routine
+	pending  []byte
+	consumed bool
+}
+
+func newZlibStream() *zlibStream {
+	s := &zlibStream{
+		input:  make(chan []byte),
+		output: make(chan zlibChunk),
+		done:   make(chan struct{}),
+	}
+	go s.inflate()
+	return s
+}
+
+// Read feeds the inflater with compressed messages. io.EOF is returned once the stream is closed.
+func (s *zlibStream) Read(p []byte) (n int, err error) {
+	if len(s.pending) == 0 {
+		if s.consumed {
+			s.consumed = false
+			select {
+			case s.output <- zlibChunk{end: true}:
+			case <-s.done:
+				return 0, io.EOF
+			}
+		}
+
+		select {
+		case s.pending = <-s.input:
+			s.consumed = true
+		case <-s.done:
+			return 0, io.EOF
+		}
+	}
+
+	n = copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+func (s *zlibStream) inflate() {
+	r, err := zlib.NewReader(s)
+	if err != nil {
+		s.fail(err)
+		return
+	}
+	defer r.Close()
+
+	for {
+		buf := make([]byte, 32*1024)
+		n, err := r.Read(buf)
+		if n > 0 {
+			select {
+			case s.output <- zlibChunk{data: buf[:n]}:
+			case <-s.done:
+				return
+			}
+		}
+		if err != nil {
+			s.fail(err)
+			return
+		}
+	}
+}
+
+func (s *zlibStream) fail(err error) {
+	select {
+	case s.output <- zlibChunk{err: err}:
+	case <-s.done:
+	}
+}
+
+// Decompress decompresses a complete message, which must end with the zlib suffix.
+func (s *zlibStream) Decompress(message []byte) ([]byte, error) {
+	select {
+	case s.input <- message:
+	case <-s.done:
+		return nil, errors.New("zlib stream is closed")
+	}
+
+	var output []byte
+	for {
+		var chunk zlibChunk
+		select {
+		case chunk = <-s.output:
+		case <-s.done:
+			return nil, errors.New("zlib stream is closed")
+		}
+
+		if chunk.err != nil {
+			return nil, chunk.err
+		}
+		if chunk.end {
+			return output, nil
+		}
+		output = append(output, chunk.data...)
+	}
+}
+
+// Close stops the inflater. The stream can not be used afterwards.
+func (s *zlibStream) Close() {
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+}
+
+// zlibMessage collects websocket frames until a complete zlib-stream message has been received.
+type zlibMessage struct {
+	buffer bytes.Buffer
+}
+
+// add adds a frame and returns the complete message, or nil if more frames are needed.
+func (m *zlibMessage) add(frame []byte) []byte {
+	if m.buffer.Len() == 0 && bytes.HasSuffix(frame, zlibSuffix) {
+		return frame
+	}
+
+	m.buffer.Write(frame)
+	if !bytes.HasSuffix(m.buffer.Bytes(), zlibSuffix) {
+		return nil
+	}
+
+	message := make([]byte, m.buffer.Len())
+	copy(message, m.buffer.Bytes())
+	m.buffer.Reset()
+	return message
+}
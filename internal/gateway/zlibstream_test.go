@@ -0,0 +1,67 @@
+// +build !integration
+
+package gateway
+
+import (
+	"bytes"
+	"compress/zlib"
+	"strings"
+	"testing"
+)
+
+func TestZlibStream(t *testing.T) {
+	messages := []string{
+		`{"op":10,"d":{"heartbeat_interval":41250}}`,
+		`{"op":0,"t":"READY","s":1,"d":{"v":10}}`,
+		`{"op":0,"t":"MESSAGE_CREATE","s":2,"d":{"content":"` + strings.Repeat("hello ", 10000) + `"}}`,
+		`{"op":11}`,
+	}
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+
+	stream := newZlibStream()
+	defer stream.Close()
+
+	var frames zlibMessage
+	for _, message := range messages {
+		_, _ = w.Write([]byte(message))
+		_ = w.Flush() // Z_SYNC_FLUSH, as done by Discord
+		data := append([]byte{}, compressed.Bytes()...)
+		compressed.Reset()
+
+		// split the message across two frames
+		if frames.add(data[:len(data)/2]) != nil {
+			t.Fatal("message should not be complete before the last frame")
+		}
+		complete := frames.add(data[len(data)/2:])
+		if complete == nil {
+			t.Fatal("message should be complete")
+		}
+
+		output, err := stream.Decompress(complete)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(output) != message {
+			t.Errorf("incorrect message. Got %s, wants %s", output, message)
+		}
+	}
+}
+
+func TestSetEndpointQuery(t *testing.T) {
+	endpoint, err := setEndpointQuery("wss://gateway.discord.gg/?encoding=json&v=10", "compress", zlibStreamCompression)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if encoding := endpointQuery(endpoint, "encoding"); encoding != "json" {
+		t.Errorf("expected encoding to be kept. Got %s", endpoint)
+	}
+	if compression := endpointQuery(endpoint, "compress"); compression != zlibStreamCompression {
+		t.Errorf("expected compression to be requested. Got %s", endpoint)
+	}
+	if endpointQuery("wss://gateway.discord.gg/?encoding=json&v=10", "compress") != "" {
+		t.Error("compression should not be requested by default")
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type ErrorCode struct {
+	Code    int
+	Name    string
+	Message string
+}
+
+func main() {
+	codes := parseCodes("internal/generate/errorcodes/codes.txt")
+	makeFile(codes, "internal/generate/errorcodes/errorcodes.gohtml", "errorcodes_gen.go")
+}
+
+func parseCodes(file string) (codes []ErrorCode) {
+	f, err := os.Open(file)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			panic("invalid error code entry: " + line)
+		}
+		code, err := strconv.Atoi(fields[0])
+		if err != nil {
+			panic(err)
+		}
+		codes = append(codes, ErrorCode{Code: code, Name: fields[1], Message: fields[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		panic(err)
+	}
+
+	return codes
+}
+
+func makeFile(codes []ErrorCode, tplFile, target string) {
+	// Open & parse our template
+	tpl := template.Must(template.New(path.Base(tplFile)).ParseFiles(tplFile))
+
+	// Execute the template, inserting all the error codes
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, codes); err != nil {
+		panic(err)
+	}
+
+	// Format it according to gofmt standards
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	// And write it.
+	if err = ioutil.WriteFile(target, formatted, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -132,6 +132,15 @@ func (e eventName) IsDiscordEvent() bool {
 	return e.Docs != nil
 }
 
+// IsPooled reports whether the event struct can be recycled, see Config.PoolEvents
+func (e eventName) IsPooled() bool {
+	switch e.varName {
+	case "MessageCreate", "TypingStart", "PresenceUpdate":
+		return true
+	}
+	return false
+}
+
 func (e eventName) RenderDoc() string {
 	return e.renderDocWithPrefix("")
 }
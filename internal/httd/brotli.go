@@ -0,0 +1,17 @@
+// +build disgord_brotli
+
+package httd
+
+import (
+	"io"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Brotli compressed responses are smaller than gzip for large payloads, such as guilds, but depend on
+// github.com/andybalholm/brotli. Build with -tags=disgord_brotli to accept them.
+func init() {
+	decoders[BrotliCompression] = func(r io.Reader) (io.Reader, error) {
+		return brotli.NewReader(r), nil
+	}
+}
@@ -0,0 +1,32 @@
+// +build disgord_brotli,!integration
+
+package httd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+)
+
+func TestDecodingResponseBodyWithBrotli(t *testing.T) {
+	expected := "9ng574g8573g394g3874gf837g"
+	var b bytes.Buffer
+	w := brotli.NewWriter(&b)
+	_, _ = w.Write([]byte(expected))
+	_ = w.Close()
+
+	resp := &http.Response{
+		Body:   ioutil.NopCloser(&b),
+		Header: http.Header{ContentEncoding: []string{BrotliCompression}},
+	}
+	body, err := (&Client{}).decodeResponseBody(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != expected {
+		t.Errorf("decoding failed. Got %s, wants %s", string(body), expected)
+	}
+}
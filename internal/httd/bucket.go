@@ -9,18 +9,32 @@ import (
 	"time"
 
 	"github.com/Vedza/disgord/internal/util"
+	"go.uber.org/atomic"
 )
 
 func newLeakyBucket(global *ltBucket) (b *ltBucket) {
 	b = &ltBucket{
 		remaining: -1,
+		limit:     -1,
 		resetTime: time.Now(),
 		global:    global,
 	}
+	if global != nil {
+		b.preemption = global.preemption
+	} else {
+		b.preemption = &preemption{}
+	}
 
 	return b
 }
 
+// preemption decides if requests wait for an exhausted bucket to reset before they are sent, see
+// Manager.SetPreemption. It is shared by every bucket of a manager.
+type preemption struct {
+	disabled atomic.Bool
+	margin   atomic.Duration
+}
+
 type bucketTransaction = func() (resp *http.Response, body []byte, err error)
 
 // ltBucket combines leaky and token buckets to allow time aware of the REST requests while they're in queue.
@@ -32,10 +46,14 @@ type ltBucket struct {
 	queue util.TicketQueue // Ticket => Token
 
 	remaining        int       // remaining requests
-	resetTime        time.Time // affected by time diff
-	discordResetTime time.Time // unaffected by time diff
+	limit            int       // requests allowed per reset
+	resetTime        time.Time // local clock
+	discordResetTime time.Time // reset of the latest response, to ignore outdated responses
 
 	updatedAt time.Time // use date from discord header
+	limited   bool      // the latest response was a http 429
+
+	preemption *preemption
 
 	// this bucket is global if this.global is nil or this == this.global
 	global      *ltBucket
@@ -91,7 +109,18 @@ func (b *ltBucket) Transaction(ctx context.Context, do bucketTransaction) (resp
 	// reqA = /guilds/1/members?limit=100
 	// reqB = /guilds/1/members?limit=10
 	// reqB is a subset of A, and therefore reqA can create a response for reqB locally (must be deep copy - djp)
-	token := b.queue.NewTicket()
+	opts := transactionOptionsFromContext(ctx)
+	var queueDeadline time.Time
+	if opts.maxQueueWait > 0 {
+		queueDeadline = time.Now().Add(opts.maxQueueWait)
+	}
+
+	var token util.Ticket
+	if opts.priority == PriorityHigh {
+		token = b.queue.NewPriorityTicket()
+	} else {
+		token = b.queue.NewTicket()
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -101,6 +130,11 @@ func (b *ltBucket) Transaction(ctx context.Context, do bucketTransaction) (resp
 			// TODO-perf: this wastes a lot of CPU usage
 		}
 
+		if !queueDeadline.IsZero() && time.Now().After(queueDeadline) {
+			b.queue.Delete(token)
+			return nil, nil, ErrRateLimited
+		}
+
 		if !b.queue.Next(token, b.AcquireLock) {
 			continue
 		}
@@ -125,8 +159,16 @@ func (b *ltBucket) Transaction(ctx context.Context, do bucketTransaction) (resp
 	// check if rate limited and try to wait it out
 	var wait time.Duration
 	now := time.Now()
-	if bucket.resetTime.After(now) && bucket.remaining == 0 {
-		wait = bucket.resetTime.Sub(now)
+	if bucket.remaining == 0 && (bucket.limited || !b.preemption.disabled.Load()) {
+		if reset := bucket.resetTime.Add(b.preemption.margin.Load()); reset.After(now) {
+			wait = reset.Sub(now)
+		}
+	}
+	if wait > 0 && opts.policy == RateLimitFailFast {
+		return nil, nil, ErrRateLimited
+	}
+	if !queueDeadline.IsZero() && queueDeadline.Before(now.Add(wait)) {
+		return nil, nil, ErrRateLimited
 	}
 	if deadline, ok := ctx.Deadline(); ok && deadline.Before(time.Now().Add(wait)) {
 		return nil, nil, errors.New("time out, bucket resets in " + wait.String())
@@ -145,8 +187,12 @@ func (b *ltBucket) Transaction(ctx context.Context, do bucketTransaction) (resp
 
 	// update ltBucket info
 	// reduce remaining if needed
-	if !b.updateAfterRequest(resp.Header, resp.StatusCode) && bucket.remaining > 0 {
-		bucket.remaining--
+	if !b.updateAfterRequest(resp.Header, resp.StatusCode) {
+		bucket.mu.Lock()
+		if bucket.remaining > 0 {
+			bucket.remaining--
+		}
+		bucket.mu.Unlock()
 	}
 
 	return resp, body, nil
@@ -160,16 +206,11 @@ func (b *ltBucket) updateAfterRequest(header http.Header, statusCode int) (adjus
 		panic("headers were not normalized to use milliseconds")
 	}
 
-	// to synchronize the timestamp between the bot and the discord server
-	// we assume the current time is equal the header date
 	discordTime, err := HeaderToTime(header)
 	if err != nil {
 		discordTime = time.Now()
 	}
 
-	localTime := time.Now()
-	diff := localTime.Sub(discordTime)
-
 	var isGlobal bool
 	bucketHash := header.Get(XRateLimitBucket)
 	if _, ok := header[XRateLimitBucket]; ok && bucketHash == "" {
@@ -178,6 +219,7 @@ func (b *ltBucket) updateAfterRequest(header http.Header, statusCode int) (adjus
 	isGlobal = isGlobal || header.Get(XRateLimitGlobal) == "true"
 
 	// if this is not a 429 error we can determine if the local ltBucket is a global one or not
+	b.mu.Lock()
 	if statusCode != http.StatusTooManyRequests && b.hash == "" {
 		if isGlobal {
 			b.hash = GlobalHash
@@ -185,15 +227,18 @@ func (b *ltBucket) updateAfterRequest(header http.Header, statusCode int) (adjus
 			b.hash = bucketHash
 		}
 	}
+	b.mu.Unlock()
 
 	var reset time.Time
 	var discordReset time.Time
 	var remaining int = -1
+	var limit int = -1
 	if resetStr := header.Get(XRateLimitReset); resetStr != "" {
 		epoch, _ := strconv.ParseInt(resetStr, 10, 64)
 		epoch *= int64(time.Millisecond) // ms => nano
-		reset = time.Unix(0, epoch+diff.Nanoseconds())
-		discordReset = time.Unix(0, epoch)
+		// the reset is already adjusted to the local clock by NormalizeDiscordHeader
+		reset = time.Unix(0, epoch)
+		discordReset = reset
 	}
 
 	if remainingStr := header.Get(XRateLimitRemaining); remainingStr != "" {
@@ -203,6 +248,23 @@ func (b *ltBucket) updateAfterRequest(header http.Header, statusCode int) (adjus
 		}
 	}
 
+	if limitStr := header.Get(XRateLimitLimit); limitStr != "" {
+		if limitInt64, err := strconv.ParseInt(limitStr, 10, 64); err == nil && limitInt64 >= 0 {
+			limit = int(limitInt64)
+		}
+	}
+
+	if statusCode == http.StatusTooManyRequests {
+		switch {
+		case isGlobal:
+			// the route headers do not regard the global rate limit, which blocks every bucket until it resets
+			remaining, limit = 0, -1
+		case header.Get(XRateLimitScope) == "shared":
+			// the limit regards a resource shared with others, such as a guild, and not this route
+			return false
+		}
+	}
+
 	// update ltBucket reference to whatever the header regards
 	var bucket *ltBucket
 	if isGlobal {
@@ -214,16 +276,23 @@ func (b *ltBucket) updateAfterRequest(header http.Header, statusCode int) (adjus
 		bucket.mu.Lock()
 		defer bucket.mu.Unlock()
 	} else {
-		bucket = b // no need to lock normal buckets
+		bucket = b
+		bucket.mu.Lock()
+		defer bucket.mu.Unlock()
 		if !(b.global == nil || b == b.global) && bucketHash != "" {
 			b.hash = bucketHash
 		}
 	}
 
+	bucket.limited = statusCode == http.StatusTooManyRequests
 	if discordReset.Before(time.Unix(0, int64(time.Hour))) {
 		return false
 	}
 
+	if limit >= 0 {
+		bucket.limit = limit
+	}
+
 	// TODO: this can be simpler
 	// use discord reset time, as the local reset can be different in ms or s per request.
 	if discordReset.After(bucket.discordResetTime) {
@@ -2,6 +2,7 @@ package httd
 
 import (
 	"sync"
+	"time"
 )
 
 const GlobalHash = "global"
@@ -87,16 +88,27 @@ func (r *Manager) ProxyID(id string) (pID string) {
 	return pID
 }
 
+// UpdateProxyID links the hashed endpoint to the bucket hash designated by Discord, such that every endpoint
+// with the same bucket hash and major parameters shares one bucket.
 func (r *Manager) UpdateProxyID(id, pID, bucketHash string) {
-	if bucketHash == "" || bucketHash == pID {
+	if bucketHash == "" {
+		return
+	}
+
+	// Discord rate limits each channel, guild and webhook of a bucket separately
+	key := bucketHash
+	if major := majorParameters(id); major != "" && bucketHash != GlobalHash {
+		key += ":" + major
+	}
+	if key == pID {
 		return
 	}
 
 	r.mu.Lock()
-	if _, exists := r.buckets[bucketHash]; !exists {
-		r.buckets[bucketHash] = r.buckets[r.proxy[id]]
+	if _, exists := r.buckets[key]; !exists {
+		r.buckets[key] = r.buckets[r.proxy[id]]
 	}
-	r.proxy[id] = bucketHash
+	r.proxy[id] = key
 	r.mu.Unlock()
 }
 
@@ -123,6 +135,15 @@ func (r *Manager) Bucket(id string, cb func(bucket RESTBucket)) {
 	r.UpdateProxyID(id, pID, hash)
 }
 
+// SetPreemption decides what happens to requests on a bucket without remaining requests. When enabled,
+// which is the default, they wait for the bucket to reset before being sent. The margin is added to the
+// wait, to make up for latency and imprecise clocks. When disabled, requests are sent regardless, and
+// only wait after Discord responds with a http 429.
+func (r *Manager) SetPreemption(enabled bool, margin time.Duration) {
+	r.global.preemption.disabled.Store(!enabled)
+	r.global.preemption.margin.Store(margin)
+}
+
 func (r *Manager) Consolidate() {
 
 }
@@ -157,3 +157,186 @@ func TestLtBucket_RespectRateLimit(t *testing.T) {
 	})
 
 }
+
+func TestLtBucket_RateLimitPolicy(t *testing.T) {
+	bucket := newLeakyBucket(newLeakyBucket(nil))
+	bucket.remaining = 0
+	bucket.resetTime = time.Now().Add(time.Hour)
+
+	send := func() (*http.Response, []byte, error) {
+		return nil, nil, errors.New("request should not be sent")
+	}
+
+	ctx := WithRateLimitPolicy(context.Background(), RateLimitFailFast)
+	if _, _, err := bucket.Transaction(ctx, send); err != ErrRateLimited {
+		t.Errorf("expected fail fast. Got %v", err)
+	}
+
+	ctx = WithMaxQueueWait(context.Background(), time.Minute)
+	if _, _, err := bucket.Transaction(ctx, send); err != ErrRateLimited {
+		t.Errorf("expected the reset to exceed the max queue wait. Got %v", err)
+	}
+
+	// an occupied bucket makes the request wait in the queue
+	if !bucket.atomicLock.AcquireLock() {
+		t.Fatal("unable to lock bucket")
+	}
+	defer bucket.atomicLock.Unlock()
+	bucket.resetTime = time.Now()
+
+	ctx = WithMaxQueueWait(context.Background(), 50*time.Millisecond)
+	if _, _, err := bucket.Transaction(ctx, send); err != ErrRateLimited {
+		t.Errorf("expected the max queue wait to be exceeded. Got %v", err)
+	}
+}
+
+func TestManager_SetPreemption(t *testing.T) {
+	exhaust := func(mngr *Manager, id string, statusCode int, resetAfter string) {
+		mngr.Bucket(id, func(bucket RESTBucket) {
+			_, _, _ = bucket.Transaction(context.Background(), func() (*http.Response, []byte, error) {
+				resp := &http.Response{
+					Header:     make(http.Header),
+					StatusCode: statusCode,
+				}
+				resp.Header.Set(XRateLimitBucket, id)
+				resp.Header.Set(XRateLimitLimit, "1")
+				resp.Header.Set(XRateLimitRemaining, "0")
+				resp.Header.Set(XRateLimitResetAfter, resetAfter)
+				resp.Header, _ = NormalizeDiscordHeader(resp.StatusCode, resp.Header, nil)
+				return resp, nil, nil
+			})
+		})
+	}
+	send := func(mngr *Manager, id string, timeout time.Duration) (err error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		mngr.Bucket(id, func(bucket RESTBucket) {
+			_, _, err = bucket.Transaction(ctx, func() (*http.Response, []byte, error) {
+				return &http.Response{Header: http.Header{DisgordNormalizedHeader: []string{"true"}}, StatusCode: http.StatusOK}, nil, nil
+			})
+		})
+		return err
+	}
+
+	t.Run("preemptive", func(t *testing.T) {
+		mngr := NewManager(nil)
+		exhaust(mngr, "a", http.StatusOK, "3600")
+		if err := send(mngr, "a", 100*time.Millisecond); err == nil {
+			t.Error("expected the request to wait for the bucket to reset")
+		}
+	})
+	t.Run("reactive", func(t *testing.T) {
+		mngr := NewManager(nil)
+		mngr.SetPreemption(false, 0)
+		exhaust(mngr, "a", http.StatusOK, "3600")
+		if err := send(mngr, "a", 100*time.Millisecond); err != nil {
+			t.Errorf("expected the request to be sent regardless of the remaining requests. Got %s", err)
+		}
+
+		exhaust(mngr, "b", http.StatusTooManyRequests, "3600")
+		if err := send(mngr, "b", 100*time.Millisecond); err == nil {
+			t.Error("expected the request to wait after a http 429")
+		}
+	})
+	t.Run("margin", func(t *testing.T) {
+		mngr := NewManager(nil)
+		mngr.SetPreemption(true, 200*time.Millisecond)
+		exhaust(mngr, "a", http.StatusOK, "0.01")
+
+		start := time.Now()
+		if err := send(mngr, "a", time.Second); err != nil {
+			t.Fatal(err)
+		}
+		if waited := time.Since(start); waited < 200*time.Millisecond {
+			t.Errorf("expected the margin to be added to the wait. Waited %s", waited)
+		}
+	})
+}
+
+func TestLtBucket_updateAfterRequest_Scope(t *testing.T) {
+	limited := func(scope string, body string) http.Header {
+		header := make(http.Header)
+		header.Set(XRateLimitBucket, "route")
+		header.Set(XRateLimitLimit, "5")
+		header.Set(XRateLimitRemaining, "4")
+		header.Set(XRateLimitResetAfter, "0.1")
+		header.Set(XRateLimitScope, scope)
+		header, err := NormalizeDiscordHeader(http.StatusTooManyRequests, header, []byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return header
+	}
+
+	t.Run("shared", func(t *testing.T) {
+		global := newLeakyBucket(nil)
+		bucket := newLeakyBucket(global)
+		bucket.updateAfterRequest(limited("shared", `{"retry_after":60,"global":false}`), http.StatusTooManyRequests)
+		if bucket.remaining != -1 || bucket.limited {
+			t.Errorf("expected a shared rate limit to leave the route bucket untouched. Got %d remaining", bucket.remaining)
+		}
+		if global.active() {
+			t.Error("expected a shared rate limit to leave the global bucket untouched")
+		}
+	})
+	t.Run("global", func(t *testing.T) {
+		global := newLeakyBucket(nil)
+		bucket := newLeakyBucket(global)
+		bucket.updateAfterRequest(limited("global", `{"retry_after":60,"global":true}`), http.StatusTooManyRequests)
+		if bucket.remaining != -1 {
+			t.Errorf("expected the route bucket to be untouched. Got %d remaining", bucket.remaining)
+		}
+		if !global.active() || global.remaining != 0 {
+			t.Fatalf("expected the global bucket to be exhausted. Got %d remaining", global.remaining)
+		}
+		if wait := time.Until(global.resetTime); wait < 59*time.Second {
+			t.Errorf("expected the global bucket to reset after retry_after. Got %s", wait)
+		}
+
+		other := newLeakyBucket(global)
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		if _, _, err := other.Transaction(ctx, func() (*http.Response, []byte, error) {
+			return nil, nil, errors.New("expected every bucket to wait for the global rate limit")
+		}); err == nil || !strings.Contains(err.Error(), "time out") {
+			t.Errorf("expected the request to wait for the global rate limit. Got %v", err)
+		}
+	})
+	t.Run("user", func(t *testing.T) {
+		global := newLeakyBucket(nil)
+		bucket := newLeakyBucket(global)
+		bucket.updateAfterRequest(limited("user", `{"retry_after":0.1,"global":false}`), http.StatusTooManyRequests)
+		if bucket.remaining != 4 || !bucket.limited {
+			t.Errorf("expected the route bucket to be updated. Got %d remaining", bucket.remaining)
+		}
+		if global.active() {
+			t.Error("expected the global bucket to be untouched")
+		}
+	})
+}
+
+func TestManager_UpdateProxyID(t *testing.T) {
+	mngr := NewManager(nil)
+	bucket := func(id string) (b RESTBucket) {
+		mngr.Bucket(id, func(bucket RESTBucket) {
+			b = bucket
+		})
+		return b
+	}
+	describe := func(id, hash string) {
+		mngr.Bucket(id, func(bucket RESTBucket) {
+			bucket.(*ltBucket).hash = hash
+		})
+	}
+
+	describe("POST:/channels/1/messages", "abcd")
+	describe("DELETE:/channels/1/messages/{id}", "abcd")
+	describe("POST:/channels/2/messages", "abcd")
+
+	if bucket("POST:/channels/1/messages") != bucket("DELETE:/channels/1/messages/{id}") {
+		t.Error("expected the endpoints of the same channel and bucket hash to share a bucket")
+	}
+	if bucket("POST:/channels/1/messages") == bucket("POST:/channels/2/messages") {
+		t.Error("expected every channel to have its own bucket")
+	}
+}
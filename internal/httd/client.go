@@ -7,9 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"net"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Vedza/disgord/json"
@@ -32,6 +35,9 @@ const (
 	ContentType     = "Content-Type"
 	ContentTypeJSON = "application/json"
 	GZIPCompression = "gzip"
+
+	// BrotliCompression is only accepted when built with the disgord_brotli build tag, see brotli.go.
+	BrotliCompression = "br"
 )
 
 // Requester holds all the sub-request interface for Discord interaction
@@ -73,12 +79,68 @@ type ErrREST struct {
 	HTTPCode       int      `json:"-"`
 	Bucket         []string `json:"-"`
 	HashedEndpoint string   `json:"-"`
+
+	// Errors holds the field level errors of invalid request bodies, sorted by path.
+	Errors []FieldError `json:"-"`
 }
 
 var _ error = (*ErrREST)(nil)
+var _ json.Unmarshaler = (*ErrREST)(nil)
 
 func (e *ErrREST) Error() string {
-	return fmt.Sprintf("%s\n%s\n%s => %+v", e.Msg, e.Suggestion, e.HashedEndpoint, e.Bucket)
+	msg := fmt.Sprintf("%s\n%s\n%s => %+v", e.Msg, e.Suggestion, e.HashedEndpoint, e.Bucket)
+	for i := range e.Errors {
+		msg += "\n" + e.Errors[i].String()
+	}
+	return msg
+}
+
+func (e *ErrREST) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Code   int                    `json:"code"`
+		Msg    string                 `json:"message"`
+		Errors map[string]interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	e.Code = payload.Code
+	e.Msg = payload.Msg
+	e.Errors = parseFieldErrors("", payload.Errors, nil)
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		return e.Errors[i].Path < e.Errors[j].Path
+	})
+	return nil
+}
+
+// Is matches Discord error codes. A target matches when it implements DiscordErrorCode() and returns
+// the same code as the error.
+func (e *ErrREST) Is(target error) bool {
+	code, ok := target.(interface{ DiscordErrorCode() int })
+	return ok && e.Code != 0 && code.DiscordErrorCode() == e.Code
+}
+
+// Unwrap returns the category of the error, such as ErrNotFound or ErrForbidden. Nil is returned when
+// the error does not fit any category.
+func (e *ErrREST) Unwrap() error {
+	switch {
+	case e.HTTPCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.HTTPCode == http.StatusUnauthorized || e.Code == 40001 || e.Code == 50014:
+		return ErrUnauthorized
+	case e.HTTPCode == http.StatusForbidden || e.Code == 50001 || e.Code == 50013:
+		return ErrForbidden
+	case e.HTTPCode == http.StatusNotFound || (e.Code >= 10000 && e.Code < 20000):
+		return ErrNotFound
+	case e.Code >= 30000 && e.Code < 40000:
+		return ErrLimitReached
+	case e.HTTPCode >= http.StatusInternalServerError:
+		return ErrServerError
+	case e.HTTPCode == http.StatusBadRequest:
+		return ErrInvalidRequest
+	}
+	return nil
 }
 
 type HttpClientDoer interface {
@@ -88,10 +150,27 @@ type HttpClientDoer interface {
 // Client for handling Discord REST requests
 type Client struct {
 	url                          string // base url with API version
+	headerMu                     sync.RWMutex
 	reqHeader                    http.Header
 	httpClient                   HttpClientDoer
 	cancelRequestWhenRateLimited bool
 	buckets                      RESTBucketManager
+	onRateLimited                func(hit *RateLimitHit)
+	limiter                      *globalLimiter
+	timeout                      time.Duration
+	proxyRateLimits              bool
+	compressAbove                int
+}
+
+// UpdateToken replaces the bot token used by the following requests. Requests that are already sent are
+// not affected.
+func (c *Client) UpdateToken(token string) {
+	c.headerMu.Lock()
+	defer c.headerMu.Unlock()
+
+	header := copyHeader(c.reqHeader)
+	header.Set("Authorization", fmt.Sprintf(AuthorizationFormat, token))
+	c.reqHeader = header
 }
 
 func (c *Client) BucketGrouping() (group map[string][]string) {
@@ -115,6 +194,16 @@ func SupportsDiscordAPIVersion(version int) bool {
 	return supported
 }
 
+// cloneStaticHeader creates the header of a request from the header fields shared by every request, which are
+// never modified. The values are shared, as they are only replaced and never appended to in place.
+func cloneStaticHeader(h http.Header) http.Header {
+	cp := make(http.Header, len(h)+3)
+	for k, vs := range h {
+		cp[k] = vs[:len(vs):len(vs)]
+	}
+	return cp
+}
+
 func copyHeader(h http.Header) http.Header {
 	cp := make(http.Header, len(h))
 	for k, vs := range h {
@@ -131,7 +220,7 @@ func NewClient(conf *Config) (*Client, error) {
 		return nil, errors.New(fmt.Sprintf("Discord API version %d is not supported", conf.APIVersion))
 	}
 
-	if conf.BotToken == "" {
+	if conf.BotToken == "" && !conf.Unauthenticated {
 		return nil, errors.New("no Discord Bot Token was provided")
 	}
 
@@ -150,20 +239,38 @@ func NewClient(conf *Config) (*Client, error) {
 		return nil, errors.New("both a source(url) and a version must be present for sending requests to the Discord REST API")
 	}
 
+	requestsPerSecond := conf.RequestsPerSecond
+	if requestsPerSecond == 0 {
+		requestsPerSecond = DefaultRequestsPerSecond
+	}
+
 	// setup the required http request header fields
-	authorization := fmt.Sprintf(AuthorizationFormat, conf.BotToken)
 	userAgent := fmt.Sprintf(UserAgentFormat, conf.UserAgentSourceURL, conf.UserAgentVersion, conf.UserAgentExtra)
 	header := map[string][]string{
-		"Authorization":   {authorization},
 		"User-Agent":      {userAgent},
-		"Accept-Encoding": {"gzip"},
+		"Accept-Encoding": {acceptEncoding()},
+	}
+	if conf.BotToken != "" {
+		header["Authorization"] = []string{fmt.Sprintf(AuthorizationFormat, conf.BotToken)}
+	}
+
+	baseURL := BaseURL
+	if conf.BaseURL != "" {
+		baseURL = strings.TrimSuffix(conf.BaseURL, "/")
 	}
 
 	return &Client{
-		url:        BaseURL + "/v" + strconv.Itoa(conf.APIVersion),
-		reqHeader:  header,
-		httpClient: conf.HttpClient,
-		buckets:    conf.RESTBucketManager,
+		url:           baseURL + "/v" + strconv.Itoa(conf.APIVersion),
+		reqHeader:     header,
+		httpClient:    conf.HttpClient,
+		buckets:       conf.RESTBucketManager,
+		onRateLimited: conf.OnRateLimited,
+
+		cancelRequestWhenRateLimited: conf.CancelRequestWhenRateLimited,
+		limiter:                      newGlobalLimiter(requestsPerSecond, conf.MaxConcurrentRequests),
+		timeout:                      conf.Timeout,
+		proxyRateLimits:              conf.ProxyRateLimits,
+		compressAbove:                conf.CompressRequestsAbove,
 	}, nil
 }
 
@@ -173,13 +280,46 @@ type Config struct {
 	APIVersion int
 	BotToken   string
 
+	// BaseURL replaces the Discord API, excluding the version, eg. "http://localhost:8080/api". Defaults
+	// to BaseURL.
+	BaseURL string
+
+	// ProxyRateLimits tells that the API at BaseURL respects the rate limits on behalf of the client, such
+	// that requests are sent without waiting for their bucket to reset.
+	ProxyRateLimits bool
+
+	// Unauthenticated allows the bot token to be empty, for clients that only use endpoints which are
+	// authenticated through the URL, such as webhooks.
+	Unauthenticated bool
+
 	HttpClient HttpClientDoer
 
+	// CancelRequestWhenRateLimited makes requests fail with ErrRateLimited instead of waiting for the
+	// bucket to reset. Can be overridden per request, see WithRateLimitPolicy.
 	CancelRequestWhenRateLimited bool
 
 	// RESTBucketManager stores all rate limit buckets and dictates the behaviour of how rate limiting is respected
 	RESTBucketManager RESTBucketManager
 
+	// OnRateLimited is called whenever Discord responds with a http 429.
+	OnRateLimited func(hit *RateLimitHit)
+
+	// RequestsPerSecond caps the requests sent per second across every bucket, to avoid hitting the
+	// global rate limit. Defaults to DefaultRequestsPerSecond, while a negative value disables the cap.
+	RequestsPerSecond int
+
+	// MaxConcurrentRequests caps the number of requests in flight across every bucket. 0 means no cap.
+	MaxConcurrentRequests int
+
+	// Timeout is the maximum duration of a request, including the time spent waiting for rate limits
+	// and retries. It is combined with the context of the request, where the earliest deadline wins.
+	// 0 means no timeout. Can be overridden per request, see Request.Timeout.
+	Timeout time.Duration
+
+	// CompressRequestsAbove gzip compresses json request bodies larger than the given number of bytes.
+	// 0 disables the compression. Can be overridden per request, see Request.CompressAbove.
+	CompressRequestsAbove int
+
 	// Header field: `User-Agent: DiscordBot ({Source}, {Version}) {Extra}`
 	UserAgentVersion   string
 	UserAgentSourceURL string
@@ -194,36 +334,143 @@ type Details struct {
 	SuccessHTTPCode int
 }
 
-func (c *Client) decodeResponseBody(resp *http.Response) (body []byte, err error) {
-	buffer, err := ioutil.ReadAll(resp.Body)
+// buffers and gzip readers are reused between responses, as large payloads, such as guilds, would otherwise
+// put a lot of pressure on the garbage collector.
+var (
+	bufferPool = sync.Pool{
+		New: func() interface{} {
+			return new(bytes.Buffer)
+		},
+	}
+	gzipReaderPool sync.Pool
+)
+
+// decoders decompress response bodies, by Content-Encoding, in addition to gzip. They are registered by
+// files with build tags, such that their dependencies are optional.
+var decoders = map[string]func(r io.Reader) (io.Reader, error){}
+
+// acceptEncoding lists gzip and every registered decoder, for the Accept-Encoding header.
+func acceptEncoding() string {
+	encodings := []string{GZIPCompression}
+	for encoding := range decoders {
+		encodings = append(encodings, encoding)
+	}
+	sort.Strings(encodings[1:])
+	return strings.Join(encodings, ", ")
+}
+
+// releaseBuffer returns a buffer to the pool.
+func releaseBuffer(buffer *bytes.Buffer) {
+	buffer.Reset()
+	bufferPool.Put(buffer)
+}
+
+// gzipReader reuses a pooled gzip reader, which must be put back into the gzipReaderPool once read.
+func gzipReader(r io.Reader) (*gzip.Reader, error) {
+	if pooled, ok := gzipReaderPool.Get().(*gzip.Reader); ok {
+		if err := pooled.Reset(r); err != nil {
+			return nil, err
+		}
+		return pooled, nil
+	}
+	return gzip.NewReader(r)
+}
+
+// decompress reads a decoded body into a pooled buffer, which must be released by the caller.
+func decompress(r io.Reader) (*bytes.Buffer, error) {
+	decompressed := bufferPool.Get().(*bytes.Buffer)
+	_, err := decompressed.ReadFrom(r)
+	if closer, ok := r.(io.Closer); ok && err == nil {
+		err = closer.Close()
+	}
 	if err != nil {
+		releaseBuffer(decompressed)
 		return nil, err
 	}
+	return decompressed, nil
+}
 
-	switch resp.Header.Get(ContentEncoding) {
+func (c *Client) decodeResponseBody(resp *http.Response) (body []byte, err error) {
+	raw := bufferPool.Get().(*bytes.Buffer)
+	defer releaseBuffer(raw)
+	if resp.ContentLength > 0 {
+		raw.Grow(int(resp.ContentLength))
+	}
+	if _, err = raw.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	var r io.Reader
+	switch encoding := resp.Header.Get(ContentEncoding); encoding {
 	case GZIPCompression:
-		b := bytes.NewBuffer(buffer)
-		r, err := gzip.NewReader(b)
-		if err != nil {
+		var gz *gzip.Reader
+		if gz, err = gzipReader(raw); err != nil {
 			return nil, err
 		}
-		defer r.Close()
+		defer gzipReaderPool.Put(gz)
+		r = gz
+	default:
+		if decode, ok := decoders[encoding]; ok {
+			if r, err = decode(raw); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-		var resB bytes.Buffer
-		_, err = resB.ReadFrom(r)
-		if err != nil {
+	buffer := raw
+	if r != nil {
+		if buffer, err = decompress(r); err != nil {
 			return nil, err
 		}
-
-		body = resB.Bytes()
-	default:
-		body = buffer
+		defer releaseBuffer(buffer)
 	}
 
+	// the body outlives the request, so it can not be pooled
+	body = make([]byte, buffer.Len())
+	copy(body, buffer.Bytes())
 	return body, nil
 }
 
 func (c *Client) Do(ctx context.Context, r *Request) (resp *http.Response, body []byte, err error) {
+	timeout := c.timeout
+	if r.Timeout != 0 {
+		timeout = r.Timeout
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if r.Priority != PriorityNormal {
+		ctx = withPriority(ctx, r.Priority)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, body, err = c.do(ctx, r)
+		if err == nil || attempt >= r.Retries || !retryable(err) || !r.replayable() {
+			return resp, body, err
+		}
+
+		// the body must be encoded again
+		r.bodyReader = nil
+
+		backoff := time.Duration(attempt+1) * 500 * time.Millisecond
+		select {
+		case <-ctx.Done():
+			return nil, nil, err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// retryable reports whether the request failed because of a connection failure or a Discord server error.
+func retryable(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, ErrServerError) || errors.As(err, &netErr)
+}
+
+func (c *Client) do(ctx context.Context, r *Request) (resp *http.Response, body []byte, err error) {
 	r.PopulateMissing()
 	if r.Body != nil && r.bodyReader == nil {
 		switch b := r.Body.(type) { // Determine the type of the passed body so we can treat it differently
@@ -238,6 +485,9 @@ func (c *Client) Do(ctx context.Context, r *Request) (resp *http.Response, body
 			if r.bodyReader, err = convertStructToIOReader(json.Marshal, r.Body); err != nil {
 				return nil, nil, err
 			}
+			if err = c.compressBody(r); err != nil {
+				return nil, nil, err
+			}
 		}
 	}
 
@@ -247,8 +497,16 @@ func (c *Client) Do(ctx context.Context, r *Request) (resp *http.Response, body
 		return nil, nil, err
 	}
 
-	header := copyHeader(c.reqHeader)
+	c.headerMu.RLock()
+	header := cloneStaticHeader(c.reqHeader)
+	c.headerMu.RUnlock()
 	header.Set(ContentType, r.ContentType)
+	if r.compressed {
+		header.Set(ContentEncoding, GZIPCompression)
+	}
+	if r.Authorization != "" {
+		header.Set("Authorization", r.Authorization)
+	}
 	if r.Reason != "" {
 		header.Add(XAuditLogReason, r.Reason)
 	} else {
@@ -257,34 +515,59 @@ func (c *Client) Do(ctx context.Context, r *Request) (resp *http.Response, body
 	}
 	req.Header = header
 
-	// queue & send request
-	c.buckets.Bucket(r.hashedEndpoint, func(bucket RESTBucket) {
-		resp, body, err = bucket.Transaction(ctx, func() (*http.Response, []byte, error) {
-			resp, err := c.httpClient.Do(req)
-			if err != nil {
-				return nil, nil, err
-			}
+	// resolve the rate limit behaviour of the request before it is queued
+	if c.cancelRequestWhenRateLimited && transactionOptionsFromContext(ctx).policy == RateLimitDefault {
+		ctx = WithRateLimitPolicy(ctx, RateLimitFailFast)
+	}
 
-			// store the current timestamp
-			epochMs := time.Now().UnixNano() / int64(time.Millisecond)
-			resp.Header.Set(XDisgordNow, strconv.FormatInt(epochMs, 10))
+	send := func() (*http.Response, []byte, error) {
+		if err := c.limiter.acquire(ctx); err != nil {
+			return nil, nil, err
+		}
+		resp, err := c.httpClient.Do(req)
+		c.limiter.release()
+		if err != nil {
+			return nil, nil, err
+		}
 
-			// decode body
-			body, err := c.decodeResponseBody(resp)
-			_ = resp.Body.Close()
-			if err != nil {
-				return nil, nil, err
-			}
+		// store the current timestamp
+		epochMs := time.Now().UnixNano() / int64(time.Millisecond)
+		resp.Header.Set(XDisgordNow, strconv.FormatInt(epochMs, 10))
 
-			// normalize Discord header fields
-			resp.Header, err = NormalizeDiscordHeader(resp.StatusCode, resp.Header, body)
-			return resp, body, err
+		// decode body
+		body, err := c.decodeResponseBody(resp)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// normalize Discord header fields
+		resp.Header, err = NormalizeDiscordHeader(resp.StatusCode, resp.Header, body)
+		return resp, body, err
+	}
+
+	// queue & send request
+	if c.proxyRateLimits {
+		resp, body, err = send()
+	} else {
+		c.buckets.Bucket(r.hashedEndpoint, func(bucket RESTBucket) {
+			resp, body, err = bucket.Transaction(ctx, send)
 		})
-	})
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		hit := newRateLimitHit(resp.Header, r.hashedEndpoint)
+		if hit.Global {
+			c.limiter.block(time.Now().Add(hit.RetryAfter))
+		}
+		if c.onRateLimited != nil {
+			c.onRateLimited(hit)
+		}
+	}
+
 	// check if request was successful
 	noDiff := resp.StatusCode == http.StatusNotModified
 	withinSuccessScope := 200 <= resp.StatusCode && resp.StatusCode < 300
@@ -311,6 +594,32 @@ func (c *Client) Do(ctx context.Context, r *Request) (resp *http.Response, body
 	return resp, body, nil
 }
 
+// compressBody gzip compresses the encoded json body when it exceeds the compression threshold.
+func (c *Client) compressBody(r *Request) error {
+	threshold := c.compressAbove
+	if r.CompressAbove != 0 {
+		threshold = r.CompressAbove
+	}
+	r.compressed = false
+
+	body, ok := r.bodyReader.(*bytes.Reader)
+	if !ok || threshold <= 0 || body.Len() <= threshold {
+		return nil
+	}
+
+	var buffer bytes.Buffer
+	gz := gzip.NewWriter(&buffer)
+	if _, err := body.WriteTo(gz); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	r.bodyReader = bytes.NewReader(buffer.Bytes())
+	r.compressed = true
+	return nil
+}
+
 // helper functions
 func convertStructToIOReader(marshal func(v interface{}) ([]byte, error), v interface{}) (io.Reader, error) {
 	jsonParamsBytes, err := marshal(v)
@@ -4,10 +4,18 @@ package httd
 
 import (
 	"bytes"
+	"compress/flate"
 	"compress/gzip"
+	"context"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/json"
 )
 
 func missingImplError(t *testing.T, interfaceName string) {
@@ -77,3 +85,380 @@ func TestDecodingResponseBodyWithGZIP(t *testing.T) {
 		t.Errorf("decoding failed. Got %s, wants %s", string(body), expected)
 	}
 }
+
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_RateLimits(t *testing.T) {
+	var hits []*RateLimitHit
+	client, err := NewClient(&Config{
+		APIVersion:         8,
+		BotToken:           "testing",
+		UserAgentSourceURL: "localhost",
+		UserAgentVersion:   "v0",
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			}
+			resp.Header.Set("date", time.Now().Format(time.RFC1123))
+			resp.Header.Set(XRateLimitBucket, "abcd")
+			resp.Header.Set(XRateLimitLimit, "5")
+			resp.Header.Set(XRateLimitRemaining, "4")
+			resp.Header.Set(XRateLimitResetAfter, "1.5")
+			if req.Method == http.MethodPost {
+				resp.StatusCode = http.StatusTooManyRequests
+				resp.Header.Set(XRateLimitRemaining, "0")
+				resp.Header.Set(XRateLimitScope, "shared")
+			}
+			return resp, nil
+		}),
+		OnRateLimited: func(hit *RateLimitHit) {
+			hits = append(hits, hit)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = client.Do(context.Background(), &Request{Endpoint: "/channels/1/messages"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var found bool
+	for _, limit := range client.RateLimits() {
+		if limit.Bucket != "abcd" {
+			continue
+		}
+		found = true
+		if limit.Limit != 5 || limit.Remaining != 4 {
+			t.Errorf("unexpected limit/remaining. Got %d/%d", limit.Limit, limit.Remaining)
+		}
+		if len(limit.Endpoints) != 1 || limit.Endpoints[0] != "GET:/channels/1/messages" {
+			t.Errorf("unexpected endpoints. Got %+v", limit.Endpoints)
+		}
+		if !limit.Reset.After(time.Now()) {
+			t.Error("expected the reset to be in the future")
+		}
+	}
+	if !found {
+		t.Errorf("bucket was not listed. Got %+v", client.RateLimits())
+	}
+
+	_, _, err = client.Do(context.Background(), &Request{Method: MethodPost, Endpoint: "/channels/1/messages"})
+	if err == nil {
+		t.Fatal("expected an error for a 429 response")
+	}
+	if len(hits) != 1 {
+		t.Fatalf("expected one rate limit hit. Got %d", len(hits))
+	}
+	if hits[0].Scope != "shared" || hits[0].Global {
+		t.Errorf("unexpected scope. Got %+v", hits[0])
+	}
+	if hits[0].RetryAfter <= 0 || hits[0].RetryAfter > 1500*time.Millisecond {
+		t.Errorf("unexpected retry after. Got %s", hits[0].RetryAfter)
+	}
+}
+
+func TestClient_GlobalRateLimit(t *testing.T) {
+	var requests int
+	client, err := NewClient(&Config{
+		APIVersion:         8,
+		BotToken:           "testing",
+		UserAgentSourceURL: "localhost",
+		UserAgentVersion:   "v0",
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			resp := &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"message":"rate limited","retry_after":60}`)),
+			}
+			resp.Header.Set("date", time.Now().Format(time.RFC1123))
+			resp.Header.Set(XRateLimitScope, "global")
+			return resp, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = client.Do(context.Background(), &Request{Endpoint: "/channels/1"}); err == nil {
+		t.Fatal("expected an error for a 429 response")
+	}
+
+	// other routes must wait for the global rate limit as well
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, _, err = client.Do(ctx, &Request{Endpoint: "/guilds/1"}); err == nil {
+		t.Error("expected the global rate limit to block the request")
+	}
+	if requests != 1 {
+		t.Errorf("expected one request to be sent. Got %d", requests)
+	}
+}
+
+func TestErrREST_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"code": 50035,
+		"message": "Invalid Form Body",
+		"errors": {
+			"embeds": {"0": {"fields": {"1": {"name": {"_errors": [
+				{"code": "BASE_TYPE_MAX_LENGTH", "message": "Must be 256 or fewer in length."}
+			]}}}}},
+			"content": {"_errors": [{"code": "BASE_TYPE_REQUIRED", "message": "This field is required"}]}
+		}
+	}`)
+
+	err := &ErrREST{}
+	if e := json.Unmarshal(data, err); e != nil {
+		t.Fatal(e)
+	}
+	if err.Code != 50035 || err.Msg != "Invalid Form Body" {
+		t.Errorf("unexpected code or message. Got %d, %s", err.Code, err.Msg)
+	}
+
+	expected := []FieldError{
+		{Path: "content", Code: "BASE_TYPE_REQUIRED", Message: "This field is required"},
+		{Path: "embeds.0.fields.1.name", Code: "BASE_TYPE_MAX_LENGTH", Message: "Must be 256 or fewer in length."},
+	}
+	if !reflect.DeepEqual(err.Errors, expected) {
+		t.Errorf("unexpected field errors. Got %+v", err.Errors)
+	}
+}
+
+func TestClient_Timeout(t *testing.T) {
+	client, err := NewClient(&Config{
+		APIVersion:         8,
+		BotToken:           "testing",
+		UserAgentSourceURL: "localhost",
+		UserAgentVersion:   "v0",
+		Timeout:            time.Hour,
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, _, err = client.Do(context.Background(), &Request{Endpoint: "/channels/1", Timeout: 50 * time.Millisecond})
+	if err == nil {
+		t.Fatal("expected the request to time out")
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("the request timeout was not used. Took %s", time.Since(start))
+	}
+}
+
+func TestClient_BaseURL(t *testing.T) {
+	var urls []string
+	client, err := NewClient(&Config{
+		APIVersion:         8,
+		BotToken:           "testing",
+		UserAgentSourceURL: "localhost",
+		UserAgentVersion:   "v0",
+		BaseURL:            "http://localhost:8080/api/",
+		ProxyRateLimits:    true,
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			urls = append(urls, req.URL.String())
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			}
+			resp.Header.Set(XRateLimitBucket, "abcd")
+			resp.Header.Set(XRateLimitLimit, "1")
+			resp.Header.Set(XRateLimitRemaining, "0")
+			resp.Header.Set(XRateLimitResetAfter, "60")
+			return resp, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the proxy respects the rate limits, so the exhausted bucket must not hold back the second request
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		if _, _, err = client.Do(ctx, &Request{Endpoint: "/channels/1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(urls) != 2 || urls[0] != "http://localhost:8080/api/v8/channels/1" {
+		t.Errorf("expected the requests to be sent to the proxy. Got %+v", urls)
+	}
+}
+
+func gzipped(b *testing.B, data []byte) []byte {
+	var buffer bytes.Buffer
+	gz := gzip.NewWriter(&buffer)
+	if _, err := gz.Write(data); err != nil {
+		b.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		b.Fatal(err)
+	}
+	return buffer.Bytes()
+}
+
+func BenchmarkClient_decodeResponseBody(b *testing.B) {
+	payload := gzipped(b, bytes.Repeat([]byte(`{"id":"486833611564253186","name":"general","type":0},`), 200))
+	client := &Client{}
+	header := http.Header{ContentEncoding: []string{GZIPCompression}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp := &http.Response{
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(payload)),
+			ContentLength: int64(len(payload)),
+		}
+		if _, err := client.decodeResponseBody(resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkClient_Do(b *testing.B) {
+	payload := gzipped(b, bytes.Repeat([]byte(`{"id":"486833611564253186","name":"general","type":0},`), 200))
+	client, err := NewClient(&Config{
+		APIVersion:         8,
+		BotToken:           "testing",
+		UserAgentSourceURL: "localhost",
+		UserAgentVersion:   "v0",
+		BaseURL:            "http://localhost/api",
+		ProxyRateLimits:    true,
+		RequestsPerSecond:  -1,
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			resp := &http.Response{
+				StatusCode:    http.StatusOK,
+				Header:        make(http.Header),
+				Body:          ioutil.NopCloser(bytes.NewReader(payload)),
+				ContentLength: int64(len(payload)),
+			}
+			resp.Header.Set(ContentEncoding, GZIPCompression)
+			return resp, nil
+		}),
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err = client.Do(context.Background(), &Request{Endpoint: "/guilds/1/channels"}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestClient_CompressRequestsAbove(t *testing.T) {
+	var encoding string
+	var received []byte
+	client, err := NewClient(&Config{
+		APIVersion:            8,
+		BotToken:              "testing",
+		UserAgentSourceURL:    "localhost",
+		UserAgentVersion:      "v0",
+		CompressRequestsAbove: 100,
+		HttpClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			encoding = req.Header.Get(ContentEncoding)
+			received, _ = ioutil.ReadAll(req.Body)
+			if encoding == GZIPCompression {
+				gz, err := gzip.NewReader(bytes.NewReader(received))
+				if err != nil {
+					return nil, err
+				}
+				received, _ = ioutil.ReadAll(gz)
+			}
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	large := map[string]string{"content": string(bytes.Repeat([]byte("a"), 200))}
+	table := []struct {
+		name       string
+		body       interface{}
+		threshold  int
+		compressed bool
+	}{
+		{"small", map[string]string{"content": "a"}, 0, false},
+		{"large", large, 0, true},
+		{"disabled-per-request", large, -1, false},
+		{"raised-per-request", large, 1000, false},
+	}
+	for _, tt := range table {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.Do(context.Background(), &Request{
+				Method:        MethodPost,
+				Endpoint:      "/channels/1/messages",
+				Body:          tt.body,
+				ContentType:   ContentTypeJSON,
+				CompressAbove: tt.threshold,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if compressed := encoding == GZIPCompression; compressed != tt.compressed {
+				t.Errorf("expected compressed to be %t. Got Content-Encoding %q", tt.compressed, encoding)
+			}
+
+			wants, _ := json.Marshal(tt.body)
+			if !bytes.Equal(received, wants) {
+				t.Errorf("unexpected body. Got %s, wants %s", received, wants)
+			}
+		})
+	}
+}
+
+func TestDecodingResponseBodyWithDecoder(t *testing.T) {
+	// deflate stands in for brotli, which is only registered with the disgord_brotli build tag
+	registered := decoders
+	defer func() {
+		decoders = registered
+	}()
+	decoders = map[string]func(r io.Reader) (io.Reader, error){
+		"deflate": func(r io.Reader) (io.Reader, error) {
+			return flate.NewReader(r), nil
+		},
+	}
+
+	if accept := acceptEncoding(); !strings.HasPrefix(accept, "gzip, ") || !strings.Contains(accept, "deflate") {
+		t.Errorf("expected the decoder to be advertised. Got %q", accept)
+	}
+
+	expected := "9ng574g8573g394g3874gf837g"
+	var b bytes.Buffer
+	w, _ := flate.NewWriter(&b, flate.DefaultCompression)
+	_, _ = w.Write([]byte(expected))
+	_ = w.Close()
+
+	resp := &http.Response{
+		Body:   ioutil.NopCloser(&b),
+		Header: http.Header{ContentEncoding: []string{"deflate"}},
+	}
+	body, err := (&Client{}).decodeResponseBody(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != expected {
+		t.Errorf("decoding failed. Got %s, wants %s", string(body), expected)
+	}
+}
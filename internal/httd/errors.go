@@ -15,4 +15,50 @@ func (e *Error) Error() string {
 
 var (
 	ErrRateLimited error = &Error{"rate limited", time.Unix(0, 0)}
+
+	// categories of Discord errors, see ErrREST.Unwrap
+	ErrUnauthorized   error = &Error{"unauthorized", time.Unix(0, 0)}
+	ErrForbidden      error = &Error{"missing access or permissions", time.Unix(0, 0)}
+	ErrNotFound       error = &Error{"unknown resource", time.Unix(0, 0)}
+	ErrLimitReached   error = &Error{"maximum number reached", time.Unix(0, 0)}
+	ErrInvalidRequest error = &Error{"invalid request", time.Unix(0, 0)}
+	ErrServerError    error = &Error{"discord server error", time.Unix(0, 0)}
 )
+
+// FieldError is an error for a single field of a request body, eg. an embed field name that is too long.
+type FieldError struct {
+	// Path to the invalid field, eg. "embeds.0.fields.1.name".
+	Path    string
+	Code    string // eg. BASE_TYPE_MAX_LENGTH
+	Message string
+}
+
+func (e FieldError) String() string {
+	return e.Path + ": " + e.Code + " " + e.Message
+}
+
+// parseFieldErrors flattens the nested errors object of Discord into field errors.
+//  {"embeds": {"0": {"fields": {"1": {"name": {"_errors": [{"code": "BASE_TYPE_MAX_LENGTH", "message": "..."}]}}}}}}
+func parseFieldErrors(path string, errs map[string]interface{}, fieldErrs []FieldError) []FieldError {
+	for key, value := range errs {
+		if key == "_errors" {
+			list, _ := value.([]interface{})
+			for i := range list {
+				item, _ := list[i].(map[string]interface{})
+				code, _ := item["code"].(string)
+				message, _ := item["message"].(string)
+				fieldErrs = append(fieldErrs, FieldError{Path: path, Code: code, Message: message})
+			}
+			continue
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			subPath := key
+			if path != "" {
+				subPath = path + "." + key
+			}
+			fieldErrs = parseFieldErrors(subPath, nested, fieldErrs)
+		}
+	}
+	return fieldErrs
+}
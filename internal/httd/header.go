@@ -2,6 +2,7 @@ package httd
 
 import (
 	"errors"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -19,6 +20,7 @@ const (
 	XRateLimitReset         = "X-RateLimit-Reset"
 	XRateLimitResetAfter    = "X-RateLimit-Reset-After"
 	XRateLimitGlobal        = "X-RateLimit-Global"
+	XRateLimitScope         = "X-RateLimit-Scope"
 	RateLimitRetryAfter     = "Retry-After"
 	DisgordNormalizedHeader = "X-Disgord-Normalized-Kufdsfksduhf-S47yf"
 	XDisgordNow             = "X-Disgord-Now-fsagkhf"
@@ -38,6 +40,22 @@ func HeaderToTime(header http.Header) (t time.Time, err error) {
 	return
 }
 
+// clockSkew estimates how far the local clock is ahead of the Discord servers, using the Date header. As the
+// Date header only has a precision of seconds, a difference below one second is not regarded as skew.
+func clockSkew(header http.Header, now time.Time) time.Duration {
+	date, err := HeaderToTime(header)
+	if err != nil {
+		return 0
+	}
+
+	// the date is truncated, so the server time is somewhere within the second after it
+	skew := now.Sub(date)
+	if skew >= 0 && skew < time.Second {
+		return 0
+	}
+	return skew
+}
+
 type RateLimitResponseStructure struct {
 	Message    string  `json:"message"`     // A message saying you are being rate limited.
 	RetryAfter float64 `json:"retry_after"` // The number of seconds to wait before submitting another request.
@@ -47,9 +65,9 @@ type RateLimitResponseStructure struct {
 // NormalizeDiscordHeader overrides header fields with body content and make sure every header field
 // uses milliseconds and not seconds. Regards rate limits only.
 func NormalizeDiscordHeader(statusCode int, header http.Header, body []byte) (h http.Header, err error) {
+	// rounded up, such that fractional delays such as 0.0015 do not reset early
 	secondsToMilli := func(s float64) int64 {
-		s *= 1000
-		return int64(s)
+		return int64(math.Ceil(s*1000 - 1e-6))
 	}
 
 	var now time.Time
@@ -72,42 +90,40 @@ func NormalizeDiscordHeader(statusCode int, header http.Header, body []byte) (h
 		delay = secondsToMilli(delayF)
 	}
 
-	// sometimes the body might be populated too
-	if delay == 0 && statusCode == http.StatusTooManyRequests && body != nil {
+	if statusCode == http.StatusTooManyRequests {
+		// the body of a 429 tells the wait for the limit that was hit, which is not necessarily the
+		// route, as global and shared limits can be hit too. A 429 from the edge, such as a Cloudflare ban,
+		// is not json.
 		var rateLimitBodyInfo *RateLimitResponseStructure
-		if err = json.Unmarshal(body, &rateLimitBodyInfo); err != nil {
-			return nil, err
-		}
-		if rateLimitBodyInfo.Global {
-			header.Set(XRateLimitGlobal, "true")
+		if len(body) > 0 && json.Unmarshal(body, &rateLimitBodyInfo) == nil && rateLimitBodyInfo != nil {
+			if rateLimitBodyInfo.Global {
+				header.Set(XRateLimitGlobal, "true")
+			}
+			if rateLimitBodyInfo.RetryAfter > 0 {
+				delay = secondsToMilli(rateLimitBodyInfo.RetryAfter)
+			}
 		}
-		if delay == 0 && rateLimitBodyInfo.RetryAfter > 0 {
-			delay = secondsToMilli(rateLimitBodyInfo.RetryAfter)
+		if retry := header.Get(RateLimitRetryAfter); delay == 0 && retry != "" {
+			delayF, _ := strconv.ParseFloat(retry, 64)
+			delay = secondsToMilli(delayF)
 		}
 	}
 
-	// convert reset to store milliseconds and not seconds
-	// if there is no content, we create a reset unix using the delay
-	if reset := header.Get(XRateLimitReset); reset != "" {
-		if delay == 0 {
-			epoch, _ := strconv.ParseFloat(reset, 64)
-			epochMilli := secondsToMilli(epoch)
-			header.Set(XRateLimitReset, strconv.FormatInt(epochMilli, 10))
-		} else {
-			epochNow := now.UnixNano() / int64(time.Millisecond)
-			header.Set(XRateLimitReset, strconv.FormatInt(epochNow+delay, 10))
-		}
-	} else if delay > 0 {
-		timestamp, err := HeaderToTime(header)
-		if err != nil {
-			// does add an delay, but there is no reason
-			// to go insane if timestamp could not be handled
-			timestamp = now
-		}
+	// a global rate limit affects every bucket
+	if header.Get(XRateLimitScope) == "global" {
+		header.Set(XRateLimitGlobal, "true")
+	}
 
-		reset := timestamp.Add(time.Duration(delay) * time.Millisecond)
-		ms := reset.UnixNano() / int64(time.Millisecond)
-		header.Set(XRateLimitReset, strconv.FormatInt(ms, 10))
+	// X-RateLimit-Reset is rewritten to the local clock, in milliseconds. A relative delay is preferred as it
+	// does not depend on the clocks being synchronised, and is added to the time the response was received.
+	// The absolute epoch of Discord is only used when there is no delay, corrected by the clock skew.
+	epochNow := now.UnixNano() / int64(time.Millisecond)
+	if delay > 0 {
+		header.Set(XRateLimitReset, strconv.FormatInt(epochNow+delay, 10))
+	} else if reset := header.Get(XRateLimitReset); reset != "" {
+		epoch, _ := strconv.ParseFloat(reset, 64)
+		epochMilli := secondsToMilli(epoch) + clockSkew(header, now).Milliseconds()
+		header.Set(XRateLimitReset, strconv.FormatInt(epochMilli, 10))
 	}
 
 	header.Set(DisgordNormalizedHeader, "true")
@@ -0,0 +1,88 @@
+// +build !integration
+
+package httd
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNormalizeDiscordHeader_ClockSkew(t *testing.T) {
+	// the local clock is 10 seconds ahead of Discord
+	local := time.Unix(1600000000, 0)
+	discord := local.Add(-10 * time.Second)
+	stamp := strconv.FormatInt(local.UnixNano()/int64(time.Millisecond), 10)
+
+	t.Run("reset-after", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(XDisgordNow, stamp)
+		header.Set("Date", discord.UTC().Format(time.RFC1123))
+		header.Set(XRateLimitReset, strconv.FormatInt(discord.Add(2*time.Second).Unix(), 10))
+		header.Set(XRateLimitResetAfter, "2.5")
+
+		header, err := NormalizeDiscordHeader(http.StatusOK, header, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wants := local.Add(2500*time.Millisecond).UnixNano() / int64(time.Millisecond)
+		if got := header.Get(XRateLimitReset); got != strconv.FormatInt(wants, 10) {
+			t.Errorf("expected the reset to be relative to the local clock. Got %s, wants %d", got, wants)
+		}
+	})
+	t.Run("absolute-reset", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(XDisgordNow, stamp)
+		header.Set("Date", discord.UTC().Format(time.RFC1123))
+		header.Set(XRateLimitReset, strconv.FormatInt(discord.Add(2*time.Second).Unix(), 10))
+
+		header, err := NormalizeDiscordHeader(http.StatusOK, header, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wants := local.Add(2*time.Second).UnixNano() / int64(time.Millisecond)
+		if got := header.Get(XRateLimitReset); got != strconv.FormatInt(wants, 10) {
+			t.Errorf("expected the reset to be corrected by the clock skew. Got %s, wants %d", got, wants)
+		}
+	})
+	t.Run("bucket", func(t *testing.T) {
+		header := http.Header{}
+		header.Set(XDisgordNow, strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10))
+		header.Set("Date", time.Now().Add(-time.Hour).UTC().Format(time.RFC1123))
+		header.Set(XRateLimitRemaining, "0")
+		header.Set(XRateLimitResetAfter, "2")
+
+		header, err := NormalizeDiscordHeader(http.StatusOK, header, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		bucket := newLeakyBucket(nil)
+		bucket.updateAfterRequest(header, http.StatusOK)
+		if wait := time.Until(bucket.resetTime); wait < time.Second || wait > 2*time.Second {
+			t.Errorf("expected the bucket to reset in 2 seconds, regardless of the Date header. Got %s", wait)
+		}
+	})
+}
+
+func TestNormalizeDiscordHeader_Fractional(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	epochNow := now.UnixNano() / int64(time.Millisecond)
+
+	for delay, wants := range map[string]int64{"0.0015": 2, "2.787": 2787, "1.1": 1100} {
+		header := http.Header{}
+		header.Set(XDisgordNow, strconv.FormatInt(epochNow, 10))
+		header.Set(XRateLimitResetAfter, delay)
+
+		header, err := NormalizeDiscordHeader(http.StatusOK, header, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := header.Get(XRateLimitReset); got != strconv.FormatInt(epochNow+wants, 10) {
+			t.Errorf("expected a reset after %dms for %ss. Got %s, wants %d", wants, delay, got, epochNow+wants)
+		}
+	}
+}
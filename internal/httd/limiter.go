@@ -0,0 +1,100 @@
+package httd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the global rate limit of Discord for most bots.
+const DefaultRequestsPerSecond = 50
+
+// globalLimiter restricts the number of requests sent across every bucket. It caps the requests per
+// second to stay below the global rate limit, the number of requests in flight, and pauses every request
+// when Discord responds with a global 429.
+type globalLimiter struct {
+	mu           sync.Mutex
+	perSecond    int
+	windowStart  time.Time
+	windowCount  int
+	blockedUntil time.Time
+
+	inFlight chan struct{} // nil when the number of concurrent requests is not capped
+}
+
+func newGlobalLimiter(perSecond, maxConcurrent int) *globalLimiter {
+	l := &globalLimiter{perSecond: perSecond}
+	if maxConcurrent > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// reserve returns how long to wait before a request can be sent. A request slot is reserved when the
+// wait is 0.
+func (l *globalLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.perSecond <= 0 {
+		return 0
+	}
+
+	if now.Sub(l.windowStart) >= time.Second {
+		l.windowStart = now
+		l.windowCount = 0
+	}
+	if l.windowCount >= l.perSecond {
+		return l.windowStart.Add(time.Second).Sub(now)
+	}
+	l.windowCount++
+	return 0
+}
+
+// acquire blocks until a request may be sent. release must be called once the response is received.
+func (l *globalLimiter) acquire(ctx context.Context) error {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return errors.New("time out")
+		}
+	}
+
+	for {
+		wait := l.reserve(time.Now())
+		if wait == 0 {
+			return nil
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(time.Now().Add(wait)) {
+			l.release()
+			return errors.New("time out, global rate limit resets in " + wait.String())
+		}
+		select {
+		case <-ctx.Done():
+			l.release()
+			return errors.New("time out")
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (l *globalLimiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// block pauses every request until the global rate limit resets.
+func (l *globalLimiter) block(until time.Time) {
+	l.mu.Lock()
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+	l.mu.Unlock()
+}
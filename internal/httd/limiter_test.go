@@ -0,0 +1,49 @@
+// +build !integration
+
+package httd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGlobalLimiter_reserve(t *testing.T) {
+	limiter := newGlobalLimiter(2, 0)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(now); wait != 0 {
+			t.Fatalf("request %d should not wait. Got %s", i, wait)
+		}
+	}
+	if wait := limiter.reserve(now.Add(200 * time.Millisecond)); wait != 800*time.Millisecond {
+		t.Errorf("expected to wait for the next window. Got %s", wait)
+	}
+	if wait := limiter.reserve(now.Add(time.Second)); wait != 0 {
+		t.Errorf("expected a new window. Got %s", wait)
+	}
+
+	limiter.block(now.Add(3 * time.Second))
+	if wait := limiter.reserve(now.Add(time.Second)); wait != 2*time.Second {
+		t.Errorf("expected to wait for the global rate limit. Got %s", wait)
+	}
+}
+
+func TestGlobalLimiter_acquire(t *testing.T) {
+	limiter := newGlobalLimiter(-1, 1)
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(ctx); err == nil {
+		t.Error("expected the in flight cap to block the request")
+	}
+
+	limiter.release()
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,173 @@
+package httd
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// RateLimit is the last known state of a rate limit bucket.
+type RateLimit struct {
+	// Bucket is the bucket hash designated by Discord. Buckets which Discord has not described yet
+	// uses the hashed endpoint instead.
+	Bucket string
+
+	// Endpoints holds the hashed endpoints that share the bucket, eg. "GET:/channels/{id}/messages".
+	Endpoints []string
+
+	// Limit and Remaining are -1 when unknown.
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	Global    bool
+}
+
+// RateLimitHit describes a http 429 response from Discord.
+type RateLimitHit struct {
+	// Scope is the X-RateLimit-Scope header: "user", "global" or "shared".
+	Scope      string
+	Global     bool
+	RetryAfter time.Duration
+
+	Bucket         string
+	HashedEndpoint string
+}
+
+// RateLimitInspector is implemented by bucket managers that can describe their buckets.
+type RateLimitInspector interface {
+	RateLimits() []RateLimit
+}
+
+var _ RateLimitInspector = (*Manager)(nil)
+
+// RateLimits returns the state of every known bucket, including the global bucket.
+func (r *Manager) RateLimits() []RateLimit {
+	r.mu.RLock()
+	endpoints := make(map[*ltBucket][]string)
+	for id, pID := range r.proxy {
+		if bucket, ok := r.buckets[pID]; ok {
+			endpoints[bucket] = append(endpoints[bucket], id)
+		}
+	}
+	buckets := make([]*ltBucket, 0, len(endpoints)+1)
+	buckets = append(buckets, r.global)
+	seen := map[*ltBucket]bool{r.global: true}
+	for _, bucket := range r.buckets {
+		if !seen[bucket] {
+			seen[bucket] = true
+			buckets = append(buckets, bucket)
+		}
+	}
+	r.mu.RUnlock()
+
+	limits := make([]RateLimit, 0, len(buckets))
+	for _, bucket := range buckets {
+		ids := endpoints[bucket]
+		sort.Strings(ids)
+
+		bucket.mu.RLock()
+		limit := RateLimit{
+			Bucket:    bucket.hash,
+			Endpoints: ids,
+			Limit:     bucket.limit,
+			Remaining: bucket.remaining,
+			Reset:     bucket.resetTime,
+			Global:    bucket == r.global,
+		}
+		bucket.mu.RUnlock()
+
+		if limit.Bucket == "" && len(ids) > 0 {
+			limit.Bucket = ids[0]
+		}
+		limits = append(limits, limit)
+	}
+	return limits
+}
+
+// RateLimits returns the state of every known bucket. Nil is returned when the bucket manager does
+// not implement RateLimitInspector.
+func (c *Client) RateLimits() []RateLimit {
+	if inspector, ok := c.buckets.(RateLimitInspector); ok {
+		return inspector.RateLimits()
+	}
+	return nil
+}
+
+// newRateLimitHit extracts the rate limit details of a 429 response.
+//
+// Note! you must call NormalizeDiscordHeader before using this.
+func newRateLimitHit(header http.Header, hashedEndpoint string) *RateLimitHit {
+	hit := &RateLimitHit{
+		Scope:          header.Get(XRateLimitScope),
+		Bucket:         header.Get(XRateLimitBucket),
+		HashedEndpoint: hashedEndpoint,
+	}
+	hit.Global = hit.Scope == "global" || header.Get(XRateLimitGlobal) == "true"
+	if hit.Scope == "" {
+		if hit.Global {
+			hit.Scope = "global"
+		} else {
+			hit.Scope = "user"
+		}
+	}
+
+	reset, _ := strconv.ParseInt(header.Get(XRateLimitReset), 10, 64)
+	now, _ := strconv.ParseInt(header.Get(XDisgordNow), 10, 64)
+	if reset > now && now > 0 {
+		hit.RetryAfter = time.Duration(reset-now) * time.Millisecond
+	}
+	return hit
+}
+
+// RateLimitPolicy decides what a request does when its bucket is rate limited.
+type RateLimitPolicy int
+
+const (
+	// RateLimitDefault uses the CancelRequestWhenRateLimited option of the client.
+	RateLimitDefault RateLimitPolicy = iota
+
+	// RateLimitWait waits for the bucket to reset, until the context is done.
+	RateLimitWait
+
+	// RateLimitFailFast returns ErrRateLimited instead of waiting for the bucket to reset.
+	RateLimitFailFast
+)
+
+type transactionOptionsKey struct{}
+
+// transactionOptions holds the per request rate limit behaviour, and is passed to the bucket through
+// the request context.
+type transactionOptions struct {
+	policy       RateLimitPolicy
+	maxQueueWait time.Duration
+	priority     Priority
+}
+
+func transactionOptionsFromContext(ctx context.Context) transactionOptions {
+	opts, _ := ctx.Value(transactionOptionsKey{}).(transactionOptions)
+	return opts
+}
+
+// WithRateLimitPolicy overrides the rate limit behaviour for requests using the context.
+func WithRateLimitPolicy(ctx context.Context, policy RateLimitPolicy) context.Context {
+	opts := transactionOptionsFromContext(ctx)
+	opts.policy = policy
+	return context.WithValue(ctx, transactionOptionsKey{}, opts)
+}
+
+// WithMaxQueueWait limits how long requests using the context can wait in a bucket queue, including
+// waiting for the bucket to reset. ErrRateLimited is returned once the duration is exceeded, while the
+// context deadline still applies to the http request itself.
+func WithMaxQueueWait(ctx context.Context, wait time.Duration) context.Context {
+	opts := transactionOptionsFromContext(ctx)
+	opts.maxQueueWait = wait
+	return context.WithValue(ctx, transactionOptionsKey{}, opts)
+}
+
+func withPriority(ctx context.Context, priority Priority) context.Context {
+	opts := transactionOptionsFromContext(ctx)
+	opts.priority = priority
+	return context.WithValue(ctx, transactionOptionsKey{}, opts)
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"regexp"
 	"strings"
+	"time"
 )
 
 type httpMethod string
@@ -25,11 +25,6 @@ const (
 	MethodPut    httpMethod = http.MethodPut
 )
 
-var regexpURLSnowflakes = regexp.MustCompile(RegexpURLSnowflakes)
-var regexpURLReactionPrefix = regexp.MustCompile(`\/channels\/` + RegexpSnowflakes + `\/messages\/\{id\}\/reactions\/`)
-var regexpURLReactionEmoji = regexp.MustCompile(`\/channels\/[0-9]+\/messages\/\{id\}\/reactions\/` + RegexpEmoji + `\/?`)
-var regexpURLReactionEmojiSegment = regexp.MustCompile(`\/reactions\/` + RegexpEmoji)
-
 // Request is populated before executing a Discord request to correctly generate a http request
 type Request struct {
 	Ctx context.Context
@@ -42,8 +37,40 @@ type Request struct {
 	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
 	Reason string
 
+	// Authorization overrides the Authorization header of the client for this request only. Used for
+	// endpoints that must be called on behalf of a user, eg. with an OAuth2 bearer token.
+	Authorization string
+
+	// Retries is the number of times the request is retried on server errors and connection failures.
+	// Requests with a io.Reader body are never retried, as the body can only be read once.
+	Retries int
+
+	// Priority decides the order of the request among the requests waiting for the same bucket.
+	Priority Priority
+
+	// Timeout overrides the timeout of the client for this request. A negative value disables the timeout.
+	Timeout time.Duration
+
+	// CompressAbove overrides the compression threshold of the client for this request, see
+	// Config.CompressRequestsAbove. A negative value disables the compression.
+	CompressAbove int
+
 	bodyReader     io.Reader
 	hashedEndpoint string
+	compressed     bool
+}
+
+// Priority decides the order of requests waiting in the same bucket queue.
+type Priority int
+
+const (
+	PriorityNormal Priority = iota
+	PriorityHigh
+)
+
+func (r *Request) replayable() bool {
+	_, isReader := r.Body.(io.Reader)
+	return !isReader
 }
 
 func (r *Request) PopulateMissing() {
@@ -61,52 +88,62 @@ func (r *Request) PopulateMissing() {
 	r.hashedEndpoint = r.HashEndpoint()
 }
 
+// HashEndpoint identifies the rate limit bucket of the request before Discord has described it. The major
+// parameters, a channel id, a guild id or a webhook id and token, are kept, as Discord rate limits every
+// channel, guild and webhook separately. Other ids are replaced by {id}, interaction tokens by {token} and
+// reaction emojis by {emoji}. eg. "/channels/1/messages/2" is hashed to "GET:/channels/1/messages/{id}".
 func (r *Request) HashEndpoint() string {
 	endpoint := strings.Split(r.Endpoint, "?")[0]
-	matches := regexpURLSnowflakes.FindAllString(endpoint, -1)
+	endpoint = strings.Trim(endpoint, "/")
+	if endpoint == "" {
+		return r.Method.String() + ":"
+	}
 
-	var isMajor bool
-	for _, prefix := range []string{"/guilds", "/channels", "/webhooks", "/interactions"} {
-		if strings.HasPrefix(endpoint, prefix) {
-			isMajor = true
-			break
+	segments := strings.Split(endpoint, "/")
+	for i, segment := range segments {
+		switch {
+		case i == 1 && isMajorResource(segments[0]):
+		case i == 2 && segments[0] == "webhooks":
+			// the webhook token
+		case i == 2 && segments[0] == "interactions":
+			segments[i] = "{token}"
+		case i > 0 && segments[i-1] == "reactions":
+			segments[i] = "{emoji}"
+		case isSnowflake(segment):
+			segments[i] = "{id}"
 		}
 	}
+	return r.Method.String() + ":/" + strings.Join(segments, "/")
+}
 
-	buffer := endpoint
-	for i := range matches {
-		if i == 0 && isMajor {
-			continue
-		}
+func isMajorResource(resource string) bool {
+	return resource == "channels" || resource == "guilds" || resource == "webhooks"
+}
 
-		buffer = strings.ReplaceAll(buffer, matches[i], "/{id}/")
+func isSnowflake(segment string) bool {
+	if segment == "" {
+		return false
 	}
-
-	// check for reaction endpoints, convert emoji identifier to {emoji}
-	reactionPrefixMatch := regexpURLReactionPrefix.FindAllString(buffer, -1)
-	if reactionPrefixMatch != nil {
-		if regexpURLReactionEmoji.FindAllString(buffer, -1) != nil {
-			reactionEmojis := regexpURLReactionEmojiSegment.FindAllString(buffer, -1)
-			for i := range reactionEmojis {
-				buffer = strings.ReplaceAll(buffer, reactionEmojis[i], "/reactions/{emoji}")
-			}
-		} else {
-			// corner case for urls with emojis
-			suffix := buffer[len(reactionPrefixMatch[0]):]
-			until := len(suffix)
-			for i, rune := range suffix {
-				if rune == '/' {
-					until = i
-					break
-				}
-			}
-			newSuffix := "{emoji}" + suffix[until:]
-			buffer = buffer[:len(buffer)-len(suffix)] + newSuffix
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
 		}
 	}
+	return true
+}
+
+// majorParameters returns the major parameters of a hashed endpoint, eg. "channels/1" for
+// "POST:/channels/1/messages", or an empty string if there are none.
+func majorParameters(hashedEndpoint string) string {
+	endpoint := hashedEndpoint[strings.Index(hashedEndpoint, ":")+1:]
+	segments := strings.Split(strings.Trim(endpoint, "/"), "/")
+	if len(segments) < 2 || !isMajorResource(segments[0]) {
+		return ""
+	}
 
-	if strings.HasSuffix(buffer, "/") {
-		buffer = buffer[:len(buffer)-1]
+	major := segments[0] + "/" + segments[1]
+	if segments[0] == "webhooks" && len(segments) > 2 {
+		major += "/" + segments[2]
 	}
-	return r.Method.String() + ":" + buffer
+	return major
 }
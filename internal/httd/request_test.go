@@ -25,6 +25,16 @@ func TestRequest_RateLimitID(t *testing.T) {
 		"/webhooks/345345":                 "GET:/webhooks/345345",
 		"/webhooks/345345/sdfsdf":          "GET:/webhooks/345345/sdfsdf",
 		"/webhooks/345345/sdfsdf/32987234": "GET:/webhooks/345345/sdfsdf/{id}",
+		// major, where the id is repeated as a minor parameter
+		"/channels/345345/messages/345345":      "GET:/channels/345345/messages/{id}",
+		"/channels/345/messages/3459":           "GET:/channels/345/messages/{id}",
+		"/guilds/345345/members/345345/roles/1": "GET:/guilds/345345/members/{id}/roles/{id}",
+		// major, webhook id and token
+		"/webhooks/345345/aW3b_t0k3n":              "GET:/webhooks/345345/aW3b_t0k3n",
+		"/webhooks/345345/aW3b_t0k3n/messages/123": "GET:/webhooks/345345/aW3b_t0k3n/messages/{id}",
+		// not major
+		"/interactions/345345/aW3b_t0k3n/callback": "GET:/interactions/{id}/{token}/callback",
+		"/users/@me/guilds/345345":                 "GET:/users/@me/guilds/{id}",
 		// major + reaction
 		"/channels/540519296640614416/messages/540519319814275089/reactions/DeepinScreenshot_selectarea_2019:540519588153262081/@me":             "GET:/channels/540519296640614416/messages/{id}/reactions/{emoji}/@me",
 		"/channels/540519296640614416/messages/540519319814275089/reactions/DeepinScreenshot_selectarea_2019:540519588153262081/":                "GET:/channels/540519296640614416/messages/{id}/reactions/{emoji}",
@@ -51,3 +61,21 @@ func TestRequest_RateLimitID(t *testing.T) {
 		}
 	}
 }
+
+func TestMajorParameters(t *testing.T) {
+	table := map[string]string{
+		"GET:/channels/1/messages/{id}":            "channels/1",
+		"GET:/guilds/2/members/{id}":               "guilds/2",
+		"POST:/webhooks/3/t0k3n":                   "webhooks/3/t0k3n",
+		"GET:/webhooks/3":                          "webhooks/3",
+		"POST:/interactions/{id}/{token}/callback": "",
+		"GET:/users/@me":                           "",
+		"GET:":                                     "",
+	}
+
+	for hashedEndpoint, wants := range table {
+		if got := majorParameters(hashedEndpoint); got != wants {
+			t.Errorf("got %s, wants %s for %s", got, wants, hashedEndpoint)
+		}
+	}
+}
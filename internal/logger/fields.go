@@ -0,0 +1,172 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Field is a structured logging field, such as the shard id or the event name.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+func (f Field) String() string {
+	return f.Key + "=" + fmt.Sprint(f.Value)
+}
+
+// FieldLogger is implemented by loggers that support structured fields. The returned logger must add the
+// fields to every entry.
+type FieldLogger interface {
+	Logger
+	With(fields ...Field) Logger
+}
+
+// With returns a logger that adds the fields to every entry. Loggers without support for structured fields
+// receives the fields as the first arguments, eg. "shard_id=2".
+func With(l Logger, fields ...Field) Logger {
+	if len(fields) == 0 {
+		return l
+	}
+	if fl, ok := l.(FieldLogger); ok {
+		return fl.With(fields...)
+	}
+	return &fieldPrefixer{log: l, fields: fields}
+}
+
+type fieldPrefixer struct {
+	log    Logger
+	fields []Field
+}
+
+var _ FieldLogger = (*fieldPrefixer)(nil)
+
+func (l *fieldPrefixer) prefix(v []interface{}) []interface{} {
+	args := make([]interface{}, 0, len(l.fields)+len(v))
+	for i := range l.fields {
+		args = append(args, l.fields[i].String())
+	}
+	return append(args, v...)
+}
+
+func (l *fieldPrefixer) Debug(v ...interface{}) { l.log.Debug(l.prefix(v)...) }
+func (l *fieldPrefixer) Info(v ...interface{})  { l.log.Info(l.prefix(v)...) }
+func (l *fieldPrefixer) Error(v ...interface{}) { l.log.Error(l.prefix(v)...) }
+
+func (l *fieldPrefixer) With(fields ...Field) Logger {
+	merged := make([]Field, 0, len(l.fields)+len(fields))
+	merged = append(merged, l.fields...)
+	return &fieldPrefixer{log: l.log, fields: append(merged, fields...)}
+}
+
+const redacted = "[REDACTED]"
+
+// Redact returns a logger that replaces the secrets, such as the bot token, with [REDACTED] wherever they
+// appear in the logged values, including header dumps, URLs and errors.
+func Redact(l Logger, secrets ...string) Logger {
+	var nonEmpty []string
+	for i := range secrets {
+		if secrets[i] != "" {
+			nonEmpty = append(nonEmpty, secrets[i])
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return l
+	}
+	if r, ok := l.(*redactor); ok {
+		return &redactor{log: r.log, secrets: &secretList{values: append(r.secrets.list(), nonEmpty...)}}
+	}
+	return &redactor{log: l, secrets: &secretList{values: nonEmpty}}
+}
+
+// AddSecret redacts the secret in the logger, and every logger derived from it, such as a new bot token
+// after a rotation. Loggers that were not created by Redact are left as is.
+func AddSecret(l Logger, secret string) {
+	r, ok := l.(*redactor)
+	if !ok || secret == "" {
+		return
+	}
+	r.secrets.Lock()
+	r.secrets.values = append(r.secrets.values, secret)
+	r.secrets.Unlock()
+}
+
+// secretList is shared by the redactor and the loggers derived from it
+type secretList struct {
+	sync.RWMutex
+	values []string
+}
+
+func (s *secretList) list() []string {
+	s.RLock()
+	defer s.RUnlock()
+	return append([]string{}, s.values...)
+}
+
+type redactor struct {
+	log     Logger
+	secrets *secretList
+}
+
+var _ FieldLogger = (*redactor)(nil)
+
+func (l *redactor) redact(s string) string {
+	l.secrets.RLock()
+	defer l.secrets.RUnlock()
+	for i := range l.secrets.values {
+		s = strings.ReplaceAll(s, l.secrets.values[i], redacted)
+	}
+	return s
+}
+
+func (l *redactor) containsSecret(s string) bool {
+	l.secrets.RLock()
+	defer l.secrets.RUnlock()
+	for i := range l.secrets.values {
+		if strings.Contains(s, l.secrets.values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *redactor) clean(v []interface{}) []interface{} {
+	cleaned := v
+	for i := range v {
+		var s string
+		switch t := v[i].(type) {
+		case nil, bool, int, int64, uint, uint64, float64:
+			continue
+		case string:
+			s = t
+		default:
+			s = fmt.Sprint(t)
+		}
+		if !l.containsSecret(s) {
+			continue
+		}
+
+		// the arguments are owned by the caller
+		if &cleaned[0] == &v[0] {
+			cleaned = append([]interface{}{}, v...)
+		}
+		cleaned[i] = l.redact(s)
+	}
+	return cleaned
+}
+
+func (l *redactor) Debug(v ...interface{}) { l.log.Debug(l.clean(v)...) }
+func (l *redactor) Info(v ...interface{})  { l.log.Info(l.clean(v)...) }
+func (l *redactor) Error(v ...interface{}) { l.log.Error(l.clean(v)...) }
+
+func (l *redactor) With(fields ...Field) Logger {
+	cleaned := make([]Field, len(fields))
+	for i := range fields {
+		cleaned[i] = fields[i]
+		if s, ok := fields[i].Value.(string); ok && l.containsSecret(s) {
+			cleaned[i].Value = l.redact(s)
+		}
+	}
+	return &redactor{log: With(l.log, cleaned...), secrets: l.secrets}
+}
@@ -0,0 +1,58 @@
+// +build !integration
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type recorder struct {
+	entries [][]interface{}
+}
+
+func (r *recorder) Debug(v ...interface{}) { r.entries = append(r.entries, v) }
+func (r *recorder) Info(v ...interface{})  { r.entries = append(r.entries, v) }
+func (r *recorder) Error(v ...interface{}) { r.entries = append(r.entries, v) }
+
+func TestWith(t *testing.T) {
+	rec := &recorder{}
+	log := With(With(rec, Field{Key: "shard_id", Value: 2}), Field{Key: "event", Value: "READY"})
+	log.Info("connected")
+
+	expected := []interface{}{"shard_id=2", "event=READY", "connected"}
+	if !reflect.DeepEqual(rec.entries[0], expected) {
+		t.Errorf("unexpected entry. Got %v", rec.entries[0])
+	}
+}
+
+func TestRedact(t *testing.T) {
+	const token = "NzkyNzE1NDU0MTk2MDg4ODQy.X-hvzA.Ovy4MCQywSkoMRRclStW4xAYK7I"
+
+	rec := &recorder{}
+	log := Redact(rec, token, "")
+	log.Debug("header", map[string]string{"Authorization": "Bot " + token}, 3)
+	log.Error(errors.New("GET https://discord.com/api/webhooks/1/" + token + ": timeout"))
+	With(log, Field{Key: "token", Value: token}).Info("ok")
+
+	for _, entry := range rec.entries {
+		if s := fmt.Sprint(entry...); strings.Contains(s, token) {
+			t.Errorf("the token was not redacted. Got %s", s)
+		}
+	}
+	if rec.entries[0][2] != 3 {
+		t.Error("values without secrets should not be changed")
+	}
+
+	const rotated = "NzkyNzE1NDU0MTk2MDg4ODQy.Y-abcD.Rotated4MCQywSkoMRRclStW4xAY"
+	derived := With(log, Field{Key: "shard_id", Value: 1})
+	AddSecret(log, rotated)
+	derived.Info("identify", rotated)
+	if s := fmt.Sprint(rec.entries[len(rec.entries)-1]...); strings.Contains(s, rotated) {
+		t.Errorf("the added secret was not redacted by a derived logger. Got %s", s)
+	}
+}
+
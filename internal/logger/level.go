@@ -0,0 +1,75 @@
+package logger
+
+import "sync/atomic"
+
+// Level is the minimum level of the entries that are logged.
+type Level int32
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelError
+	LevelDisabled
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelError:
+		return "error"
+	case LevelDisabled:
+		return "disabled"
+	}
+	return "unknown"
+}
+
+// LevelVar is a level that can be changed at runtime. It is safe for concurrent use.
+type LevelVar struct {
+	level int32
+}
+
+func (v *LevelVar) Level() Level {
+	return Level(atomic.LoadInt32(&v.level))
+}
+
+func (v *LevelVar) Set(level Level) {
+	atomic.StoreInt32(&v.level, int32(level))
+}
+
+// WithLevel returns a logger that discards entries below the level. Changes to the level applies to the
+// loggers derived using With as well.
+func WithLevel(l Logger, level *LevelVar) Logger {
+	return &leveled{log: l, level: level}
+}
+
+type leveled struct {
+	log   Logger
+	level *LevelVar
+}
+
+var _ FieldLogger = (*leveled)(nil)
+
+func (l *leveled) Debug(v ...interface{}) {
+	if l.level.Level() <= LevelDebug {
+		l.log.Debug(v...)
+	}
+}
+
+func (l *leveled) Info(v ...interface{}) {
+	if l.level.Level() <= LevelInfo {
+		l.log.Info(v...)
+	}
+}
+
+func (l *leveled) Error(v ...interface{}) {
+	if l.level.Level() <= LevelError {
+		l.log.Error(v...)
+	}
+}
+
+func (l *leveled) With(fields ...Field) Logger {
+	return &leveled{log: With(l.log, fields...), level: l.level}
+}
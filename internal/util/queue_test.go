@@ -67,3 +67,22 @@ func TestQueueOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestTicketQueue_Priority(t *testing.T) {
+	q := &TicketQueue{}
+	a := q.NewTicket()
+	b := q.NewTicket()
+	c := q.NewPriorityTicket()
+	d := q.NewPriorityTicket()
+	q.Delete(b)
+
+	accept := func() bool { return true }
+	for _, ticket := range []Ticket{c, d, a} {
+		if !q.Next(ticket, accept) {
+			t.Fatalf("expected ticket %d to be next. Queue %v", ticket, q.tickets)
+		}
+	}
+	if len(q.tickets) != 0 || len(q.prioritized) != 0 {
+		t.Errorf("expected an empty queue. Got %v", q.tickets)
+	}
+}
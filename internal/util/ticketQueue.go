@@ -11,9 +11,10 @@ const (
 )
 
 type TicketQueue struct {
-	mu         sync.Mutex
-	tickets    []Ticket
-	nextTicket Ticket
+	mu          sync.Mutex
+	tickets     []Ticket
+	nextTicket  Ticket
+	prioritized map[Ticket]bool
 }
 
 func (q *TicketQueue) NewTicket() (ticket Ticket) {
@@ -29,6 +30,30 @@ func (q *TicketQueue) NewTicket() (ticket Ticket) {
 	return ticket
 }
 
+// NewPriorityTicket creates a ticket that is placed after the prioritized tickets, but in front of
+// every other ticket in the queue.
+func (q *TicketQueue) NewPriorityTicket() (ticket Ticket) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ticket = q.nextTicket
+	q.nextTicket++
+	if q.prioritized == nil {
+		q.prioritized = make(map[Ticket]bool)
+	}
+	q.prioritized[ticket] = true
+
+	var i int
+	for i < len(q.tickets) && q.prioritized[q.tickets[i]] {
+		i++
+	}
+	q.tickets = append(q.tickets, 0)
+	copy(q.tickets[i+1:], q.tickets[i:])
+	q.tickets[i] = ticket
+
+	return ticket
+}
+
 func (q *TicketQueue) Delete(ticket Ticket) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
@@ -45,11 +70,8 @@ func (q *TicketQueue) Delete(ticket Ticket) {
 		return
 	}
 
-	if i == len(q.tickets)-1 {
-		q.tickets = q.tickets[:i]
-	} else {
-		q.tickets = append(q.tickets[i:], q.tickets[i+1:]...)
-	}
+	delete(q.prioritized, ticket)
+	q.tickets = append(q.tickets[:i], q.tickets[i+1:]...)
 }
 
 func (q *TicketQueue) Next(ticket Ticket, cb func() bool) bool {
@@ -68,6 +90,7 @@ func (q *TicketQueue) Next(ticket Ticket, cb func() bool) bool {
 		return false
 	}
 
+	delete(q.prioritized, ticket)
 	if len(q.tickets) > 1 {
 		q.tickets = q.tickets[1:]
 	} else {
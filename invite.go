@@ -7,6 +7,16 @@ import (
 	"github.com/Vedza/disgord/internal/httd"
 )
 
+// InviteTargetType is the type of target for a voice channel invite.
+// https://discord.com/developers/docs/resources/invite#invite-object-invite-target-types
+type InviteTargetType uint
+
+const (
+	_ InviteTargetType = iota
+	InviteTargetStream
+	InviteTargetEmbeddedApplication
+)
+
 // PartialInvite ...
 // {
 //    "code": "abc"
@@ -52,11 +62,39 @@ type Invite struct {
 
 	// ApproximatePresenceCount approximate count of total members
 	ApproximateMemberCount int `json:"approximate_member_count,omitempty"`
+
+	// TargetType the type of target for this voice channel invite
+	TargetType InviteTargetType `json:"target_type,omitempty"`
+
+	// TargetUser the user whose stream to display for this voice channel stream invite
+	TargetUser *User `json:"target_user,omitempty"`
+
+	// TargetApplication the embedded application to open for this voice channel embedded application invite
+	TargetApplication *MessageApplication `json:"target_application,omitempty"`
+
+	// ExpiresAt the expiration date of this invite, zero if it never expires
+	ExpiresAt Time `json:"expires_at,omitempty"`
+
+	// StageInstance stage instance data if there is a public stage instance in the stage channel this invite is for
+	// Deprecated: Discord no longer sends stage instance data with invites
+	StageInstance *InviteStageInstance `json:"stage_instance,omitempty"`
+
+	// GuildScheduledEvent the scheduled event, when the invite was requested with a GuildScheduledEventID
+	GuildScheduledEvent *GuildScheduledEvent `json:"guild_scheduled_event,omitempty"`
 }
 
 var _ Copier = (*Invite)(nil)
 var _ DeepCopier = (*Invite)(nil)
 
+// InviteStageInstance the public stage instance an invite is for.
+// https://discord.com/developers/docs/resources/invite#invite-stage-instance-object
+type InviteStageInstance struct {
+	Members          []*Member `json:"members"`
+	ParticipantCount int       `json:"participant_count"`
+	SpeakerCount     int       `json:"speaker_count"`
+	Topic            string    `json:"topic"`
+}
+
 // InviteMetadata Object
 // https://discord.com/developers/docs/resources/invite#invite-metadata-object
 // Reviewed: 2018-06-10
@@ -95,10 +133,13 @@ type InviteQueryBuilder interface {
 	WithContext(ctx context.Context) InviteQueryBuilder
 
 	// Get Returns an invite object for the given code.
-	Get(withMemberCount bool, flags ...Flag) (*Invite, error)
+	Get(withMemberCount bool, flags ...RequestOption) (*Invite, error)
+
+	// GetWithParams Returns an invite object for the given code, with the extra data requested in params.
+	GetWithParams(params *GetInviteParams, flags ...RequestOption) (*Invite, error)
 
 	// Delete an invite. Requires the MANAGE_CHANNELS permission. Returns an invite object on success.
-	Delete(flags ...Flag) (deleted *Invite, err error)
+	Delete(flags ...RequestOption) (deleted *Invite, err error)
 }
 
 func (c clientQueryBuilder) Invite(code string) InviteQueryBuilder {
@@ -116,11 +157,19 @@ func (i inviteQueryBuilder) WithContext(ctx context.Context) InviteQueryBuilder
 	return &i
 }
 
-type getInviteParams struct {
-	WithMemberCount bool `urlparam:"with_count,omitempty"`
+// GetInviteParams https://discord.com/developers/docs/resources/invite#get-invite-query-string-params
+type GetInviteParams struct {
+	// WithCounts whether the invite should contain approximate member counts
+	WithCounts bool `urlparam:"with_counts,omitempty"`
+
+	// WithExpiration whether the invite should contain the expiration date
+	WithExpiration bool `urlparam:"with_expiration,omitempty"`
+
+	// GuildScheduledEventID the guild scheduled event to include with the invite
+	GuildScheduledEventID Snowflake `urlparam:"guild_scheduled_event_id,omitempty"`
 }
 
-var _ URLQueryStringer = (*getInviteParams)(nil)
+var _ URLQueryStringer = (*GetInviteParams)(nil)
 
 // Get [REST] Returns an invite object for the given code.
 //  Method                  GET
@@ -129,8 +178,21 @@ var _ URLQueryStringer = (*getInviteParams)(nil)
 //  Reviewed                2018-06-10
 //  Comment                 -
 //  withMemberCount: whether or not the invite should contain the approximate number of members
-func (i inviteQueryBuilder) Get(withMemberCount bool, flags ...Flag) (invite *Invite, err error) {
-	params := &getInviteParams{withMemberCount}
+func (i inviteQueryBuilder) Get(withMemberCount bool, flags ...RequestOption) (invite *Invite, err error) {
+	return i.GetWithParams(&GetInviteParams{WithCounts: withMemberCount}, flags...)
+}
+
+// GetWithParams [REST] Returns an invite object for the given code. The params decide whether member counts,
+// the expiration date and a scheduled event are included.
+//  Method                  GET
+//  Endpoint                /invites/{invite.code}
+//  Discord documentation   https://discord.com/developers/docs/resources/invite#get-invite
+//  Reviewed                2024-06-01
+//  Comment                 -
+func (i inviteQueryBuilder) GetWithParams(params *GetInviteParams, flags ...RequestOption) (invite *Invite, err error) {
+	if params == nil {
+		params = &GetInviteParams{}
+	}
 
 	r := i.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.Invite(i.inviteCode) + params.URLQueryString(),
@@ -147,7 +209,7 @@ func (i inviteQueryBuilder) Get(withMemberCount bool, flags ...Flag) (invite *In
 //  Discord documentation   https://discord.com/developers/docs/resources/invite#delete-invite
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (i inviteQueryBuilder) Delete(flags ...Flag) (deleted *Invite, err error) {
+func (i inviteQueryBuilder) Delete(flags ...RequestOption) (deleted *Invite, err error) {
 	r := i.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.Invite(i.inviteCode),
@@ -0,0 +1,76 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestInvite_TargetsAndMetadata(t *testing.T) {
+	var query string
+	var body map[string]interface{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+			return jsonResponse(req, `{
+				"code":"abc",
+				"target_type":2,
+				"target_application":{"id":"9","name":"activity"},
+				"expires_at":"2024-06-01T12:00:00.000000+00:00",
+				"approximate_member_count":10,
+				"guild_scheduled_event":{"id":"5","name":"event"}
+			}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invite, err := client.Invite("abc").GetWithParams(&GetInviteParams{
+		WithCounts:            true,
+		WithExpiration:        true,
+		GuildScheduledEventID: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "guild_scheduled_event_id=5&with_counts=true&with_expiration=true"; query != expected {
+		t.Errorf("expected query %s. Got %s", expected, query)
+	}
+	if invite.TargetType != InviteTargetEmbeddedApplication || invite.TargetApplication == nil || invite.TargetApplication.ID != 9 {
+		t.Errorf("unexpected invite target %+v", invite)
+	}
+	if invite.ExpiresAt.IsZero() || invite.ApproximateMemberCount != 10 {
+		t.Errorf("expected the invite metadata to be set. Got %+v", invite)
+	}
+	if invite.GuildScheduledEvent == nil || invite.GuildScheduledEvent.ID != 5 {
+		t.Errorf("expected the scheduled event to be set. Got %+v", invite.GuildScheduledEvent)
+	}
+
+	if _, err = client.Invite("abc").Get(true); err != nil {
+		t.Fatal(err)
+	}
+	if query != "with_counts=true" {
+		t.Errorf("expected the member counts to be requested. Got %s", query)
+	}
+
+	_, err = client.Channel(1).CreateInvite().
+		SetTargetType(InviteTargetEmbeddedApplication).
+		SetTargetApplicationID(9).
+		Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body["target_type"] != float64(InviteTargetEmbeddedApplication) || body["target_application_id"] != "9" {
+		t.Errorf("unexpected invite body %+v", body)
+	}
+}
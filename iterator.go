@@ -0,0 +1,374 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// pageIterator pages through a list endpoint. fetch loads the next page of at most limit items, and
+// returns the number of items loaded.
+type pageIterator struct {
+	pageSize  int
+	remaining int // number of items left to iterate, -1 is unlimited
+	index     int
+	length    int
+	last      bool
+	err       error
+	fetch     func(ctx context.Context, limit int) (int, error)
+}
+
+func newPageIterator(pageSize, limit int, fetch func(ctx context.Context, limit int) (int, error)) pageIterator {
+	remaining := limit
+	if limit <= 0 {
+		remaining = -1
+	}
+	return pageIterator{pageSize: pageSize, remaining: remaining, fetch: fetch}
+}
+
+func (it *pageIterator) next(ctx context.Context) bool {
+	if it.err != nil || it.remaining == 0 {
+		return false
+	}
+
+	if it.index+1 >= it.length {
+		if it.last {
+			return false
+		}
+
+		limit := it.pageSize
+		if it.remaining > 0 && it.remaining < limit {
+			limit = it.remaining
+		}
+
+		var length int
+		if length, it.err = it.fetch(ctx, limit); it.err != nil || length == 0 {
+			return false
+		}
+		it.index, it.length, it.last = -1, length, length < limit
+	}
+
+	it.index++
+	if it.remaining > 0 {
+		it.remaining--
+	}
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *pageIterator) Err() error {
+	return it.err
+}
+
+func highestSnowflake(ids func(i int) Snowflake, length int) (highest Snowflake) {
+	for i := 0; i < length; i++ {
+		if id := ids(i); id > highest {
+			highest = id
+		}
+	}
+	return highest
+}
+
+// MessageIterator iterates over the messages of a channel, see ChannelQueryBuilder.IterateMessages.
+//  it := client.Channel(channelID).IterateMessages(nil)
+//  for it.Next(ctx) {
+//      msg := it.Value()
+//  }
+//  if err := it.Err(); err != nil {
+//      return err
+//  }
+type MessageIterator struct {
+	pageIterator
+	page []*Message
+}
+
+// Next moves to the next message, and reports whether there was one. Pages are fetched as needed.
+func (it *MessageIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current message.
+func (it *MessageIterator) Value() *Message {
+	return it.page[it.index]
+}
+
+// IterateMessages iterates over the messages of the channel, from the newest to the oldest. When params.After is set,
+// the messages are iterated from the oldest to the newest instead. params.Limit is the total number of messages to
+// iterate over, where 0 means every message. Around is not supported.
+func (c channelQueryBuilder) IterateMessages(params *GetMessagesParams, flags ...RequestOption) *MessageIterator {
+	p := GetMessagesParams{}
+	if params != nil {
+		p = *params
+	}
+
+	it := &MessageIterator{}
+	it.pageIterator = newPageIterator(100, int(p.Limit), func(ctx context.Context, limit int) (int, error) {
+		if !p.Around.IsZero() {
+			return 0, errors.New("around is not supported when iterating over messages")
+		}
+
+		c.ctx = ctx
+		msgs, err := c.getMessages(&GetMessagesParams{Before: p.Before, After: p.After, Limit: uint(limit)}, flags...)
+		if err != nil || len(msgs) == 0 {
+			return 0, err
+		}
+
+		forward := !p.After.IsZero()
+		sort.Slice(msgs, func(i, j int) bool {
+			return (msgs[i].ID < msgs[j].ID) == forward
+		})
+		if forward {
+			p.After = msgs[len(msgs)-1].ID
+		} else {
+			p.Before = msgs[len(msgs)-1].ID
+		}
+		it.page = msgs
+		return len(msgs), nil
+	})
+	return it
+}
+
+// MemberIterator iterates over the members of a guild, see GuildQueryBuilder.IterateMembers.
+type MemberIterator struct {
+	pageIterator
+	page []*Member
+}
+
+// Next moves to the next member, and reports whether there was one. Pages are fetched as needed.
+func (it *MemberIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current member.
+func (it *MemberIterator) Value() *Member {
+	return it.page[it.index]
+}
+
+// IterateMembers iterates over the members of the guild, ordered by user id. params.Limit is the total number of
+// members to iterate over, where 0 means every member. Members are always fetched from the Discord API, as the
+// cache might not hold every member.
+func (g guildQueryBuilder) IterateMembers(params *GetMembersParams, flags ...RequestOption) *MemberIterator {
+	p := GetMembersParams{}
+	if params != nil {
+		p = *params
+	}
+	flags = append(flags, IgnoreCache)
+
+	it := &MemberIterator{}
+	it.pageIterator = newPageIterator(1000, int(p.Limit), func(ctx context.Context, limit int) (int, error) {
+		g.ctx = ctx
+		members, err := g.getGuildMembers(&getGuildMembersParams{After: p.After, Limit: limit}, flags...)
+		if err != nil {
+			return 0, err
+		}
+
+		p.After = highestSnowflake(func(i int) Snowflake {
+			if members[i].User == nil {
+				return 0
+			}
+			return members[i].User.ID
+		}, len(members))
+		it.page = members
+		return len(members), nil
+	})
+	return it
+}
+
+// BanIterator iterates over the bans of a guild, see GuildQueryBuilder.IterateBans.
+type BanIterator struct {
+	pageIterator
+	page []*Ban
+}
+
+// Next moves to the next ban, and reports whether there was one. Pages are fetched as needed.
+func (it *BanIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current ban.
+func (it *BanIterator) Value() *Ban {
+	return it.page[it.index]
+}
+
+// IterateBans iterates over every ban of the guild, ordered by user id. Requires the 'BAN_MEMBERS' permission.
+func (g guildQueryBuilder) IterateBans(flags ...RequestOption) *BanIterator {
+	var after Snowflake
+
+	it := &BanIterator{}
+	it.pageIterator = newPageIterator(1000, 0, func(ctx context.Context, limit int) (int, error) {
+		g.ctx = ctx
+		bans, err := g.getBans(&getBansParams{After: after, Limit: limit}, flags...)
+		if err != nil {
+			return 0, err
+		}
+
+		after = highestSnowflake(func(i int) Snowflake {
+			if bans[i].User == nil {
+				return 0
+			}
+			return bans[i].User.ID
+		}, len(bans))
+		it.page = bans
+		return len(bans), nil
+	})
+	return it
+}
+
+// GetAuditLogsParams filters the audit log entries, see GuildQueryBuilder.IterateAuditLogs.
+type GetAuditLogsParams struct {
+	UserID     Snowflake
+	ActionType AuditLogEvt
+	Before     Snowflake
+
+	// Limit is the total number of entries to iterate over, where 0 means every entry.
+	Limit int
+}
+
+// AuditLogIterator iterates over the audit log entries of a guild, see GuildQueryBuilder.IterateAuditLogs.
+type AuditLogIterator struct {
+	pageIterator
+	page []*AuditLogEntry
+}
+
+// Next moves to the next audit log entry, and reports whether there was one. Pages are fetched as needed.
+func (it *AuditLogIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current audit log entry.
+func (it *AuditLogIterator) Value() *AuditLogEntry {
+	return it.page[it.index]
+}
+
+// IterateAuditLogs iterates over the audit log entries of the guild, from the newest to the oldest. Requires the
+// 'VIEW_AUDIT_LOG' permission.
+func (g guildQueryBuilder) IterateAuditLogs(params *GetAuditLogsParams, flags ...RequestOption) *AuditLogIterator {
+	p := GetAuditLogsParams{}
+	if params != nil {
+		p = *params
+	}
+
+	it := &AuditLogIterator{}
+	it.pageIterator = newPageIterator(100, p.Limit, func(ctx context.Context, limit int) (int, error) {
+		g.ctx = ctx
+		builder := g.GetAuditLogs(flags...).SetLimit(limit)
+		if !p.UserID.IsZero() {
+			builder.SetUserID(p.UserID)
+		}
+		if p.ActionType != 0 {
+			builder.SetActionType(uint(p.ActionType))
+		}
+		if !p.Before.IsZero() {
+			builder.SetBefore(p.Before)
+		}
+
+		log, err := builder.Execute()
+		if err != nil || len(log.AuditLogEntries) == 0 {
+			return 0, err
+		}
+
+		entries := log.AuditLogEntries
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].ID > entries[j].ID
+		})
+		p.Before = entries[len(entries)-1].ID
+		it.page = entries
+		return len(entries), nil
+	})
+	return it
+}
+
+// UserIterator iterates over users, such as those that reacted to a message, see ReactionQueryBuilder.Iterate.
+type UserIterator struct {
+	pageIterator
+	page []*User
+}
+
+// Next moves to the next user, and reports whether there was one. Pages are fetched as needed.
+func (it *UserIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current user.
+func (it *UserIterator) Value() *User {
+	return it.page[it.index]
+}
+
+// Iterate iterates over the users that reacted with the emoji, ordered by user id. params.Limit is the total number
+// of users to iterate over, where 0 means every user.
+func (r reactionQueryBuilder) Iterate(params *GetReactionURLParams, flags ...RequestOption) *UserIterator {
+	p := GetReactionURLParams{}
+	if params != nil {
+		p = *params
+	}
+
+	it := &UserIterator{}
+	it.pageIterator = newPageIterator(100, p.Limit, func(ctx context.Context, limit int) (int, error) {
+		r.ctx = ctx
+		users, err := r.Get(&GetReactionURLParams{After: p.After, Limit: limit}, flags...)
+		if err != nil {
+			return 0, err
+		}
+
+		p.After = highestSnowflake(func(i int) Snowflake {
+			return users[i].ID
+		}, len(users))
+		it.page = users
+		return len(users), nil
+	})
+	return it
+}
+
+// ScheduledEventUserIterator iterates over the users subscribed to a guild scheduled event,
+// see GuildScheduledEventQueryBuilder.IterateUsers.
+type ScheduledEventUserIterator struct {
+	pageIterator
+	page []*GuildScheduledEventUser
+}
+
+// Next moves to the next user, and reports whether there was one. Pages are fetched as needed.
+func (it *ScheduledEventUserIterator) Next(ctx context.Context) bool {
+	return it.next(ctx)
+}
+
+// Value returns the current user.
+func (it *ScheduledEventUserIterator) Value() *GuildScheduledEventUser {
+	return it.page[it.index]
+}
+
+// IterateUsers iterates over the users subscribed to the event, ordered by user id. When params.Before is set,
+// the users are iterated from the highest id to the lowest instead. params.Limit is the total number of users
+// to iterate over, where 0 means every user.
+func (g guildScheduledEventQueryBuilder) IterateUsers(params *GetGuildScheduledEventUsersParams, flags ...RequestOption) *ScheduledEventUserIterator {
+	p := GetGuildScheduledEventUsersParams{}
+	if params != nil {
+		p = *params
+	}
+
+	it := &ScheduledEventUserIterator{}
+	it.pageIterator = newPageIterator(100, p.Limit, func(ctx context.Context, limit int) (int, error) {
+		g.ctx = ctx
+		users, err := g.GetUsers(&GetGuildScheduledEventUsersParams{
+			Limit:      limit,
+			WithMember: p.WithMember,
+			Before:     p.Before,
+			After:      p.After,
+		}, flags...)
+		if err != nil || len(users) == 0 {
+			return 0, err
+		}
+
+		backward := !p.Before.IsZero()
+		sort.Slice(users, func(i, j int) bool {
+			return (users[i].userID() < users[j].userID()) != backward
+		})
+		if backward {
+			p.Before = users[len(users)-1].userID()
+		} else {
+			p.After = users[len(users)-1].userID()
+		}
+		it.page = users
+		return len(users), nil
+	})
+	return it
+}
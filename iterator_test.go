@@ -0,0 +1,110 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMessageIterator(t *testing.T) {
+	const total = 250
+
+	var requests int
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			query := req.URL.Query()
+			before, _ := strconv.Atoi(query.Get("before"))
+			if before == 0 {
+				before = total + 1
+			}
+			limit, _ := strconv.Atoi(query.Get("limit"))
+
+			// newest first, like Discord
+			var msgs []string
+			for id := before - 1; id > 0 && len(msgs) < limit; id-- {
+				msgs = append(msgs, `{"id":"`+strconv.Itoa(id)+`","channel_id":"1"}`)
+			}
+			return jsonResponse(req, "["+strings.Join(msgs, ",")+"]"), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := client.Channel(1).IterateMessages(nil)
+	expected := Snowflake(total)
+	for it.Next(context.Background()) {
+		if id := it.Value().ID; id != expected {
+			t.Fatalf("expected message %d. Got %d", expected, id)
+		}
+		expected--
+	}
+	if err = it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if expected != 0 {
+		t.Errorf("expected every message. Stopped at %d", expected)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 pages. Got %d requests", requests)
+	}
+
+	requests = 0
+	it = client.Channel(1).IterateMessages(&GetMessagesParams{Limit: 120})
+	var count int
+	for it.Next(context.Background()) {
+		count++
+	}
+	if count != 120 || requests != 2 {
+		t.Errorf("expected the limit to be respected. Got %d messages in %d requests", count, requests)
+	}
+}
+
+func TestScheduledEventUserIterator(t *testing.T) {
+	const total = 150
+
+	var queries []string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			queries = append(queries, req.URL.RawQuery)
+			after, _ := strconv.Atoi(query.Get("after"))
+			limit, _ := strconv.Atoi(query.Get("limit"))
+
+			var users []string
+			for id := after + 1; id <= total && len(users) < limit; id++ {
+				users = append(users, `{"guild_scheduled_event_id":"2","user":{"id":"`+strconv.Itoa(id)+`"},"member":{"nick":"n"}}`)
+			}
+			return jsonResponse(req, "["+strings.Join(users, ",")+"]"), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := client.Guild(1).ScheduledEvent(2).IterateUsers(&GetGuildScheduledEventUsersParams{WithMember: true})
+	expected := Snowflake(1)
+	for it.Next(context.Background()) {
+		user := it.Value()
+		if user.User.ID != expected || user.Member == nil {
+			t.Fatalf("expected user %d with a member. Got %+v", expected, user)
+		}
+		expected++
+	}
+	if err = it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if expected != total+1 {
+		t.Errorf("expected every user. Stopped at %d", expected)
+	}
+	if len(queries) != 2 || !strings.Contains(queries[1], "after=100") || !strings.Contains(queries[1], "with_member=true") {
+		t.Errorf("unexpected pages %v", queries)
+	}
+}
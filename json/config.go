@@ -1,13 +1,16 @@
 package json
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"io"
+)
 
 var (
 	Marshal       = json.Marshal
 	Unmarshal     = json.Unmarshal
 	MarshalIndent = json.MarshalIndent
 	Indent        = json.Indent
-	NewDecoder    = json.NewDecoder
+	NewDecoder    = func(r io.Reader) Decoder { return json.NewDecoder(r) }
 	NewEncoder    = json.NewEncoder
 )
 
@@ -20,3 +23,31 @@ type Unmarshaler interface {
 type Marshaler interface {
 	MarshalJSON() ([]byte, error)
 }
+
+// Decoder reads JSON values from an input stream. Satisfied by the decoders of encoding/json, jsoniter,
+// go-json and sonic.
+type Decoder interface {
+	Decode(v interface{}) error
+	UseNumber()
+}
+
+// Codec is a JSON implementation, see Use. Functions left nil keep their current implementation.
+type Codec struct {
+	Marshal    func(v interface{}) ([]byte, error)
+	Unmarshal  func(data []byte, v interface{}) error
+	NewDecoder func(r io.Reader) Decoder
+}
+
+// Use swaps out the JSON implementation used by Disgord. The implementation is shared by every client,
+// and must be set before any client is created.
+func Use(codec Codec) {
+	if codec.Marshal != nil {
+		Marshal = codec.Marshal
+	}
+	if codec.Unmarshal != nil {
+		Unmarshal = codec.Unmarshal
+	}
+	if codec.NewDecoder != nil {
+		NewDecoder = codec.NewDecoder
+	}
+}
@@ -0,0 +1,36 @@
+// +build !integration
+
+package json
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestUse(t *testing.T) {
+	marshal, unmarshal, newDecoder := Marshal, Unmarshal, NewDecoder
+	defer func() {
+		Marshal, Unmarshal, NewDecoder = marshal, unmarshal, newDecoder
+	}()
+
+	var decoders int
+	Use(Codec{
+		NewDecoder: func(r io.Reader) Decoder {
+			decoders++
+			return newDecoder(r)
+		},
+	})
+
+	var v map[string]int
+	if err := NewDecoder(strings.NewReader(`{"a":1}`)).Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if decoders != 1 || v["a"] != 1 {
+		t.Error("expected the decoder of the codec to be used")
+	}
+
+	if Marshal == nil || Unmarshal == nil {
+		t.Error("expected functions missing from the codec to be kept")
+	}
+}
@@ -1,8 +1,145 @@
 package disgord
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/Vedza/disgord/internal/logger"
 )
 
 // Logger super basic logging interface
 type Logger = logger.Logger
+
+// LogField is a structured logging field. Disgord adds fields such as shard_id, event, endpoint and bucket
+// to the log entries.
+type LogField = logger.Field
+
+// FieldLogger is implemented by loggers that support structured fields. Loggers that do not, receive the
+// fields as the first arguments of every log entry, eg. "shard_id=2".
+type FieldLogger = logger.FieldLogger
+
+// KeyValueLogger is a logger with alternating keys and values, such as *zap.SugaredLogger.
+type KeyValueLogger interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+}
+
+// NewKeyValueLogger adapts a key value logger, such as *zap.SugaredLogger, to the Logger interface.
+//  zapLogger, _ := zap.NewProduction()
+//  client := disgord.New(disgord.Config{
+//      BotToken: os.Getenv("DISGORD_TOKEN"),
+//      Logger:   disgord.NewKeyValueLogger(zapLogger.Sugar()),
+//  })
+func NewKeyValueLogger(log KeyValueLogger) FieldLogger {
+	return &keyValueLogger{log: log}
+}
+
+type keyValueLogger struct {
+	log           KeyValueLogger
+	keysAndValues []interface{}
+}
+
+var _ FieldLogger = (*keyValueLogger)(nil)
+
+func (l *keyValueLogger) Debug(v ...interface{}) { l.log.Debugw(logMessage(v), l.keysAndValues...) }
+func (l *keyValueLogger) Info(v ...interface{})  { l.log.Infow(logMessage(v), l.keysAndValues...) }
+func (l *keyValueLogger) Error(v ...interface{}) { l.log.Errorw(logMessage(v), l.keysAndValues...) }
+
+func (l *keyValueLogger) With(fields ...LogField) Logger {
+	keysAndValues := make([]interface{}, 0, len(l.keysAndValues)+len(fields)*2)
+	keysAndValues = append(keysAndValues, l.keysAndValues...)
+	for i := range fields {
+		keysAndValues = append(keysAndValues, fields[i].Key, fields[i].Value)
+	}
+	return &keyValueLogger{log: l.log, keysAndValues: keysAndValues}
+}
+
+// logMessage joins the arguments with spaces, as fmt.Println does.
+func logMessage(v []interface{}) string {
+	return strings.TrimSuffix(fmt.Sprintln(v...), "\n")
+}
+
+// LogLevel is the minimum level of the entries that are logged, see Client.SetLogLevel.
+type LogLevel = logger.Level
+
+const (
+	LogLevelDebug    = logger.LevelDebug
+	LogLevelInfo     = logger.LevelInfo
+	LogLevelError    = logger.LevelError
+	LogLevelDisabled = logger.LevelDisabled
+)
+
+// LogSubsystem is a part of Disgord with its own log level. Every entry of a subsystem has a "subsystem" field.
+type LogSubsystem string
+
+const (
+	LogGateway LogSubsystem = "gateway"
+	LogREST    LogSubsystem = "rest"
+	LogVoice   LogSubsystem = "voice"
+	LogCache   LogSubsystem = "cache"
+)
+
+func logSubsystems() []LogSubsystem {
+	return []LogSubsystem{LogGateway, LogREST, LogVoice, LogCache}
+}
+
+// subsystemLoggers holds the logger and the runtime adjustable level of every subsystem.
+type subsystemLoggers struct {
+	levels  map[LogSubsystem]*logger.LevelVar
+	loggers map[LogSubsystem]Logger
+}
+
+func newSubsystemLoggers(log Logger, levels map[LogSubsystem]LogLevel) (*subsystemLoggers, error) {
+	s := &subsystemLoggers{
+		levels:  make(map[LogSubsystem]*logger.LevelVar),
+		loggers: make(map[LogSubsystem]Logger),
+	}
+	for _, subsystem := range logSubsystems() {
+		level := &logger.LevelVar{}
+		s.levels[subsystem] = level
+		s.loggers[subsystem] = logger.WithLevel(logger.With(log, LogField{Key: "subsystem", Value: string(subsystem)}), level)
+	}
+	for subsystem, level := range levels {
+		if err := s.setLevel(subsystem, level); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *subsystemLoggers) setLevel(subsystem LogSubsystem, level LogLevel) error {
+	levelVar, ok := s.levels[subsystem]
+	if !ok {
+		return fmt.Errorf("unknown log subsystem %q", subsystem)
+	}
+	if level < LogLevelDebug || level > LogLevelDisabled {
+		return fmt.Errorf("unknown log level %d", level)
+	}
+	levelVar.Set(level)
+	return nil
+}
+
+// SetLogLevel changes the log level of a subsystem while the client is running. Every subsystem logs
+// everything by default, leaving the filtering to the injected Logger.
+//  // debug gateway frames, but only errors for REST requests
+//  client.SetLogLevel(disgord.LogGateway, disgord.LogLevelDebug)
+//  client.SetLogLevel(disgord.LogREST, disgord.LogLevelError)
+func (c *Client) SetLogLevel(subsystem LogSubsystem, level LogLevel) error {
+	if c.logs == nil {
+		return errors.New("the client has no subsystem loggers")
+	}
+	return c.logs.setLevel(subsystem, level)
+}
+
+// subsystemLog returns the logger of the subsystem.
+func (c *Client) subsystemLog(subsystem LogSubsystem) Logger {
+	if c.logs == nil {
+		if c.log == nil {
+			return logger.Empty{}
+		}
+		return c.log
+	}
+	return c.logs.loggers[subsystem]
+}
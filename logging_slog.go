@@ -0,0 +1,44 @@
+//go:build go1.21
+// +build go1.21
+
+package disgord
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlogLogger adapts a slog.Logger to the Logger interface. Fields, such as the shard id, are added as
+// attributes.
+//  client := disgord.New(disgord.Config{
+//      BotToken: os.Getenv("DISGORD_TOKEN"),
+//      Logger:   disgord.NewSlogLogger(slog.Default()),
+//  })
+func NewSlogLogger(log *slog.Logger) FieldLogger {
+	return &slogLogger{log: log}
+}
+
+type slogLogger struct {
+	log *slog.Logger
+}
+
+var _ FieldLogger = (*slogLogger)(nil)
+
+func (l *slogLogger) emit(level slog.Level, v []interface{}) {
+	ctx := context.Background()
+	if l.log.Enabled(ctx, level) {
+		l.log.Log(ctx, level, logMessage(v))
+	}
+}
+
+func (l *slogLogger) Debug(v ...interface{}) { l.emit(slog.LevelDebug, v) }
+func (l *slogLogger) Info(v ...interface{})  { l.emit(slog.LevelInfo, v) }
+func (l *slogLogger) Error(v ...interface{}) { l.emit(slog.LevelError, v) }
+
+func (l *slogLogger) With(fields ...LogField) Logger {
+	args := make([]interface{}, 0, len(fields))
+	for i := range fields {
+		args = append(args, slog.Any(fields[i].Key, fields[i].Value))
+	}
+	return &slogLogger{log: l.log.With(args...)}
+}
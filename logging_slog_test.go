@@ -0,0 +1,26 @@
+//go:build go1.21 && !integration
+// +build go1.21,!integration
+
+package disgord
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+	log.With(LogField{Key: "event", Value: EvtReady}).Info("handler", "failed")
+	log.Debug("not enabled")
+
+	out := buf.String()
+	if !strings.Contains(out, `msg="handler failed"`) || !strings.Contains(out, "event=READY") {
+		t.Errorf("unexpected output. Got %s", out)
+	}
+	if strings.Contains(out, "not enabled") {
+		t.Error("debug entries should be filtered by the handler")
+	}
+}
@@ -0,0 +1,78 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type keyValueRecorder struct {
+	msg           string
+	keysAndValues []interface{}
+}
+
+func (r *keyValueRecorder) Debugw(msg string, keysAndValues ...interface{}) {
+	r.msg, r.keysAndValues = msg, keysAndValues
+}
+func (r *keyValueRecorder) Infow(msg string, keysAndValues ...interface{}) {
+	r.msg, r.keysAndValues = msg, keysAndValues
+}
+func (r *keyValueRecorder) Errorw(msg string, keysAndValues ...interface{}) {
+	r.msg, r.keysAndValues = msg, keysAndValues
+}
+
+func TestKeyValueLogger(t *testing.T) {
+	rec := &keyValueRecorder{}
+	log := NewKeyValueLogger(rec).With(LogField{Key: "shard_id", Value: 1})
+	log.Error("unable to connect:", 4004)
+
+	if rec.msg != "unable to connect: 4004" {
+		t.Errorf("unexpected message. Got %q", rec.msg)
+	}
+	if !reflect.DeepEqual(rec.keysAndValues, []interface{}{"shard_id", 1}) {
+		t.Errorf("unexpected fields. Got %v", rec.keysAndValues)
+	}
+}
+
+type entryRecorder struct {
+	entries []string
+}
+
+func (r *entryRecorder) Debug(v ...interface{}) {
+	r.entries = append(r.entries, "debug "+logMessage(v))
+}
+func (r *entryRecorder) Info(v ...interface{}) { r.entries = append(r.entries, "info "+logMessage(v)) }
+func (r *entryRecorder) Error(v ...interface{}) {
+	r.entries = append(r.entries, "error "+logMessage(v))
+}
+
+func TestClient_SetLogLevel(t *testing.T) {
+	rec := &entryRecorder{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken:  "testing",
+		Logger:    rec,
+		LogLevels: map[LogSubsystem]LogLevel{LogREST: LogLevelError},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.subsystemLog(LogREST).Debug("discarded")
+	client.subsystemLog(LogGateway).Debug("frame")
+	if err = client.SetLogLevel(LogGateway, LogLevelInfo); err != nil {
+		t.Fatal(err)
+	}
+	client.subsystemLog(LogGateway).Debug("discarded")
+	client.subsystemLog(LogREST).Error("failed")
+
+	expected := []string{"debug subsystem=gateway frame", "error subsystem=rest failed"}
+	if !reflect.DeepEqual(rec.entries, expected) {
+		t.Errorf("unexpected entries. Got %q", rec.entries)
+	}
+
+	if err = client.SetLogLevel("unknown", LogLevelInfo); err == nil {
+		t.Error("expected an error for an unknown subsystem")
+	}
+}
@@ -11,16 +11,16 @@ import (
 type GuildMemberQueryBuilder interface {
 	WithContext(ctx context.Context) GuildMemberQueryBuilder
 
-	Get(flags ...Flag) (*Member, error)
-	UpdateBuilder(flags ...Flag) UpdateGuildMemberBuilder
-	AddRole(roleID Snowflake, flags ...Flag) error
-	RemoveRole(roleID Snowflake, flags ...Flag) error
-	Kick(reason string, flags ...Flag) error
-	Ban(params *BanMemberParams, flags ...Flag) error
-	GetPermissions(flags ...Flag) (PermissionBit, error)
+	Get(flags ...RequestOption) (*Member, error)
+	UpdateBuilder(flags ...RequestOption) UpdateGuildMemberBuilder
+	AddRole(roleID Snowflake, flags ...RequestOption) error
+	RemoveRole(roleID Snowflake, flags ...RequestOption) error
+	Kick(reason string, flags ...RequestOption) error
+	Ban(params *BanMemberParams, flags ...RequestOption) error
+	GetPermissions(flags ...RequestOption) (PermissionBit, error)
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) UpdateGuildMemberBuilder
+	Update(flags ...RequestOption) UpdateGuildMemberBuilder
 }
 
 func (g guildQueryBuilder) Member(userID Snowflake) GuildMemberQueryBuilder {
@@ -40,7 +40,7 @@ func (g guildMemberQueryBuilder) WithContext(ctx context.Context) GuildMemberQue
 }
 
 // GetMember Returns a guild member object for the specified user.
-func (g guildMemberQueryBuilder) Get(flags ...Flag) (*Member, error) {
+func (g guildMemberQueryBuilder) Get(flags ...RequestOption) (*Member, error) {
 	if !ignoreCache(flags...) {
 		if member, _ := g.client.cache.GetMember(g.gid, g.uid); member != nil {
 			return member, nil
@@ -58,16 +58,22 @@ func (g guildMemberQueryBuilder) Get(flags ...Flag) (*Member, error) {
 		}
 	}
 
-	member, err := getMember(r.Execute)
+	v, shared, err := g.client.requests.do("member:"+g.gid.String()+":"+g.uid.String(), func() (interface{}, error) {
+		return getMember(r.Execute)
+	})
 	if err != nil {
 		return nil, err
 	}
+	member := v.(*Member)
+	if shared {
+		member = DeepCopy(member).(*Member)
+	}
 	member.GuildID = g.gid
 	return member, nil
 }
 
 // UpdateMember is used to create a builder to update a guild member.
-func (g guildMemberQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildMemberBuilder {
+func (g guildMemberQueryBuilder) UpdateBuilder(flags ...RequestOption) UpdateGuildMemberBuilder {
 	builder := &updateGuildMemberBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Member{
@@ -89,7 +95,7 @@ func (g guildMemberQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildMemberB
 
 // AddGuildMemberRole adds a role to a guild member. Requires the 'MANAGE_ROLES' permission.
 // Returns a 204 empty response on success. Fires a Guild Member Update Gateway event.
-func (g guildMemberQueryBuilder) AddRole(roleID Snowflake, flags ...Flag) error {
+func (g guildMemberQueryBuilder) AddRole(roleID Snowflake, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodPut,
 		Endpoint: endpoint.GuildMemberRole(g.gid, g.uid, roleID),
@@ -102,7 +108,7 @@ func (g guildMemberQueryBuilder) AddRole(roleID Snowflake, flags ...Flag) error
 
 // RemoveMemberRole removes a role from a guild member. Requires the 'MANAGE_ROLES' permission.
 // Returns a 204 empty response on success. Fires a Guild Member Update Gateway event.
-func (g guildMemberQueryBuilder) RemoveRole(roleID Snowflake, flags ...Flag) error {
+func (g guildMemberQueryBuilder) RemoveRole(roleID Snowflake, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.GuildMemberRole(g.gid, g.uid, roleID),
@@ -115,7 +121,7 @@ func (g guildMemberQueryBuilder) RemoveRole(roleID Snowflake, flags ...Flag) err
 
 // KickMember kicks a member from a guild. Requires 'KICK_MEMBERS' permission.
 // Returns a 204 empty response on success. Fires a Guild Member Remove Gateway event.
-func (g guildMemberQueryBuilder) Kick(reason string, flags ...Flag) error {
+func (g guildMemberQueryBuilder) Kick(reason string, flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.GuildMember(g.gid, g.uid),
@@ -129,7 +135,7 @@ func (g guildMemberQueryBuilder) Kick(reason string, flags ...Flag) error {
 
 // BanMember Create a guild ban, and optionally delete previous messages sent by the banned user. Requires
 // the 'BAN_MEMBERS' permission. Returns a 204 empty response on success. Fires a Guild Ban Add Gateway event.
-func (g guildMemberQueryBuilder) Ban(params *BanMemberParams, flags ...Flag) (err error) {
+func (g guildMemberQueryBuilder) Ban(params *BanMemberParams, flags ...RequestOption) (err error) {
 	if params == nil {
 		return errors.New("params was nil")
 	}
@@ -138,10 +144,12 @@ func (g guildMemberQueryBuilder) Ban(params *BanMemberParams, flags ...Flag) (er
 	}
 
 	r := g.client.newRESTRequest(&httd.Request{
-		Method:   httd.MethodPut,
-		Endpoint: endpoint.GuildBan(g.gid, g.uid) + params.URLQueryString(),
-		Ctx:      g.ctx,
-		Reason:   params.Reason,
+		Method:      httd.MethodPut,
+		Endpoint:    endpoint.GuildBan(g.gid, g.uid),
+		Body:        params.prepare(),
+		ContentType: httd.ContentTypeJSON,
+		Ctx:         g.ctx,
+		Reason:      params.Reason,
 	}, flags)
 
 	_, err = r.Execute()
@@ -149,7 +157,7 @@ func (g guildMemberQueryBuilder) Ban(params *BanMemberParams, flags ...Flag) (er
 }
 
 // GetPermissions is used to return the members permissions.
-func (g guildMemberQueryBuilder) GetPermissions(flags ...Flag) (PermissionBit, error) {
+func (g guildMemberQueryBuilder) GetPermissions(flags ...RequestOption) (PermissionBit, error) {
 	member, err := g.Get(flags...)
 	if err != nil {
 		return 0, err
@@ -0,0 +1,177 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
+)
+
+func TestMember_Timeout(t *testing.T) {
+	var body map[string]interface{}
+	var reason string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			if !strings.HasSuffix(req.URL.Path, "/guilds/1/members/2") || req.Method != http.MethodPatch {
+				t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			}
+			reason = req.Header.Get(httd.XAuditLogReason)
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			_ = json.Unmarshal(data, &body)
+			resp := jsonResponse(req, "")
+			resp.StatusCode = http.StatusNoContent
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	member := &Member{GuildID: 1, UserID: 2}
+	if err = member.Timeout(context.Background(), client, time.Hour, "spam"); err != nil {
+		t.Fatal(err)
+	}
+	until, err := time.Parse(time.RFC3339, body["communication_disabled_until"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(until); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("expected the timeout to end in an hour. Got %s", d)
+	}
+	if reason != "spam" {
+		t.Errorf("expected the audit log reason to be set. Got %q", reason)
+	}
+
+	if err = member.RemoveTimeout(context.Background(), client, ""); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := body["communication_disabled_until"]; !ok || v != nil {
+		t.Errorf("expected the timeout to be removed with null. Got %+v", body)
+	}
+
+	if err = member.Timeout(context.Background(), client, MaxMemberTimeout+time.Second, ""); err == nil {
+		t.Error("expected an error for a timeout longer than 28 days")
+	}
+
+	member.CommunicationDisabledUntil = Time{time.Now().Add(time.Minute)}
+	if !member.IsTimedOut() {
+		t.Error("expected the member to be timed out")
+	}
+}
+
+func TestGuild_Bans(t *testing.T) {
+	var body map[string]interface{}
+	var path, query string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path, query = req.URL.Path, req.URL.RawQuery
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			_ = json.Unmarshal(data, &body)
+			if req.Method == http.MethodPost {
+				return jsonResponse(req, `{"banned_users":["2","3"],"failed_users":["4"]}`), nil
+			}
+			resp := jsonResponse(req, "")
+			resp.StatusCode = http.StatusNoContent
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.Guild(1).Member(2).Ban(&BanMemberParams{DeleteMessageDays: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if query != "" || body["delete_message_seconds"] != float64(86400) {
+		t.Errorf("expected the deprecated days to be sent as seconds. Got %q, %+v", query, body)
+	}
+	if err = client.Guild(1).Member(2).Ban(&BanMemberParams{DeleteMessageSeconds: MaxBanDeleteMessageSeconds + 1}); err == nil {
+		t.Error("expected an error when deleting more than 7 days of messages")
+	}
+
+	res, err := client.Guild(1).BulkBan(&BulkBanParams{UserIDs: []Snowflake{2, 3, 4}, DeleteMessageSeconds: 60})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(path, "/guilds/1/bulk-ban") {
+		t.Errorf("unexpected endpoint %s", path)
+	}
+	if ids, ok := body["user_ids"].([]interface{}); !ok || len(ids) != 3 || body["delete_message_seconds"] != float64(60) {
+		t.Errorf("unexpected bulk ban body %+v", body)
+	}
+	if len(res.BannedUsers) != 2 || len(res.FailedUsers) != 1 || res.FailedUsers[0] != 4 {
+		t.Errorf("unexpected bulk ban response %+v", res)
+	}
+
+	if _, err = client.Guild(1).BulkBan(&BulkBanParams{UserIDs: make([]Snowflake, BulkBanLimit+1)}); err == nil {
+		t.Error("expected an error when banning more than 200 users")
+	}
+}
+
+func TestGuild_Prune(t *testing.T) {
+	var body map[string]interface{}
+	var query string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			query = req.URL.RawQuery
+			body = nil
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+			if req.Method == http.MethodPost && body["compute_prune_count"] == false {
+				return jsonResponse(req, `{"pruned":null}`), nil
+			}
+			return jsonResponse(req, `{"pruned":12}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := client.Guild(1).GetPruneCount(&GetPruneCountParams{Days: 30, IncludeRoles: []Snowflake{2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 12 {
+		t.Errorf("expected 12 members. Got %d", count)
+	}
+	if expected := "days=30&include_roles=2%2C3"; query != expected {
+		t.Errorf("expected query %s. Got %s", expected, query)
+	}
+
+	pruned, err := client.Guild(1).Prune(&PruneMembersParams{Days: 7, ComputePruneCount: true, IncludeRoles: []Snowflake{2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned == nil || *pruned != 12 {
+		t.Errorf("expected the pruned count. Got %v", pruned)
+	}
+	if roles, ok := body["include_roles"].([]interface{}); !ok || len(roles) != 1 {
+		t.Errorf("unexpected prune body %+v", body)
+	}
+
+	pruned, err = client.Guild(1).Prune(&PruneMembersParams{Days: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != nil {
+		t.Errorf("expected no pruned count for large guilds. Got %d", *pruned)
+	}
+
+	if _, err = client.Guild(1).Prune(&PruneMembersParams{Days: MaxPruneDays + 1}); err == nil {
+		t.Error("expected an error when pruning more than 30 days")
+	}
+}
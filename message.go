@@ -29,6 +29,14 @@ const (
 	MessageFlagUrgent
 )
 
+const (
+	// MessageFlagEphemeral makes the message only visible to the user who invoked the interaction.
+	MessageFlagEphemeral MessageFlag = 1 << 6
+
+	// MessageFlagSuppressNotifications sends the message without triggering push and desktop notifications.
+	MessageFlagSuppressNotifications MessageFlag = 1 << 12
+)
+
 // The different message types usually generated by Discord. eg. "a new user joined"
 type MessageType uint // TODO: once auto generated, un-export this.
 
@@ -76,12 +84,39 @@ type MentionChannel struct {
 var _ Copier = (*MentionChannel)(nil)
 var _ DeepCopier = (*MentionChannel)(nil)
 
+// MessageReferenceType decides how a referenced message is shown.
+type MessageReferenceType int
+
+const (
+	// MessageReferenceDefault replies to the referenced message.
+	MessageReferenceDefault MessageReferenceType = iota
+
+	// MessageReferenceForward forwards the referenced message.
+	MessageReferenceForward
+)
+
+// MessageReference https://discord.com/developers/docs/resources/message#message-reference-structure
 type MessageReference struct {
-	MessageID Snowflake `json:"message_id"`
-	ChannelID Snowflake `json:"channel_id"`
-	GuildID   Snowflake `json:"guild_id"`
+	Type      MessageReferenceType `json:"type,omitempty"`
+	MessageID Snowflake            `json:"message_id"`
+	ChannelID Snowflake            `json:"channel_id"`
+	GuildID   Snowflake            `json:"guild_id,omitempty"`
+
+	// FailIfNotExists when false, a reply to a deleted message is sent as a normal message. Defaults to true
+	FailIfNotExists *bool `json:"fail_if_not_exists,omitempty"`
+}
+
+// MessageSnapshot is a copy of a forwarded message, taken when the message was forwarded.
+// https://discord.com/developers/docs/resources/message#message-snapshot-object
+type MessageSnapshot struct {
+	// Message holds a subset of the message fields: the content, embeds, attachments, timestamps, flags,
+	// mentions, stickers and components.
+	Message *Message `json:"message"`
 }
 
+var _ Copier = (*MessageSnapshot)(nil)
+var _ DeepCopier = (*MessageSnapshot)(nil)
+
 type MessageComponentType = int
 
 const (
@@ -131,8 +166,11 @@ const (
 	MessageStickerFormatPNG
 	MessageStickerFormatAPNG
 	MessageStickerFormatLOTTIE
+	MessageStickerFormatGIF
 )
 
+// MessageSticker is the sticker object sent with messages in earlier API versions.
+// Deprecated: use Message.StickerItems
 type MessageSticker struct {
 	ID           Snowflake                `json:"id"`
 	PackID       Snowflake                `json:"pack_id"`
@@ -174,9 +212,12 @@ type Message struct {
 	MessageReference  *MessageReference   `json:"message_reference"`
 	ReferencedMessage *Message            `json:"referenced_message"`
 	Flags             MessageFlag         `json:"flags"`
-	Stickers          []*MessageSticker   `json:"stickers"`
+	Stickers          []*MessageSticker   `json:"stickers"` // Deprecated: use StickerItems
+	StickerItems      []*StickerItem      `json:"sticker_items,omitempty"`
 	Components        []*MessageComponent `json:"components"`
 	Interaction       *MessageInteraction `json:"interaction"`
+	Poll              *Poll               `json:"poll,omitempty"`
+	MessageSnapshots  []*MessageSnapshot  `json:"message_snapshots,omitempty"` // set for forwarded messages
 	// SpoilerTagContent is only true if the entire message text is tagged as a spoiler (aka completely wrapped in ||)
 	SpoilerTagContent        bool `json:"-"`
 	SpoilerTagAllAttachments bool `json:"-"`
@@ -246,7 +287,7 @@ func (m *Message) IsDirectMessage() bool {
 }
 
 // Send sends this message to discord.
-func (m *Message) Send(ctx context.Context, s Session, flags ...Flag) (msg *Message, err error) {
+func (m *Message) Send(ctx context.Context, s Session, flags ...RequestOption) (msg *Message, err error) {
 	nonce := fmt.Sprint(m.Nonce)
 	if len(nonce) > 25 {
 		return nil, errors.New("nonce can not be more than 25 characters")
@@ -275,7 +316,29 @@ func (m *Message) Reply(ctx context.Context, s Session, data ...interface{}) (*M
 	return s.WithContext(ctx).SendMsg(m.ChannelID, data...)
 }
 
-func (m *Message) React(ctx context.Context, s Session, emoji interface{}, flags ...Flag) error {
+// Forward forwards the message to the given channel. The forwarded message shows the original message,
+// as it was at the time it was forwarded.
+//  forwarded, err := msg.Forward(ctx, client, logChannelID)
+func (m *Message) Forward(ctx context.Context, s Session, channelID Snowflake, flags ...RequestOption) (*Message, error) {
+	if m.ID.IsZero() {
+		return nil, errors.New("missing message ID")
+	} else if m.ChannelID.IsZero() {
+		return nil, errors.New("missing channel ID")
+	}
+
+	params, err := NewMessageBuilder().Forward(m).CreateParams()
+	if err != nil {
+		return nil, err
+	}
+	return s.Channel(channelID).WithContext(ctx).CreateMessage(params, flags...)
+}
+
+// IsForward reports whether the message is a forward of another message, see MessageSnapshots.
+func (m *Message) IsForward() bool {
+	return m.MessageReference != nil && m.MessageReference.Type == MessageReferenceForward
+}
+
+func (m *Message) React(ctx context.Context, s Session, emoji interface{}, flags ...RequestOption) error {
 	if m.ID.IsZero() {
 		return errors.New("missing message ID")
 	} else if m.ChannelID.IsZero() {
@@ -285,7 +348,7 @@ func (m *Message) React(ctx context.Context, s Session, emoji interface{}, flags
 	return s.Channel(m.ChannelID).Message(m.ID).Reaction(emoji).WithContext(ctx).Create(flags...)
 }
 
-func (m *Message) Unreact(ctx context.Context, s Session, emoji interface{}, flags ...Flag) error {
+func (m *Message) Unreact(ctx context.Context, s Session, emoji interface{}, flags ...RequestOption) error {
 	if m.ID.IsZero() {
 		return errors.New("missing message ID")
 	} else if m.ChannelID.IsZero() {
@@ -311,36 +374,50 @@ type MessageQueryBuilder interface {
 	WithContext(ctx context.Context) MessageQueryBuilder
 
 	// PinMessageID Pin a message by its ID and channel ID. Requires the 'MANAGE_MESSAGES' permission.
-	Pin(flags ...Flag) error
+	Pin(flags ...RequestOption) error
 
 	// UnpinMessageID Delete a pinned message in a channel. Requires the 'MANAGE_MESSAGES' permission.
-	Unpin(flags ...Flag) error
+	Unpin(flags ...RequestOption) error
 
 	// GetMessage Returns a specific message in the channel. If operating on a guild channel, this endpoints
 	// requires the 'READ_MESSAGE_HISTORY' permission to be present on the current user.
 	// Returns a message object on success.
-	Get(flags ...Flag) (*Message, error)
+	Get(flags ...RequestOption) (*Message, error)
 
 	// UpdateMessage Edit a previously sent message. You can only edit messages that have been sent by the
 	// current user. Returns a message object. Fires a Message Update Gateway event.
-	UpdateBuilder(flags ...Flag) *updateMessageBuilder
+	UpdateBuilder(flags ...RequestOption) *updateMessageBuilder
 	SetContent(content string) (*Message, error)
 	SetEmbed(embed *Embed) (*Message, error)
 
-	CrossPost(flags ...Flag) (*Message, error)
+	// Edit a previously sent message, uploading any new files. See MessageBuilder.UpdateParams.
+	Edit(params *UpdateMessageParams, flags ...RequestOption) (*Message, error)
+
+	CrossPost(flags ...RequestOption) (*Message, error)
 
 	// Deprecated: use UpdateBuilder instead
-	Update(flags ...Flag) *updateMessageBuilder
+	Update(flags ...RequestOption) *updateMessageBuilder
 
 	// DeleteMessage Delete a message. If operating on a guild channel and trying to delete a message that was not
 	// sent by the current user, this endpoint requires the 'MANAGE_MESSAGES' permission. Fires a Message Delete Gateway event.
-	Delete(flags ...Flag) error
+	Delete(flags ...RequestOption) error
 
 	// DeleteAllReactions Deletes all reactions on a message. This endpoint requires the 'MANAGE_MESSAGES'
 	// permission to be present on the current user.
-	DeleteAllReactions(flags ...Flag) error
+	DeleteAllReactions(flags ...RequestOption) error
 
+	// Reaction targets the reactions of an emoji, given as an EmojiIdentifier, a *Emoji, or a string accepted
+	// by ParseEmoji.
 	Reaction(emoji interface{}) ReactionQueryBuilder
+
+	// StartThread Creates a new thread from this message.
+	StartThread(params *StartThreadParams, flags ...RequestOption) (*Channel, error)
+
+	// GetPollAnswerVoters Get a list of users that voted for this specific answer.
+	GetPollAnswerVoters(answerID int, params *GetPollAnswerVotersParams, flags ...RequestOption) ([]*User, error)
+
+	// EndPoll Immediately ends the poll. You cannot end polls from other users.
+	EndPoll(flags ...RequestOption) (*Message, error)
 }
 
 func (c channelQueryBuilder) Message(id Snowflake) MessageQueryBuilder {
@@ -367,7 +444,7 @@ func (m messageQueryBuilder) WithContext(ctx context.Context) MessageQueryBuilde
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#get-channel-message
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (m messageQueryBuilder) Get(flags ...Flag) (*Message, error) {
+func (m messageQueryBuilder) Get(flags ...RequestOption) (*Message, error) {
 	if m.cid.IsZero() {
 		err := errors.New("channelID must be set to get channel messages")
 		return nil, err
@@ -402,7 +479,7 @@ func (m messageQueryBuilder) Get(flags ...Flag) (*Message, error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#edit-message
 //  Reviewed                2018-06-10
 //  Comment                 All parameters to this endpoint are optional.
-func (m messageQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateMessageBuilder) {
+func (m messageQueryBuilder) UpdateBuilder(flags ...RequestOption) (builder *updateMessageBuilder) {
 	builder = &updateMessageBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Message{}
@@ -416,6 +493,10 @@ func (m messageQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateMessag
 		Endpoint:    "/channels/" + m.cid.String() + "/messages/" + m.mid.String(),
 		ContentType: httd.ContentTypeJSON,
 	}, nil)
+	if m.client.allowedMentions != nil {
+		// overwritten by SetAllowedMentions
+		builder.SetAllowedMentions(m.client.allowedMentions)
+	}
 
 	return builder
 }
@@ -428,7 +509,7 @@ func (m messageQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateMessag
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-message
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (m messageQueryBuilder) Delete(flags ...Flag) (err error) {
+func (m messageQueryBuilder) Delete(flags ...RequestOption) (err error) {
 	if m.cid.IsZero() {
 		err = errors.New("channelID must be set to get channel messages")
 		return
@@ -455,7 +536,7 @@ func (m messageQueryBuilder) Delete(flags ...Flag) (err error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#add-pinned-channel-message
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (m messageQueryBuilder) Pin(flags ...Flag) (err error) {
+func (m messageQueryBuilder) Pin(flags ...RequestOption) (err error) {
 	r := m.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodPut,
 		Endpoint: endpoint.ChannelPin(m.cid, m.mid),
@@ -473,7 +554,7 @@ func (m messageQueryBuilder) Pin(flags ...Flag) (err error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-pinned-channel-message
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (m messageQueryBuilder) Unpin(flags ...Flag) (err error) {
+func (m messageQueryBuilder) Unpin(flags ...RequestOption) (err error) {
 	if m.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -497,7 +578,7 @@ func (m messageQueryBuilder) Unpin(flags ...Flag) (err error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#crosspost-message
 //  Reviewed                2021-04-07
 //  Comment                 -
-func (m messageQueryBuilder) CrossPost(flags ...Flag) (*Message, error) {
+func (m messageQueryBuilder) CrossPost(flags ...RequestOption) (*Message, error) {
 	if m.cid.IsZero() {
 		return nil, errors.New("channelID must be set to target the correct channel")
 	}
@@ -528,7 +609,7 @@ func (m messageQueryBuilder) CrossPost(flags ...Flag) (*Message, error) {
 //  Endpoint                /channels/{channel.id}/messages/{message.id}/reactions
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-all-reactions
 //  Reviewed                2019-01-28
-func (m messageQueryBuilder) DeleteAllReactions(flags ...Flag) error {
+func (m messageQueryBuilder) DeleteAllReactions(flags ...RequestOption) error {
 	if m.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -566,6 +647,69 @@ func (m messageQueryBuilder) SetEmbed(embed *Embed) (*Message, error) {
 		Execute()
 }
 
+// UpdateMessageParams https://discord.com/developers/docs/resources/channel#edit-message-jsonform-params
+// Only the set fields are changed.
+type UpdateMessageParams struct {
+	Content         *string             `json:"content,omitempty"`
+	Embeds          []*Embed            `json:"embeds,omitempty"`
+	Flags           MessageFlag         `json:"flags,omitempty"`
+	AllowedMentions *AllowedMentions    `json:"allowed_mentions,omitempty"`
+	Components      []*MessageComponent `json:"components,omitempty"`
+
+	// Files are uploaded in addition to the existing attachments of the message
+	Files []CreateMessageFileParams `json:"-"`
+}
+
+func (p *UpdateMessageParams) prepare() (postBody interface{}, contentType string, err error) {
+	if len(p.Files) == 0 {
+		return p, httd.ContentTypeJSON, nil
+	}
+	return writeMultipart(p, p.Files)
+}
+
+// Edit a previously sent message. You can only edit messages that have been sent by the current user,
+// except for the flags. Returns a message object. Fires a Message Update Gateway event.
+//  Method                  PATCH
+//  Endpoint                /channels/{channel.id}/messages/{message.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#edit-message
+//  Reviewed                2024-06-01
+//  Comment                 Files are sent as a multipart body.
+func (m messageQueryBuilder) Edit(params *UpdateMessageParams, flags ...RequestOption) (*Message, error) {
+	if m.cid.IsZero() {
+		return nil, errors.New("channelID must be set to edit the message")
+	}
+	if m.mid.IsZero() {
+		return nil, errors.New("msgID must be set to edit the message")
+	}
+	if params == nil {
+		return nil, errors.New("params must be set")
+	}
+	if params.AllowedMentions == nil && m.client.allowedMentions != nil {
+		p := *params
+		p.AllowedMentions = m.client.allowedMentions
+		params = &p
+	}
+
+	postBody, contentType, err := params.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	r := m.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         m.ctx,
+		Endpoint:    endpoint.ChannelMessage(m.cid, m.mid),
+		Body:        postBody,
+		ContentType: contentType,
+	}, flags)
+	r.pool = m.client.pool.message
+	r.factory = func() interface{} {
+		return &Message{}
+	}
+
+	return getMessage(r.Execute)
+}
+
 //////////////////////////////////////////////////////
 //
 // REST Builders
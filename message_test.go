@@ -3,7 +3,13 @@
 package disgord
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+
+	"github.com/Vedza/disgord/json"
 )
 
 func TestMessage_updateInternals(t *testing.T) {
@@ -51,3 +57,91 @@ func TestMessage_updateInternals(t *testing.T) {
 		t.Error("expects spoiler tag for attachments to be false. Got true")
 	}
 }
+
+func TestCreatePollParams_FindErrors(t *testing.T) {
+	valid := func() *CreatePollParams {
+		return &CreatePollParams{
+			Question: PollMedia{Text: "Best gopher?"},
+			Answers: []*PollAnswer{
+				{PollMedia: &PollMedia{Text: "Gordon"}},
+				{PollMedia: &PollMedia{Emoji: &Emoji{Name: "🐹"}}},
+			},
+			Duration: 48,
+		}
+	}
+
+	if err := valid().FindErrors(); err != nil {
+		t.Errorf("expected poll to be valid. Got %s", err)
+	}
+
+	invalid := map[string]func(p *CreatePollParams){
+		"missing question": func(p *CreatePollParams) { p.Question.Text = "" },
+		"no answers":       func(p *CreatePollParams) { p.Answers = nil },
+		"missing media":    func(p *CreatePollParams) { p.Answers[0].PollMedia = nil },
+		"long duration":    func(p *CreatePollParams) { p.Duration = 33 * 24 },
+		"too many answers": func(p *CreatePollParams) {
+			for i := 0; i < 10; i++ {
+				p.Answers = append(p.Answers, &PollAnswer{PollMedia: &PollMedia{Text: "x"}})
+			}
+		},
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			p := valid()
+			modify(p)
+			if err := p.FindErrors(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestMessage_Forward(t *testing.T) {
+	var body map[string]interface{}
+	var path string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path = req.URL.Path
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			_ = json.Unmarshal(data, &body)
+			return jsonResponse(req, `{
+				"id":"10",
+				"channel_id":"3",
+				"message_reference":{"type":1,"message_id":"1","channel_id":"2"},
+				"message_snapshots":[{"message":{"content":"hello","type":0}}]
+			}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := &Message{ID: 1, ChannelID: 2, Content: "hello"}
+	forwarded, err := msg.Forward(context.Background(), client, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(path, "/channels/3/messages") {
+		t.Errorf("expected the message to be sent to the target channel. Got %s", path)
+	}
+	reference, ok := body["message_reference"].(map[string]interface{})
+	if !ok || reference["type"] != float64(MessageReferenceForward) || reference["message_id"] != "1" {
+		t.Errorf("unexpected message reference %+v", body)
+	}
+	if _, ok := reference["guild_id"]; ok {
+		t.Error("expected the guild id to be omitted for direct messages")
+	}
+	if content, _ := body["content"].(string); content != "" {
+		t.Errorf("expected a forward without content. Got %s", content)
+	}
+
+	if !forwarded.IsForward() || len(forwarded.MessageSnapshots) != 1 || forwarded.MessageSnapshots[0].Message.Content != "hello" {
+		t.Errorf("expected the forwarded message snapshot. Got %+v", forwarded)
+	}
+
+	if _, err = (&Message{ChannelID: 2}).Forward(context.Background(), client, 3); err == nil {
+		t.Error("expected an error when the message id is missing")
+	}
+}
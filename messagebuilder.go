@@ -0,0 +1,206 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// Message limits. See https://discord.com/developers/docs/resources/channel#create-message
+const (
+	MessageContentLimit  = 2000
+	MessageEmbedsLimit   = 10
+	MessageStickersLimit = 3
+	MessageFilesLimit    = 10
+)
+
+// MessageBuilder builds a message to be created or edited. Files are sent as a multipart body, while
+// messages without files are sent as JSON.
+//  embed, _ := disgord.NewEmbed().Title("Report").Build()
+//  params, err := disgord.NewMessageBuilder().
+//      Content("here is the report").
+//      Embed(embed).
+//      File("report.txt", file).
+//      Reply(msg).
+//      CreateParams()
+//  if err != nil {
+//      return err
+//  }
+//
+//  msg, err = client.Channel(msg.ChannelID).CreateMessage(params)
+type MessageBuilder struct {
+	content         *string
+	tts             bool
+	embeds          []*Embed
+	files           []CreateMessageFileParams
+	components      []*MessageComponent
+	allowedMentions *AllowedMentions
+	reference       *MessageReference
+	flags           MessageFlag
+	stickers        []Snowflake
+}
+
+// NewMessageBuilder creates an empty message builder.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// Content sets the text of the message. An empty content removes the text of an edited message.
+func (b *MessageBuilder) Content(content string) *MessageBuilder {
+	b.content = &content
+	return b
+}
+
+// TTS sends the message as a text to speech message. Only used when creating a message.
+func (b *MessageBuilder) TTS(tts bool) *MessageBuilder {
+	b.tts = tts
+	return b
+}
+
+// Embed adds one or more embeds. See NewEmbed.
+func (b *MessageBuilder) Embed(embeds ...*Embed) *MessageBuilder {
+	b.embeds = append(b.embeds, embeds...)
+	return b
+}
+
+// File adds a file to upload. Refer to it from an embed with "attachment://{name}".
+func (b *MessageBuilder) File(name string, r io.Reader) *MessageBuilder {
+	b.files = append(b.files, CreateMessageFileParams{FileName: name, Reader: r})
+	return b
+}
+
+// SpoilerFile adds a file to upload, which is blurred out until clicked.
+func (b *MessageBuilder) SpoilerFile(name string, r io.Reader) *MessageBuilder {
+	b.files = append(b.files, CreateMessageFileParams{FileName: name, Reader: r, SpoilerTag: true})
+	return b
+}
+
+// Components adds one or more components, such as action rows holding buttons.
+func (b *MessageBuilder) Components(components ...*MessageComponent) *MessageBuilder {
+	b.components = append(b.components, components...)
+	return b
+}
+
+// AllowedMentions decides who can be mentioned by the message.
+func (b *MessageBuilder) AllowedMentions(mentions *AllowedMentions) *MessageBuilder {
+	b.allowedMentions = mentions
+	return b
+}
+
+// Reply makes the message a reply to the given message. Only used when creating a message.
+func (b *MessageBuilder) Reply(msg *Message) *MessageBuilder {
+	b.reference = &MessageReference{
+		Type:      MessageReferenceDefault,
+		MessageID: msg.ID,
+		ChannelID: msg.ChannelID,
+		GuildID:   msg.GuildID,
+	}
+	return b
+}
+
+// Forward forwards the given message, which can be from another channel. Forwarded messages can not
+// have content, embeds, files or stickers of their own. Only used when creating a message.
+func (b *MessageBuilder) Forward(msg *Message) *MessageBuilder {
+	b.reference = &MessageReference{
+		Type:      MessageReferenceForward,
+		MessageID: msg.ID,
+		ChannelID: msg.ChannelID,
+		GuildID:   msg.GuildID,
+	}
+	return b
+}
+
+// Flags adds message flags, such as MessageFlagSupressEmbeds or MessageFlagSuppressNotifications.
+func (b *MessageBuilder) Flags(flags MessageFlag) *MessageBuilder {
+	b.flags |= flags
+	return b
+}
+
+// Stickers adds up to 3 stickers. Only used when creating a message.
+func (b *MessageBuilder) Stickers(ids ...Snowflake) *MessageBuilder {
+	b.stickers = append(b.stickers, ids...)
+	return b
+}
+
+func (b *MessageBuilder) validate() error {
+	if b.content != nil {
+		if length := utf8.RuneCountInString(*b.content); length > MessageContentLimit {
+			return fmt.Errorf("message content is %d long, which exceeds the limit of %d", length, MessageContentLimit)
+		}
+	}
+	if len(b.embeds) > MessageEmbedsLimit {
+		return fmt.Errorf("message has %d embeds, which exceeds the limit of %d", len(b.embeds), MessageEmbedsLimit)
+	}
+	if len(b.stickers) > MessageStickersLimit {
+		return fmt.Errorf("message has %d stickers, which exceeds the limit of %d", len(b.stickers), MessageStickersLimit)
+	}
+	if len(b.files) > MessageFilesLimit {
+		return fmt.Errorf("message has %d files, which exceeds the limit of %d", len(b.files), MessageFilesLimit)
+	}
+
+	// the total embed limit applies to every embed combined
+	var total int
+	for i, embed := range b.embeds {
+		if embed == nil {
+			return fmt.Errorf("embeds[%d] is nil", i)
+		}
+		if err := embed.Validate(); err != nil {
+			return fmt.Errorf("embeds[%d]: %w", i, err)
+		}
+		total += embed.length()
+	}
+	if total > EmbedTotalLimit {
+		return &EmbedLimitError{Field: "total", Length: total, Limit: EmbedTotalLimit}
+	}
+
+	if b.reference != nil && b.reference.Type == MessageReferenceForward {
+		if b.content != nil || len(b.embeds) > 0 || len(b.files) > 0 || len(b.stickers) > 0 {
+			return errors.New("a forwarded message can not have content, embeds, files or stickers")
+		}
+	}
+	return nil
+}
+
+// CreateParams creates the parameters for ChannelQueryBuilder.CreateMessage, or returns an error when
+// a limit is exceeded.
+func (b *MessageBuilder) CreateParams() (*CreateMessageParams, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+
+	params := &CreateMessageParams{
+		Tts:              b.tts,
+		Embeds:           append([]*Embed(nil), b.embeds...),
+		Components:       append([]*MessageComponent(nil), b.components...),
+		Files:            append([]CreateMessageFileParams(nil), b.files...),
+		AllowedMentions:  b.allowedMentions,
+		MessageReference: b.reference,
+		StickerIDs:       append([]Snowflake(nil), b.stickers...),
+		Flags:            b.flags,
+	}
+	if b.content != nil {
+		params.Content = *b.content
+	}
+	return params, nil
+}
+
+// UpdateParams creates the parameters for MessageQueryBuilder.Edit, or returns an error when a limit is
+// exceeded or the builder holds fields that can not be edited.
+func (b *MessageBuilder) UpdateParams() (*UpdateMessageParams, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	if b.tts || b.reference != nil || len(b.stickers) > 0 {
+		return nil, errors.New("tts, message references and stickers can not be edited")
+	}
+
+	return &UpdateMessageParams{
+		Content:         b.content,
+		Embeds:          append([]*Embed(nil), b.embeds...),
+		Flags:           b.flags,
+		AllowedMentions: b.allowedMentions,
+		Components:      append([]*MessageComponent(nil), b.components...),
+		Files:           append([]CreateMessageFileParams(nil), b.files...),
+	}, nil
+}
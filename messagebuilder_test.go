@@ -0,0 +1,117 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
+)
+
+func TestMessageBuilder(t *testing.T) {
+	embed, err := NewEmbed().Title("report").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	replyTo := &Message{ID: 3, ChannelID: 2, GuildID: 1}
+
+	params, err := NewMessageBuilder().
+		Content("hi").
+		Embed(embed).
+		Reply(replyTo).
+		Flags(MessageFlagSuppressNotifications).
+		Stickers(4).
+		CreateParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, contentType, err := params.prepare()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != httd.ContentTypeJSON {
+		t.Errorf("expected a JSON body without files. Got %s", contentType)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{`"embeds":[{"title":"report","type":"rich"`, `"flags":4096`, `"sticker_ids":["4"]`, `"message_reference":{"message_id":"3"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("expected %s in the payload. Got %s", expected, data)
+		}
+	}
+
+	params, err = NewMessageBuilder().Content("file").File("a.txt", strings.NewReader("a")).CreateParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, contentType, _ = params.prepare(); !strings.HasPrefix(contentType, "multipart/form-data") {
+		t.Errorf("expected a multipart body with files. Got %s", contentType)
+	}
+
+	if _, err = NewMessageBuilder().Content(strings.Repeat("a", MessageContentLimit+1)).CreateParams(); err == nil {
+		t.Error("expected an error when the content is too long")
+	}
+	if _, err = NewMessageBuilder().Content("hi").Forward(replyTo).CreateParams(); err == nil {
+		t.Error("expected an error when a forwarded message has content")
+	}
+	if _, err = NewMessageBuilder().Reply(replyTo).UpdateParams(); err == nil {
+		t.Error("expected an error when editing a message reference")
+	}
+
+	large := &Embed{Description: strings.Repeat("a", EmbedDescriptionLimit)}
+	if _, err = NewMessageBuilder().Embed(large, large).CreateParams(); err == nil {
+		t.Error("expected an error when the embeds combined exceed the total limit")
+	}
+}
+
+func TestMessageQueryBuilder_Edit(t *testing.T) {
+	var contentType, body string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodPatch {
+				t.Errorf("expected a PATCH request. Got %s", req.Method)
+			}
+			contentType = req.Header.Get(httd.ContentType)
+			data, _ := ioutil.ReadAll(req.Body)
+			body = string(data)
+			return jsonResponse(req, `{"id":"2","channel_id":"1","content":"edited"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params, err := NewMessageBuilder().Content("edited").UpdateParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := client.Channel(1).Message(2).Edit(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Content != "edited" {
+		t.Errorf("unexpected message %+v", msg)
+	}
+	if contentType != httd.ContentTypeJSON || body != `{"content":"edited"}` {
+		t.Errorf("unexpected body %s %s", contentType, body)
+	}
+
+	params, err = NewMessageBuilder().File("a.txt", strings.NewReader("file content")).UpdateParams()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Channel(1).Message(2).Edit(params); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(contentType, "multipart/form-data") || !strings.Contains(body, "file content") {
+		t.Errorf("expected a multipart body. Got %s %s", contentType, body)
+	}
+}
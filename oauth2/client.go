@@ -0,0 +1,84 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// Client sends REST requests on behalf of a user, using the access token of the user.
+type Client struct {
+	token      *Token
+	httpClient *http.Client
+}
+
+// Client creates a REST client for the user of the token.
+func (c *Config) Client(token *Token) *Client {
+	return &Client{token: token, httpClient: c.httpClient()}
+}
+
+func (c *Client) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token.AccessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("oauth2: unexpected response from %s: %d %s", path, resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, v)
+}
+
+// CurrentUser returns the user of the token. Requires the identify scope.
+func (c *Client) CurrentUser(ctx context.Context) (*disgord.User, error) {
+	user := &disgord.User{}
+	if err := c.get(ctx, "/users/@me", user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CurrentUserGuilds returns the guilds of the user. Requires the guilds scope.
+func (c *Client) CurrentUserGuilds(ctx context.Context) ([]*disgord.Guild, error) {
+	var guilds []*disgord.Guild
+	if err := c.get(ctx, "/users/@me/guilds", &guilds); err != nil {
+		return nil, err
+	}
+	return guilds, nil
+}
+
+// CurrentUserMember returns the member of the user in the given guild. Requires the guilds.members.read scope.
+func (c *Client) CurrentUserMember(ctx context.Context, guildID disgord.Snowflake) (*disgord.Member, error) {
+	member := &disgord.Member{}
+	if err := c.get(ctx, "/users/@me/guilds/"+guildID.String()+"/member", member); err != nil {
+		return nil, err
+	}
+	member.GuildID = guildID
+	return member, nil
+}
+
+// JoinGuild adds the user to the guild, using the bot client. The bot must be in the guild, and have the
+// CREATE_INSTANT_INVITE permission. Requires the guilds.join scope.
+func (c *Client) JoinGuild(ctx context.Context, bot disgord.ClientQueryBuilder, guildID disgord.Snowflake, params *disgord.AddGuildMemberParams) (*disgord.Member, error) {
+	user, err := c.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.Guild(guildID).WithContext(ctx).CreateMember(user.ID, c.token.AccessToken, params)
+}
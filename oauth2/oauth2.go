@@ -0,0 +1,255 @@
+// Package oauth2 implements the OAuth2 flows of Discord, for dashboards and other web applications that act on
+// behalf of users.
+//
+//  conf := &oauth2.Config{
+//      ClientID:     clientID,
+//      ClientSecret: clientSecret,
+//      RedirectURL:  "https://example.com/callback",
+//      Scopes:       []oauth2.Scope{oauth2.ScopeIdentify, oauth2.ScopeGuilds},
+//  }
+//
+//  // redirect the user to the authorization page
+//  http.Redirect(w, r, conf.AuthURL(&oauth2.AuthURLParams{State: state}), http.StatusFound)
+//
+//  // and in the callback, exchange the code for a token
+//  token, err := conf.Exchange(ctx, r.URL.Query().Get("code"))
+//  user, err := conf.Client(token).CurrentUser(ctx)
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/internal/constant"
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
+)
+
+const authorizeURL = "https://discord.com/oauth2/authorize"
+
+var apiURL = httd.BaseURL + "/v" + strconv.Itoa(constant.DiscordVersion)
+
+// Scope is an OAuth2 scope, which grants access to parts of a user account.
+type Scope string
+
+const (
+	ScopeIdentify                 Scope = "identify"
+	ScopeEmail                    Scope = "email"
+	ScopeConnections              Scope = "connections"
+	ScopeGuilds                   Scope = "guilds"
+	ScopeGuildsJoin               Scope = "guilds.join"
+	ScopeGuildsMembersRead        Scope = "guilds.members.read"
+	ScopeGDMJoin                  Scope = "gdm.join"
+	ScopeBot                      Scope = "bot"
+	ScopeApplicationsCommands     Scope = "applications.commands"
+	ScopeWebhookIncoming          Scope = "webhook.incoming"
+	ScopeMessagesRead             Scope = "messages.read"
+	ScopeRoleConnectionsWrite     Scope = "role_connections.write"
+	ScopeApplicationsEntitlements Scope = "applications.entitlements"
+)
+
+func joinScopes(scopes []Scope) string {
+	s := make([]string, len(scopes))
+	for i := range scopes {
+		s[i] = string(scopes[i])
+	}
+	return strings.Join(s, " ")
+}
+
+// Config holds the credentials of an application.
+type Config struct {
+	ClientID     disgord.Snowflake
+	ClientSecret string
+
+	// RedirectURL must match one of the redirects registered for the application.
+	RedirectURL string
+
+	// Scopes requested by AuthURL and ClientCredentials.
+	Scopes []Scope
+
+	// HTTPClient sends the requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// AuthURLParams are the optional parameters of the authorization URL.
+type AuthURLParams struct {
+	// State is returned to the redirect URL untouched, and should be used to protect against CSRF.
+	State string
+
+	// Permissions requested for the bot, when the bot scope is requested.
+	Permissions disgord.PermissionBit
+
+	// GuildID pre-selects the guild the bot is added to.
+	GuildID            disgord.Snowflake
+	DisableGuildSelect bool
+
+	// Prompt is either "consent", which always asks the user, or "none" which skips the authorization
+	// screen when the user has authorized the application before.
+	Prompt string
+}
+
+// AuthURL returns the URL of the authorization page, where the user is sent to authorize the application.
+func (c *Config) AuthURL(params *AuthURLParams) string {
+	if params == nil {
+		params = &AuthURLParams{}
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", c.ClientID.String())
+	query.Set("scope", joinScopes(c.Scopes))
+	if c.RedirectURL != "" {
+		query.Set("redirect_uri", c.RedirectURL)
+	}
+	if params.State != "" {
+		query.Set("state", params.State)
+	}
+	if params.Permissions != 0 {
+		query.Set("permissions", strconv.FormatUint(uint64(params.Permissions), 10))
+	}
+	if !params.GuildID.IsZero() {
+		query.Set("guild_id", params.GuildID.String())
+	}
+	if params.DisableGuildSelect {
+		query.Set("disable_guild_select", "true")
+	}
+	if params.Prompt != "" {
+		query.Set("prompt", params.Prompt)
+	}
+	return authorizeURL + "?" + query.Encode()
+}
+
+// Token is an OAuth2 access token.
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+	ExpiresIn    int    `json:"expires_in"`
+
+	// Expiry is the time the access token expires, computed from ExpiresIn.
+	Expiry time.Time `json:"expiry"`
+
+	// Guild is the guild the bot was added to, when the bot scope was authorized.
+	Guild *disgord.Guild `json:"guild,omitempty"`
+
+	// Webhook is the webhook that was created, when the webhook.incoming scope was authorized.
+	Webhook *disgord.Webhook `json:"webhook,omitempty"`
+}
+
+// Valid reports whether the access token is set and has not expired.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Before(t.Expiry))
+}
+
+// Scopes returns the scopes granted by the user.
+func (t *Token) Scopes() (scopes []Scope) {
+	for _, scope := range strings.Fields(t.Scope) {
+		scopes = append(scopes, Scope(scope))
+	}
+	return scopes
+}
+
+// Error is returned by Discord when a token request fails.
+type Error struct {
+	HTTPCode    int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("oauth2: %s (%d): %s", e.Code, e.HTTPCode, e.Description)
+}
+
+// Exchange exchanges the code, which was passed to the redirect URL, for an access token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.RedirectURL)
+	return c.requestToken(ctx, form)
+}
+
+// Refresh creates a new access token from a refresh token.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	return c.requestToken(ctx, form)
+}
+
+// ClientCredentials creates an access token for the owner of the application, which is useful for testing.
+// The scopes of the config are requested.
+func (c *Config) ClientCredentials(ctx context.Context) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", joinScopes(c.Scopes))
+	return c.requestToken(ctx, form)
+}
+
+// Revoke revokes the access or refresh token.
+func (c *Config) Revoke(ctx context.Context, token string) error {
+	form := url.Values{}
+	form.Set("token", token)
+	_, err := c.postForm(ctx, "/oauth2/token/revoke", form)
+	return err
+}
+
+func (c *Config) requestToken(ctx context.Context, form url.Values) (*Token, error) {
+	body, err := c.postForm(ctx, "/oauth2/token", form)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{}
+	if err = json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func (c *Config) postForm(ctx context.Context, path string, form url.Values) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.ClientID.String(), c.ClientSecret)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		oauthErr := &Error{HTTPCode: resp.StatusCode}
+		if json.Unmarshal(body, oauthErr) != nil || oauthErr.Code == "" {
+			oauthErr.Code = "unexpected_response"
+			oauthErr.Description = string(body)
+		}
+		return nil, oauthErr
+	}
+	return body, nil
+}
@@ -0,0 +1,87 @@
+// +build !integration
+
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestConfig_AuthURL(t *testing.T) {
+	conf := &Config{
+		ClientID:    1,
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []Scope{ScopeBot, ScopeIdentify},
+	}
+
+	u, err := url.Parse(conf.AuthURL(&AuthURLParams{State: "abc", Permissions: 8}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Get("client_id") != "1" || query.Get("scope") != "bot identify" || query.Get("state") != "abc" {
+		t.Errorf("incorrect query. Got %s", u.RawQuery)
+	}
+	if query.Get("permissions") != "8" || query.Get("redirect_uri") != conf.RedirectURL {
+		t.Errorf("incorrect query. Got %s", u.RawQuery)
+	}
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			id, secret, _ := r.BasicAuth()
+			if id != "1" || secret != "secret" {
+				t.Error("expected the client credentials as basic auth")
+			}
+			if r.FormValue("code") != "valid" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Invalid code"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh","scope":"identify guilds"}`))
+		case "/users/@me":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"2","username":"test"}`))
+		}
+	}))
+	defer server.Close()
+
+	prev := apiURL
+	apiURL = server.URL
+	defer func() {
+		apiURL = prev
+	}()
+
+	conf := &Config{ClientID: 1, ClientSecret: "secret"}
+	ctx := context.Background()
+
+	if _, err := conf.Exchange(ctx, "invalid"); err == nil {
+		t.Error("expected an error for an invalid code")
+	} else if oauthErr, ok := err.(*Error); !ok || oauthErr.Code != "invalid_grant" {
+		t.Errorf("expected the error of the response. Got %v", err)
+	}
+
+	token, err := conf.Exchange(ctx, "valid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !token.Valid() || token.RefreshToken != "refresh" || len(token.Scopes()) != 2 {
+		t.Errorf("incorrect token. Got %+v", token)
+	}
+
+	user, err := conf.Client(token).CurrentUser(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != 2 {
+		t.Errorf("incorrect user. Got %d", user.ID)
+	}
+}
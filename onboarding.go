@@ -0,0 +1,161 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// OnboardingMode defines the criteria used to satisfy the onboarding constraints that are required for enabling.
+// https://discord.com/developers/docs/resources/guild#guild-onboarding-object-onboarding-mode
+type OnboardingMode uint
+
+const (
+	// OnboardingModeDefault counts only default channels towards the constraints
+	OnboardingModeDefault OnboardingMode = iota
+	// OnboardingModeAdvanced counts default channels and questions towards the constraints
+	OnboardingModeAdvanced
+)
+
+// OnboardingPromptType https://discord.com/developers/docs/resources/guild#guild-onboarding-object-prompt-types
+type OnboardingPromptType uint
+
+const (
+	OnboardingPromptMultipleChoice OnboardingPromptType = iota
+	OnboardingPromptDropdown
+)
+
+// OnboardingPromptOption https://discord.com/developers/docs/resources/guild#guild-onboarding-object-prompt-option-structure
+type OnboardingPromptOption struct {
+	ID          Snowflake   `json:"id,omitempty"`
+	ChannelIDs  []Snowflake `json:"channel_ids"`
+	RoleIDs     []Snowflake `json:"role_ids"`
+	Title       string      `json:"title"`
+	Description string      `json:"description,omitempty"`
+
+	// Emoji is only set when retrieving the onboarding. Use the EmojiID, EmojiName and
+	// EmojiAnimated fields when updating the onboarding.
+	Emoji         *Emoji    `json:"emoji,omitempty"`
+	EmojiID       Snowflake `json:"emoji_id,omitempty"`
+	EmojiName     string    `json:"emoji_name,omitempty"`
+	EmojiAnimated bool      `json:"emoji_animated,omitempty"`
+}
+
+var _ Copier = (*OnboardingPromptOption)(nil)
+var _ DeepCopier = (*OnboardingPromptOption)(nil)
+
+// OnboardingPrompt https://discord.com/developers/docs/resources/guild#guild-onboarding-object-onboarding-prompt-structure
+type OnboardingPrompt struct {
+	ID           Snowflake                 `json:"id"`
+	Type         OnboardingPromptType      `json:"type"`
+	Options      []*OnboardingPromptOption `json:"options"`
+	Title        string                    `json:"title"`
+	SingleSelect bool                      `json:"single_select"`
+	Required     bool                      `json:"required"`
+	InOnboarding bool                      `json:"in_onboarding"`
+}
+
+var _ Copier = (*OnboardingPrompt)(nil)
+var _ DeepCopier = (*OnboardingPrompt)(nil)
+
+// GuildOnboarding https://discord.com/developers/docs/resources/guild#guild-onboarding-object
+type GuildOnboarding struct {
+	GuildID           Snowflake           `json:"guild_id"`
+	Prompts           []*OnboardingPrompt `json:"prompts"`
+	DefaultChannelIDs []Snowflake         `json:"default_channel_ids"`
+	Enabled           bool                `json:"enabled"`
+	Mode              OnboardingMode      `json:"mode"`
+}
+
+var _ Copier = (*GuildOnboarding)(nil)
+var _ DeepCopier = (*GuildOnboarding)(nil)
+
+// UpdateGuildOnboardingParams https://discord.com/developers/docs/resources/guild#modify-guild-onboarding-json-params
+// The onboarding is replaced as a whole, prompts and default channels that are left out are removed.
+type UpdateGuildOnboardingParams struct {
+	Prompts           []*OnboardingPrompt `json:"prompts"`
+	DefaultChannelIDs []Snowflake         `json:"default_channel_ids"`
+	Enabled           bool                `json:"enabled"`
+	Mode              OnboardingMode      `json:"mode"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateGuildOnboardingParams) FindErrors() error {
+	for _, prompt := range p.Prompts {
+		if prompt.Title == "" {
+			return errors.New("onboarding prompts must have a title")
+		}
+		if len(prompt.Options) == 0 || len(prompt.Options) > 50 {
+			return errors.New("onboarding prompts must have 1-50 options")
+		}
+		for _, option := range prompt.Options {
+			if option.Title == "" {
+				return errors.New("onboarding prompt options must have a title")
+			}
+			if len(option.ChannelIDs) == 0 && len(option.RoleIDs) == 0 {
+				return errors.New("onboarding prompt options must assign at least one channel or role")
+			}
+		}
+	}
+	return nil
+}
+
+// GetOnboarding [REST] Returns the onboarding object for the guild.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/onboarding
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-onboarding
+//  Reviewed                2023-06-05
+//  Comment                 -
+func (g guildQueryBuilder) GetOnboarding(flags ...RequestOption) (*GuildOnboarding, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildOnboarding(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildOnboarding{}
+	}
+
+	return getGuildOnboarding(r.Execute)
+}
+
+// UpdateOnboarding [REST] Modifies the onboarding configuration of the guild. Returns the onboarding object
+// for the guild. Requires the MANAGE_GUILD and MANAGE_ROLES permissions. Fires a Guild Audit Log Entry Create
+// Gateway event.
+//  Method                  PUT
+//  Endpoint                /guilds/{guild.id}/onboarding
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#modify-guild-onboarding
+//  Reviewed                2023-06-05
+//  Comment                 Onboarding enforces constraints when enabled, such as a minimum number of
+//                          default channels. The request fails when these constraints are not met.
+func (g guildQueryBuilder) UpdateOnboarding(params *UpdateGuildOnboardingParams, flags ...RequestOption) (*GuildOnboarding, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPut,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildOnboarding(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildOnboarding{}
+	}
+
+	return getGuildOnboarding(r.Execute)
+}
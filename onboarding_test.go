@@ -0,0 +1,90 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestGuildOnboarding(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/guilds"):]
+			requests = append(requests, req.Method+" "+path)
+			body = nil
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+			return jsonResponse(req, `{"guild_id":"1","enabled":true,"mode":1,"default_channel_ids":["2"],"prompts":[
+				{"id":"3","type":1,"title":"games","single_select":true,"options":[
+					{"id":"4","title":"chess","channel_ids":["5"],"role_ids":[],"emoji":{"name":"♟"}}
+				]}
+			]}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	onboarding, err := client.Guild(1).GetOnboarding()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if onboarding.GuildID != 1 || !onboarding.Enabled || onboarding.Mode != OnboardingModeAdvanced || len(onboarding.DefaultChannelIDs) != 1 {
+		t.Errorf("unexpected onboarding %+v", onboarding)
+	}
+	if len(onboarding.Prompts) != 1 || len(onboarding.Prompts[0].Options) != 1 {
+		t.Fatalf("unexpected prompts %+v", onboarding.Prompts)
+	}
+	prompt := onboarding.Prompts[0]
+	if prompt.ID != 3 || prompt.Type != OnboardingPromptDropdown || !prompt.SingleSelect {
+		t.Errorf("unexpected prompt %+v", prompt)
+	}
+	if option := prompt.Options[0]; option.ID != 4 || option.ChannelIDs[0] != 5 || option.Emoji == nil || option.Emoji.Name != "♟" {
+		t.Errorf("unexpected prompt option %+v", option)
+	}
+
+	params := &UpdateGuildOnboardingParams{
+		Prompts: []*OnboardingPrompt{{
+			Title:   "games",
+			Options: []*OnboardingPromptOption{{Title: "chess", RoleIDs: []Snowflake{6}, EmojiName: "♟"}},
+		}},
+		DefaultChannelIDs: []Snowflake{2},
+		Enabled:           true,
+	}
+	if _, err = client.Guild(1).UpdateOnboarding(params); err != nil {
+		t.Fatal(err)
+	}
+	prompts, ok := body["prompts"].([]interface{})
+	if !ok || len(prompts) != 1 || body["enabled"] != true || body["mode"] != float64(OnboardingModeDefault) {
+		t.Fatalf("unexpected body %+v", body)
+	}
+	options := prompts[0].(map[string]interface{})["options"].([]interface{})
+	option := options[0].(map[string]interface{})
+	if option["emoji_name"] != "♟" || option["role_ids"].([]interface{})[0] != "6" {
+		t.Errorf("unexpected prompt option %+v", option)
+	}
+
+	expected := []string{
+		"GET /guilds/1/onboarding",
+		"PUT /guilds/1/onboarding",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	params.Prompts[0].Options[0].RoleIDs = nil
+	if _, err = client.Guild(1).UpdateOnboarding(params); err == nil {
+		t.Error("expected an error when an option assigns no channels or roles")
+	}
+}
@@ -0,0 +1,129 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PermissionTimedOut holds the permissions a timed out member keeps in every channel.
+const PermissionTimedOut = PermissionReadMessages | PermissionReadMessageHistory
+
+// ComputeBasePermissions calculates the guild wide permissions of a member, before any channel
+// overwrites are applied. The guild owner and members with the administrator permission are
+// given every permission.
+// https://discord.com/developers/docs/topics/permissions#permission-overwrites
+func ComputeBasePermissions(guild *Guild, member *Member) PermissionBit {
+	if guild.OwnerID == member.UserID {
+		return PermissionAll
+	}
+
+	var permissions PermissionBit
+	for _, role := range guild.Roles {
+		if role.ID == guild.ID {
+			// @everyone
+			permissions |= role.Permissions
+			break
+		}
+	}
+	for _, roleID := range member.Roles {
+		for _, role := range guild.Roles {
+			if role.ID == roleID {
+				permissions |= role.Permissions
+				break
+			}
+		}
+	}
+
+	if permissions.Contains(PermissionAdministrator) {
+		return PermissionAll
+	}
+	return permissions
+}
+
+// ComputeOverwrites applies the channel permission overwrites to the base permissions of a member.
+// The @everyone overwrite is applied first, followed by the combined role overwrites and finally
+// the member specific overwrite.
+func ComputeOverwrites(base PermissionBit, guildID Snowflake, channel *Channel, member *Member) PermissionBit {
+	if base.Contains(PermissionAdministrator) {
+		return PermissionAll
+	}
+
+	permissions := base
+	var everyone, own *PermissionOverwrite
+	var allow, deny PermissionBit
+	for i := range channel.PermissionOverwrites {
+		overwrite := &channel.PermissionOverwrites[i]
+		switch {
+		case overwrite.Type == PermissionOverwriteRole && overwrite.ID == guildID:
+			everyone = overwrite
+		case overwrite.Type == PermissionOverwriteMember && overwrite.ID == member.UserID:
+			own = overwrite
+		case overwrite.Type == PermissionOverwriteRole:
+			for _, roleID := range member.Roles {
+				if roleID == overwrite.ID {
+					allow |= overwrite.Allow
+					deny |= overwrite.Deny
+					break
+				}
+			}
+		}
+	}
+
+	if everyone != nil {
+		permissions &^= everyone.Deny
+		permissions |= everyone.Allow
+	}
+	permissions &^= deny
+	permissions |= allow
+	if own != nil {
+		permissions &^= own.Deny
+		permissions |= own.Allow
+	}
+	return permissions
+}
+
+// ComputePermissions calculates the effective permissions of a member in the given channel. Timed out
+// members are restricted to PermissionTimedOut, unless they are the guild owner or an administrator.
+func ComputePermissions(guild *Guild, channel *Channel, member *Member) PermissionBit {
+	base := ComputeBasePermissions(guild, member)
+	if base.Contains(PermissionAdministrator) {
+		return PermissionAll
+	}
+
+	permissions := ComputeOverwrites(base, guild.ID, channel, member)
+	if member.CommunicationDisabledUntil.After(time.Now()) {
+		permissions &= PermissionTimedOut
+	}
+	return permissions
+}
+
+// EffectivePermissions looks up the channel, guild and member and computes the effective permissions of
+// the user in the channel. Objects are retrieved from the cache when possible, see ComputePermissions.
+func EffectivePermissions(ctx context.Context, s Session, channelID, userID Snowflake, flags ...RequestOption) (PermissionBit, error) {
+	channel, err := s.Channel(channelID).WithContext(ctx).Get(flags...)
+	if err != nil {
+		return 0, err
+	}
+	if channel.GuildID.IsZero() {
+		return 0, errors.New("permissions can only be computed for guild channels")
+	}
+
+	guild, err := s.Guild(channel.GuildID).WithContext(ctx).Get(flags...)
+	if err != nil {
+		return 0, err
+	}
+	if len(guild.Roles) == 0 {
+		if guild.Roles, err = s.Guild(channel.GuildID).WithContext(ctx).GetRoles(flags...); err != nil {
+			return 0, err
+		}
+	}
+
+	member, err := s.Guild(channel.GuildID).Member(userID).WithContext(ctx).Get(flags...)
+	if err != nil {
+		return 0, err
+	}
+	member.UserID = userID
+
+	return ComputePermissions(guild, channel, member), nil
+}
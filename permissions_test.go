@@ -3,6 +3,7 @@ package disgord
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/Vedza/disgord/json"
 )
@@ -26,7 +27,7 @@ func (p permissionTestingSession) Guild(_ Snowflake) GuildQueryBuilder {
 	return &permissionTestingGuildBuilder{p: &p}
 }
 
-func (p permissionTestingGuildBuilder) GetRoles(_ ...Flag) ([]*Role, error) {
+func (p permissionTestingGuildBuilder) GetRoles(_ ...RequestOption) ([]*Role, error) {
 	if p.p.getFakeRole {
 		return []*Role{fakePermissionsRole}, nil
 	}
@@ -70,3 +71,54 @@ func TestMember_GetPermissions(t *testing.T) {
 		t.Fatal("permissions should be 2048, is", p)
 	}
 }
+
+func TestComputePermissions(t *testing.T) {
+	const (
+		guildID   = Snowflake(100)
+		ownerID   = Snowflake(1)
+		userID    = Snowflake(2)
+		modRole   = Snowflake(200)
+		mutedRole = Snowflake(201)
+		adminRole = Snowflake(202)
+	)
+	guild := &Guild{
+		ID:      guildID,
+		OwnerID: ownerID,
+		Roles: []*Role{
+			{ID: guildID, Permissions: PermissionReadMessages | PermissionSendMessages},
+			{ID: modRole, Permissions: PermissionManageMessages},
+			{ID: mutedRole},
+			{ID: adminRole, Permissions: PermissionAdministrator},
+		},
+	}
+	channel := &Channel{
+		PermissionOverwrites: []PermissionOverwrite{
+			{ID: guildID, Type: PermissionOverwriteRole, Deny: PermissionSendMessages},
+			{ID: modRole, Type: PermissionOverwriteRole, Allow: PermissionSendMessages},
+			{ID: mutedRole, Type: PermissionOverwriteRole, Deny: PermissionSendMessages | PermissionAddReactions},
+			{ID: userID, Type: PermissionOverwriteMember, Deny: PermissionReadMessages},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		member   *Member
+		expected PermissionBit
+	}{
+		{"owner", &Member{UserID: ownerID}, PermissionAll},
+		{"everyone overwrite", &Member{UserID: 3}, PermissionReadMessages},
+		{"role allow wins over role deny", &Member{UserID: 3, Roles: []Snowflake{modRole, mutedRole}}, PermissionReadMessages | PermissionSendMessages | PermissionManageMessages},
+		{"member overwrite", &Member{UserID: userID, Roles: []Snowflake{modRole}}, PermissionSendMessages | PermissionManageMessages},
+		{"administrator", &Member{UserID: userID, Roles: []Snowflake{adminRole}}, PermissionAll},
+		{"timed out", &Member{UserID: 3, Roles: []Snowflake{modRole}, CommunicationDisabledUntil: Time{time.Now().Add(time.Hour)}}, PermissionReadMessages},
+		{"timeout expired", &Member{UserID: 3, Roles: []Snowflake{modRole}, CommunicationDisabledUntil: Time{time.Now().Add(-time.Hour)}}, PermissionReadMessages | PermissionSendMessages | PermissionManageMessages},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if p := ComputePermissions(guild, channel, tc.member); p != tc.expected {
+				t.Errorf("expected permissions %d, got %d", tc.expected, p)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// PollLayoutType https://discord.com/developers/docs/resources/poll#layout-type
+type PollLayoutType uint
+
+const (
+	_ PollLayoutType = iota
+	PollLayoutDefault
+)
+
+const (
+	maxPollAnswers  = 10
+	maxPollDuration = 32 * 24 // hours
+)
+
+// PollMedia is the content of a poll question or answer. Questions only support text.
+// https://discord.com/developers/docs/resources/poll#poll-media-object
+type PollMedia struct {
+	Text  string `json:"text,omitempty"`
+	Emoji *Emoji `json:"emoji,omitempty"`
+}
+
+var _ Copier = (*PollMedia)(nil)
+var _ DeepCopier = (*PollMedia)(nil)
+
+// PollAnswer https://discord.com/developers/docs/resources/poll#poll-answer-object
+type PollAnswer struct {
+	// AnswerID is set by Discord and should not be specified when creating a poll
+	AnswerID  int        `json:"answer_id,omitempty"`
+	PollMedia *PollMedia `json:"poll_media"`
+}
+
+var _ Copier = (*PollAnswer)(nil)
+var _ DeepCopier = (*PollAnswer)(nil)
+
+// PollAnswerCount holds the number of votes for an answer.
+// https://discord.com/developers/docs/resources/poll#poll-results-object-poll-answer-count-object-structure
+type PollAnswerCount struct {
+	ID      int  `json:"id"`
+	Count   int  `json:"count"`
+	MeVoted bool `json:"me_voted"`
+}
+
+var _ Copier = (*PollAnswerCount)(nil)
+var _ DeepCopier = (*PollAnswerCount)(nil)
+
+// PollResults holds the vote counts of a poll. The counts might not be accurate while the poll
+// is in progress, only when IsFinalized is true are they precisely counted.
+// https://discord.com/developers/docs/resources/poll#poll-results-object
+type PollResults struct {
+	IsFinalized  bool               `json:"is_finalized"`
+	AnswerCounts []*PollAnswerCount `json:"answer_counts"`
+}
+
+var _ Copier = (*PollResults)(nil)
+var _ DeepCopier = (*PollResults)(nil)
+
+// Poll https://discord.com/developers/docs/resources/poll#poll-object
+type Poll struct {
+	Question         PollMedia      `json:"question"`
+	Answers          []*PollAnswer  `json:"answers"`
+	Expiry           Time           `json:"expiry"`
+	AllowMultiselect bool           `json:"allow_multiselect"`
+	LayoutType       PollLayoutType `json:"layout_type"`
+
+	// Results is not always present on a message, when missing the results are unknown.
+	Results *PollResults `json:"results,omitempty"`
+}
+
+var _ Copier = (*Poll)(nil)
+var _ DeepCopier = (*Poll)(nil)
+
+// CreatePollParams is the poll request object used when sending a message with a poll.
+// https://discord.com/developers/docs/resources/poll#poll-create-request-object
+type CreatePollParams struct {
+	Question PollMedia     `json:"question"`
+	Answers  []*PollAnswer `json:"answers"`
+
+	// Duration is the number of hours the poll should be open for, up to 32 days. Defaults to 24.
+	Duration         int            `json:"duration,omitempty"`
+	AllowMultiselect bool           `json:"allow_multiselect,omitempty"`
+	LayoutType       PollLayoutType `json:"layout_type,omitempty"`
+}
+
+func (p *CreatePollParams) FindErrors() error {
+	if p.Question.Text == "" {
+		return errors.New("poll question must have a text")
+	}
+	if len(p.Question.Text) > 300 {
+		return errors.New("poll question can not be longer than 300 characters")
+	}
+	if len(p.Answers) == 0 || len(p.Answers) > maxPollAnswers {
+		return fmt.Errorf("a poll must have between 1 and %d answers", maxPollAnswers)
+	}
+	for i := range p.Answers {
+		if p.Answers[i] == nil || p.Answers[i].PollMedia == nil {
+			return errors.New("poll answer is missing poll media")
+		}
+		if len(p.Answers[i].PollMedia.Text) > 55 {
+			return errors.New("poll answer can not be longer than 55 characters")
+		}
+	}
+	if p.Duration < 0 || p.Duration > maxPollDuration {
+		return fmt.Errorf("poll duration must be between 1 and %d hours", maxPollDuration)
+	}
+	return nil
+}
+
+// GetPollAnswerVotersParams https://discord.com/developers/docs/resources/poll#get-answer-voters-query-string-params
+type GetPollAnswerVotersParams struct {
+	After Snowflake `urlparam:"after,omitempty"` // get users after this user ID
+	Limit int       `urlparam:"limit,omitempty"` // max number of users to return (1-100), defaults to 25
+}
+
+var _ URLQueryStringer = (*GetPollAnswerVotersParams)(nil)
+
+type pollAnswerVotersResponse struct {
+	Users []*User `json:"users"`
+}
+
+// GetPollAnswerVoters [REST] Get a list of users that voted for this specific answer.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/polls/{message.id}/answers/{answer_id}
+//  Discord documentation   https://discord.com/developers/docs/resources/poll#get-answer-voters
+//  Reviewed                2024-04-18
+//  Comment                 -
+func (m messageQueryBuilder) GetPollAnswerVoters(answerID int, params *GetPollAnswerVotersParams, flags ...RequestOption) ([]*User, error) {
+	if m.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if m.mid.IsZero() {
+		return nil, errors.New("messageID must be set to target the specific channel message")
+	}
+
+	query := ""
+	if params != nil {
+		if params.Limit < 0 || params.Limit > 100 {
+			return nil, errors.New("limit must be between 1 and 100")
+		}
+		query += params.URLQueryString()
+	}
+
+	r := m.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ChannelPollAnswer(m.cid, m.mid, answerID) + query,
+		Ctx:      m.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &pollAnswerVotersResponse{}
+	}
+
+	v, err := r.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return v.(*pollAnswerVotersResponse).Users, nil
+}
+
+// EndPoll [REST] Immediately ends the poll. You cannot end polls from other users.
+// Returns the message object and fires a Message Update Gateway event.
+//  Method                  POST
+//  Endpoint                /channels/{channel.id}/polls/{message.id}/expire
+//  Discord documentation   https://discord.com/developers/docs/resources/poll#end-poll
+//  Reviewed                2024-04-18
+//  Comment                 -
+func (m messageQueryBuilder) EndPoll(flags ...RequestOption) (*Message, error) {
+	if m.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if m.mid.IsZero() {
+		return nil, errors.New("messageID must be set to target the specific channel message")
+	}
+
+	r := m.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodPost,
+		Endpoint: endpoint.ChannelPollExpire(m.cid, m.mid),
+		Ctx:      m.ctx,
+	}, flags)
+	r.pool = m.client.pool.message
+	r.factory = func() interface{} {
+		return &Message{}
+	}
+
+	return getMessage(r.Execute)
+}
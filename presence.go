@@ -0,0 +1,92 @@
+package disgord
+
+import "time"
+
+// PresenceBuilder builds a presence update for the bot, to be used with Client.UpdateStatus or
+// Config.Presence. The last presence update is sent again whenever a shard resumes its session.
+//  presence := disgord.NewPresence().
+//      Status(disgord.StatusIdle).
+//      Watching("over 42 servers").
+//      Build()
+//
+//  err := client.UpdateStatus(presence)
+type PresenceBuilder struct {
+	status     string
+	afk        bool
+	since      *uint
+	activities []*Activity
+}
+
+// NewPresence creates a presence builder with the status online and no activities.
+func NewPresence() *PresenceBuilder {
+	return &PresenceBuilder{status: StatusOnline}
+}
+
+// Status sets the status, see StatusOnline, StatusDND, StatusIdle and StatusInvisible.
+func (p *PresenceBuilder) Status(status string) *PresenceBuilder {
+	p.status = status
+	return p
+}
+
+// AFK marks the bot as away from keyboard.
+func (p *PresenceBuilder) AFK(afk bool) *PresenceBuilder {
+	p.afk = afk
+	return p
+}
+
+// IdleSince sets the status to idle, since the given time.
+func (p *PresenceBuilder) IdleSince(t time.Time) *PresenceBuilder {
+	since := uint(t.UnixNano() / int64(time.Millisecond))
+	p.since = &since
+	p.status = StatusIdle
+	return p
+}
+
+// Activity adds an activity. Use the other activity methods for the common cases.
+func (p *PresenceBuilder) Activity(activity *Activity) *PresenceBuilder {
+	p.activities = append(p.activities, activity)
+	return p
+}
+
+// Playing adds a "Playing {name}" activity.
+func (p *PresenceBuilder) Playing(name string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: name, Type: ActivityTypeGame})
+}
+
+// Streaming adds a "Streaming {name}" activity. Discord only accepts Twitch and YouTube urls.
+func (p *PresenceBuilder) Streaming(name, url string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: name, Type: ActivityTypeStreaming, URL: url})
+}
+
+// ListeningTo adds a "Listening to {name}" activity.
+func (p *PresenceBuilder) ListeningTo(name string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: name, Type: ActivityTypeListening})
+}
+
+// Watching adds a "Watching {name}" activity.
+func (p *PresenceBuilder) Watching(name string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: name, Type: ActivityTypeWatching})
+}
+
+// Competing adds a "Competing in {name}" activity.
+func (p *PresenceBuilder) Competing(name string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: name, Type: ActivityTypeCompeting})
+}
+
+// Custom adds a custom status, where the state is the text shown.
+func (p *PresenceBuilder) Custom(state string) *PresenceBuilder {
+	return p.Activity(&Activity{Name: "Custom Status", Type: ActivityTypeCustom, State: state})
+}
+
+// Build creates the presence update payload.
+func (p *PresenceBuilder) Build() *UpdateStatusPayload {
+	activities := make([]*Activity, len(p.activities))
+	copy(activities, p.activities)
+
+	return &UpdateStatusPayload{
+		Since:  p.since,
+		Game:   activities,
+		Status: p.status,
+		AFK:    p.afk,
+	}
+}
@@ -0,0 +1,43 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestPresenceBuilder(t *testing.T) {
+	since := time.Unix(1600000000, 0)
+	payload := NewPresence().
+		IdleSince(since).
+		Streaming("disgord", "https://twitch.tv/disgord").
+		Custom("building things").
+		AFK(true).
+		Build()
+
+	if payload.Status != StatusIdle {
+		t.Errorf("expected status idle, got %s", payload.Status)
+	}
+	if payload.Since == nil || *payload.Since != 1600000000000 {
+		t.Error("expected since to be set in milliseconds")
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"since":1600000000000,"activities":[` +
+		`{"name":"disgord","type":1,"url":"https://twitch.tv/disgord","created_at":0},` +
+		`{"name":"Custom Status","type":4,"created_at":0,"state":"building things"}],` +
+		`"status":"idle","afk":true}`
+	if string(data) != expected {
+		t.Errorf("unexpected payload.\nGot  %s\nWant %s", data, expected)
+	}
+
+	if payload := NewPresence().Watching("you").Build(); payload.Status != StatusOnline {
+		t.Errorf("expected the default status to be online, got %s", payload.Status)
+	}
+}
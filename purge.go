@@ -0,0 +1,103 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// bulkDeleteMaxAge is how old a message can be to be deleted using the bulk delete endpoint. A minute is
+// subtracted to not race against messages turning two weeks old during the purge.
+const bulkDeleteMaxAge = 14*24*time.Hour - time.Minute
+
+// PurgeMessagesParams decides which messages are deleted by ChannelQueryBuilder.PurgeMessages.
+type PurgeMessagesParams struct {
+	// Filter returns true for messages that should be deleted. Nil deletes every message.
+	Filter func(msg *Message) bool
+
+	// Before and After limits the messages to those sent before, or after, a message. Only one can be set.
+	Before Snowflake
+	After  Snowflake
+
+	// Limit is the maximum number of messages to look at, including those not accepted by the filter.
+	// 0 looks at the entire channel history.
+	Limit uint
+
+	// SkipOld skips messages older than 14 days. Those can not be bulk deleted, and are otherwise
+	// deleted one by one.
+	SkipOld bool
+}
+
+// PurgeMessages deletes the messages of the channel accepted by the filter, and returns the number of
+// deleted messages. Messages are bulk deleted in chunks of 100, while messages older than 14 days are
+// deleted individually, unless PurgeMessagesParams.SkipOld is set. Requires the 'MANAGE_MESSAGES' permission.
+// The rate limits of each endpoint are respected, so purging old messages can take a while.
+//  // delete the last 500 messages of a user
+//  deleted, err := client.Channel(channelID).PurgeMessages(ctx, &disgord.PurgeMessagesParams{
+//      Limit:  500,
+//      Filter: func(msg *disgord.Message) bool { return msg.Author.ID == userID },
+//  }, disgord.WithReason("spam"))
+func (c channelQueryBuilder) PurgeMessages(ctx context.Context, params *PurgeMessagesParams, flags ...RequestOption) (deleted int, err error) {
+	if c.cid.IsZero() {
+		return 0, errors.New("channelID must be set to purge messages")
+	}
+	p := PurgeMessagesParams{}
+	if params != nil {
+		p = *params
+	}
+	if !p.Before.IsZero() && !p.After.IsZero() {
+		return 0, errors.New("before and after are mutually exclusive")
+	}
+
+	oldest := SnowflakeFromTime(time.Now().Add(-bulkDeleteMaxAge))
+	var bulk, old []Snowflake
+
+	c.ctx = ctx
+	flush := func() error {
+		switch len(bulk) {
+		case 0:
+		case 1:
+			// bulk delete requires at least two messages
+			old = append(old, bulk[0])
+		default:
+			if err := c.DeleteMessages(&DeleteMessagesParams{Messages: bulk}, flags...); err != nil {
+				return err
+			}
+			deleted += len(bulk)
+		}
+		bulk = nil
+
+		for len(old) > 0 {
+			if err := c.Message(old[0]).WithContext(ctx).Delete(flags...); err != nil {
+				return err
+			}
+			old = old[1:]
+			deleted++
+		}
+		return nil
+	}
+
+	it := c.IterateMessages(&GetMessagesParams{Before: p.Before, After: p.After, Limit: p.Limit}, flags...)
+	for it.Next(ctx) {
+		msg := it.Value()
+		if p.Filter != nil && !p.Filter(msg) {
+			continue
+		}
+
+		if msg.ID >= oldest {
+			bulk = append(bulk, msg.ID)
+		} else if !p.SkipOld {
+			old = append(old, msg.ID)
+		}
+		if len(bulk) == 100 || len(old) == 100 {
+			if err = flush(); err != nil {
+				return deleted, err
+			}
+		}
+	}
+	if err = it.Err(); err != nil {
+		return deleted, err
+	}
+	err = flush()
+	return deleted, err
+}
@@ -0,0 +1,85 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChannelQueryBuilder_PurgeMessages(t *testing.T) {
+	now := time.Now()
+	var ids []Snowflake
+	for i := 1; i <= 205; i++ {
+		ids = append(ids, SnowflakeFromTime(now.Add(-time.Duration(i)*time.Minute)))
+	}
+	for i := 1; i <= 3; i++ {
+		ids = append(ids, SnowflakeFromTime(now.Add(-20*24*time.Hour-time.Duration(i)*time.Minute)))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+
+	var bulkDeletes, deletes, bulkDeleted int
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			switch {
+			case req.Method == http.MethodPost:
+				bulkDeletes++
+				body, _ := ioutil.ReadAll(req.Body)
+				bulkDeleted += strings.Count(string(body), ",") + 1
+			case req.Method == http.MethodDelete:
+				deletes++
+			default:
+				query := req.URL.Query()
+				before, _ := strconv.ParseUint(query.Get("before"), 10, 64)
+				limit, _ := strconv.Atoi(query.Get("limit"))
+
+				var msgs []string
+				for _, id := range ids {
+					if (before == 0 || uint64(id) < before) && len(msgs) < limit {
+						msgs = append(msgs, `{"id":"`+id.String()+`","channel_id":"1"}`)
+					}
+				}
+				return jsonResponse(req, "["+strings.Join(msgs, ",")+"]"), nil
+			}
+			resp := jsonResponse(req, "")
+			resp.StatusCode = http.StatusNoContent
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newest := ids[0]
+	deleted, err := client.Channel(1).PurgeMessages(context.Background(), &PurgeMessagesParams{
+		Filter: func(msg *Message) bool { return msg.ID != newest },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 207 {
+		t.Errorf("expected 207 deleted messages. Got %d", deleted)
+	}
+	if bulkDeletes != 3 || bulkDeleted != 204 {
+		t.Errorf("expected 204 messages in 3 bulk deletes. Got %d in %d", bulkDeleted, bulkDeletes)
+	}
+	if deletes != 3 {
+		t.Errorf("expected the old messages to be deleted individually. Got %d deletes", deletes)
+	}
+
+	bulkDeletes, deletes, bulkDeleted = 0, 0, 0
+	deleted, err = client.Channel(1).PurgeMessages(context.Background(), &PurgeMessagesParams{SkipOld: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 205 || deletes != 0 {
+		t.Errorf("expected old messages to be skipped. Got %d deleted and %d individual deletes", deleted, deletes)
+	}
+}
@@ -20,21 +20,27 @@ var _ Reseter = (*Reaction)(nil)
 var _ Copier = (*Reaction)(nil)
 var _ DeepCopier = (*Reaction)(nil)
 
+// emojiReference returns the escaped emoji identifier used by the reaction endpoints
 func emojiReference(i interface{}) (string, error) {
-	var emojiCode string
-	if e, ok := i.(*Emoji); ok {
-		if e.ID.IsZero() {
-			emojiCode = e.Name
-		} else {
-			emojiCode = e.Name + ":" + e.ID.String()
+	var id EmojiIdentifier
+	switch e := i.(type) {
+	case EmojiIdentifier:
+		id = e
+	case *Emoji:
+		id = e.Identifier()
+	case string:
+		emoji, err := ParseEmoji(e)
+		if err != nil {
+			return "", err
 		}
-	} else if _, ok := i.(string); ok {
-		emojiCode = i.(string) // unicode
-		emojiCode = unwrapEmoji(emojiCode)
-	} else {
-		return "", errors.New("emoji type can only be a unicode string or a *Emoji struct")
+		id = emoji.Identifier()
+	default:
+		return "", errors.New("emoji type can only be a unicode string, EmojiIdentifier or a *Emoji struct")
 	}
-	return emojiCode, nil
+	if id == "" {
+		return "", errors.New("emoji identifier is empty")
+	}
+	return id.Escaped(), nil
 }
 
 func unwrapEmoji(e string) string {
@@ -53,18 +59,21 @@ type ReactionQueryBuilder interface {
 	// permission to be present on the current user. Additionally, if nobody else has reacted to the message using this
 	// emoji, this endpoint requires the 'ADD_REACTIONS' permission to be present on the current user. Returns a 204
 	// empty response on success. The maximum request size when sending a message is 8MB.
-	Create(flags ...Flag) (err error)
+	Create(flags ...RequestOption) (err error)
 
 	// GetReaction Get a list of Users that reacted with this emoji. Returns an array of user objects on success.
-	Get(params URLQueryStringer, flags ...Flag) (reactors []*User, err error)
+	Get(params URLQueryStringer, flags ...RequestOption) (reactors []*User, err error)
+
+	// Iterate iterates over the users that reacted with the emoji, fetching pages as needed.
+	Iterate(params *GetReactionURLParams, flags ...RequestOption) *UserIterator
 
 	// DeleteOwnReaction Delete a reaction the current user has made for the message.
 	// Returns a 204 empty response on success.
-	DeleteOwn(flags ...Flag) (err error)
+	DeleteOwn(flags ...RequestOption) (err error)
 
 	// DeleteUserReaction Deletes another user's reaction. This endpoint requires the 'MANAGE_MESSAGES' permission
 	// to be present on the current user. Returns a 204 empty response on success.
-	DeleteUser(userID Snowflake, flags ...Flag) (err error)
+	DeleteUser(userID Snowflake, flags ...RequestOption) (err error)
 }
 
 func (m messageQueryBuilder) Reaction(emoji interface{}) ReactionQueryBuilder {
@@ -92,8 +101,8 @@ func (r reactionQueryBuilder) WithContext(ctx context.Context) ReactionQueryBuil
 //  Endpoint                /channels/{channel.id}/messages/{message.id}/reactions/{emoji}/@me
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#create-reaction
 //  Reviewed                2019-01-30
-//  Comment                 emoji either unicode (string) or *Emoji with an snowflake Snowflake if it's custom
-func (r reactionQueryBuilder) Create(flags ...Flag) error {
+//  Comment                 emoji is either a EmojiIdentifier, a string accepted by ParseEmoji, or a *Emoji
+func (r reactionQueryBuilder) Create(flags ...RequestOption) error {
 	if r.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -125,8 +134,8 @@ func (r reactionQueryBuilder) Create(flags ...Flag) error {
 //  Endpoint                /channels/{channel.id}/messages/{message.id}/reactions/{emoji}/@me
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-own-reaction
 //  Reviewed                2019-01-28
-//  Comment                 emoji either unicode (string) or *Emoji with an snowflake Snowflake if it's custom
-func (r reactionQueryBuilder) DeleteOwn(flags ...Flag) error {
+//  Comment                 emoji is either a EmojiIdentifier, a string accepted by ParseEmoji, or a *Emoji
+func (r reactionQueryBuilder) DeleteOwn(flags ...RequestOption) error {
 	if r.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -158,8 +167,8 @@ func (r reactionQueryBuilder) DeleteOwn(flags ...Flag) error {
 //  Endpoint                /channels/{channel.id}/messages/{message.id}/reactions/{emoji}/@me
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#delete-user-reaction
 //  Reviewed                2019-01-28
-//  Comment                 emoji either unicode (string) or *Emoji with an snowflake Snowflake if it's custom
-func (r reactionQueryBuilder) DeleteUser(userID Snowflake, flags ...Flag) error {
+//  Comment                 emoji is either a EmojiIdentifier, a string accepted by ParseEmoji, or a *Emoji
+func (r reactionQueryBuilder) DeleteUser(userID Snowflake, flags ...RequestOption) error {
 	if r.cid.IsZero() {
 		return errors.New("channelID must be set to target the correct channel")
 	}
@@ -202,8 +211,8 @@ var _ URLQueryStringer = (*GetReactionURLParams)(nil)
 //  Endpoint                /channels/{channel.id}/messages/{message.id}/reactions/{emoji}
 //  Discord documentation   https://discord.com/developers/docs/resources/channel#get-reactions
 //  Reviewed                2019-01-28
-//  Comment                 emoji either unicode (string) or *Emoji with an snowflake Snowflake if it's custom
-func (r reactionQueryBuilder) Get(params URLQueryStringer, flags ...Flag) (ret []*User, err error) {
+//  Comment                 emoji is either a EmojiIdentifier, a string accepted by ParseEmoji, or a *Emoji
+func (r reactionQueryBuilder) Get(params URLQueryStringer, flags ...RequestOption) (ret []*User, err error) {
 	if r.cid.IsZero() {
 		return nil, errors.New("channelID must be set to target the correct channel")
 	}
@@ -1,12 +1,18 @@
 package disgord
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"runtime/debug"
 	"sync"
 	"time"
 
+	"go.uber.org/atomic"
+
 	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/internal/logger"
+	"github.com/Vedza/disgord/json"
 )
 
 //////////////////////////////////////////////////////
@@ -36,38 +42,72 @@ func (c *Client) demultiplexer(d *dispatcher, read <-chan *gateway.Event) {
 		case <-d.shutdown:
 			return
 		}
+		if c.forwarder != nil {
+			c.forwarder.forward(evt)
+		}
+		c.demultiplex(d, evt)
+	}
+}
 
-		// var resource evtResource
-		// if resource = defineResource(evt.Name); resource == nil {
-		// 	fmt.Printf("------\nTODO\nImplement event handler for `%s`, data: \n%+v\n------\n\n", evt.Name, string(evt.Data))
-		// 	continue // move on to next event
-		// }
+// demultiplex updates the cache and schedules the event for dispatching.
+func (c *Client) demultiplex(d *dispatcher, evt *gateway.Event) {
+	d.pending.Inc() // decremented once dispatched, or when the event is skipped
+	if !knownEvents[evt.Name] {
+		c.unknownEvent(d, evt)
+		return
+	}
 
-		resourceI, err := cacheDispatcher(c.cache, evt.Name, evt.Data)
-		if resourceI == nil {
-			err = fmt.Errorf("cache did not instantiate object. Prev error: %w", err)
-		}
-		if err != nil {
-			// skip unknown events
-			var knownEvent bool
-			evts := AllEvents()
-			for _, e := range evts {
-				if knownEvent = e == evt.Name; knownEvent {
-					break
-				}
-			}
-			if !knownEvent {
-				continue
-			}
+	d.triggerRaw(evt.Name, evt.Data)
 
-			err = fmt.Errorf("demultiplexer{%s}: %w, data '%s'", evt.Name, err, string(evt.Data))
-			d.session.Logger().Error(err)
-			continue
+	// var resource evtResource
+	// if resource = defineResource(evt.Name); resource == nil {
+	// 	fmt.Printf("------\nTODO\nImplement event handler for `%s`, data: \n%+v\n------\n\n", evt.Name, string(evt.Data))
+	// 	return // move on to next event
+	// }
+
+	var guildID Snowflake
+	if d.hasGuildScope(evt.Name) {
+		guildID = payloadGuildID(evt.Name, evt.Data)
+
+		// without a cache to update, there is no need to unmarshal events no handler wants
+		if _, nop := c.cache.(*CacheNop); nop && !d.wantsGuild(evt.Name, guildID) {
+			d.pending.Dec()
+			return
 		}
-		resource := resourceI.(evtResource)
-		resource.setShardID(evt.ShardID)
+	}
+
+	resourceI, err := cacheDispatcher(c.cache, evt.Name, evt.Data)
+	if resourceI == nil {
+		err = fmt.Errorf("cache did not instantiate object. Prev error: %w", err)
+	}
+	if err != nil {
+		d.pending.Dec()
+		err = fmt.Errorf("demultiplexer{%s}: %w, data '%s'", evt.Name, err, string(evt.Data))
+		logger.With(c.subsystemLog(LogCache), logger.Field{Key: "event", Value: evt.Name}).Error(err)
+		return
+	}
+	if c.unknownFields != nil {
+		c.unknownFields.check(evt.Name, evt.Data, resourceI)
+	}
+	resource := resourceI.(evtResource)
+	resource.setShardID(evt.ShardID)
+
+	d.schedule(evt.Name, guildID, resource)
+}
+
+// payloadGuildID extracts the guild id of an event payload without unmarshalling the whole event.
+func payloadGuildID(evtName string, data []byte) Snowflake {
+	var payload struct {
+		ID      Snowflake `json:"id"`
+		GuildID Snowflake `json:"guild_id"`
+	}
+	_ = json.Unmarshal(data, &payload)
 
-		go d.dispatch(evt.Name, resource)
+	switch evtName {
+	case EvtGuildCreate, EvtGuildUpdate, EvtGuildDelete:
+		return payload.ID
+	default:
+		return payload.GuildID
 	}
 }
 
@@ -85,6 +125,33 @@ type dispatcher struct {
 	// an event can have one or more handlers
 	handlerSpecs map[string][]*handlerSpec
 
+	// raw handlers are triggered for every event
+	rawHandlers []HandlerRaw
+
+	// unknownEventHandlers are triggered by events unknown to disgord, see Config.UnknownEvents
+	unknownEventHandlers []HandlerUnknownEvent
+	unknownEvents        UnknownEventPolicy
+
+	// middlewares wrap the dispatch of every event
+	middlewares []DispatchMiddleware
+
+	// pools of workers for dispatching specific event types, see Config.DispatchPools
+	pools     map[string]*dispatchPool
+	poolsOnce sync.Once
+
+	// onHandlerError is called when a handler panics, see Config.OnHandlerError
+	onHandlerError HandlerErrorFunc
+
+	// events recycles dispatched events, see Config.PoolEvents
+	events *eventPools
+
+	// pending counts the events received by the demultiplexer that have not been dispatched yet, see
+	// Client.Shutdown
+	pending atomic.Int64
+
+	// suspension holds back events while dispatching is suspended, see Client.Suspend
+	suspension suspension
+
 	// use session to allow mocking the Client instance later on
 	session  Session
 	shutdown chan struct{}
@@ -99,8 +166,17 @@ func (d *dispatcher) addSessionInstance(s Session) {
 // deregistration is done automatically by checking the controller spec after each dispatch.
 // See HandlerCtrl.
 func (d *dispatcher) register(evt string, inputs ...interface{}) error {
+	return d.registerSpec(evt, false, inputs...)
+}
+
+// registerInternal registers handlers used by disgord itself, see handlerSpec.internal.
+func (d *dispatcher) registerInternal(evt string, inputs ...interface{}) error {
+	return d.registerSpec(evt, true, inputs...)
+}
+
+func (d *dispatcher) registerSpec(evt string, internal bool, inputs ...interface{}) error {
 	// detect middleware then handlers. Ordering is important.
-	spec := &handlerSpec{}
+	spec := &handlerSpec{internal: internal}
 	if err := spec.populate(inputs...); err != nil { // TODO: improve redundant checking
 		return err // if the pattern is wrong: (event,[ ...middlewares,] ...handlers[, controller])
 		// if you want to error check before you use the .On, you can use disgord.ValidateHandlerInputs(...)
@@ -117,14 +193,135 @@ func (d *dispatcher) register(evt string, inputs ...interface{}) error {
 	d.handlerSpecs[evt] = append(d.handlerSpecs[evt], spec)
 	d.Unlock()
 
+	if ctrl, ok := spec.ctrl.(*Ctrl); ok && ctrl.Context != nil {
+		go d.deregisterOnDone(evt, ctrl, spec)
+	}
+
 	return nil
 }
 
-func (d *dispatcher) dispatch(evtName string, evt resource) {
-	// handlers
+// deregisterOnDone removes the handlers of a controller once its context is done, and closes the
+// channel of the controller.
+func (d *dispatcher) deregisterOnDone(evtName string, ctrl *Ctrl, spec *handlerSpec) {
+	select {
+	case <-ctrl.Context.Done():
+	case <-d.shutdown:
+		return
+	}
+
+	// the handlers might already have been removed by the dispatcher, when the controller died
+	d.deregister(evtName, ctrl)
+	if ctrl.Channel == nil {
+		return
+	}
+
+	// wait for handlers that are still running, before closing the channel
+	spec.Lock()
+	closeChannel(ctrl.Channel)
+	spec.Unlock()
+}
+
+// hasGuildScope reports whether any handler of the event is limited to specific guilds.
+func (d *dispatcher) hasGuildScope(evtName string) bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	for _, spec := range d.handlerSpecs[evtName] {
+		if spec.guilds != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// wantsGuild reports whether any handler of the event accepts events from the given guild.
+func (d *dispatcher) wantsGuild(evtName string, guildID Snowflake) bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	for _, spec := range d.handlerSpecs[evtName] {
+		if spec.inScope(guildID) {
+			return true
+		}
+	}
+	return false
+}
+
+// registeredEvents returns the names of the events that have at least one handler, ignoring the handlers
+// registered by disgord itself.
+func (d *dispatcher) registeredEvents() []string {
+	d.RLock()
+	defer d.RUnlock()
+
+	events := make([]string, 0, len(d.handlerSpecs))
+	for evtName, specs := range d.handlerSpecs {
+		for _, spec := range specs {
+			if !spec.internal {
+				events = append(events, evtName)
+				break
+			}
+		}
+	}
+	return events
+}
+
+func (d *dispatcher) addRawHandler(handler HandlerRaw) {
+	d.Lock()
+	d.rawHandlers = append(d.rawHandlers, handler)
+	d.Unlock()
+}
+
+func (d *dispatcher) triggerRaw(evtName string, payload json.RawMessage) {
+	d.RLock()
+	handlers := d.rawHandlers
+	d.RUnlock()
+
+	for _, handler := range handlers {
+		handler(evtName, payload)
+	}
+}
+
+// use adds dispatch middlewares to the end of the chain, such that they are called after the existing ones.
+func (d *dispatcher) use(middlewares ...DispatchMiddleware) {
+	d.Lock()
+	d.middlewares = append(d.middlewares, middlewares...)
+	d.Unlock()
+}
+
+// dispatch triggers the handlers of an event. The guild id of the payload is used by guild scoped
+// handlers, and is 0 for events that do not belong to a guild.
+func (d *dispatcher) dispatch(evtName string, guildID Snowflake, evt resource) {
+	defer d.pending.Dec()
+
+	// a panicking middleware must not take down the go routine either
+	defer d.recoverHandler(evtName)
+
+	// the handlers are decided before dispatching, as one-shot handlers are removed once they have run,
+	// while they may still be using the event
 	d.RLock()
+	middlewares := d.middlewares
 	specs := d.handlerSpecs[evtName]
 	d.RUnlock()
+	pooled := d.poolable(specs)
+
+	// the first middleware is the outermost
+	chain := func(_ Session, evtName string, evt interface{}) {
+		if unknown, ok := evt.(*UnknownEvent); ok {
+			d.dispatchUnknownEvent(unknown)
+			return
+		}
+		d.dispatchToHandlers(evtName, guildID, evt, specs)
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		chain = middlewares[i](chain)
+	}
+	chain(d.session, evtName, evt)
+	if pooled {
+		d.events.put(evt)
+	}
+}
+
+func (d *dispatcher) dispatchToHandlers(evtName string, guildID Snowflake, evt interface{}, specs []*handlerSpec) {
 
 	dead := make([]*handlerSpec, 0)
 
@@ -136,25 +333,12 @@ func (d *dispatcher) dispatch(evtName string, evt resource) {
 		//	dead = append(dead, spec)
 		//	continue
 		//}
-		spec.Lock()
-		if dead := spec.ctrl.IsDead(); !dead {
-			localEvt := spec.runMdlws(evt)
-			if localEvt == nil {
-				spec.Unlock()
-				continue
-			}
-
-			for _, handler := range spec.handlers {
-				d.trigger(handler, localEvt)
-			}
-
-			spec.ctrl.Update()
+		if !spec.inScope(guildID) {
+			continue
 		}
-
-		if spec.ctrl.IsDead() {
+		if d.runSpec(evtName, spec, evt) {
 			dead = append(dead, spec)
 		}
-		spec.Unlock()
 	}
 
 	// time to remove the dead
@@ -192,6 +376,89 @@ func (d *dispatcher) dispatch(evtName string, evt resource) {
 	}(dead)
 }
 
+// deregister removes the handler specifications with the given controller, without waiting for
+// the next event to discover that the controller is dead.
+func (d *dispatcher) deregister(evtName string, ctrl HandlerCtrl) (removed []*handlerSpec) {
+	d.Lock()
+	specs := d.handlerSpecs[evtName]
+	for i := 0; i < len(specs); i++ {
+		if specs[i].ctrl == ctrl {
+			removed = append(removed, specs[i])
+			specs = append(specs[:i:i], specs[i+1:]...)
+			i--
+		}
+	}
+	d.handlerSpecs[evtName] = specs
+	d.Unlock()
+
+	for i := range removed {
+		if err := removed[i].ctrl.OnRemove(d.session); err != nil {
+			d.session.Logger().Error(err)
+		}
+	}
+	return removed
+}
+
+// withStreamCtrl ties the handlers to the context, and closes the stream channel once they are removed.
+func (shr *socketHandlerRegister) withStreamCtrl(ctx context.Context, channel interface{}) {
+	if shr.ctrl != nil {
+		panic("a controller can not be used together with a stream, use the context instead")
+	}
+	shr.ctrl = &Ctrl{Context: ctx, Channel: channel}
+
+	// the events are forwarded to the stream channel, and are owned by the receiver
+	shr.retains = true
+}
+
+// runSpec runs the middlewares and handlers of a handler specification, and reports whether the
+// specification has died. The lock is released even if a handler panics.
+func (d *dispatcher) runSpec(evtName string, spec *handlerSpec, evt resource) (dead bool) {
+	spec.Lock()
+	defer spec.Unlock()
+
+	if !spec.ctrl.IsDead() {
+		localEvt := spec.runMdlws(evt)
+		if localEvt == nil {
+			return false
+		}
+
+		for _, handler := range spec.handlers {
+			d.safeTrigger(evtName, handler, localEvt)
+		}
+
+		spec.ctrl.Update()
+	}
+
+	return spec.ctrl.IsDead()
+}
+
+// safeTrigger runs a handler and recovers from panics, such that the remaining handlers still run.
+func (d *dispatcher) safeTrigger(evtName string, handler Handler, evt resource) {
+	defer d.recoverHandler(evtName)
+	d.trigger(handler, evt)
+}
+
+// recoverHandler must be deferred. A panic is reported to Config.OnHandlerError, or logged if not set.
+func (d *dispatcher) recoverHandler(evtName string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	err, ok := r.(error)
+	if !ok {
+		err = fmt.Errorf("%v", r)
+	}
+	err = fmt.Errorf("handler panicked: %w", err)
+	stack := debug.Stack()
+
+	if d.onHandlerError != nil {
+		d.onHandlerError(evtName, err, stack)
+	} else if d.session != nil {
+		logger.With(d.session.Logger(), logger.Field{Key: "event", Value: evtName}).Error(err, string(stack))
+	}
+}
+
 //////////////////////////////////////////////////////
 //
 // Handler logic
@@ -215,6 +482,28 @@ type HandlerCtrl interface {
 // Handler needs to match one of the *Handler signatures
 type Handler = interface{}
 
+// HandlerErrorFunc receives the errors of handlers for the given event, see Config.OnHandlerError.
+type HandlerErrorFunc = func(evtName string, err error, stack []byte)
+
+// DispatchFunc dispatches an event to the registered handlers.
+type DispatchFunc = func(s Session, evtName string, evt interface{})
+
+// DispatchMiddleware wraps the dispatch of every event, see GatewayQueryBuilder.Use. A middleware can
+// stop the propagation by not calling next, or mutate the event by giving next a different event
+// of the same type.
+//  func guildOnly(next disgord.DispatchFunc) disgord.DispatchFunc {
+//      return func(s disgord.Session, evtName string, evt interface{}) {
+//          if msg, ok := evt.(*disgord.MessageCreate); ok && msg.Message.GuildID.IsZero() {
+//              return // ignore direct messages
+//          }
+//          next(s, evtName, evt)
+//      }
+//  }
+type DispatchMiddleware = func(next DispatchFunc) DispatchFunc
+
+// HandlerRaw receives the event name and the unparsed payload of a dispatched event. See GatewayQueryBuilder.OnRaw.
+type HandlerRaw = func(evtName string, payload json.RawMessage)
+
 // Middleware allows you to manipulate data during the "stream"
 type Middleware = func(interface{}) interface{}
 
@@ -229,6 +518,29 @@ type handlerSpec struct {
 	middlewares []Middleware
 	handlers    []Handler
 	ctrl        HandlerCtrl
+
+	// guilds limits the handlers to events from the given guilds, nil means every event
+	guilds map[Snowflake]bool
+
+	// retains is true when the handlers keep the event after they return, such that it must not be pooled.
+	// This is the case for channel handlers, and handlers with a controller, such as Once and the await
+	// helpers, which hand the event over to the caller.
+	retains bool
+
+	// internal is true for handlers registered by disgord itself, which do not decide the intents
+	internal bool
+}
+
+// retainEvents marks a handler specification whose handlers keep the events, such as streams, such that the
+// events are never pooled.
+type retainEvents struct{}
+
+// guildScope limits a handler specification to events from the given guilds. See
+// SocketHandlerRegistrator.WithGuild.
+type guildScope []Snowflake
+
+func (hs *handlerSpec) inScope(guildID Snowflake) bool {
+	return hs.guilds == nil || hs.guilds[guildID]
 }
 
 func (hs *handlerSpec) next() bool {
@@ -247,6 +559,24 @@ func (hs *handlerSpec) next() bool {
 func (hs *handlerSpec) populate(inputs ...interface{}) (err error) {
 	var i int
 
+	if len(inputs) > 0 {
+		if _, ok := inputs[0].(retainEvents); ok {
+			hs.retains = true
+			i++
+		}
+	}
+
+	// guild scope
+	if len(inputs) > i {
+		if scope, ok := inputs[i].(guildScope); ok {
+			hs.guilds = make(map[Snowflake]bool, len(scope))
+			for _, guildID := range scope {
+				hs.guilds[guildID] = true
+			}
+			i++
+		}
+	}
+
 	// middlewares
 	for ; i < len(inputs); i++ {
 		if mdlw, ok := inputs[i].(Middleware); ok {
@@ -277,6 +607,11 @@ func (hs *handlerSpec) populate(inputs ...interface{}) (err error) {
 		}
 	}
 
+	hs.retains = hs.retains || hs.ctrl != eternalCtrl
+	for _, handler := range hs.handlers {
+		hs.retains = hs.retains || isChannelHandler(handler)
+	}
+
 	if len(inputs) != i {
 		format := "unable to add all handlers/middlewares (%d/%d). Are they in correct order? middlewares, then handlers"
 		err = errors.New(fmt.Sprintf(format, i, len(inputs)))
@@ -311,11 +646,24 @@ func (hs *handlerSpec) runMdlws(evt interface{}) interface{} {
 //
 //  // Allow voting until the month is over
 //  Client.On("MESSAGE_CREATE", filter.NonVotes, registerVoteHandler, &disgord.Ctrl{Until: time.Now().AddDate(0, 1, 0)})
+//
+//  // Allow voting until the poll is cancelled
+//  Client.On("MESSAGE_CREATE", filter.NonVotes, registerVoteHandler, &disgord.Ctrl{Context: ctx})
 type Ctrl struct {
 	Runs     int
 	Until    time.Time
 	Duration time.Duration
 	Channel  interface{}
+
+	// Context removes the handlers once done, without waiting for another event. The Channel is closed
+	// afterwards, if set.
+	Context context.Context
+}
+
+// Once returns a controller which removes the handlers after their first run.
+//  client.Gateway().WithCtrl(disgord.Once()).MessageCreate(handler)
+func Once() *Ctrl {
+	return &Ctrl{Runs: 1}
 }
 
 var _ HandlerCtrl = (*Ctrl)(nil)
@@ -346,7 +694,7 @@ func (c *Ctrl) OnRemove(Session) error {
 }
 
 func (c *Ctrl) IsDead() bool {
-	return c.Runs == 0 || time.Now().After(c.Until)
+	return c.Runs == 0 || time.Now().After(c.Until) || (c.Context != nil && c.Context.Err() != nil)
 }
 
 func (c *Ctrl) Update() {
@@ -12,6 +12,14 @@ package disgord
 func defineResource(evt string) (resource evtResource) {
 	switch evt {
 
+	case EvtAutoModerationActionExecution:
+		resource = &AutoModerationActionExecution{}
+	case EvtAutoModerationRuleCreate:
+		resource = &AutoModerationRuleCreate{}
+	case EvtAutoModerationRuleDelete:
+		resource = &AutoModerationRuleDelete{}
+	case EvtAutoModerationRuleUpdate:
+		resource = &AutoModerationRuleUpdate{}
 	case EvtChannelCreate:
 		resource = &ChannelCreate{}
 	case EvtChannelDelete:
@@ -20,6 +28,12 @@ func defineResource(evt string) (resource evtResource) {
 		resource = &ChannelPinsUpdate{}
 	case EvtChannelUpdate:
 		resource = &ChannelUpdate{}
+	case EvtEntitlementCreate:
+		resource = &EntitlementCreate{}
+	case EvtEntitlementDelete:
+		resource = &EntitlementDelete{}
+	case EvtEntitlementUpdate:
+		resource = &EntitlementUpdate{}
 	case EvtGuildBanAdd:
 		resource = &GuildBanAdd{}
 	case EvtGuildBanRemove:
@@ -46,6 +60,18 @@ func defineResource(evt string) (resource evtResource) {
 		resource = &GuildRoleDelete{}
 	case EvtGuildRoleUpdate:
 		resource = &GuildRoleUpdate{}
+	case EvtGuildScheduledEventCreate:
+		resource = &GuildScheduledEventCreate{}
+	case EvtGuildScheduledEventDelete:
+		resource = &GuildScheduledEventDelete{}
+	case EvtGuildScheduledEventUpdate:
+		resource = &GuildScheduledEventUpdate{}
+	case EvtGuildScheduledEventUserAdd:
+		resource = &GuildScheduledEventUserAdd{}
+	case EvtGuildScheduledEventUserRemove:
+		resource = &GuildScheduledEventUserRemove{}
+	case EvtGuildStickersUpdate:
+		resource = &GuildStickersUpdate{}
 	case EvtGuildUpdate:
 		resource = &GuildUpdate{}
 	case EvtInteractionCreate:
@@ -60,6 +86,10 @@ func defineResource(evt string) (resource evtResource) {
 		resource = &MessageDelete{}
 	case EvtMessageDeleteBulk:
 		resource = &MessageDeleteBulk{}
+	case EvtMessagePollVoteAdd:
+		resource = &MessagePollVoteAdd{}
+	case EvtMessagePollVoteRemove:
+		resource = &MessagePollVoteRemove{}
 	case EvtMessageReactionAdd:
 		resource = &MessageReactionAdd{}
 	case EvtMessageReactionRemove:
@@ -76,6 +106,30 @@ func defineResource(evt string) (resource evtResource) {
 		resource = &Ready{}
 	case EvtResumed:
 		resource = &Resumed{}
+	case EvtStageInstanceCreate:
+		resource = &StageInstanceCreate{}
+	case EvtStageInstanceDelete:
+		resource = &StageInstanceDelete{}
+	case EvtStageInstanceUpdate:
+		resource = &StageInstanceUpdate{}
+	case EvtSubscriptionCreate:
+		resource = &SubscriptionCreate{}
+	case EvtSubscriptionDelete:
+		resource = &SubscriptionDelete{}
+	case EvtSubscriptionUpdate:
+		resource = &SubscriptionUpdate{}
+	case EvtThreadCreate:
+		resource = &ThreadCreate{}
+	case EvtThreadDelete:
+		resource = &ThreadDelete{}
+	case EvtThreadListSync:
+		resource = &ThreadListSync{}
+	case EvtThreadMemberUpdate:
+		resource = &ThreadMemberUpdate{}
+	case EvtThreadMembersUpdate:
+		resource = &ThreadMembersUpdate{}
+	case EvtThreadUpdate:
+		resource = &ThreadUpdate{}
 	case EvtTypingStart:
 		resource = &TypingStart{}
 	case EvtUserUpdate:
@@ -99,6 +153,22 @@ func isHandler(h Handler) (ok bool) {
 		ok = true
 	case chan interface{}:
 		ok = true
+	case HandlerAutoModerationActionExecution:
+		ok = true
+	case chan *AutoModerationActionExecution:
+		ok = true
+	case HandlerAutoModerationRuleCreate:
+		ok = true
+	case chan *AutoModerationRuleCreate:
+		ok = true
+	case HandlerAutoModerationRuleDelete:
+		ok = true
+	case chan *AutoModerationRuleDelete:
+		ok = true
+	case HandlerAutoModerationRuleUpdate:
+		ok = true
+	case chan *AutoModerationRuleUpdate:
+		ok = true
 	case HandlerChannelCreate:
 		ok = true
 	case chan *ChannelCreate:
@@ -115,6 +185,18 @@ func isHandler(h Handler) (ok bool) {
 		ok = true
 	case chan *ChannelUpdate:
 		ok = true
+	case HandlerEntitlementCreate:
+		ok = true
+	case chan *EntitlementCreate:
+		ok = true
+	case HandlerEntitlementDelete:
+		ok = true
+	case chan *EntitlementDelete:
+		ok = true
+	case HandlerEntitlementUpdate:
+		ok = true
+	case chan *EntitlementUpdate:
+		ok = true
 	case HandlerGuildBanAdd:
 		ok = true
 	case chan *GuildBanAdd:
@@ -167,6 +249,30 @@ func isHandler(h Handler) (ok bool) {
 		ok = true
 	case chan *GuildRoleUpdate:
 		ok = true
+	case HandlerGuildScheduledEventCreate:
+		ok = true
+	case chan *GuildScheduledEventCreate:
+		ok = true
+	case HandlerGuildScheduledEventDelete:
+		ok = true
+	case chan *GuildScheduledEventDelete:
+		ok = true
+	case HandlerGuildScheduledEventUpdate:
+		ok = true
+	case chan *GuildScheduledEventUpdate:
+		ok = true
+	case HandlerGuildScheduledEventUserAdd:
+		ok = true
+	case chan *GuildScheduledEventUserAdd:
+		ok = true
+	case HandlerGuildScheduledEventUserRemove:
+		ok = true
+	case chan *GuildScheduledEventUserRemove:
+		ok = true
+	case HandlerGuildStickersUpdate:
+		ok = true
+	case chan *GuildStickersUpdate:
+		ok = true
 	case HandlerGuildUpdate:
 		ok = true
 	case chan *GuildUpdate:
@@ -195,6 +301,14 @@ func isHandler(h Handler) (ok bool) {
 		ok = true
 	case chan *MessageDeleteBulk:
 		ok = true
+	case HandlerMessagePollVoteAdd:
+		ok = true
+	case chan *MessagePollVoteAdd:
+		ok = true
+	case HandlerMessagePollVoteRemove:
+		ok = true
+	case chan *MessagePollVoteRemove:
+		ok = true
 	case HandlerMessageReactionAdd:
 		ok = true
 	case chan *MessageReactionAdd:
@@ -227,6 +341,54 @@ func isHandler(h Handler) (ok bool) {
 		ok = true
 	case chan *Resumed:
 		ok = true
+	case HandlerStageInstanceCreate:
+		ok = true
+	case chan *StageInstanceCreate:
+		ok = true
+	case HandlerStageInstanceDelete:
+		ok = true
+	case chan *StageInstanceDelete:
+		ok = true
+	case HandlerStageInstanceUpdate:
+		ok = true
+	case chan *StageInstanceUpdate:
+		ok = true
+	case HandlerSubscriptionCreate:
+		ok = true
+	case chan *SubscriptionCreate:
+		ok = true
+	case HandlerSubscriptionDelete:
+		ok = true
+	case chan *SubscriptionDelete:
+		ok = true
+	case HandlerSubscriptionUpdate:
+		ok = true
+	case chan *SubscriptionUpdate:
+		ok = true
+	case HandlerThreadCreate:
+		ok = true
+	case chan *ThreadCreate:
+		ok = true
+	case HandlerThreadDelete:
+		ok = true
+	case chan *ThreadDelete:
+		ok = true
+	case HandlerThreadListSync:
+		ok = true
+	case chan *ThreadListSync:
+		ok = true
+	case HandlerThreadMemberUpdate:
+		ok = true
+	case chan *ThreadMemberUpdate:
+		ok = true
+	case HandlerThreadMembersUpdate:
+		ok = true
+	case chan *ThreadMembersUpdate:
+		ok = true
+	case HandlerThreadUpdate:
+		ok = true
+	case chan *ThreadUpdate:
+		ok = true
 	case HandlerTypingStart:
 		ok = true
 	case chan *TypingStart:
@@ -255,6 +417,14 @@ func closeChannel(channel interface{}) {
 	switch t := channel.(type) {
 	case chan interface{}:
 		close(t)
+	case chan *AutoModerationActionExecution:
+		close(t)
+	case chan *AutoModerationRuleCreate:
+		close(t)
+	case chan *AutoModerationRuleDelete:
+		close(t)
+	case chan *AutoModerationRuleUpdate:
+		close(t)
 	case chan *ChannelCreate:
 		close(t)
 	case chan *ChannelDelete:
@@ -263,6 +433,12 @@ func closeChannel(channel interface{}) {
 		close(t)
 	case chan *ChannelUpdate:
 		close(t)
+	case chan *EntitlementCreate:
+		close(t)
+	case chan *EntitlementDelete:
+		close(t)
+	case chan *EntitlementUpdate:
+		close(t)
 	case chan *GuildBanAdd:
 		close(t)
 	case chan *GuildBanRemove:
@@ -289,6 +465,18 @@ func closeChannel(channel interface{}) {
 		close(t)
 	case chan *GuildRoleUpdate:
 		close(t)
+	case chan *GuildScheduledEventCreate:
+		close(t)
+	case chan *GuildScheduledEventDelete:
+		close(t)
+	case chan *GuildScheduledEventUpdate:
+		close(t)
+	case chan *GuildScheduledEventUserAdd:
+		close(t)
+	case chan *GuildScheduledEventUserRemove:
+		close(t)
+	case chan *GuildStickersUpdate:
+		close(t)
 	case chan *GuildUpdate:
 		close(t)
 	case chan *InteractionCreate:
@@ -303,6 +491,10 @@ func closeChannel(channel interface{}) {
 		close(t)
 	case chan *MessageDeleteBulk:
 		close(t)
+	case chan *MessagePollVoteAdd:
+		close(t)
+	case chan *MessagePollVoteRemove:
+		close(t)
 	case chan *MessageReactionAdd:
 		close(t)
 	case chan *MessageReactionRemove:
@@ -319,6 +511,30 @@ func closeChannel(channel interface{}) {
 		close(t)
 	case chan *Resumed:
 		close(t)
+	case chan *StageInstanceCreate:
+		close(t)
+	case chan *StageInstanceDelete:
+		close(t)
+	case chan *StageInstanceUpdate:
+		close(t)
+	case chan *SubscriptionCreate:
+		close(t)
+	case chan *SubscriptionDelete:
+		close(t)
+	case chan *SubscriptionUpdate:
+		close(t)
+	case chan *ThreadCreate:
+		close(t)
+	case chan *ThreadDelete:
+		close(t)
+	case chan *ThreadListSync:
+		close(t)
+	case chan *ThreadMemberUpdate:
+		close(t)
+	case chan *ThreadMembersUpdate:
+		close(t)
+	case chan *ThreadUpdate:
+		close(t)
 	case chan *TypingStart:
 		close(t)
 	case chan *UserUpdate:
@@ -359,6 +575,30 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 		t <- evt
 	case chan<- interface{}:
 		t <- evt
+	case HandlerAutoModerationActionExecution:
+		t(d.session, evt.(*AutoModerationActionExecution))
+	case chan *AutoModerationActionExecution:
+		t <- evt.(*AutoModerationActionExecution)
+	case chan<- *AutoModerationActionExecution:
+		t <- evt.(*AutoModerationActionExecution)
+	case HandlerAutoModerationRuleCreate:
+		t(d.session, evt.(*AutoModerationRuleCreate))
+	case chan *AutoModerationRuleCreate:
+		t <- evt.(*AutoModerationRuleCreate)
+	case chan<- *AutoModerationRuleCreate:
+		t <- evt.(*AutoModerationRuleCreate)
+	case HandlerAutoModerationRuleDelete:
+		t(d.session, evt.(*AutoModerationRuleDelete))
+	case chan *AutoModerationRuleDelete:
+		t <- evt.(*AutoModerationRuleDelete)
+	case chan<- *AutoModerationRuleDelete:
+		t <- evt.(*AutoModerationRuleDelete)
+	case HandlerAutoModerationRuleUpdate:
+		t(d.session, evt.(*AutoModerationRuleUpdate))
+	case chan *AutoModerationRuleUpdate:
+		t <- evt.(*AutoModerationRuleUpdate)
+	case chan<- *AutoModerationRuleUpdate:
+		t <- evt.(*AutoModerationRuleUpdate)
 	case HandlerChannelCreate:
 		t(d.session, evt.(*ChannelCreate))
 	case chan *ChannelCreate:
@@ -383,6 +623,24 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 		t <- evt.(*ChannelUpdate)
 	case chan<- *ChannelUpdate:
 		t <- evt.(*ChannelUpdate)
+	case HandlerEntitlementCreate:
+		t(d.session, evt.(*EntitlementCreate))
+	case chan *EntitlementCreate:
+		t <- evt.(*EntitlementCreate)
+	case chan<- *EntitlementCreate:
+		t <- evt.(*EntitlementCreate)
+	case HandlerEntitlementDelete:
+		t(d.session, evt.(*EntitlementDelete))
+	case chan *EntitlementDelete:
+		t <- evt.(*EntitlementDelete)
+	case chan<- *EntitlementDelete:
+		t <- evt.(*EntitlementDelete)
+	case HandlerEntitlementUpdate:
+		t(d.session, evt.(*EntitlementUpdate))
+	case chan *EntitlementUpdate:
+		t <- evt.(*EntitlementUpdate)
+	case chan<- *EntitlementUpdate:
+		t <- evt.(*EntitlementUpdate)
 	case HandlerGuildBanAdd:
 		t(d.session, evt.(*GuildBanAdd))
 	case chan *GuildBanAdd:
@@ -461,6 +719,42 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 		t <- evt.(*GuildRoleUpdate)
 	case chan<- *GuildRoleUpdate:
 		t <- evt.(*GuildRoleUpdate)
+	case HandlerGuildScheduledEventCreate:
+		t(d.session, evt.(*GuildScheduledEventCreate))
+	case chan *GuildScheduledEventCreate:
+		t <- evt.(*GuildScheduledEventCreate)
+	case chan<- *GuildScheduledEventCreate:
+		t <- evt.(*GuildScheduledEventCreate)
+	case HandlerGuildScheduledEventDelete:
+		t(d.session, evt.(*GuildScheduledEventDelete))
+	case chan *GuildScheduledEventDelete:
+		t <- evt.(*GuildScheduledEventDelete)
+	case chan<- *GuildScheduledEventDelete:
+		t <- evt.(*GuildScheduledEventDelete)
+	case HandlerGuildScheduledEventUpdate:
+		t(d.session, evt.(*GuildScheduledEventUpdate))
+	case chan *GuildScheduledEventUpdate:
+		t <- evt.(*GuildScheduledEventUpdate)
+	case chan<- *GuildScheduledEventUpdate:
+		t <- evt.(*GuildScheduledEventUpdate)
+	case HandlerGuildScheduledEventUserAdd:
+		t(d.session, evt.(*GuildScheduledEventUserAdd))
+	case chan *GuildScheduledEventUserAdd:
+		t <- evt.(*GuildScheduledEventUserAdd)
+	case chan<- *GuildScheduledEventUserAdd:
+		t <- evt.(*GuildScheduledEventUserAdd)
+	case HandlerGuildScheduledEventUserRemove:
+		t(d.session, evt.(*GuildScheduledEventUserRemove))
+	case chan *GuildScheduledEventUserRemove:
+		t <- evt.(*GuildScheduledEventUserRemove)
+	case chan<- *GuildScheduledEventUserRemove:
+		t <- evt.(*GuildScheduledEventUserRemove)
+	case HandlerGuildStickersUpdate:
+		t(d.session, evt.(*GuildStickersUpdate))
+	case chan *GuildStickersUpdate:
+		t <- evt.(*GuildStickersUpdate)
+	case chan<- *GuildStickersUpdate:
+		t <- evt.(*GuildStickersUpdate)
 	case HandlerGuildUpdate:
 		t(d.session, evt.(*GuildUpdate))
 	case chan *GuildUpdate:
@@ -503,6 +797,18 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 		t <- evt.(*MessageDeleteBulk)
 	case chan<- *MessageDeleteBulk:
 		t <- evt.(*MessageDeleteBulk)
+	case HandlerMessagePollVoteAdd:
+		t(d.session, evt.(*MessagePollVoteAdd))
+	case chan *MessagePollVoteAdd:
+		t <- evt.(*MessagePollVoteAdd)
+	case chan<- *MessagePollVoteAdd:
+		t <- evt.(*MessagePollVoteAdd)
+	case HandlerMessagePollVoteRemove:
+		t(d.session, evt.(*MessagePollVoteRemove))
+	case chan *MessagePollVoteRemove:
+		t <- evt.(*MessagePollVoteRemove)
+	case chan<- *MessagePollVoteRemove:
+		t <- evt.(*MessagePollVoteRemove)
 	case HandlerMessageReactionAdd:
 		t(d.session, evt.(*MessageReactionAdd))
 	case chan *MessageReactionAdd:
@@ -551,6 +857,78 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 		t <- evt.(*Resumed)
 	case chan<- *Resumed:
 		t <- evt.(*Resumed)
+	case HandlerStageInstanceCreate:
+		t(d.session, evt.(*StageInstanceCreate))
+	case chan *StageInstanceCreate:
+		t <- evt.(*StageInstanceCreate)
+	case chan<- *StageInstanceCreate:
+		t <- evt.(*StageInstanceCreate)
+	case HandlerStageInstanceDelete:
+		t(d.session, evt.(*StageInstanceDelete))
+	case chan *StageInstanceDelete:
+		t <- evt.(*StageInstanceDelete)
+	case chan<- *StageInstanceDelete:
+		t <- evt.(*StageInstanceDelete)
+	case HandlerStageInstanceUpdate:
+		t(d.session, evt.(*StageInstanceUpdate))
+	case chan *StageInstanceUpdate:
+		t <- evt.(*StageInstanceUpdate)
+	case chan<- *StageInstanceUpdate:
+		t <- evt.(*StageInstanceUpdate)
+	case HandlerSubscriptionCreate:
+		t(d.session, evt.(*SubscriptionCreate))
+	case chan *SubscriptionCreate:
+		t <- evt.(*SubscriptionCreate)
+	case chan<- *SubscriptionCreate:
+		t <- evt.(*SubscriptionCreate)
+	case HandlerSubscriptionDelete:
+		t(d.session, evt.(*SubscriptionDelete))
+	case chan *SubscriptionDelete:
+		t <- evt.(*SubscriptionDelete)
+	case chan<- *SubscriptionDelete:
+		t <- evt.(*SubscriptionDelete)
+	case HandlerSubscriptionUpdate:
+		t(d.session, evt.(*SubscriptionUpdate))
+	case chan *SubscriptionUpdate:
+		t <- evt.(*SubscriptionUpdate)
+	case chan<- *SubscriptionUpdate:
+		t <- evt.(*SubscriptionUpdate)
+	case HandlerThreadCreate:
+		t(d.session, evt.(*ThreadCreate))
+	case chan *ThreadCreate:
+		t <- evt.(*ThreadCreate)
+	case chan<- *ThreadCreate:
+		t <- evt.(*ThreadCreate)
+	case HandlerThreadDelete:
+		t(d.session, evt.(*ThreadDelete))
+	case chan *ThreadDelete:
+		t <- evt.(*ThreadDelete)
+	case chan<- *ThreadDelete:
+		t <- evt.(*ThreadDelete)
+	case HandlerThreadListSync:
+		t(d.session, evt.(*ThreadListSync))
+	case chan *ThreadListSync:
+		t <- evt.(*ThreadListSync)
+	case chan<- *ThreadListSync:
+		t <- evt.(*ThreadListSync)
+	case HandlerThreadMemberUpdate:
+		t(d.session, evt.(*ThreadMemberUpdate))
+	case chan *ThreadMemberUpdate:
+		t <- evt.(*ThreadMemberUpdate)
+	case chan<- *ThreadMemberUpdate:
+		t <- evt.(*ThreadMemberUpdate)
+	case HandlerThreadMembersUpdate:
+		t(d.session, evt.(*ThreadMembersUpdate))
+	case chan *ThreadMembersUpdate:
+		t <- evt.(*ThreadMembersUpdate)
+	case chan<- *ThreadMembersUpdate:
+		t <- evt.(*ThreadMembersUpdate)
+	case HandlerThreadUpdate:
+		t(d.session, evt.(*ThreadUpdate))
+	case chan *ThreadUpdate:
+		t <- evt.(*ThreadUpdate)
+	case chan<- *ThreadUpdate:
+		t <- evt.(*ThreadUpdate)
 	case HandlerTypingStart:
 		t(d.session, evt.(*TypingStart))
 	case chan *TypingStart:
@@ -594,6 +972,18 @@ func (d *dispatcher) trigger(h Handler, evt resource) {
 type HandlerSimplest = func()
 type HandlerSimple = func(Session)
 
+// HandlerAutoModerationActionExecution is triggered by AutoModerationActionExecution events
+type HandlerAutoModerationActionExecution = func(s Session, h *AutoModerationActionExecution)
+
+// HandlerAutoModerationRuleCreate is triggered by AutoModerationRuleCreate events
+type HandlerAutoModerationRuleCreate = func(s Session, h *AutoModerationRuleCreate)
+
+// HandlerAutoModerationRuleDelete is triggered by AutoModerationRuleDelete events
+type HandlerAutoModerationRuleDelete = func(s Session, h *AutoModerationRuleDelete)
+
+// HandlerAutoModerationRuleUpdate is triggered by AutoModerationRuleUpdate events
+type HandlerAutoModerationRuleUpdate = func(s Session, h *AutoModerationRuleUpdate)
+
 // HandlerChannelCreate is triggered by ChannelCreate events
 type HandlerChannelCreate = func(s Session, h *ChannelCreate)
 
@@ -606,6 +996,15 @@ type HandlerChannelPinsUpdate = func(s Session, h *ChannelPinsUpdate)
 // HandlerChannelUpdate is triggered by ChannelUpdate events
 type HandlerChannelUpdate = func(s Session, h *ChannelUpdate)
 
+// HandlerEntitlementCreate is triggered by EntitlementCreate events
+type HandlerEntitlementCreate = func(s Session, h *EntitlementCreate)
+
+// HandlerEntitlementDelete is triggered by EntitlementDelete events
+type HandlerEntitlementDelete = func(s Session, h *EntitlementDelete)
+
+// HandlerEntitlementUpdate is triggered by EntitlementUpdate events
+type HandlerEntitlementUpdate = func(s Session, h *EntitlementUpdate)
+
 // HandlerGuildBanAdd is triggered by GuildBanAdd events
 type HandlerGuildBanAdd = func(s Session, h *GuildBanAdd)
 
@@ -645,6 +1044,24 @@ type HandlerGuildRoleDelete = func(s Session, h *GuildRoleDelete)
 // HandlerGuildRoleUpdate is triggered by GuildRoleUpdate events
 type HandlerGuildRoleUpdate = func(s Session, h *GuildRoleUpdate)
 
+// HandlerGuildScheduledEventCreate is triggered by GuildScheduledEventCreate events
+type HandlerGuildScheduledEventCreate = func(s Session, h *GuildScheduledEventCreate)
+
+// HandlerGuildScheduledEventDelete is triggered by GuildScheduledEventDelete events
+type HandlerGuildScheduledEventDelete = func(s Session, h *GuildScheduledEventDelete)
+
+// HandlerGuildScheduledEventUpdate is triggered by GuildScheduledEventUpdate events
+type HandlerGuildScheduledEventUpdate = func(s Session, h *GuildScheduledEventUpdate)
+
+// HandlerGuildScheduledEventUserAdd is triggered by GuildScheduledEventUserAdd events
+type HandlerGuildScheduledEventUserAdd = func(s Session, h *GuildScheduledEventUserAdd)
+
+// HandlerGuildScheduledEventUserRemove is triggered by GuildScheduledEventUserRemove events
+type HandlerGuildScheduledEventUserRemove = func(s Session, h *GuildScheduledEventUserRemove)
+
+// HandlerGuildStickersUpdate is triggered by GuildStickersUpdate events
+type HandlerGuildStickersUpdate = func(s Session, h *GuildStickersUpdate)
+
 // HandlerGuildUpdate is triggered by GuildUpdate events
 type HandlerGuildUpdate = func(s Session, h *GuildUpdate)
 
@@ -666,6 +1083,12 @@ type HandlerMessageDelete = func(s Session, h *MessageDelete)
 // HandlerMessageDeleteBulk is triggered by MessageDeleteBulk events
 type HandlerMessageDeleteBulk = func(s Session, h *MessageDeleteBulk)
 
+// HandlerMessagePollVoteAdd is triggered by MessagePollVoteAdd events
+type HandlerMessagePollVoteAdd = func(s Session, h *MessagePollVoteAdd)
+
+// HandlerMessagePollVoteRemove is triggered by MessagePollVoteRemove events
+type HandlerMessagePollVoteRemove = func(s Session, h *MessagePollVoteRemove)
+
 // HandlerMessageReactionAdd is triggered by MessageReactionAdd events
 type HandlerMessageReactionAdd = func(s Session, h *MessageReactionAdd)
 
@@ -690,6 +1113,42 @@ type HandlerReady = func(s Session, h *Ready)
 // HandlerResumed is triggered by Resumed events
 type HandlerResumed = func(s Session, h *Resumed)
 
+// HandlerStageInstanceCreate is triggered by StageInstanceCreate events
+type HandlerStageInstanceCreate = func(s Session, h *StageInstanceCreate)
+
+// HandlerStageInstanceDelete is triggered by StageInstanceDelete events
+type HandlerStageInstanceDelete = func(s Session, h *StageInstanceDelete)
+
+// HandlerStageInstanceUpdate is triggered by StageInstanceUpdate events
+type HandlerStageInstanceUpdate = func(s Session, h *StageInstanceUpdate)
+
+// HandlerSubscriptionCreate is triggered by SubscriptionCreate events
+type HandlerSubscriptionCreate = func(s Session, h *SubscriptionCreate)
+
+// HandlerSubscriptionDelete is triggered by SubscriptionDelete events
+type HandlerSubscriptionDelete = func(s Session, h *SubscriptionDelete)
+
+// HandlerSubscriptionUpdate is triggered by SubscriptionUpdate events
+type HandlerSubscriptionUpdate = func(s Session, h *SubscriptionUpdate)
+
+// HandlerThreadCreate is triggered by ThreadCreate events
+type HandlerThreadCreate = func(s Session, h *ThreadCreate)
+
+// HandlerThreadDelete is triggered by ThreadDelete events
+type HandlerThreadDelete = func(s Session, h *ThreadDelete)
+
+// HandlerThreadListSync is triggered by ThreadListSync events
+type HandlerThreadListSync = func(s Session, h *ThreadListSync)
+
+// HandlerThreadMemberUpdate is triggered by ThreadMemberUpdate events
+type HandlerThreadMemberUpdate = func(s Session, h *ThreadMemberUpdate)
+
+// HandlerThreadMembersUpdate is triggered by ThreadMembersUpdate events
+type HandlerThreadMembersUpdate = func(s Session, h *ThreadMembersUpdate)
+
+// HandlerThreadUpdate is triggered by ThreadUpdate events
+type HandlerThreadUpdate = func(s Session, h *ThreadUpdate)
+
 // HandlerTypingStart is triggered by TypingStart events
 type HandlerTypingStart = func(s Session, h *TypingStart)
 
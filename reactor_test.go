@@ -3,8 +3,14 @@
 package disgord
 
 import (
+	"context"
 	"sync"
 	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/internal/logger"
+	"github.com/Vedza/disgord/json"
 )
 
 func Test_isHandler(t *testing.T) {
@@ -42,7 +48,7 @@ func TestRegister(t *testing.T) {
 		<-handler
 		wg.Done()
 	}()
-	d.dispatch(EvtMessageCreate, &MessageCreate{})
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
 	wg.Wait()
 }
 
@@ -61,7 +67,7 @@ func TestCtrl_CloseChannel(t *testing.T) {
 		<-handler
 		wg.Done()
 	}()
-	d.dispatch(EvtMessageCreate, &MessageCreate{})
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
 	wg.Wait()
 
 	// close channel
@@ -71,5 +77,176 @@ func TestCtrl_CloseChannel(t *testing.T) {
 	}
 
 	// should not hang
-	d.dispatch(EvtMessageCreate, &MessageCreate{})
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
+}
+
+func TestDispatcher_RawHandler(t *testing.T) {
+	d := newDispatcher()
+	c := &Client{cache: &CacheNop{}}
+
+	type rawEvent struct {
+		name    string
+		payload string
+	}
+	received := make(chan rawEvent, 2)
+	d.addRawHandler(func(evtName string, payload json.RawMessage) {
+		received <- rawEvent{evtName, string(payload)}
+	})
+
+	events := make(chan *gateway.Event, 2)
+	events <- &gateway.Event{Name: "SOME_NEW_EVENT", Data: []byte(`{"id":"1"}`)}
+	events <- &gateway.Event{Name: "ANOTHER_NEW_EVENT", Data: []byte(`{}`)}
+	close(events)
+	c.demultiplexer(d, events)
+
+	if evt := <-received; evt.name != "SOME_NEW_EVENT" || evt.payload != `{"id":"1"}` {
+		t.Errorf("unexpected raw event. Got %+v", evt)
+	}
+	if evt := <-received; evt.name != "ANOTHER_NEW_EVENT" {
+		t.Errorf("raw events should be received in order. Got %s", evt.name)
+	}
+}
+
+func TestDispatcher_Use(t *testing.T) {
+	d := newDispatcher()
+	handler := make(chan *MessageCreate, 2)
+	if err := d.register(EvtMessageCreate, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	d.use(func(next DispatchFunc) DispatchFunc {
+		return func(s Session, evtName string, evt interface{}) {
+			order = append(order, "first")
+			next(s, evtName, evt)
+		}
+	}, func(next DispatchFunc) DispatchFunc {
+		return func(s Session, evtName string, evt interface{}) {
+			order = append(order, "second")
+			msg := evt.(*MessageCreate)
+			if msg.Message.Content == "stop" {
+				return
+			}
+			next(s, evtName, &MessageCreate{Message: &Message{Content: msg.Message.Content + "!"}})
+		}
+	})
+
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "stop"}})
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "hello"}})
+
+	if len(handler) != 1 {
+		t.Fatalf("expected the propagation of one event to stop. Got %d events", len(handler))
+	}
+	if evt := <-handler; evt.Message.Content != "hello!" {
+		t.Errorf("expected the mutated event. Got %s", evt.Message.Content)
+	}
+	if len(order) != 4 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("middlewares were called out of order: %v", order)
+	}
+}
+
+func TestDispatcher_HandlerPanic(t *testing.T) {
+	d := newDispatcher()
+
+	var reported []string
+	d.onHandlerError = func(evtName string, err error, stack []byte) {
+		if len(stack) == 0 {
+			t.Error("expected a stack trace")
+		}
+		reported = append(reported, evtName+": "+err.Error())
+	}
+
+	handled := make(chan *MessageCreate, 1)
+	err := d.register(EvtMessageCreate, func(_ Session, _ *MessageCreate) {
+		panic("oops")
+	}, handled)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
+	if len(handled) != 1 {
+		t.Error("the remaining handlers should run after a panic")
+	}
+	if len(reported) != 1 || reported[0] != EvtMessageCreate+": handler panicked: oops" {
+		t.Errorf("expected the panic to be reported. Got %v", reported)
+	}
+}
+
+func TestDispatcher_GuildScope(t *testing.T) {
+	d := newDispatcher()
+	c := &Client{cache: &CacheNop{}}
+	d.addSessionInstance(c)
+
+	scoped := make(chan *MessageCreate, 3)
+	register := socketHandlerRegister{reactor: d}
+	register.WithGuild(1, 2).MessageCreateChan(scoped)
+
+	events := make(chan *gateway.Event, 3)
+	events <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"10","guild_id":"1"}`)}
+	events <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"11","guild_id":"3"}`)}
+	events <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"12"}`)}
+	close(events)
+	c.demultiplexer(d, events)
+
+	select {
+	case evt := <-scoped:
+		if evt.Message.ID != 10 {
+			t.Errorf("expected the message from guild 1. Got %d", evt.Message.ID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the event from a subscribed guild was not dispatched")
+	}
+	select {
+	case evt := <-scoped:
+		t.Errorf("events from other guilds should not be dispatched. Got %d", evt.Message.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if id := payloadGuildID(EvtGuildCreate, []byte(`{"id":"5"}`)); id != 5 {
+		t.Errorf("expected the guild id of a guild event. Got %d", id)
+	}
+}
+
+func TestSocketHandlerRegister_Stream(t *testing.T) {
+	d := newDispatcher()
+	d.addSessionInstance(&Client{log: &logger.Empty{}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := socketHandlerRegister{reactor: d}.MessageCreateStream(ctx)
+
+	go d.dispatch(EvtMessageCreate, 0, &MessageCreate{Message: &Message{Content: "hello"}})
+	if evt := <-stream; evt.Message.Content != "hello" {
+		t.Errorf("unexpected event. Got %s", evt.Message.Content)
+	}
+
+	cancel()
+	select {
+	case _, open := <-stream:
+		if open {
+			t.Error("expected the stream to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the stream was not closed once the context was cancelled")
+	}
+
+	d.RLock()
+	defer d.RUnlock()
+	if len(d.handlerSpecs[EvtMessageCreate]) != 0 {
+		t.Error("expected the handler to be removed")
+	}
+}
+
+func TestOnce(t *testing.T) {
+	d := newDispatcher()
+	handler := make(chan *MessageCreate, 2)
+	if err := d.register(EvtMessageCreate, handler, Once()); err != nil {
+		t.Fatal(err)
+	}
+
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
+	d.dispatch(EvtMessageCreate, 0, &MessageCreate{})
+	if len(handler) != 1 {
+		t.Errorf("expected the handler to run once. Got %d", len(handler))
+	}
 }
@@ -0,0 +1,44 @@
+package disgord
+
+import "sync"
+
+// requestGroup deduplicates concurrent REST requests for the same resource, such that a burst of cache
+// misses for the same guild results in a single request.
+type requestGroup struct {
+	mu    sync.Mutex
+	calls map[string]*groupCall
+}
+
+type groupCall struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+}
+
+// do runs fn, unless a call with the same key is in progress. Then the result of that call is
+// returned instead, and shared is true. Shared results must be copied before being handed out.
+func (g *requestGroup) do(key string, fn func() (interface{}, error)) (v interface{}, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*groupCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.val, true, call.err
+	}
+
+	call := &groupCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.val, call.err = fn()
+	call.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.val, false, call.err
+}
@@ -0,0 +1,100 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// blockingTransport counts requests, and holds them until released
+type blockingTransport struct {
+	calls   atomic.Int32
+	release chan struct{}
+	body    string
+}
+
+func (t *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls.Inc()
+	<-t.release
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestGuildQueryBuilder_Get_ReadThrough(t *testing.T) {
+	req := &blockingTransport{
+		release: make(chan struct{}),
+		body:    `{"id":"7","name":"test"}`,
+	}
+	client, err := NewClient(context.Background(), Config{
+		BotToken:   "testing",
+		HTTPClient: &http.Client{Transport: req},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const lookups = 50
+	guilds := make([]*Guild, lookups)
+	var wg sync.WaitGroup
+	for i := 0; i < lookups; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			guild, err := client.Guild(7).Get()
+			if err != nil {
+				t.Error(err)
+			}
+			guilds[i] = guild
+		}(i)
+	}
+
+	// let every lookup miss the cache before the request completes
+	time.Sleep(50 * time.Millisecond)
+	close(req.release)
+	wg.Wait()
+
+	if calls := req.calls.Load(); calls != 1 {
+		t.Errorf("expected concurrent lookups to share one request. Got %d requests", calls)
+	}
+	for i := range guilds {
+		if guilds[i] == nil || guilds[i].Name != "test" {
+			t.Fatal("expected every lookup to get the guild")
+		}
+		for j := i + 1; j < len(guilds); j++ {
+			if guilds[i] == guilds[j] {
+				t.Fatal("expected every lookup to get its own copy of the guild")
+			}
+		}
+	}
+
+	cached, err := client.cache.GetGuild(7)
+	if err != nil || cached == nil || cached.Name != "test" {
+		t.Fatal("expected the guild to be cached", err)
+	}
+
+	if _, err = client.Guild(7).Get(); err != nil {
+		t.Fatal(err)
+	}
+	if calls := req.calls.Load(); calls != 1 {
+		t.Errorf("expected a cached guild to be returned without a request. Got %d requests", calls)
+	}
+
+	if _, err = client.Guild(7).Get(IgnoreCache); err != nil {
+		t.Fatal(err)
+	}
+	if calls := req.calls.Load(); calls != 2 {
+		t.Errorf("expected IgnoreCache to request the guild. Got %d requests", calls)
+	}
+}
@@ -0,0 +1,122 @@
+package disgord
+
+import (
+	"time"
+
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// RequestPriority decides the order of REST requests waiting for the same rate limit bucket.
+type RequestPriority = httd.Priority
+
+const (
+	RequestPriorityNormal = httd.PriorityNormal
+
+	// RequestPriorityHigh requests are sent before the normal requests waiting for the same bucket.
+	RequestPriorityHigh = httd.PriorityHigh
+)
+
+// RequestOption configures a single REST request, and is accepted by every REST method. Flags, such as
+// IgnoreCache, are request options as well.
+//  msg, err := client.Channel(channelID).Message(messageID).Get(disgord.IgnoreCache)
+//
+//  err = client.Channel(channelID).Message(messageID).Delete(
+//      disgord.WithReason("spam"),
+//      disgord.WithRetry(2),
+//  )
+type RequestOption interface {
+	applyRequestOption(opts *requestOptions)
+}
+
+type requestOptions struct {
+	flags    Flag
+	reason   string
+	retries  int
+	priority RequestPriority
+	timeout  time.Duration
+	compress int
+}
+
+type requestOptionFunc func(opts *requestOptions)
+
+func (f requestOptionFunc) applyRequestOption(opts *requestOptions) {
+	f(opts)
+}
+
+func (f Flag) applyRequestOption(opts *requestOptions) {
+	opts.flags |= f
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	options := &requestOptions{}
+	for i := range opts {
+		if opts[i] != nil {
+			opts[i].applyRequestOption(options)
+		}
+	}
+	return options
+}
+
+// apply sets the options on the http request.
+func (o *requestOptions) apply(req *httd.Request) {
+	if o.reason != "" {
+		req.Reason = o.reason
+	}
+	if o.retries > 0 {
+		req.Retries = o.retries
+	}
+	if o.priority != RequestPriorityNormal {
+		req.Priority = o.priority
+	}
+	if o.timeout != 0 {
+		req.Timeout = o.timeout
+	}
+	if o.compress != 0 {
+		req.CompressAbove = o.compress
+	}
+}
+
+// WithReason adds a reason that shows up in the audit log for this action. It takes precedence over
+// the Reason field of the request params.
+func WithReason(reason string) RequestOption {
+	return requestOptionFunc(func(opts *requestOptions) {
+		opts.reason = reason
+	})
+}
+
+// WithRetry retries the request when Discord responds with a server error, or the connection fails.
+// Requests with a io.Reader body, such as file uploads, are never retried.
+func WithRetry(retries int) RequestOption {
+	return requestOptionFunc(func(opts *requestOptions) {
+		opts.retries = retries
+	})
+}
+
+// WithPriority decides the order of the request among the requests waiting for the same bucket.
+func WithPriority(priority RequestPriority) RequestOption {
+	return requestOptionFunc(func(opts *requestOptions) {
+		opts.priority = priority
+	})
+}
+
+// WithTimeout overrides Config.RESTTimeout for the request. The deadline of the request context still
+// applies, and a negative timeout disables the timeout.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return requestOptionFunc(func(opts *requestOptions) {
+		opts.timeout = timeout
+	})
+}
+
+// WithCompression overrides Config.RESTCompressRequestsAbove for the request, such that a json body larger
+// than the given number of bytes is gzip compressed. A negative threshold disables the compression.
+//  msg, err := client.Channel(channelID).CreateMessage(params, disgord.WithCompression(8192))
+func WithCompression(threshold int) RequestOption {
+	return requestOptionFunc(func(opts *requestOptions) {
+		opts.compress = threshold
+	})
+}
+
+// WithFlags merges the flags into one request option.
+func WithFlags(flags ...Flag) RequestOption {
+	return mergeFlags(flags)
+}
@@ -0,0 +1,65 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+func TestRequestOptions(t *testing.T) {
+	opts := newRequestOptions([]RequestOption{
+		IgnoreCache,
+		WithFlags(SortByID, OrderDescending),
+		WithReason("spam"),
+		WithRetry(2),
+		WithPriority(RequestPriorityHigh),
+		WithTimeout(time.Second),
+		WithCompression(1024),
+		nil,
+	})
+	if opts.flags != IgnoreCache|SortByID|OrderDescending {
+		t.Errorf("unexpected flags. Got %d", opts.flags)
+	}
+
+	req := &httd.Request{Reason: "from params"}
+	opts.apply(req)
+	if req.Reason != "spam" || req.Retries != 2 || req.Priority != RequestPriorityHigh || req.Timeout != time.Second || req.CompressAbove != 1024 {
+		t.Errorf("options were not applied. Got %+v", req)
+	}
+}
+
+func TestRequestOptions_Request(t *testing.T) {
+	var requests int
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			if reason := req.Header.Get(httd.XAuditLogReason); reason != "spam" {
+				t.Errorf("expected an audit log reason. Got %q", reason)
+			}
+			resp := jsonResponse(req, "")
+			if requests == 1 {
+				resp.StatusCode = http.StatusBadGateway
+			} else {
+				resp.StatusCode = http.StatusNoContent
+			}
+			return resp, nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.Channel(1).Message(2).Delete(WithReason("spam"), WithRetry(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the server error to be retried. Got %d requests", requests)
+	}
+}
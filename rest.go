@@ -8,15 +8,62 @@ import (
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Vedza/disgord/internal/constant"
 	"github.com/Vedza/disgord/json"
 
 	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/internal/logger"
 )
 
 type ErrRest = httd.ErrREST
 
+// FieldError describes an invalid field of a request body, see ErrRest.Errors.
+type FieldError = httd.FieldError
+
+// RateLimit is the last known state of a REST rate limit bucket, see Client.RateLimits.
+type RateLimit = httd.RateLimit
+
+// RateLimitHit describes a http 429 response, see Config.OnRateLimited.
+type RateLimitHit = httd.RateLimitHit
+
+// ErrRateLimited is returned when a request is not sent because of a rate limit, see WithRateLimitPolicy
+// and WithMaxQueueWait.
+var ErrRateLimited = httd.ErrRateLimited
+
+// RateLimitPolicy decides what a REST request does when its bucket is rate limited.
+type RateLimitPolicy = httd.RateLimitPolicy
+
+const (
+	// RateLimitDefault follows Config.CancelRequestWhenRateLimited.
+	RateLimitDefault = httd.RateLimitDefault
+
+	// RateLimitWait waits for the bucket to reset, until the context is done.
+	RateLimitWait = httd.RateLimitWait
+
+	// RateLimitFailFast returns ErrRateLimited instead of waiting for the bucket to reset.
+	RateLimitFailFast = httd.RateLimitFailFast
+)
+
+// WithRateLimitPolicy overrides Config.CancelRequestWhenRateLimited for the REST requests using the context.
+//  ctx := disgord.WithRateLimitPolicy(context.Background(), disgord.RateLimitFailFast)
+//  _, err := client.Channel(channelID).WithContext(ctx).CreateMessage(params)
+//  if errors.Is(err, disgord.ErrRateLimited) {
+//      // the bucket was exhausted
+//  }
+func WithRateLimitPolicy(ctx context.Context, policy RateLimitPolicy) context.Context {
+	return httd.WithRateLimitPolicy(ctx, policy)
+}
+
+// WithMaxQueueWait limits how long the REST requests using the context may wait in a rate limit queue,
+// including waiting for the bucket to reset. ErrRateLimited is returned once the duration is exceeded.
+// Useful for latency sensitive requests, such as interaction responses that must be sent within 3 seconds.
+func WithMaxQueueWait(ctx context.Context, wait time.Duration) context.Context {
+	return httd.WithMaxQueueWait(ctx, wait)
+}
+
 // URLQueryStringer converts a struct of values to a valid URL query string
 type URLQueryStringer interface {
 	URLQueryString() string
@@ -70,6 +117,9 @@ type rest struct {
 
 	conf *httd.Request
 
+	// cacheFill populates the cache with the response body of a successful request
+	cacheFill func(body []byte)
+
 	// steps
 	doRequest restStepDoRequest
 }
@@ -110,6 +160,9 @@ func (r *rest) stepDoRequest() (resp *http.Response, body []byte, err error) {
 		return
 	}
 
+	r.c.pendingRequests.Inc()
+	defer r.c.pendingRequests.Dec()
+
 	resp, body, err = r.c.req.Do(r.conf.Ctx, r.conf)
 	return
 }
@@ -129,6 +182,13 @@ func (r *rest) processContent(body []byte) (v interface{}, err error) {
 		r.Put(obj)
 		return nil, err
 	}
+	if r.c.unknownFields != nil {
+		endpoint := r.conf.Endpoint
+		if i := strings.Index(endpoint, "?"); i >= 0 {
+			endpoint = endpoint[:i]
+		}
+		r.c.unknownFields.check(r.httpMethod+" "+endpoint, body, obj)
+	}
 	executeInternalUpdater(obj)
 
 	return obj, nil
@@ -138,6 +198,14 @@ func (r *rest) Execute() (v interface{}, err error) {
 	var resp *http.Response
 	var body []byte
 	if resp, body, err = r.doRequest(); err != nil {
+		var errRest *ErrRest
+		if errors.As(err, &errRest) {
+			log := logger.With(r.c.subsystemLog(LogREST),
+				logger.Field{Key: "endpoint", Value: errRest.HashedEndpoint},
+				logger.Field{Key: "bucket", Value: errRest.Bucket},
+			)
+			log.Debug("rest request failed with http code", errRest.HTTPCode, errRest.Msg)
+		}
 		return nil, err
 	}
 
@@ -157,6 +225,9 @@ func (r *rest) Execute() (v interface{}, err error) {
 	if obj, err = r.processContent(body); err != nil {
 		return nil, err
 	}
+	if r.cacheFill != nil && len(body) > 0 {
+		r.cacheFill(body)
+	}
 
 	if r.flags.Sort() {
 		Sort(obj, r.flags)
@@ -176,7 +247,7 @@ type RESTBuilder struct {
 	config     *httd.Request
 	client     httd.Requester
 
-	flags []Flag // TODO: checking
+	flags []RequestOption // TODO: checking
 
 	prerequisites []string // error msg
 
@@ -221,8 +292,9 @@ func (b *RESTBuilder) prepare() {
 	}
 	b.config.Endpoint += b.urlParams.URLQueryString()
 
-	flags := mergeFlags(b.flags)
-	if flags.Ignorecache() {
+	opts := newRequestOptions(b.flags)
+	opts.apply(b.config)
+	if opts.flags.Ignorecache() {
 		b.IgnoreCache()
 	}
 	if b.config.Ctx == nil {
@@ -261,8 +333,8 @@ func (b *RESTBuilder) execute() (v interface{}, err error) {
 		}
 		executeInternalUpdater(v)
 	}
-	if mergeFlags(b.flags).Sort() {
-		Sort(v, b.flags...)
+	if flags := newRequestOptions(b.flags).flags; flags.Sort() {
+		Sort(v, flags)
 	}
 	return v, nil
 }
@@ -318,10 +390,22 @@ type basicBuilder struct {
 
 type ClientQueryBuilderExecutables interface {
 	// CreateGuild Create a new guild. Returns a guild object on success. Fires a Guild Create Gateway event.
-	CreateGuild(guildName string, params *CreateGuildParams, flags ...Flag) (*Guild, error)
+	CreateGuild(guildName string, params *CreateGuildParams, flags ...RequestOption) (*Guild, error)
 
 	// GetVoiceRegionsBuilder Returns an array of voice region objects that can be used when creating servers.
-	GetVoiceRegions(flags ...Flag) ([]*VoiceRegion, error)
+	GetVoiceRegions(flags ...RequestOption) ([]*VoiceRegion, error)
+
+	// GetSticker Returns a sticker object for the given sticker ID.
+	GetSticker(stickerID Snowflake, flags ...RequestOption) (*Sticker, error)
+
+	// GetStickerPacks Returns the list of sticker packs available to Nitro subscribers.
+	GetStickerPacks(flags ...RequestOption) ([]*StickerPack, error)
+
+	// GetSKUSubscriptions Returns all subscriptions of a user containing the SKU.
+	GetSKUSubscriptions(skuID Snowflake, params *GetSKUSubscriptionsParams, flags ...RequestOption) ([]*Subscription, error)
+
+	// GetSKUSubscription Get a subscription by its ID.
+	GetSKUSubscription(skuID, subscriptionID Snowflake, flags ...RequestOption) (*Subscription, error)
 
 	BotAuthorizeURL() (*url.URL, error)
 	SendMsg(channelID Snowflake, data ...interface{}) (*Message, error)
@@ -333,11 +417,13 @@ type ClientQueryBuilder interface {
 	ClientQueryBuilderExecutables
 
 	Invite(code string) InviteQueryBuilder
+	GuildTemplate(code string) GuildTemplateQueryBuilder
 	Channel(cid Snowflake) ChannelQueryBuilder
 	User(uid Snowflake) UserQueryBuilder
 	CurrentUser() CurrentUserQueryBuilder
 	Guild(id Snowflake) GuildQueryBuilder
 	Gateway() GatewayQueryBuilder
+	Application(id Snowflake) ApplicationQueryBuilder
 }
 
 type clientQueryBuilder struct {
@@ -357,7 +443,7 @@ func (c clientQueryBuilder) WithContext(ctx context.Context) ClientQueryBuilderE
 // If you want to affect the actual message data besides .Content; provide a
 // MessageCreateParams. The reply message will be updated by the last one provided.
 func (c clientQueryBuilder) SendMsg(channelID Snowflake, data ...interface{}) (msg *Message, err error) {
-	var flags []Flag
+	var flags []RequestOption
 	params := &CreateMessageParams{}
 	addEmbed := func(e *Embed) error {
 		if params.Embed != nil {
@@ -452,7 +538,7 @@ func (c clientQueryBuilder) SendMsg(channelID Snowflake, data ...interface{}) (m
 
 	// wtf?
 	if data == nil {
-		if mergeFlags(flags).IgnoreEmptyParams() {
+		if newRequestOptions(flags).flags.IgnoreEmptyParams() {
 			params.Content = ""
 		} else {
 			return nil, errors.New("params were nil")
@@ -470,6 +556,9 @@ func (c clientQueryBuilder) SendMsg(channelID Snowflake, data ...interface{}) (m
 // for your bot to run successfully, you should utilise
 //  Client.
 func (c clientQueryBuilder) BotAuthorizeURL() (*url.URL, error) {
+	if c.client.botID.IsZero() {
+		return nil, errors.New("the bot ID is unknown, which is the case for a RESTClient")
+	}
 	format := "https://discord.com/oauth2/authorize?scope=bot&client_id=%s&permissions=%d"
 	u := fmt.Sprintf(format, c.client.botID.String(), c.client.permissions)
 	return url.Parse(u)
@@ -492,7 +581,7 @@ func ensureDiscordGatewayURLHasQueryParams(urlString string) (string, error) {
 	return u.String(), nil
 }
 
-func exec(f func() (interface{}, error), flags ...Flag) (v interface{}, err error) {
+func exec(f func() (interface{}, error), flags ...RequestOption) (v interface{}, err error) {
 	if v, err = f(); err != nil {
 		return nil, err
 	}
@@ -505,7 +594,7 @@ func exec(f func() (interface{}, error), flags ...Flag) (v interface{}, err erro
 }
 
 // TODO: auto generate
-func getChannel(f func() (interface{}, error), flags ...Flag) (channel *Channel, err error) {
+func getChannel(f func() (interface{}, error), flags ...RequestOption) (channel *Channel, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -514,7 +603,7 @@ func getChannel(f func() (interface{}, error), flags ...Flag) (channel *Channel,
 }
 
 // TODO: auto generate
-func getChannels(f func() (interface{}, error), flags ...Flag) (channels []*Channel, err error) {
+func getChannels(f func() (interface{}, error), flags ...RequestOption) (channels []*Channel, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -527,8 +616,258 @@ func getChannels(f func() (interface{}, error), flags ...Flag) (channels []*Chan
 	panic("v was not assumed type. Got " + fmt.Sprint(v))
 }
 
+func getThreadMember(f func() (interface{}, error), flags ...RequestOption) (member *ThreadMember, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*ThreadMember), nil
+}
+
+func getThreadMembers(f func() (interface{}, error), flags ...RequestOption) (members []*ThreadMember, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*ThreadMember); ok {
+		return *list, nil
+	} else if list, ok := v.([]*ThreadMember); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getThreadList(f func() (interface{}, error), flags ...RequestOption) (list *ThreadList, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*ThreadList), nil
+}
+
+func getStageInstance(f func() (interface{}, error), flags ...RequestOption) (stage *StageInstance, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*StageInstance), nil
+}
+
+func getGuildScheduledEvent(f func() (interface{}, error), flags ...RequestOption) (event *GuildScheduledEvent, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildScheduledEvent), nil
+}
+
+func getGuildScheduledEvents(f func() (interface{}, error), flags ...RequestOption) (events []*GuildScheduledEvent, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*GuildScheduledEvent); ok {
+		return *list, nil
+	} else if list, ok := v.([]*GuildScheduledEvent); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getGuildScheduledEventUsers(f func() (interface{}, error), flags ...RequestOption) (users []*GuildScheduledEventUser, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*GuildScheduledEventUser); ok {
+		return *list, nil
+	} else if list, ok := v.([]*GuildScheduledEventUser); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getSticker(f func() (interface{}, error), flags ...RequestOption) (sticker *Sticker, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*Sticker), nil
+}
+
+func getStickers(f func() (interface{}, error), flags ...RequestOption) (stickers []*Sticker, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*Sticker); ok {
+		return *list, nil
+	} else if list, ok := v.([]*Sticker); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getAutoModerationRule(f func() (interface{}, error), flags ...RequestOption) (rule *AutoModerationRule, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*AutoModerationRule), nil
+}
+
+func getAutoModerationRules(f func() (interface{}, error), flags ...RequestOption) (rules []*AutoModerationRule, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*AutoModerationRule); ok {
+		return *list, nil
+	} else if list, ok := v.([]*AutoModerationRule); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getWelcomeScreen(f func() (interface{}, error), flags ...RequestOption) (screen *WelcomeScreen, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*WelcomeScreen), nil
+}
+
+func getGuildPreview(f func() (interface{}, error), flags ...RequestOption) (preview *GuildPreview, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildPreview), nil
+}
+
+func getGuildWidgetSettings(f func() (interface{}, error), flags ...RequestOption) (settings *GuildWidgetSettings, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildWidgetSettings), nil
+}
+
+func getGuildWidget(f func() (interface{}, error), flags ...RequestOption) (widget *GuildWidget, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildWidget), nil
+}
+
+func getGuildOnboarding(f func() (interface{}, error), flags ...RequestOption) (onboarding *GuildOnboarding, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildOnboarding), nil
+}
+
+func getGuildTemplate(f func() (interface{}, error), flags ...RequestOption) (template *GuildTemplate, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*GuildTemplate), nil
+}
+
+func getGuildTemplates(f func() (interface{}, error), flags ...RequestOption) (templates []*GuildTemplate, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*GuildTemplate); ok {
+		return *list, nil
+	} else if list, ok := v.([]*GuildTemplate); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getApplicationRoleConnection(f func() (interface{}, error), flags ...RequestOption) (connection *ApplicationRoleConnection, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*ApplicationRoleConnection), nil
+}
+
+func getApplicationRoleConnectionMetadata(f func() (interface{}, error), flags ...RequestOption) (records []*ApplicationRoleConnectionMetadata, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*ApplicationRoleConnectionMetadata); ok {
+		return *list, nil
+	} else if list, ok := v.([]*ApplicationRoleConnectionMetadata); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getSKUs(f func() (interface{}, error), flags ...RequestOption) (skus []*SKU, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*SKU); ok {
+		return *list, nil
+	} else if list, ok := v.([]*SKU); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getEntitlement(f func() (interface{}, error), flags ...RequestOption) (entitlement *Entitlement, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*Entitlement), nil
+}
+
+func getEntitlements(f func() (interface{}, error), flags ...RequestOption) (entitlements []*Entitlement, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*Entitlement); ok {
+		return *list, nil
+	} else if list, ok := v.([]*Entitlement); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
+func getSubscription(f func() (interface{}, error), flags ...RequestOption) (subscription *Subscription, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	return v.(*Subscription), nil
+}
+
+func getSubscriptions(f func() (interface{}, error), flags ...RequestOption) (subscriptions []*Subscription, err error) {
+	var v interface{}
+	if v, err = exec(f, flags...); err != nil {
+		return nil, err
+	}
+	if list, ok := v.(*[]*Subscription); ok {
+		return *list, nil
+	} else if list, ok := v.([]*Subscription); ok {
+		return list, nil
+	}
+	panic("v was not assumed type. Got " + fmt.Sprint(v))
+}
+
 // TODO: auto generate
-func getRole(f func() (interface{}, error), flags ...Flag) (role *Role, err error) {
+func getRole(f func() (interface{}, error), flags ...RequestOption) (role *Role, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -537,7 +876,7 @@ func getRole(f func() (interface{}, error), flags ...Flag) (role *Role, err erro
 }
 
 // TODO: auto generate
-func getRoles(f func() (interface{}, error), flags ...Flag) (roles []*Role, err error) {
+func getRoles(f func() (interface{}, error), flags ...RequestOption) (roles []*Role, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -551,7 +890,7 @@ func getRoles(f func() (interface{}, error), flags ...Flag) (roles []*Role, err
 }
 
 // TODO: auto generate
-func getMember(f func() (interface{}, error), flags ...Flag) (member *Member, err error) {
+func getMember(f func() (interface{}, error), flags ...RequestOption) (member *Member, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -560,7 +899,7 @@ func getMember(f func() (interface{}, error), flags ...Flag) (member *Member, er
 }
 
 // TODO: auto generate
-func getMembers(f func() (interface{}, error), flags ...Flag) (members []*Member, err error) {
+func getMembers(f func() (interface{}, error), flags ...RequestOption) (members []*Member, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -574,7 +913,7 @@ func getMembers(f func() (interface{}, error), flags ...Flag) (members []*Member
 }
 
 // TODO: auto generate
-func getWebhook(f func() (interface{}, error), flags ...Flag) (wh *Webhook, err error) {
+func getWebhook(f func() (interface{}, error), flags ...RequestOption) (wh *Webhook, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -583,7 +922,7 @@ func getWebhook(f func() (interface{}, error), flags ...Flag) (wh *Webhook, err
 }
 
 // TODO: auto generate
-func getWebhooks(f func() (interface{}, error), flags ...Flag) (whs []*Webhook, err error) {
+func getWebhooks(f func() (interface{}, error), flags ...RequestOption) (whs []*Webhook, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -597,7 +936,7 @@ func getWebhooks(f func() (interface{}, error), flags ...Flag) (whs []*Webhook,
 }
 
 // TODO: auto generate
-func getMessage(f func() (interface{}, error), flags ...Flag) (msg *Message, err error) {
+func getMessage(f func() (interface{}, error), flags ...RequestOption) (msg *Message, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -606,7 +945,7 @@ func getMessage(f func() (interface{}, error), flags ...Flag) (msg *Message, err
 }
 
 // TODO: auto generate
-func getMessages(f func() (interface{}, error), flags ...Flag) (msgs []*Message, err error) {
+func getMessages(f func() (interface{}, error), flags ...RequestOption) (msgs []*Message, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -620,7 +959,7 @@ func getMessages(f func() (interface{}, error), flags ...Flag) (msgs []*Message,
 }
 
 // TODO: auto generate
-func getUser(f func() (interface{}, error), flags ...Flag) (user *User, err error) {
+func getUser(f func() (interface{}, error), flags ...RequestOption) (user *User, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -629,7 +968,7 @@ func getUser(f func() (interface{}, error), flags ...Flag) (user *User, err erro
 }
 
 // TODO: auto generate
-func getUsers(f func() (interface{}, error), flags ...Flag) (users []*User, err error) {
+func getUsers(f func() (interface{}, error), flags ...RequestOption) (users []*User, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -643,7 +982,7 @@ func getUsers(f func() (interface{}, error), flags ...Flag) (users []*User, err
 }
 
 // TODO: auto generate
-func getNickName(f func() (interface{}, error), flags ...Flag) (nick string, err error) {
+func getNickName(f func() (interface{}, error), flags ...RequestOption) (nick string, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return "", err
@@ -652,7 +991,7 @@ func getNickName(f func() (interface{}, error), flags ...Flag) (nick string, err
 }
 
 // TODO: auto generate
-func getBan(f func() (interface{}, error), flags ...Flag) (ban *Ban, err error) {
+func getBan(f func() (interface{}, error), flags ...RequestOption) (ban *Ban, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -661,7 +1000,7 @@ func getBan(f func() (interface{}, error), flags ...Flag) (ban *Ban, err error)
 }
 
 // TODO: auto generate
-func getEmoji(f func() (interface{}, error), flags ...Flag) (emoji *Emoji, err error) {
+func getEmoji(f func() (interface{}, error), flags ...RequestOption) (emoji *Emoji, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -670,7 +1009,7 @@ func getEmoji(f func() (interface{}, error), flags ...Flag) (emoji *Emoji, err e
 }
 
 // TODO: auto generate
-func getInvite(f func() (interface{}, error), flags ...Flag) (invite *Invite, err error) {
+func getInvite(f func() (interface{}, error), flags ...RequestOption) (invite *Invite, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -679,7 +1018,7 @@ func getInvite(f func() (interface{}, error), flags ...Flag) (invite *Invite, er
 }
 
 // TODO: auto generate
-func getInvites(f func() (interface{}, error), flags ...Flag) (invite []*Invite, err error) {
+func getInvites(f func() (interface{}, error), flags ...RequestOption) (invite []*Invite, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -693,7 +1032,7 @@ func getInvites(f func() (interface{}, error), flags ...Flag) (invite []*Invite,
 }
 
 // TODO: auto generate
-func getGuild(f func() (interface{}, error), flags ...Flag) (guild *Guild, err error) {
+func getGuild(f func() (interface{}, error), flags ...RequestOption) (guild *Guild, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -702,7 +1041,7 @@ func getGuild(f func() (interface{}, error), flags ...Flag) (guild *Guild, err e
 }
 
 // TODO: auto generate
-func getIntegrations(f func() (interface{}, error), flags ...Flag) (integrations []*Integration, err error) {
+func getIntegrations(f func() (interface{}, error), flags ...RequestOption) (integrations []*Integration, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -716,7 +1055,7 @@ func getIntegrations(f func() (interface{}, error), flags ...Flag) (integrations
 }
 
 // TODO: auto generate
-func getVoiceRegions(f func() (interface{}, error), flags ...Flag) (regions []*VoiceRegion, err error) {
+func getVoiceRegions(f func() (interface{}, error), flags ...RequestOption) (regions []*VoiceRegion, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -730,7 +1069,7 @@ func getVoiceRegions(f func() (interface{}, error), flags ...Flag) (regions []*V
 }
 
 // TODO: auto generate
-func getVoiceRegion(f func() (interface{}, error), flags ...Flag) (region *VoiceRegion, err error) {
+func getVoiceRegion(f func() (interface{}, error), flags ...RequestOption) (region *VoiceRegion, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -739,7 +1078,7 @@ func getVoiceRegion(f func() (interface{}, error), flags ...Flag) (region *Voice
 }
 
 // TODO: auto generate
-func getPartialInvite(f func() (interface{}, error), flags ...Flag) (invite *PartialInvite, err error) {
+func getPartialInvite(f func() (interface{}, error), flags ...RequestOption) (invite *PartialInvite, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
@@ -748,7 +1087,7 @@ func getPartialInvite(f func() (interface{}, error), flags ...Flag) (invite *Par
 }
 
 // TODO: auto generate
-func getGuildEmbed(f func() (interface{}, error), flags ...Flag) (embed *GuildEmbed, err error) {
+func getGuildEmbed(f func() (interface{}, error), flags ...RequestOption) (embed *GuildEmbed, err error) {
 	var v interface{}
 	if v, err = exec(f, flags...); err != nil {
 		return nil, err
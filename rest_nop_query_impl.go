@@ -17,103 +17,187 @@ func (g guildQueryBuilderNop) WithContext(_ context.Context) GuildQueryBuilder {
 func (guildQueryBuilderNop) VoiceChannel(channelID Snowflake) VoiceChannelQueryBuilder {
 	return nil
 }
-func (guildQueryBuilderNop) Get(flags ...Flag) (guild *Guild, err error) {
+func (guildQueryBuilderNop) Get(flags ...RequestOption) (guild *Guild, err error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetChannels(flags ...Flag) ([]*Channel, error) {
+func (guildQueryBuilderNop) GetChannels(flags ...RequestOption) ([]*Channel, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetMembers(params *GetMembersParams, flags ...Flag) ([]*Member, error) {
+func (guildQueryBuilderNop) GetMembers(params *GetMembersParams, flags ...RequestOption) ([]*Member, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) UpdateBuilder(flags ...Flag) UpdateGuildBuilder {
+func (guildQueryBuilderNop) Presence(userID Snowflake) (*Presence, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) Presences() ([]*Presence, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) UpdateBuilder(flags ...RequestOption) UpdateGuildBuilder {
 	return nil
 }
-func (guildQueryBuilderNop) Delete(flags ...Flag) error {
+func (guildQueryBuilderNop) Delete(flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) CreateChannel(name string, params *CreateGuildChannelParams, flags ...Flag) (*Channel, error) {
+func (guildQueryBuilderNop) CreateChannel(name string, params *CreateGuildChannelParams, flags ...RequestOption) (*Channel, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...Flag) error {
+func (guildQueryBuilderNop) UpdateChannelPositions(params []UpdateGuildChannelPositionsParams, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...Flag) (*Member, error) {
+func (guildQueryBuilderNop) CreateMember(userID Snowflake, accessToken string, params *AddGuildMemberParams, flags ...RequestOption) (*Member, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) SetCurrentUserNick(nick string, flags ...Flag) (newNick string, err error) {
+func (guildQueryBuilderNop) SetCurrentUserNick(nick string, flags ...RequestOption) (newNick string, err error) {
 	return "", nil
 }
 func (guildQueryBuilderNop) KickVoiceParticipant(userID Snowflake) error {
 	return nil
 }
-func (guildQueryBuilderNop) GetBans(flags ...Flag) ([]*Ban, error) {
+func (guildQueryBuilderNop) GetBans(flags ...RequestOption) ([]*Ban, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetBan(userID Snowflake, flags ...Flag) (*Ban, error) {
+func (guildQueryBuilderNop) IterateBans(flags ...RequestOption) *BanIterator {
+	return nil
+}
+func (guildQueryBuilderNop) IterateMembers(params *GetMembersParams, flags ...RequestOption) *MemberIterator {
+	return nil
+}
+func (guildQueryBuilderNop) IterateAuditLogs(params *GetAuditLogsParams, flags ...RequestOption) *AuditLogIterator {
+	return nil
+}
+func (guildQueryBuilderNop) GetBan(userID Snowflake, flags ...RequestOption) (*Ban, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) UnbanUser(userID Snowflake, reason string, flags ...Flag) error {
+func (guildQueryBuilderNop) UnbanUser(userID Snowflake, reason string, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) GetRoles(flags ...Flag) ([]*Role, error) {
+func (guildQueryBuilderNop) BulkBan(params *BulkBanParams, flags ...RequestOption) (*BulkBanResponse, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetRoles(flags ...RequestOption) ([]*Role, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) CreateRole(params *CreateGuildRoleParams, flags ...Flag) (*Role, error) {
+func (guildQueryBuilderNop) CreateRole(params *CreateGuildRoleParams, flags ...RequestOption) (*Role, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...Flag) ([]*Role, error) {
+func (guildQueryBuilderNop) UpdateRolePositions(params []UpdateGuildRolePositionsParams, flags ...RequestOption) ([]*Role, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) EstimatePruneMembersCount(days int, flags ...Flag) (estimate int, err error) {
+func (guildQueryBuilderNop) EstimatePruneMembersCount(days int, flags ...RequestOption) (estimate int, err error) {
 	return 0, nil
 }
-func (guildQueryBuilderNop) PruneMembers(days int, reason string, flags ...Flag) error {
+func (guildQueryBuilderNop) PruneMembers(days int, reason string, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) GetVoiceRegions(flags ...Flag) ([]*VoiceRegion, error) {
+func (guildQueryBuilderNop) GetPruneCount(params *GetPruneCountParams, flags ...RequestOption) (int, error) {
+	return 0, nil
+}
+func (guildQueryBuilderNop) Prune(params *PruneMembersParams, flags ...RequestOption) (*int, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetVoiceRegions(flags ...RequestOption) ([]*VoiceRegion, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetInvites(flags ...Flag) ([]*Invite, error) {
+func (guildQueryBuilderNop) GetInvites(flags ...RequestOption) ([]*Invite, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetIntegrations(flags ...Flag) ([]*Integration, error) {
+func (guildQueryBuilderNop) GetIntegrations(flags ...RequestOption) ([]*Integration, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) CreateIntegration(params *CreateGuildIntegrationParams, flags ...Flag) error {
+func (guildQueryBuilderNop) CreateIntegration(params *CreateGuildIntegrationParams, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...Flag) error {
+func (guildQueryBuilderNop) UpdateIntegration(integrationID Snowflake, params *UpdateGuildIntegrationParams, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) DeleteIntegration(integrationID Snowflake, flags ...Flag) error {
+func (guildQueryBuilderNop) DeleteIntegration(integrationID Snowflake, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) SyncIntegration(integrationID Snowflake, flags ...Flag) error {
+func (guildQueryBuilderNop) SyncIntegration(integrationID Snowflake, flags ...RequestOption) error {
 	return nil
 }
-func (guildQueryBuilderNop) GetEmbed(flags ...Flag) (*GuildEmbed, error) {
+func (guildQueryBuilderNop) GetEmbed(flags ...RequestOption) (*GuildEmbed, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) UpdateEmbedBuilder(flags ...Flag) UpdateGuildEmbedBuilder {
+func (guildQueryBuilderNop) UpdateEmbedBuilder(flags ...RequestOption) UpdateGuildEmbedBuilder {
 	return nil
 }
-func (guildQueryBuilderNop) GetVanityURL(flags ...Flag) (*PartialInvite, error) {
+func (guildQueryBuilderNop) GetVanityURL(flags ...RequestOption) (*PartialInvite, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetWelcomeScreen(flags ...RequestOption) (*WelcomeScreen, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) UpdateWelcomeScreen(params *UpdateWelcomeScreenParams, flags ...RequestOption) (*WelcomeScreen, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetOnboarding(flags ...RequestOption) (*GuildOnboarding, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) UpdateOnboarding(params *UpdateGuildOnboardingParams, flags ...RequestOption) (*GuildOnboarding, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetPreview(flags ...RequestOption) (*GuildPreview, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetWidgetSettings(flags ...RequestOption) (*GuildWidgetSettings, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) UpdateWidgetSettings(params *UpdateGuildWidgetParams, flags ...RequestOption) (*GuildWidgetSettings, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetWidget(flags ...RequestOption) (*GuildWidget, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetTemplates(flags ...RequestOption) ([]*GuildTemplate, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) CreateTemplate(params *CreateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) SyncTemplate(code string, flags ...RequestOption) (*GuildTemplate, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) UpdateTemplate(code string, params *UpdateGuildTemplateParams, flags ...RequestOption) (*GuildTemplate, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) DeleteTemplate(code string, flags ...RequestOption) (*GuildTemplate, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetAuditLogs(flags ...Flag) GuildAuditLogsBuilder {
+func (guildQueryBuilderNop) GetAuditLogs(flags ...RequestOption) GuildAuditLogsBuilder {
 	return nil
 }
 func (guildQueryBuilderNop) VoiceConnect(channelID Snowflake) (ret VoiceConnection, err error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetEmojis(flags ...Flag) ([]*Emoji, error) {
+func (guildQueryBuilderNop) GetEmojis(flags ...RequestOption) ([]*Emoji, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) CreateEmoji(params *CreateGuildEmojiParams, flags ...RequestOption) (*Emoji, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetWebhooks(flags ...RequestOption) (ret []*Webhook, err error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetActiveThreads(flags ...RequestOption) (*ThreadList, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetScheduledEvents(withUserCount bool, flags ...RequestOption) ([]*GuildScheduledEvent, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) CreateEmoji(params *CreateGuildEmojiParams, flags ...Flag) (*Emoji, error) {
+func (guildQueryBuilderNop) CreateScheduledEvent(params *CreateGuildScheduledEventParams, flags ...RequestOption) (*GuildScheduledEvent, error) {
 	return nil, nil
 }
-func (guildQueryBuilderNop) GetWebhooks(flags ...Flag) (ret []*Webhook, err error) {
+func (guildQueryBuilderNop) GetStickers(flags ...RequestOption) ([]*Sticker, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) CreateSticker(params *CreateGuildStickerParams, flags ...RequestOption) (*Sticker, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) GetAutoModerationRules(flags ...RequestOption) ([]*AutoModerationRule, error) {
+	return nil, nil
+}
+func (guildQueryBuilderNop) CreateAutoModerationRule(params *CreateAutoModerationRuleParams, flags ...RequestOption) (*AutoModerationRule, error) {
 	return nil, nil
 }
 func (guildQueryBuilderNop) Member(userID Snowflake) GuildMemberQueryBuilder {
@@ -125,6 +209,15 @@ func (guildQueryBuilderNop) Role(roleID Snowflake) GuildRoleQueryBuilder {
 func (guildQueryBuilderNop) Emoji(emojiID Snowflake) GuildEmojiQueryBuilder {
 	return nil
 }
+func (guildQueryBuilderNop) ScheduledEvent(eventID Snowflake) GuildScheduledEventQueryBuilder {
+	return nil
+}
+func (guildQueryBuilderNop) Sticker(stickerID Snowflake) GuildStickerQueryBuilder {
+	return nil
+}
+func (guildQueryBuilderNop) AutoModerationRule(ruleID Snowflake) AutoModerationRuleQueryBuilder {
+	return nil
+}
 
 // currentUserQueryBuilderNop for testing
 type currentUserQueryBuilderNop struct{}
@@ -134,22 +227,28 @@ var _ CurrentUserQueryBuilder = (*currentUserQueryBuilderNop)(nil)
 func (c currentUserQueryBuilderNop) WithContext(_ context.Context) CurrentUserQueryBuilder {
 	return &c
 }
-func (currentUserQueryBuilderNop) Get(_ ...Flag) (*User, error) {
+func (currentUserQueryBuilderNop) Get(_ ...RequestOption) (*User, error) {
 	return nil, nil
 }
-func (currentUserQueryBuilderNop) UpdateBuilder(_ ...Flag) UpdateCurrentUserBuilder {
+func (currentUserQueryBuilderNop) UpdateBuilder(_ ...RequestOption) UpdateCurrentUserBuilder {
 	return nil
 }
-func (currentUserQueryBuilderNop) GetGuilds(_ *GetCurrentUserGuildsParams, _ ...Flag) ([]*Guild, error) {
+func (currentUserQueryBuilderNop) GetGuilds(_ *GetCurrentUserGuildsParams, _ ...RequestOption) ([]*Guild, error) {
 	return nil, nil
 }
-func (currentUserQueryBuilderNop) LeaveGuild(_ Snowflake, _ ...Flag) error {
+func (currentUserQueryBuilderNop) LeaveGuild(_ Snowflake, _ ...RequestOption) error {
 	return nil
 }
-func (currentUserQueryBuilderNop) CreateGroupDM(_ *CreateGroupDMParams, _ ...Flag) (*Channel, error) {
+func (currentUserQueryBuilderNop) CreateGroupDM(_ *CreateGroupDMParams, _ ...RequestOption) (*Channel, error) {
+	return nil, nil
+}
+func (currentUserQueryBuilderNop) GetUserConnections(_ ...RequestOption) ([]*UserConnection, error) {
+	return nil, nil
+}
+func (currentUserQueryBuilderNop) GetApplicationRoleConnection(_ Snowflake, _ string, _ ...RequestOption) (*ApplicationRoleConnection, error) {
 	return nil, nil
 }
-func (currentUserQueryBuilderNop) GetUserConnections(_ ...Flag) ([]*UserConnection, error) {
+func (currentUserQueryBuilderNop) UpdateApplicationRoleConnection(_ Snowflake, _ string, _ *UpdateApplicationRoleConnectionParams, _ ...RequestOption) (*ApplicationRoleConnection, error) {
 	return nil, nil
 }
 
@@ -161,9 +260,9 @@ var _ UserQueryBuilder = (*userQueryBuilderNop)(nil)
 func (u userQueryBuilderNop) WithContext(_ context.Context) UserQueryBuilder {
 	return u
 }
-func (userQueryBuilderNop) Get(_ ...Flag) (*User, error) {
+func (userQueryBuilderNop) Get(_ ...RequestOption) (*User, error) {
 	return nil, nil
 }
-func (userQueryBuilderNop) CreateDM(_ ...Flag) (*Channel, error) {
+func (userQueryBuilderNop) CreateDM(_ ...RequestOption) (*Channel, error) {
 	return nil, nil
 }
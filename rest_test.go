@@ -41,3 +41,14 @@ func TestParamHolder_URLQueryString(t *testing.T) {
 	params = urlQuery{}
 	verifyQueryString(t, params, "")
 }
+
+func TestGetEntitlementsParams_URLQueryString(t *testing.T) {
+	verifyQueryString(t, &GetEntitlementsParams{}, "")
+
+	params := &GetEntitlementsParams{
+		SKUIDs:         []Snowflake{1, 2},
+		Limit:          10,
+		IncludeDeleted: true,
+	}
+	verifyQueryString(t, params, "?exclude_deleted=false&limit=10&sku_ids=1%2C2")
+}
@@ -85,6 +85,9 @@ type CreateChannelInviteBuilder interface {
 	SetMaxUses(maxUses int) CreateChannelInviteBuilder
 	SetTemporary(temporary bool) CreateChannelInviteBuilder
 	SetUnique(unique bool) CreateChannelInviteBuilder
+	SetTargetType(targetType InviteTargetType) CreateChannelInviteBuilder
+	SetTargetUserID(targetUserID Snowflake) CreateChannelInviteBuilder
+	SetTargetApplicationID(targetApplicationID Snowflake) CreateChannelInviteBuilder
 }
 
 // IgnoreCache will not fetch the data from the cache if available, and always execute a
@@ -134,6 +137,23 @@ func (b *createChannelInviteBuilder) SetUnique(unique bool) CreateChannelInviteB
 	return b
 }
 
+func (b *createChannelInviteBuilder) SetTargetType(targetType InviteTargetType) CreateChannelInviteBuilder {
+	b.r.param("target_type", targetType)
+	return b
+}
+
+func (b *createChannelInviteBuilder) SetTargetUserID(targetUserID Snowflake) CreateChannelInviteBuilder {
+	b.r.addPrereq(targetUserID.IsZero(), "targetUserID can not be 0")
+	b.r.param("target_user_id", targetUserID)
+	return b
+}
+
+func (b *createChannelInviteBuilder) SetTargetApplicationID(targetApplicationID Snowflake) CreateChannelInviteBuilder {
+	b.r.addPrereq(targetApplicationID.IsZero(), "targetApplicationID can not be 0")
+	b.r.param("target_application_id", targetApplicationID)
+	return b
+}
+
 func (b *createChannelInviteBuilder) Execute() (invite *Invite, err error) {
 	var v interface{}
 	if v, err = b.r.execute(); err != nil {
@@ -158,6 +178,9 @@ type UpdateChannelBuilder interface {
 	SetTopic(topic string) UpdateChannelBuilder
 	SetPosition(position int) UpdateChannelBuilder
 	SetName(name string) UpdateChannelBuilder
+
+	SetRTCRegion(regionID string) UpdateChannelBuilder
+	AutomaticRTCRegion() UpdateChannelBuilder
 }
 
 // IgnoreCache will not fetch the data from the cache if available, and always execute a
@@ -532,6 +555,9 @@ type UpdateGuildMemberBuilder interface {
 
 	KickFromVoice() UpdateGuildMemberBuilder
 	DeleteNick() UpdateGuildMemberBuilder
+	SetCommunicationDisabledUntil(until Time) UpdateGuildMemberBuilder
+	RemoveTimeout() UpdateGuildMemberBuilder
+	WithReason(reason string) UpdateGuildMemberBuilder
 }
 
 // IgnoreCache will not fetch the data from the cache if available, and always execute a
@@ -0,0 +1,44 @@
+package disgord
+
+// RESTClient gives typed access to the Discord REST API through the same resource builders as the Client, such as
+// Guild, Channel, User and Webhook. It never connects to the gateway, and has no cache or event handlers, which
+// makes it a good fit for CLI tools and web backends.
+//  client, err := disgord.NewRESTClient(disgord.Config{BotToken: os.Getenv("DISCORD_TOKEN")})
+//  msg, err := client.Channel(channelID).CreateMessage(&disgord.CreateMessageParams{Content: "hello"})
+type RESTClient struct {
+	clientQueryBuilder
+}
+
+// NewRESTClient creates a RESTClient. Only the REST related options of the Config are used, and unlike NewClient
+// no request is made to verify the bot token.
+func NewRESTClient(conf Config) (*RESTClient, error) {
+	req, err := newRequester(&conf)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := newSubsystemLoggers(conf.Logger, conf.LogLevels)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		shutdownChan:    make(chan interface{}),
+		config:          &conf,
+		botToken:        conf.BotToken,
+		dispatcher:      newDispatcher(),
+		req:             req,
+		cache:           &CacheNop{},
+		log:             conf.Logger,
+		logs:            logs,
+		pool:            newPools(),
+		allowedMentions: conf.AllowedMentions,
+	}
+	if conf.OnUnknownField != nil {
+		c.unknownFields = newUnknownFields(conf.OnUnknownField)
+	}
+	c.clientQueryBuilder.client = c
+	// voice connections fail with an error, as there is no gateway connection to dispatch the voice state on
+	c.voiceRepository = newVoiceRepository(c)
+
+	return &RESTClient{clientQueryBuilder: c.clientQueryBuilder}, nil
+}
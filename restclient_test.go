@@ -0,0 +1,42 @@
+// +build !integration
+
+package disgord
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewRESTClient(t *testing.T) {
+	var requests int
+	client, err := NewRESTClient(Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			requests++
+			return jsonResponse(req, `{"id":"1","name":"general"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests to be made when creating the client. Got %d", requests)
+	}
+
+	for i := 0; i < 2; i++ {
+		channel, err := client.Channel(1).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if channel.Name != "general" {
+			t.Errorf("expected the channel name to be general. Got %s", channel.Name)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected every lookup to be a request, as there is no cache. Got %d requests", requests)
+	}
+
+	if _, err = client.Guild(2).VoiceChannel(3).Connect(false, false); err == nil {
+		t.Error("expected voice connections to fail without a gateway")
+	}
+}
@@ -77,11 +77,11 @@ func (r *Role) SetGuildID(id Snowflake) {
 type GuildRoleQueryBuilder interface {
 	WithContext(ctx context.Context) GuildRoleQueryBuilder
 
-	UpdateBuilder(flags ...Flag) (builder UpdateGuildRoleBuilder)
-	Delete(flags ...Flag) error
+	UpdateBuilder(flags ...RequestOption) (builder UpdateGuildRoleBuilder)
+	Delete(flags ...RequestOption) error
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) UpdateGuildRoleBuilder
+	Update(flags ...RequestOption) UpdateGuildRoleBuilder
 }
 
 func (g guildQueryBuilder) Role(id Snowflake) GuildRoleQueryBuilder {
@@ -102,7 +102,7 @@ func (g guildRoleQueryBuilder) WithContext(ctx context.Context) GuildRoleQueryBu
 
 // UpdateRole Modify a guild role. Requires the 'MANAGE_ROLES' permission.
 // Returns the updated role on success. Fires a Guild Role Update Gateway event.
-func (g guildRoleQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildRoleBuilder {
+func (g guildRoleQueryBuilder) UpdateBuilder(flags ...RequestOption) UpdateGuildRoleBuilder {
 	builder := &updateGuildRoleBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Role{}
@@ -120,7 +120,7 @@ func (g guildRoleQueryBuilder) UpdateBuilder(flags ...Flag) UpdateGuildRoleBuild
 
 // DeleteRole Delete a guild role. Requires the 'MANAGE_ROLES' permission.
 // Returns a 204 empty response on success. Fires a Guild Role Delete Gateway event.
-func (g guildRoleQueryBuilder) Delete(flags ...Flag) error {
+func (g guildRoleQueryBuilder) Delete(flags ...RequestOption) error {
 	r := g.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.GuildRole(g.gid, g.roleID),
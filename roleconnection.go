@@ -0,0 +1,225 @@
+package disgord
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// ApplicationRoleConnectionMetadataType https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object-application-role-connection-metadata-type
+type ApplicationRoleConnectionMetadataType uint
+
+const (
+	_ ApplicationRoleConnectionMetadataType = iota
+	RoleConnectionMetadataIntegerLessThanOrEqual
+	RoleConnectionMetadataIntegerGreaterThanOrEqual
+	RoleConnectionMetadataIntegerEqual
+	RoleConnectionMetadataIntegerNotEqual
+	RoleConnectionMetadataDatetimeLessThanOrEqual
+	RoleConnectionMetadataDatetimeGreaterThanOrEqual
+	RoleConnectionMetadataBooleanEqual
+	RoleConnectionMetadataBooleanNotEqual
+)
+
+const maxRoleConnectionMetadataRecords = 5
+
+// ApplicationRoleConnectionMetadata describes a requirement that guild admins can use to configure linked roles.
+// https://discord.com/developers/docs/resources/application-role-connection-metadata#application-role-connection-metadata-object
+type ApplicationRoleConnectionMetadata struct {
+	Type                     ApplicationRoleConnectionMetadataType `json:"type"`
+	Key                      string                                `json:"key"`
+	Name                     string                                `json:"name"`
+	NameLocalizations        Localizations                         `json:"name_localizations,omitempty"`
+	Description              string                                `json:"description"`
+	DescriptionLocalizations Localizations                         `json:"description_localizations,omitempty"`
+}
+
+func (m *ApplicationRoleConnectionMetadata) FindErrors() error {
+	if m.Type < RoleConnectionMetadataIntegerLessThanOrEqual || m.Type > RoleConnectionMetadataBooleanNotEqual {
+		return fmt.Errorf("unknown role connection metadata type %d", m.Type)
+	}
+	if m.Key == "" || len(m.Key) > 50 {
+		return errors.New("role connection metadata key must be between 1 and 50 characters")
+	}
+	for _, r := range m.Key {
+		if !(('a' <= r && r <= 'z') || ('0' <= r && r <= '9') || r == '_') {
+			return fmt.Errorf("role connection metadata key %q can only contain a-z, 0-9 and _", m.Key)
+		}
+	}
+	if m.Name == "" || len(m.Name) > 100 {
+		return errors.New("role connection metadata name must be between 1 and 100 characters")
+	}
+	if m.Description == "" || len(m.Description) > 200 {
+		return errors.New("role connection metadata description must be between 1 and 200 characters")
+	}
+	if err := validateLocalizations("name_localizations", m.NameLocalizations); err != nil {
+		return err
+	}
+	return validateLocalizations("description_localizations", m.DescriptionLocalizations)
+}
+
+// ApplicationRoleConnection is the role connection an application has attached to a user.
+// https://discord.com/developers/docs/resources/user#application-role-connection-object
+type ApplicationRoleConnection struct {
+	PlatformName     string `json:"platform_name"`
+	PlatformUsername string `json:"platform_username"`
+
+	// Metadata maps the keys of the application role connection metadata records to their
+	// stringified values.
+	Metadata map[string]string `json:"metadata"`
+}
+
+// UpdateApplicationRoleConnectionParams https://discord.com/developers/docs/resources/user#update-current-user-application-role-connection-json-params
+type UpdateApplicationRoleConnectionParams struct {
+	PlatformName     string            `json:"platform_name,omitempty"`
+	PlatformUsername string            `json:"platform_username,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+func (p *UpdateApplicationRoleConnectionParams) FindErrors() error {
+	if len(p.PlatformName) > 50 {
+		return errors.New("platform name can not be longer than 50 characters")
+	}
+	if len(p.PlatformUsername) > 100 {
+		return errors.New("platform username can not be longer than 100 characters")
+	}
+	for key, value := range p.Metadata {
+		if len(value) > 100 {
+			return fmt.Errorf("metadata value for %q can not be longer than 100 characters", key)
+		}
+	}
+	return nil
+}
+
+// GetRoleConnectionMetadata [REST] Returns a list of application role connection metadata objects for the
+// given application.
+//  Method                  GET
+//  Endpoint                /applications/{application.id}/role-connections/metadata
+//  Discord documentation   https://discord.com/developers/docs/resources/application-role-connection-metadata#get-application-role-connection-metadata-records
+//  Reviewed                2022-11-28
+//  Comment                 -
+func (a applicationQueryBuilder) GetRoleConnectionMetadata(flags ...RequestOption) ([]*ApplicationRoleConnectionMetadata, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ApplicationRoleConnectionMetadata(a.appID),
+		Ctx:      a.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*ApplicationRoleConnectionMetadata, 0)
+		return &tmp
+	}
+
+	return getApplicationRoleConnectionMetadata(r.Execute)
+}
+
+// UpdateRoleConnectionMetadata [REST] Updates and returns a list of application role connection metadata
+// objects for the given application. The given records replace all existing records.
+//  Method                  PUT
+//  Endpoint                /applications/{application.id}/role-connections/metadata
+//  Discord documentation   https://discord.com/developers/docs/resources/application-role-connection-metadata#update-application-role-connection-metadata-records
+//  Reviewed                2022-11-28
+//  Comment                 An application can have a maximum of 5 metadata records.
+func (a applicationQueryBuilder) UpdateRoleConnectionMetadata(records []*ApplicationRoleConnectionMetadata, flags ...RequestOption) ([]*ApplicationRoleConnectionMetadata, error) {
+	if err := a.validate(); err != nil {
+		return nil, err
+	}
+	if len(records) > maxRoleConnectionMetadataRecords {
+		return nil, fmt.Errorf("an application can have at most %d role connection metadata records", maxRoleConnectionMetadataRecords)
+	}
+	for i := range records {
+		if records[i] == nil {
+			return nil, errors.New("role connection metadata record was nil")
+		}
+		if err := records[i].FindErrors(); err != nil {
+			return nil, err
+		}
+	}
+	if records == nil {
+		// an empty list removes all records
+		records = []*ApplicationRoleConnectionMetadata{}
+	}
+
+	r := a.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPut,
+		Ctx:         a.ctx,
+		Endpoint:    endpoint.ApplicationRoleConnectionMetadata(a.appID),
+		Body:        records,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*ApplicationRoleConnectionMetadata, 0)
+		return &tmp
+	}
+
+	return getApplicationRoleConnectionMetadata(r.Execute)
+}
+
+// GetApplicationRoleConnection [REST] Returns the application role connection for the user. Requires an
+// OAuth2 access token with the role_connections.write scope for the application specified in the path.
+//  Method                  GET
+//  Endpoint                /users/@me/applications/{application.id}/role-connection
+//  Discord documentation   https://discord.com/developers/docs/resources/user#get-current-user-application-role-connection
+//  Reviewed                2022-11-28
+//  Comment                 The request is authorized with the given bearer token instead of the bot token.
+func (c currentUserQueryBuilder) GetApplicationRoleConnection(applicationID Snowflake, accessToken string, flags ...RequestOption) (*ApplicationRoleConnection, error) {
+	if applicationID.IsZero() {
+		return nil, errors.New("applicationID must be set to target the correct application")
+	}
+	authorization, err := bearerAuthorization(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint:      endpoint.UserMeApplicationRoleConnection(applicationID),
+		Ctx:           c.ctx,
+		Authorization: authorization,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ApplicationRoleConnection{}
+	}
+
+	return getApplicationRoleConnection(r.Execute)
+}
+
+// UpdateApplicationRoleConnection [REST] Updates and returns the application role connection for the user.
+// Requires an OAuth2 access token with the role_connections.write scope for the application specified in the path.
+//  Method                  PUT
+//  Endpoint                /users/@me/applications/{application.id}/role-connection
+//  Discord documentation   https://discord.com/developers/docs/resources/user#update-current-user-application-role-connection
+//  Reviewed                2022-11-28
+//  Comment                 The request is authorized with the given bearer token instead of the bot token.
+func (c currentUserQueryBuilder) UpdateApplicationRoleConnection(applicationID Snowflake, accessToken string, params *UpdateApplicationRoleConnectionParams, flags ...RequestOption) (*ApplicationRoleConnection, error) {
+	if applicationID.IsZero() {
+		return nil, errors.New("applicationID must be set to target the correct application")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+	authorization, err := bearerAuthorization(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:        httd.MethodPut,
+		Ctx:           c.ctx,
+		Endpoint:      endpoint.UserMeApplicationRoleConnection(applicationID),
+		Body:          params,
+		ContentType:   httd.ContentTypeJSON,
+		Authorization: authorization,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ApplicationRoleConnection{}
+	}
+
+	return getApplicationRoleConnection(r.Execute)
+}
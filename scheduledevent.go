@@ -0,0 +1,467 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
+)
+
+// GuildScheduledEventPrivacyLevel https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-object-guild-scheduled-event-privacy-level
+type GuildScheduledEventPrivacyLevel uint
+
+const GuildScheduledEventPrivacyGuildOnly GuildScheduledEventPrivacyLevel = 2
+
+// GuildScheduledEventStatus https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-object-guild-scheduled-event-status
+// Once the status is set to Completed or Canceled, the status can no longer be updated.
+type GuildScheduledEventStatus uint
+
+const (
+	_ GuildScheduledEventStatus = iota
+	GuildScheduledEventStatusScheduled
+	GuildScheduledEventStatusActive
+	GuildScheduledEventStatusCompleted
+	GuildScheduledEventStatusCanceled
+)
+
+// GuildScheduledEventEntityType https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-object-guild-scheduled-event-entity-types
+type GuildScheduledEventEntityType uint
+
+const (
+	_ GuildScheduledEventEntityType = iota
+	GuildScheduledEventEntityStageInstance
+	GuildScheduledEventEntityVoice
+	GuildScheduledEventEntityExternal
+)
+
+// GuildScheduledEventEntityMetadata holds additional metadata for the event. Location is required
+// for events with the entity type External.
+type GuildScheduledEventEntityMetadata struct {
+	Location string `json:"location,omitempty"`
+}
+
+// RecurrenceFrequency https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-recurrence-rule-object-guild-scheduled-event-recurrence-rule-frequency
+type RecurrenceFrequency uint
+
+const (
+	RecurrenceYearly RecurrenceFrequency = iota
+	RecurrenceMonthly
+	RecurrenceWeekly
+	RecurrenceDaily
+)
+
+// RecurrenceWeekday https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-recurrence-rule-object-guild-scheduled-event-recurrence-rule-weekday
+type RecurrenceWeekday uint
+
+const (
+	RecurrenceMonday RecurrenceWeekday = iota
+	RecurrenceTuesday
+	RecurrenceWednesday
+	RecurrenceThursday
+	RecurrenceFriday
+	RecurrenceSaturday
+	RecurrenceSunday
+)
+
+// RecurrenceNWeekday is a specific day within a week, eg. the second Tuesday of the month.
+type RecurrenceNWeekday struct {
+	N   int               `json:"n"` // 1-5
+	Day RecurrenceWeekday `json:"day"`
+}
+
+// GuildScheduledEventRecurrenceRule describes how often an event occurs. The rule is a subset
+// of the iCalendar RRULE specification.
+// https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-recurrence-rule-object
+type GuildScheduledEventRecurrenceRule struct {
+	Start      Time                 `json:"start"`
+	End        *Time                `json:"end,omitempty"`
+	Frequency  RecurrenceFrequency  `json:"frequency"`
+	Interval   int                  `json:"interval"`
+	ByWeekday  []RecurrenceWeekday  `json:"by_weekday,omitempty"`
+	ByNWeekday []RecurrenceNWeekday `json:"by_n_weekday,omitempty"`
+	ByMonth    []int                `json:"by_month,omitempty"`
+	ByMonthDay []int                `json:"by_month_day,omitempty"`
+	ByYearDay  []int                `json:"by_year_day,omitempty"`
+	Count      *int                 `json:"count,omitempty"`
+}
+
+// GuildScheduledEvent a scheduled event in a guild.
+// https://discord.com/developers/docs/resources/guild-scheduled-event#guild-scheduled-event-object
+type GuildScheduledEvent struct {
+	ID                 Snowflake                          `json:"id"`
+	GuildID            Snowflake                          `json:"guild_id"`
+	ChannelID          Snowflake                          `json:"channel_id,omitempty"`
+	CreatorID          Snowflake                          `json:"creator_id,omitempty"`
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description,omitempty"`
+	ScheduledStartTime Time                               `json:"scheduled_start_time"`
+	ScheduledEndTime   Time                               `json:"scheduled_end_time,omitempty"`
+	PrivacyLevel       GuildScheduledEventPrivacyLevel    `json:"privacy_level"`
+	Status             GuildScheduledEventStatus          `json:"status"`
+	EntityType         GuildScheduledEventEntityType      `json:"entity_type"`
+	EntityID           Snowflake                          `json:"entity_id,omitempty"`
+	EntityMetadata     *GuildScheduledEventEntityMetadata `json:"entity_metadata,omitempty"`
+	Creator            *User                              `json:"creator,omitempty"`
+	UserCount          uint                               `json:"user_count,omitempty"`
+	Image              string                             `json:"image,omitempty"` // cover image hash
+	RecurrenceRule     *GuildScheduledEventRecurrenceRule `json:"recurrence_rule,omitempty"`
+}
+
+var _ Copier = (*GuildScheduledEvent)(nil)
+var _ DeepCopier = (*GuildScheduledEvent)(nil)
+
+// GuildScheduledEventUser a user that has subscribed to a guild scheduled event.
+type GuildScheduledEventUser struct {
+	GuildScheduledEventID Snowflake `json:"guild_scheduled_event_id"`
+	User                  *User     `json:"user"`
+
+	// Member is only set when the users were requested with the member objects
+	Member *Member `json:"member,omitempty"`
+}
+
+func (u *GuildScheduledEventUser) userID() Snowflake {
+	if u.User == nil {
+		return 0
+	}
+	return u.User.ID
+}
+
+// CreateGuildScheduledEventParams https://discord.com/developers/docs/resources/guild-scheduled-event#create-guild-scheduled-event-json-params
+type CreateGuildScheduledEventParams struct {
+	ChannelID          Snowflake                          `json:"channel_id,omitempty"`
+	EntityMetadata     *GuildScheduledEventEntityMetadata `json:"entity_metadata,omitempty"`
+	Name               string                             `json:"name"`
+	PrivacyLevel       GuildScheduledEventPrivacyLevel    `json:"privacy_level"`
+	ScheduledStartTime Time                               `json:"scheduled_start_time"`
+	ScheduledEndTime   *Time                              `json:"scheduled_end_time,omitempty"`
+	Description        string                             `json:"description,omitempty"`
+	EntityType         GuildScheduledEventEntityType      `json:"entity_type"`
+	Image              string                             `json:"image,omitempty"` // base64 encoded cover image
+	RecurrenceRule     *GuildScheduledEventRecurrenceRule `json:"recurrence_rule,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *CreateGuildScheduledEventParams) FindErrors() error {
+	if p.Name == "" || len(p.Name) > 100 {
+		return errors.New("scheduled event name must be 1-100 characters long")
+	}
+	if len(p.Description) > 1000 {
+		return errors.New("scheduled event description can not be longer than 1000 characters")
+	}
+	if p.ScheduledStartTime.IsZero() {
+		return errors.New("scheduled event must have a start time")
+	}
+	if p.Image != "" && !validAvatarPrefix(p.Image) {
+		return errors.New("image string must be base64 encoded with base64 prefix")
+	}
+
+	switch p.EntityType {
+	case GuildScheduledEventEntityStageInstance, GuildScheduledEventEntityVoice:
+		if p.ChannelID.IsZero() {
+			return errors.New("a channel id is required for stage instance and voice events")
+		}
+	case GuildScheduledEventEntityExternal:
+		if !p.ChannelID.IsZero() {
+			return errors.New("external events can not be associated to a channel")
+		}
+		if p.EntityMetadata == nil || p.EntityMetadata.Location == "" {
+			return errors.New("a location is required for external events")
+		}
+		if p.ScheduledEndTime == nil || p.ScheduledEndTime.IsZero() {
+			return errors.New("an end time is required for external events")
+		}
+	default:
+		return errors.New("unknown scheduled event entity type")
+	}
+	return nil
+}
+
+// UpdateGuildScheduledEventParams https://discord.com/developers/docs/resources/guild-scheduled-event#modify-guild-scheduled-event-json-params
+// All fields are optional. Pointer fields are only sent when set. To move an event to an external location,
+// set the EntityType, EntityMetadata.Location and ScheduledEndTime; the channel id is cleared automatically.
+type UpdateGuildScheduledEventParams struct {
+	ChannelID          *Snowflake                         `json:"channel_id,omitempty"`
+	EntityMetadata     *GuildScheduledEventEntityMetadata `json:"entity_metadata,omitempty"`
+	Name               string                             `json:"name,omitempty"`
+	PrivacyLevel       GuildScheduledEventPrivacyLevel    `json:"privacy_level,omitempty"`
+	ScheduledStartTime *Time                              `json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   *Time                              `json:"scheduled_end_time,omitempty"`
+	Description        *string                            `json:"description,omitempty"`
+	EntityType         GuildScheduledEventEntityType      `json:"entity_type,omitempty"`
+	Status             GuildScheduledEventStatus          `json:"status,omitempty"`
+	Image              string                             `json:"image,omitempty"` // base64 encoded cover image
+	RecurrenceRule     *GuildScheduledEventRecurrenceRule `json:"recurrence_rule,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateGuildScheduledEventParams) FindErrors() error {
+	if len(p.Name) > 100 {
+		return errors.New("scheduled event name can not be longer than 100 characters")
+	}
+	if p.Description != nil && len(*p.Description) > 1000 {
+		return errors.New("scheduled event description can not be longer than 1000 characters")
+	}
+	if p.Image != "" && !validAvatarPrefix(p.Image) {
+		return errors.New("image string must be base64 encoded with base64 prefix")
+	}
+	if p.EntityType == GuildScheduledEventEntityExternal {
+		if p.EntityMetadata == nil || p.EntityMetadata.Location == "" {
+			return errors.New("a location is required for external events")
+		}
+		if p.ScheduledEndTime == nil || p.ScheduledEndTime.IsZero() {
+			return errors.New("an end time is required for external events")
+		}
+	}
+	return nil
+}
+
+// MarshalJSON sends a null channel id when the event is moved to an external location.
+func (p *UpdateGuildScheduledEventParams) MarshalJSON() ([]byte, error) {
+	type params UpdateGuildScheduledEventParams
+	if p.EntityType != GuildScheduledEventEntityExternal {
+		return json.Marshal((*params)(p))
+	}
+
+	return json.Marshal(&struct {
+		*params
+		ChannelID *Snowflake `json:"channel_id"`
+	}{params: (*params)(p)})
+}
+
+type getGuildScheduledEventsParams struct {
+	WithUserCount bool `urlparam:"with_user_count,omitempty"`
+}
+
+var _ URLQueryStringer = (*getGuildScheduledEventsParams)(nil)
+
+// GetGuildScheduledEventUsersParams https://discord.com/developers/docs/resources/guild-scheduled-event#get-guild-scheduled-event-users-query-string-params
+// Use Before or After to page through the users, users are ordered by their id.
+type GetGuildScheduledEventUsersParams struct {
+	Limit      int       `urlparam:"limit,omitempty"` // max number of users to return (1-100), defaults to 100
+	WithMember bool      `urlparam:"with_member,omitempty"`
+	Before     Snowflake `urlparam:"before,omitempty"`
+	After      Snowflake `urlparam:"after,omitempty"`
+}
+
+var _ URLQueryStringer = (*GetGuildScheduledEventUsersParams)(nil)
+
+func (g guildQueryBuilder) ScheduledEvent(eventID Snowflake) GuildScheduledEventQueryBuilder {
+	return &guildScheduledEventQueryBuilder{client: g.client, gid: g.gid, eventID: eventID}
+}
+
+// GetScheduledEvents [REST] Returns a list of guild scheduled events for the guild.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/scheduled-events
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#list-scheduled-events-for-guild
+//  Reviewed                2021-12-01
+//  Comment                 withUserCount includes the number of users subscribed to each event.
+func (g guildQueryBuilder) GetScheduledEvents(withUserCount bool, flags ...RequestOption) ([]*GuildScheduledEvent, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	params := &getGuildScheduledEventsParams{WithUserCount: withUserCount}
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildScheduledEvents(g.gid) + params.URLQueryString(),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*GuildScheduledEvent, 0)
+		return &tmp
+	}
+
+	return getGuildScheduledEvents(r.Execute)
+}
+
+// CreateScheduledEvent [REST] Create a guild scheduled event in the guild. Returns a guild scheduled event
+// object on success. Fires a Guild Scheduled Event Create Gateway event. A guild can have a maximum of 100
+// events with Scheduled or Active status at any time.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/scheduled-events
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#create-guild-scheduled-event
+//  Reviewed                2021-12-01
+//  Comment                 Requires the MANAGE_EVENTS permission.
+func (g guildQueryBuilder) CreateScheduledEvent(params *CreateGuildScheduledEventParams, flags ...RequestOption) (*GuildScheduledEvent, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildScheduledEvents(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildScheduledEvent{}
+	}
+
+	return getGuildScheduledEvent(r.Execute)
+}
+
+type GuildScheduledEventQueryBuilder interface {
+	WithContext(ctx context.Context) GuildScheduledEventQueryBuilder
+
+	// Get Get a guild scheduled event.
+	Get(withUserCount bool, flags ...RequestOption) (*GuildScheduledEvent, error)
+
+	// Update Modify a guild scheduled event.
+	Update(params *UpdateGuildScheduledEventParams, flags ...RequestOption) (*GuildScheduledEvent, error)
+
+	// Delete Delete a guild scheduled event.
+	Delete(flags ...RequestOption) error
+
+	// GetUsers Get a list of users subscribed to the guild scheduled event.
+	GetUsers(params *GetGuildScheduledEventUsersParams, flags ...RequestOption) ([]*GuildScheduledEventUser, error)
+
+	// IterateUsers Iterates over every user subscribed to the guild scheduled event.
+	IterateUsers(params *GetGuildScheduledEventUsersParams, flags ...RequestOption) *ScheduledEventUserIterator
+}
+
+type guildScheduledEventQueryBuilder struct {
+	ctx     context.Context
+	client  *Client
+	gid     Snowflake
+	eventID Snowflake
+}
+
+var _ GuildScheduledEventQueryBuilder = (*guildScheduledEventQueryBuilder)(nil)
+
+func (g guildScheduledEventQueryBuilder) WithContext(ctx context.Context) GuildScheduledEventQueryBuilder {
+	g.ctx = ctx
+	return &g
+}
+
+func (g guildScheduledEventQueryBuilder) validate() error {
+	if g.gid.IsZero() {
+		return errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if g.eventID.IsZero() {
+		return errors.New("eventID must be set to target the specific guild scheduled event")
+	}
+	return nil
+}
+
+// Get [REST] Get a guild scheduled event.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#get-guild-scheduled-event
+//  Reviewed                2021-12-01
+//  Comment                 withUserCount includes the number of users subscribed to the event.
+func (g guildScheduledEventQueryBuilder) Get(withUserCount bool, flags ...RequestOption) (*GuildScheduledEvent, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	params := &getGuildScheduledEventsParams{WithUserCount: withUserCount}
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildScheduledEvent(g.gid, g.eventID) + params.URLQueryString(),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildScheduledEvent{}
+	}
+
+	return getGuildScheduledEvent(r.Execute)
+}
+
+// Update [REST] Modify a guild scheduled event. Returns the modified guild scheduled event object on success.
+// Fires a Guild Scheduled Event Update Gateway event.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#modify-guild-scheduled-event
+//  Reviewed                2021-12-01
+//  Comment                 Requires the MANAGE_EVENTS permission. Set the Status to start or end an event.
+func (g guildScheduledEventQueryBuilder) Update(params *UpdateGuildScheduledEventParams, flags ...RequestOption) (*GuildScheduledEvent, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildScheduledEvent(g.gid, g.eventID),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &GuildScheduledEvent{}
+	}
+
+	return getGuildScheduledEvent(r.Execute)
+}
+
+// Delete [REST] Delete a guild scheduled event. Returns a 204 on success. Fires a Guild Scheduled Event
+// Delete Gateway event.
+//  Method                  DELETE
+//  Endpoint                /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#delete-guild-scheduled-event
+//  Reviewed                2021-12-01
+//  Comment                 Requires the MANAGE_EVENTS permission.
+func (g guildScheduledEventQueryBuilder) Delete(flags ...RequestOption) error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      g.ctx,
+		Endpoint: endpoint.GuildScheduledEvent(g.gid, g.eventID),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
+
+// GetUsers [REST] Get a list of guild scheduled event users subscribed to a guild scheduled event.
+// Returns a list of guild scheduled event user objects on success. Guild member data, if it exists,
+// is included if the WithMember param is set.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/scheduled-events/{guild_scheduled_event.id}/users
+//  Discord documentation   https://discord.com/developers/docs/resources/guild-scheduled-event#get-guild-scheduled-event-users
+//  Reviewed                2021-12-01
+//  Comment                 At most 100 users are returned per request, use the Before and After params to paginate,
+//                          or IterateUsers.
+func (g guildScheduledEventQueryBuilder) GetUsers(params *GetGuildScheduledEventUsersParams, flags ...RequestOption) ([]*GuildScheduledEventUser, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		params = &GetGuildScheduledEventUsersParams{}
+	}
+	if params.Limit < 0 || params.Limit > 100 {
+		return nil, errors.New("limit must be between 1 and 100")
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildScheduledEventUsers(g.gid, g.eventID) + params.URLQueryString(),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*GuildScheduledEventUser, 0)
+		return &tmp
+	}
+
+	return getGuildScheduledEventUsers(r.Execute)
+}
@@ -0,0 +1,79 @@
+package disgord
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// shutdownPollInterval is how often Shutdown checks whether the events and requests are done
+var shutdownPollInterval = 10 * time.Millisecond
+
+// Shutdown gracefully stops the client. The shards stop receiving events and close their connections without
+// invalidating the sessions, such that these can be resumed by the next process when a SessionStore is used.
+// Shutdown then waits for the received events to be handled, and the REST requests to finish, before
+// returning. When the context is done first, the remaining work is abandoned and the context error is returned.
+//  ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//  defer cancel()
+//  err := client.Shutdown(ctx)
+//
+// Use either Shutdown or Gateway().Disconnect, not both.
+func (c *Client) Shutdown(ctx context.Context) (err error) {
+	c.mu.RLock()
+	shards := c.shardManager
+	c.mu.RUnlock()
+
+	log := c.subsystemLog(LogGateway)
+	if shards != nil {
+		log.Info("Shutting down, waiting for handlers and requests to finish")
+		if err = shards.DisconnectResumable(); err != nil {
+			log.Error(err)
+		}
+	}
+
+	err = c.Drain(ctx)
+	if shards != nil {
+		c.shutdownOnce.Do(func() {
+			close(c.dispatcher.shutdown)
+			close(c.shutdownChan)
+		})
+	}
+	if err != nil {
+		err = fmt.Errorf("shutdown did not finish, %w", err)
+		log.Error(err)
+		return err
+	}
+
+	log.Info("Shut down")
+	return nil
+}
+
+// Drain waits until every received event has been dispatched and published to the event sink, and the REST
+// requests are done, without disconnecting. Events that keep arriving can postpone it until the context is
+// done, which is useful in tests and before taking a cache snapshot.
+func (c *Client) Drain(ctx context.Context) error {
+	ticker := time.NewTicker(shutdownPollInterval)
+	defer ticker.Stop()
+
+	// the demultiplexer counts an event just after taking it from the channel, so it must be idle for two
+	// checks in a row
+	var idle int
+	for {
+		events := int64(len(c.eventChan)) + c.dispatcher.pending.Load()
+		if c.forwarder != nil {
+			events += c.forwarder.pending.Load()
+		}
+		requests := c.pendingRequests.Load()
+		if events > 0 || requests > 0 {
+			idle = 0
+		} else if idle++; idle == 2 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d events and %d requests were left: %w", events, requests, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+)
+
+func TestClient_Shutdown(t *testing.T) {
+	release := make(chan struct{})
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			<-release
+			return jsonResponse(req, `{"id":"1"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan struct{})
+	client.Gateway().MessageCreate(func(s Session, h *MessageCreate) {
+		if _, err := s.Channel(1).Get(IgnoreCache); err != nil {
+			t.Error(err)
+		}
+		close(handled)
+	})
+
+	go client.demultiplexer(client.dispatcher, client.eventChan)
+	client.eventChan <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"2","channel_id":"1"}`)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err = client.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the shutdown to wait for the handler. Got %v", err)
+	}
+
+	close(release)
+	if err = client.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-handled:
+	default:
+		t.Error("expected the handler to be done")
+	}
+}
+
+// shutdownShards is a shard manager that only supports being disconnected
+type shutdownShards struct {
+	gateway.ShardManager
+	disconnects int
+}
+
+func (s *shutdownShards) DisconnectResumable() error {
+	s.disconnects++
+	return nil
+}
+
+func TestClient_Shutdown_Retry(t *testing.T) {
+	client, err := NewClient(context.Background(), Config{BotToken: "testing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shards := &shutdownShards{}
+	client.shardManager = shards
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		if err = client.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected the shutdown to be abandoned. Got %v", err)
+		}
+	}
+
+	if shards.disconnects != 2 {
+		t.Errorf("expected the shards to be disconnected on every attempt. Got %d", shards.disconnects)
+	}
+	select {
+	case <-client.shutdownChan:
+	default:
+		t.Error("expected the client to be shut down")
+	}
+}
@@ -0,0 +1,49 @@
+package disgord
+
+import (
+	"sort"
+	"time"
+)
+
+// discordEpoch is the first millisecond of 2015, in unix milliseconds
+const discordEpoch = 1420070400000
+
+// SnowflakeTime returns the time the snowflake was created.
+func SnowflakeTime(id Snowflake) time.Time {
+	ms := int64(id>>22) + discordEpoch
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// SnowflakeFromTime creates the lowest snowflake of the given time, which can be used as a cursor to
+// paginate by time. Snowflakes created at the same millisecond are all greater or equal.
+//  // messages sent within the last hour
+//  after := disgord.SnowflakeFromTime(time.Now().Add(-time.Hour))
+//  msgs, err := client.Channel(channelID).GetMessages(&disgord.GetMessagesParams{After: after})
+//
+// Times before the Discord epoch, 2015, results in 0.
+func SnowflakeFromTime(t time.Time) Snowflake {
+	ms := t.UnixNano()/int64(time.Millisecond) - discordEpoch
+	if ms < 0 {
+		return 0
+	}
+	return Snowflake(uint64(ms) << 22)
+}
+
+// CompareSnowflakes returns -1 when a was created before b, 1 when a was created after b, and 0 when they
+// are equal. Snowflakes created at the same millisecond are compared by their remaining bits.
+func CompareSnowflakes(a, b Snowflake) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// SortSnowflakes sorts the snowflakes by creation time, oldest first.
+func SortSnowflakes(ids []Snowflake) {
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+}
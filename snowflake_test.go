@@ -0,0 +1,42 @@
+// +build !integration
+
+package disgord
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnowflakeTime(t *testing.T) {
+	// example from the Discord documentation
+	id := Snowflake(175928847299117063)
+	created := time.Date(2016, time.April, 30, 11, 18, 25, 796*int(time.Millisecond), time.UTC)
+	if got := SnowflakeTime(id); !got.Equal(created) {
+		t.Errorf("incorrect creation time. Got %s, wants %s", got, created)
+	}
+
+	cursor := SnowflakeFromTime(created)
+	if !SnowflakeTime(cursor).Equal(created) {
+		t.Error("expected the cursor to be created at the same time")
+	}
+	if cursor > id || id-cursor >= 1<<22 {
+		t.Errorf("expected the cursor to be the lowest snowflake of the millisecond. Got %d", cursor)
+	}
+
+	if SnowflakeFromTime(time.Date(2014, time.January, 1, 0, 0, 0, 0, time.UTC)) != 0 {
+		t.Error("expected times before the Discord epoch to result in 0")
+	}
+}
+
+func TestSortSnowflakes(t *testing.T) {
+	ids := []Snowflake{3, 1, 2}
+	SortSnowflakes(ids)
+	if !reflect.DeepEqual(ids, []Snowflake{1, 2, 3}) {
+		t.Errorf("expected the snowflakes to be sorted. Got %v", ids)
+	}
+
+	if CompareSnowflakes(1, 2) != -1 || CompareSnowflakes(2, 1) != 1 || CompareSnowflakes(2, 2) != 0 {
+		t.Error("incorrect comparison")
+	}
+}
@@ -61,6 +61,18 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*AuditLogOption:
 		s = *t
+	case *[]*AutoModerationAction:
+		s = *t
+	case *[]*AutoModerationActionMetadata:
+		s = *t
+	case *[]*AutoModerationRule:
+		s = *t
+	case *[]*AutoModerationTriggerMetadata:
+		s = *t
+	case *[]*CreateAutoModerationRuleParams:
+		s = *t
+	case *[]*UpdateAutoModerationRuleParams:
+		s = *t
 	case *[]*BasicCache:
 		s = *t
 	case *[]*AllowedMentions:
@@ -75,8 +87,12 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*CreateWebhookParams:
 		s = *t
+	case *[]*DefaultReaction:
+		s = *t
 	case *[]*DeleteMessagesParams:
 		s = *t
+	case *[]*ForumTag:
+		s = *t
 	case *[]*GetMessagesParams:
 		s = *t
 	case *[]*GroupDMParticipant:
@@ -113,6 +129,22 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*Emoji:
 		s = *t
+	case *[]*CreateTestEntitlementParams:
+		s = *t
+	case *[]*Entitlement:
+		s = *t
+	case *[]*GetEntitlementsParams:
+		s = *t
+	case *[]*SKU:
+		s = *t
+	case *[]*AutoModerationActionExecution:
+		s = *t
+	case *[]*AutoModerationRuleCreate:
+		s = *t
+	case *[]*AutoModerationRuleDelete:
+		s = *t
+	case *[]*AutoModerationRuleUpdate:
+		s = *t
 	case *[]*ChannelCreate:
 		s = *t
 	case *[]*ChannelDelete:
@@ -121,6 +153,12 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*ChannelUpdate:
 		s = *t
+	case *[]*EntitlementCreate:
+		s = *t
+	case *[]*EntitlementDelete:
+		s = *t
+	case *[]*EntitlementUpdate:
+		s = *t
 	case *[]*GuildBanAdd:
 		s = *t
 	case *[]*GuildBanRemove:
@@ -147,6 +185,18 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*GuildRoleUpdate:
 		s = *t
+	case *[]*GuildScheduledEventCreate:
+		s = *t
+	case *[]*GuildScheduledEventDelete:
+		s = *t
+	case *[]*GuildScheduledEventUpdate:
+		s = *t
+	case *[]*GuildScheduledEventUserAdd:
+		s = *t
+	case *[]*GuildScheduledEventUserRemove:
+		s = *t
+	case *[]*GuildStickersUpdate:
+		s = *t
 	case *[]*GuildUpdate:
 		s = *t
 	case *[]*InteractionCreate:
@@ -161,6 +211,10 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*MessageDeleteBulk:
 		s = *t
+	case *[]*MessagePollVoteAdd:
+		s = *t
+	case *[]*MessagePollVoteRemove:
+		s = *t
 	case *[]*MessageReactionAdd:
 		s = *t
 	case *[]*MessageReactionRemove:
@@ -177,6 +231,30 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*Resumed:
 		s = *t
+	case *[]*StageInstanceCreate:
+		s = *t
+	case *[]*StageInstanceDelete:
+		s = *t
+	case *[]*StageInstanceUpdate:
+		s = *t
+	case *[]*SubscriptionCreate:
+		s = *t
+	case *[]*SubscriptionDelete:
+		s = *t
+	case *[]*SubscriptionUpdate:
+		s = *t
+	case *[]*ThreadCreate:
+		s = *t
+	case *[]*ThreadDelete:
+		s = *t
+	case *[]*ThreadListSync:
+		s = *t
+	case *[]*ThreadMemberUpdate:
+		s = *t
+	case *[]*ThreadMembersUpdate:
+		s = *t
+	case *[]*ThreadUpdate:
+		s = *t
 	case *[]*TypingStart:
 		s = *t
 	case *[]*UserUpdate:
@@ -255,6 +333,28 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*MessageSticker:
 		s = *t
+	case *[]*GuildOnboarding:
+		s = *t
+	case *[]*OnboardingPrompt:
+		s = *t
+	case *[]*OnboardingPromptOption:
+		s = *t
+	case *[]*UpdateGuildOnboardingParams:
+		s = *t
+	case *[]*CreatePollParams:
+		s = *t
+	case *[]*GetPollAnswerVotersParams:
+		s = *t
+	case *[]*Poll:
+		s = *t
+	case *[]*PollAnswer:
+		s = *t
+	case *[]*PollAnswerCount:
+		s = *t
+	case *[]*PollMedia:
+		s = *t
+	case *[]*PollResults:
+		s = *t
 	case *[]*GetReactionURLParams:
 		s = *t
 	case *[]*Reaction:
@@ -265,10 +365,60 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*Role:
 		s = *t
+	case *[]*CreateGuildScheduledEventParams:
+		s = *t
+	case *[]*GetGuildScheduledEventUsersParams:
+		s = *t
+	case *[]*GuildScheduledEvent:
+		s = *t
+	case *[]*GuildScheduledEventEntityMetadata:
+		s = *t
+	case *[]*GuildScheduledEventRecurrenceRule:
+		s = *t
+	case *[]*GuildScheduledEventUser:
+		s = *t
+	case *[]*RecurrenceNWeekday:
+		s = *t
+	case *[]*UpdateGuildScheduledEventParams:
+		s = *t
+	case *[]*CreateStageInstanceParams:
+		s = *t
+	case *[]*StageInstance:
+		s = *t
+	case *[]*UpdateStageInstanceParams:
+		s = *t
+	case *[]*CreateGuildStickerParams:
+		s = *t
+	case *[]*Sticker:
+		s = *t
+	case *[]*StickerItem:
+		s = *t
+	case *[]*StickerPack:
+		s = *t
+	case *[]*UpdateGuildStickerParams:
+		s = *t
 	case *[]*ErrorUnsupportedType:
 		s = *t
 	case *[]*Time:
 		s = *t
+	case *[]*GetSKUSubscriptionsParams:
+		s = *t
+	case *[]*Subscription:
+		s = *t
+	case *[]*ForumThread:
+		s = *t
+	case *[]*GetArchivedThreadsParams:
+		s = *t
+	case *[]*StartThreadInForumParams:
+		s = *t
+	case *[]*StartThreadParams:
+		s = *t
+	case *[]*ThreadList:
+		s = *t
+	case *[]*ThreadMember:
+		s = *t
+	case *[]*ThreadMetadata:
+		s = *t
 	case *[]*Activity:
 		s = *t
 	case *[]*ActivityAssets:
@@ -301,6 +451,12 @@ func derefSliceP(v interface{}) (s interface{}) {
 		s = *t
 	case *[]*Webhook:
 		s = *t
+	case *[]*UpdateWelcomeScreenParams:
+		s = *t
+	case *[]*WelcomeScreen:
+		s = *t
+	case *[]*WelcomeScreenChannel:
+		s = *t
 	default:
 		s = t
 	}
@@ -329,6 +485,12 @@ func sortByID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*AutoModerationRule:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*Attachment:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
@@ -341,6 +503,12 @@ func sortByID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*ForumTag:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*PartialChannel:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
@@ -359,12 +527,30 @@ func sortByID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*Entitlement:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*SKU:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*InteractionCreate:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*ThreadMembersUpdate:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*CreateGuildIntegrationParams:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
@@ -443,12 +629,72 @@ func sortByID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*OnboardingPrompt:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*OnboardingPromptOption:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*PollAnswerCount:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*Role:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
 		} else {
 			less = func(i, j int) bool { return s[i].ID < s[j].ID }
 		}
+	case []*GuildScheduledEvent:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*StageInstance:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*Sticker:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*StickerItem:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*StickerPack:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*Subscription:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
+	case []*ThreadMember:
+		if descending {
+			less = func(i, j int) bool { return s[i].ID > s[j].ID }
+		} else {
+			less = func(i, j int) bool { return s[i].ID < s[j].ID }
+		}
 	case []*ActivityEmoji:
 		if descending {
 			less = func(i, j int) bool { return s[i].ID > s[j].ID }
@@ -501,12 +747,36 @@ func sortByGuildID(v interface{}, flags Flag) {
 
 	var less func(i, j int) bool
 	switch s := v.(type) {
+	case []*AutoModerationRule:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*Channel:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
 		} else {
 			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
 		}
+	case []*Entitlement:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*GetEntitlementsParams:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*AutoModerationActionExecution:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*ChannelPinsUpdate:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
@@ -567,6 +837,24 @@ func sortByGuildID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
 		}
+	case []*GuildScheduledEventUserAdd:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*GuildScheduledEventUserRemove:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*GuildStickersUpdate:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*InteractionCreate:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
@@ -591,6 +879,18 @@ func sortByGuildID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
 		}
+	case []*MessagePollVoteAdd:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*MessagePollVoteRemove:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*MessageReactionRemoveEmoji:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
@@ -603,6 +903,18 @@ func sortByGuildID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
 		}
+	case []*ThreadListSync:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*ThreadMembersUpdate:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*TypingStart:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
@@ -645,6 +957,36 @@ func sortByGuildID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
 		}
+	case []*GuildOnboarding:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*GuildScheduledEvent:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*StageInstance:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*Sticker:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
+	case []*ThreadMember:
+		if descending {
+			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
+		} else {
+			less = func(i, j int) bool { return s[i].GuildID < s[j].GuildID }
+		}
 	case []*UserPresence:
 		if descending {
 			less = func(i, j int) bool { return s[i].GuildID > s[j].GuildID }
@@ -685,6 +1027,18 @@ func sortByChannelID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
 		}
+	case []*AutoModerationActionMetadata:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
+	case []*AutoModerationActionExecution:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
 	case []*ChannelPinsUpdate:
 		if descending {
 			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
@@ -721,6 +1075,18 @@ func sortByChannelID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
 		}
+	case []*MessagePollVoteAdd:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
+	case []*MessagePollVoteRemove:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
 	case []*MessageReactionAdd:
 		if descending {
 			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
@@ -775,6 +1141,30 @@ func sortByChannelID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
 		}
+	case []*CreateGuildScheduledEventParams:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
+	case []*GuildScheduledEvent:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
+	case []*CreateStageInstanceParams:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
+	case []*StageInstance:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
 	case []*VoiceState:
 		if descending {
 			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
@@ -787,6 +1177,12 @@ func sortByChannelID(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
 		}
+	case []*WelcomeScreenChannel:
+		if descending {
+			less = func(i, j int) bool { return s[i].ChannelID > s[j].ChannelID }
+		} else {
+			less = func(i, j int) bool { return s[i].ChannelID < s[j].ChannelID }
+		}
 	default:
 		panic(fmt.Sprintf("type %+v does not support sorting", s))
 	}
@@ -803,6 +1199,24 @@ func sortByName(v interface{}, flags Flag) {
 
 	var less func(i, j int) bool
 	switch s := v.(type) {
+	case []*AutoModerationRule:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*CreateAutoModerationRuleParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*UpdateAutoModerationRuleParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
 	case []*Channel:
 		if descending {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
@@ -815,6 +1229,12 @@ func sortByName(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
 		}
+	case []*ForumTag:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
 	case []*PartialChannel:
 		if descending {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
@@ -845,6 +1265,12 @@ func sortByName(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
 		}
+	case []*SKU:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
 	case []*CreateGuildChannelParams:
 		if descending {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
@@ -929,6 +1355,66 @@ func sortByName(v interface{}, flags Flag) {
 		} else {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
 		}
+	case []*CreateGuildScheduledEventParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*GuildScheduledEvent:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*UpdateGuildScheduledEventParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*CreateGuildStickerParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*Sticker:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*StickerItem:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*StickerPack:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*UpdateGuildStickerParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*StartThreadInForumParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
+	case []*StartThreadParams:
+		if descending {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
+		} else {
+			less = func(i, j int) bool { return strings.ToLower(s[i].Name) < strings.ToLower(s[j].Name) }
+		}
 	case []*Activity:
 		if descending {
 			less = func(i, j int) bool { return strings.ToLower(s[i].Name) > strings.ToLower(s[j].Name) }
@@ -0,0 +1,189 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// StagePrivacyLevel https://discord.com/developers/docs/resources/stage-instance#stage-instance-object-privacy-level
+type StagePrivacyLevel uint
+
+const (
+	_ StagePrivacyLevel = iota
+	// Deprecated: StagePrivacyPublic is no longer supported by Discord
+	StagePrivacyPublic
+	StagePrivacyGuildOnly
+)
+
+// StageInstance holds information about a live stage.
+// https://discord.com/developers/docs/resources/stage-instance#stage-instance-object
+type StageInstance struct {
+	ID                    Snowflake         `json:"id"`
+	GuildID               Snowflake         `json:"guild_id"`
+	ChannelID             Snowflake         `json:"channel_id"`
+	Topic                 string            `json:"topic"`
+	PrivacyLevel          StagePrivacyLevel `json:"privacy_level"`
+	DiscoverableDisabled  bool              `json:"discoverable_disabled"`
+	GuildScheduledEventID Snowflake         `json:"guild_scheduled_event_id,omitempty"`
+}
+
+var _ Copier = (*StageInstance)(nil)
+var _ DeepCopier = (*StageInstance)(nil)
+
+func validateStageTopic(topic string) error {
+	if topic == "" {
+		return errors.New("stage instance must have a topic")
+	}
+	if len(topic) > 120 {
+		return errors.New("stage topic can not be longer than 120 characters")
+	}
+	return nil
+}
+
+// CreateStageInstanceParams https://discord.com/developers/docs/resources/stage-instance#create-stage-instance-json-params
+type CreateStageInstanceParams struct {
+	ChannelID    Snowflake         `json:"channel_id"`
+	Topic        string            `json:"topic"`
+	PrivacyLevel StagePrivacyLevel `json:"privacy_level,omitempty"`
+
+	// SendStartNotification notifies @everyone that the stage instance has started.
+	// Requires the MENTION_EVERYONE permission.
+	SendStartNotification bool `json:"send_start_notification,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *CreateStageInstanceParams) FindErrors() error {
+	return validateStageTopic(p.Topic)
+}
+
+// UpdateStageInstanceParams https://discord.com/developers/docs/resources/stage-instance#modify-stage-instance-json-params
+type UpdateStageInstanceParams struct {
+	Topic        string            `json:"topic,omitempty"`
+	PrivacyLevel StagePrivacyLevel `json:"privacy_level,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateStageInstanceParams) FindErrors() error {
+	if p.Topic == "" {
+		return nil
+	}
+	return validateStageTopic(p.Topic)
+}
+
+// CreateStageInstance [REST] Creates a new stage instance associated to this stage channel. Requires the user to
+// be a moderator of the stage channel. Returns the stage instance and fires a Stage Instance Create Gateway event.
+//  Method                  POST
+//  Endpoint                /stage-instances
+//  Discord documentation   https://discord.com/developers/docs/resources/stage-instance#create-stage-instance
+//  Reviewed                2021-08-28
+//  Comment                 The channel id in the params is ignored, the channel of the query builder is used.
+func (c channelQueryBuilder) CreateStageInstance(params *CreateStageInstanceParams, flags ...RequestOption) (*StageInstance, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct stage channel")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+	withChannel := *params
+	withChannel.ChannelID = c.cid
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         c.ctx,
+		Endpoint:    endpoint.StageInstances(),
+		Body:        &withChannel,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &StageInstance{}
+	}
+
+	return getStageInstance(r.Execute)
+}
+
+// GetStageInstance [REST] Gets the stage instance associated with the stage channel, if it exists.
+//  Method                  GET
+//  Endpoint                /stage-instances/{channel.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/stage-instance#get-stage-instance
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) GetStageInstance(flags ...RequestOption) (*StageInstance, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct stage channel")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.StageInstance(c.cid),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &StageInstance{}
+	}
+
+	return getStageInstance(r.Execute)
+}
+
+// UpdateStageInstance [REST] Updates fields of an existing stage instance. Requires the user to be a moderator
+// of the stage channel. Returns the updated stage instance and fires a Stage Instance Update Gateway event.
+//  Method                  PATCH
+//  Endpoint                /stage-instances/{channel.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/stage-instance#modify-stage-instance
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) UpdateStageInstance(params *UpdateStageInstanceParams, flags ...RequestOption) (*StageInstance, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct stage channel")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         c.ctx,
+		Endpoint:    endpoint.StageInstance(c.cid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &StageInstance{}
+	}
+
+	return getStageInstance(r.Execute)
+}
+
+// DeleteStageInstance [REST] Deletes the stage instance, closing the stage. Requires the user to be a moderator
+// of the stage channel. Returns a 204 empty response on success and fires a Stage Instance Delete Gateway event.
+//  Method                  DELETE
+//  Endpoint                /stage-instances/{channel.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/stage-instance#delete-stage-instance
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) DeleteStageInstance(flags ...RequestOption) error {
+	if c.cid.IsZero() {
+		return errors.New("channelID must be set to target the correct stage channel")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      c.ctx,
+		Endpoint: endpoint.StageInstance(c.cid),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
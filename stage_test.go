@@ -0,0 +1,88 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/json"
+)
+
+func TestStageInstance(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	var reason string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/stage-instances"):]
+			requests = append(requests, req.Method+" "+path)
+			reason = req.Header.Get(httd.XAuditLogReason)
+			body = nil
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+
+			if req.Method == http.MethodDelete {
+				resp := jsonResponse(req, "")
+				resp.StatusCode = http.StatusNoContent
+				return resp, nil
+			}
+			return jsonResponse(req, `{"id":"3","guild_id":"1","channel_id":"2","topic":"town hall","privacy_level":2}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := &CreateStageInstanceParams{Topic: "town hall", SendStartNotification: true, Reason: "event"}
+	stage, err := client.Channel(2).CreateStageInstance(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage.ID != 3 || stage.GuildID != 1 || stage.ChannelID != 2 || stage.Topic != "town hall" || stage.PrivacyLevel != StagePrivacyGuildOnly {
+		t.Errorf("unexpected stage instance %+v", stage)
+	}
+	if body["channel_id"] != "2" || body["topic"] != "town hall" || body["send_start_notification"] != true {
+		t.Errorf("unexpected body %+v", body)
+	}
+	if !params.ChannelID.IsZero() {
+		t.Error("expected the params to be left as is")
+	}
+	if reason != "event" {
+		t.Errorf("expected the audit log reason to be set. Got %q", reason)
+	}
+
+	if _, err = client.Channel(2).GetStageInstance(IgnoreCache); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Channel(2).UpdateStageInstance(&UpdateStageInstanceParams{Topic: "q&a"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != 1 || body["topic"] != "q&a" {
+		t.Errorf("expected only the topic to be updated. Got %+v", body)
+	}
+	if err = client.Channel(2).DeleteStageInstance(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /stage-instances",
+		"GET /stage-instances/2",
+		"PATCH /stage-instances/2",
+		"DELETE /stage-instances/2",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	if _, err = client.Channel(2).CreateStageInstance(&CreateStageInstanceParams{}); err == nil {
+		t.Error("expected an error when the stage instance has no topic")
+	}
+}
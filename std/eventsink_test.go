@@ -0,0 +1,103 @@
+// +build !integration
+
+package std
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord"
+)
+
+func TestNATSEventSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("unable to listen on localhost:", err)
+	}
+	defer listener.Close()
+
+	published := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test"}` + "\r\n"))
+		for {
+			line, err := readNATSLine(reader)
+			if err != nil {
+				return
+			}
+			switch {
+			case line == "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			case strings.HasPrefix(line, "PUB "):
+				fields := strings.Fields(line)
+				size, _ := strconv.Atoi(fields[2])
+				msg := make([]byte, size+2)
+				if _, err = io.ReadFull(reader, msg); err != nil {
+					return
+				}
+				published <- fields[1] + " " + string(msg[:size])
+			}
+		}
+	}()
+
+	sink := NewNATSEventSink(listener.Addr().String())
+	err = sink.Publish(context.Background(), &disgord.EventEnvelope{Name: disgord.EvtMessageCreate, Data: []byte(`{"id":"1"}`), ShardID: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-published:
+		expected := `disgord.events.MESSAGE_CREATE {"v":1,"name":"MESSAGE_CREATE","shard_id":3,"seq":0,"received":"0001-01-01T00:00:00Z","data":{"id":"1"}}`
+		if msg != expected {
+			t.Errorf("unexpected message %s", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the event to be published")
+	}
+}
+
+func TestKafkaEventSink(t *testing.T) {
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(data)
+		_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":1}]}`))
+	}))
+	defer server.Close()
+
+	sink := NewKafkaEventSink(server.URL)
+	err := sink.Publish(context.Background(), &disgord.EventEnvelope{Name: disgord.EvtMessageCreate, Data: []byte(`{"id":"1"}`), ShardID: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/topics/disgord.events" {
+		t.Errorf("unexpected path %s", path)
+	}
+	expected := `{"records":[{"key":"3","value":{"v":1,"name":"MESSAGE_CREATE","shard_id":3,"seq":0,"received":"0001-01-01T00:00:00Z","data":{"id":"1"}}}]}`
+	if body != expected {
+		t.Errorf("unexpected body %s", body)
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"offsets":[{"error_code":50002,"error":"unknown topic"}]}`))
+	})
+	if err = sink.Publish(context.Background(), &disgord.EventEnvelope{Name: disgord.EvtMessageCreate, Data: []byte(`{}`)}); err == nil {
+		t.Error("expected the record error to be returned")
+	}
+}
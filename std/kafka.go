@@ -0,0 +1,119 @@
+package std
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// KafkaEventSink is a disgord.EventSink producing the gateway events to a Kafka topic through the Kafka REST Proxy
+// (v2 API), such that no Kafka client library is needed.
+//
+//  client := disgord.New(disgord.Config{
+//      EventSink: std.NewKafkaEventSink("http://localhost:8082"),
+//  })
+//
+// The record key is the shard id, such that the events of a shard keep their order, and the value is the event
+// encoded by disgord.EncodeEvent. Workers can dispatch the records using Client.DispatchSerialized.
+type KafkaEventSink struct {
+	// URL of the Kafka REST Proxy, eg. "http://localhost:8082"
+	URL string
+
+	// Topic the events are produced to. Defaults to "disgord.events".
+	Topic string
+
+	// Header is added to every request, eg. for authentication.
+	Header http.Header
+
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+var _ disgord.EventSink = (*KafkaEventSink)(nil)
+
+// NewKafkaEventSink creates a Kafka event sink.
+func NewKafkaEventSink(url string) *KafkaEventSink {
+	return &KafkaEventSink{URL: url}
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (k *KafkaEventSink) Publish(ctx context.Context, evt *disgord.EventEnvelope) error {
+	value, err := disgord.EncodeEvent(evt)
+	if err != nil {
+		return err
+	}
+
+	topic := k.Topic
+	if topic == "" {
+		topic = "disgord.events"
+	}
+	client := k.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(map[string][]*kafkaRecord{
+		"records": {{
+			Key:   strconv.FormatUint(uint64(evt.ShardID), 10),
+			Value: value,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimSuffix(k.URL, "/") + "/topics/" + topic
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for key, values := range k.Header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("kafka: producing to %s failed with status %d: %s", topic, resp.StatusCode, data)
+	}
+
+	var produced kafkaProduceResponse
+	if err = json.Unmarshal(data, &produced); err != nil {
+		return err
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("kafka: producing to %s failed with error code %d: %s", topic, *offset.ErrorCode, offset.Error)
+		}
+	}
+	return nil
+}
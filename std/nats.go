@@ -0,0 +1,232 @@
+package std
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// NATSEventSink is a disgord.EventSink publishing the gateway events to a NATS server, using the core NATS
+// protocol. Publishing is fire and forget, so events are lost while the server is unavailable.
+//
+//  client := disgord.New(disgord.Config{
+//      EventSink: std.NewNATSEventSink("localhost:4222"),
+//  })
+//
+// Every event is published to the subject {subject}.{event name}, eg. "disgord.events.MESSAGE_CREATE", encoded
+// by disgord.EncodeEvent. Workers can dispatch the messages using Client.DispatchSerialized.
+type NATSEventSink struct {
+	// Addr of the NATS server, eg. "localhost:4222"
+	Addr string
+
+	// Token, or User and Password, authenticates the connection when the server requires it.
+	Token    string
+	User     string
+	Password string
+
+	// Subject prefix of the events. Defaults to "disgord.events".
+	Subject string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	remote error // error sent by the server, returned by the next Publish
+}
+
+var _ disgord.EventSink = (*NATSEventSink)(nil)
+
+// NewNATSEventSink creates a NATS event sink.
+func NewNATSEventSink(addr string) *NATSEventSink {
+	return &NATSEventSink{Addr: addr}
+}
+
+func (n *NATSEventSink) subject(evtName string) string {
+	prefix := n.Subject
+	if prefix == "" {
+		prefix = "disgord.events"
+	}
+	return prefix + "." + evtName
+}
+
+func (n *NATSEventSink) Publish(ctx context.Context, evt *disgord.EventEnvelope) (err error) {
+	data, err := disgord.EncodeEvent(evt)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err = n.remote; err != nil {
+		n.remote = nil
+		return err
+	}
+	if n.conn == nil {
+		if err = n.dial(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(10 * time.Second)
+	}
+	if err = n.conn.SetWriteDeadline(deadline); err != nil {
+		n.close()
+		return err
+	}
+	if _, err = n.conn.Write(encodeNATSPublish(n.subject(evt.Name), data)); err != nil {
+		n.close()
+	}
+	return err
+}
+
+func (n *NATSEventSink) dial(ctx context.Context) (err error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.Addr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(10 * time.Second)
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	line, err := readNATSLine(reader)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return errors.New("nats: expected INFO from the server, got " + line)
+	}
+
+	options, err := json.Marshal(&natsConnectOptions{
+		Name:     disgord.LibraryInfo(),
+		Lang:     "go",
+		Version:  disgord.Version,
+		Protocol: 1,
+		Token:    n.Token,
+		User:     n.User,
+		Password: n.Password,
+	})
+	if err != nil {
+		return err
+	}
+	connect := "CONNECT " + string(options) + "\r\nPING\r\n"
+	if _, err = conn.Write([]byte(connect)); err != nil {
+		return err
+	}
+
+	// the server answers the ping once the connection is accepted
+	for {
+		if line, err = readNATSLine(reader); err != nil {
+			return err
+		}
+		if line == "PONG" {
+			break
+		}
+		if strings.HasPrefix(line, "-ERR") {
+			return natsError(line)
+		}
+	}
+
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	n.conn = conn
+	go n.read(conn, reader)
+	return nil
+}
+
+// read answers the pings of the server, which otherwise closes the connection, and keeps the errors sent by
+// the server.
+func (n *NATSEventSink) read(conn net.Conn, reader *bufio.Reader) {
+	for {
+		line, err := readNATSLine(reader)
+
+		n.mu.Lock()
+		if n.conn != conn {
+			n.mu.Unlock()
+			return
+		}
+		switch {
+		case err != nil:
+			n.close()
+		case line == "PING":
+			_ = conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if _, err = conn.Write([]byte("PONG\r\n")); err != nil {
+				n.close()
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			n.remote = natsError(line)
+		}
+		n.mu.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (n *NATSEventSink) close() {
+	if n.conn != nil {
+		_ = n.conn.Close()
+		n.conn = nil
+	}
+}
+
+type natsConnectOptions struct {
+	Verbose  bool   `json:"verbose"`
+	Pedantic bool   `json:"pedantic"`
+	Name     string `json:"name"`
+	Lang     string `json:"lang"`
+	Version  string `json:"version"`
+	Protocol int    `json:"protocol"`
+	Token    string `json:"auth_token,omitempty"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"pass,omitempty"`
+}
+
+type natsError string
+
+func (e natsError) Error() string {
+	return "nats: " + strings.Trim(strings.TrimSpace(strings.TrimPrefix(string(e), "-ERR")), "'")
+}
+
+// encodeNATSPublish encodes a PUB message.
+func encodeNATSPublish(subject string, data []byte) []byte {
+	buf := make([]byte, 0, len(subject)+len(data)+32)
+	buf = append(buf, "PUB "...)
+	buf = append(buf, subject...)
+	buf = append(buf, ' ')
+	buf = strconv.AppendInt(buf, int64(len(data)), 10)
+	buf = append(buf, '\r', '\n')
+	buf = append(buf, data...)
+	buf = append(buf, '\r', '\n')
+	return buf
+}
+
+func readNATSLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,242 @@
+package std
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// redisConn is a minimal Redis client using the Redis serialization protocol (RESP), which is all the
+// Redis implementations in this package need. It is not safe for concurrent use.
+type redisConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func (r *redisConn) eval(ctx context.Context, addr, password, script string, keys []string, args ...string) (int64, error) {
+	cmd := append([]string{"EVAL", script, strconv.Itoa(len(keys))}, keys...)
+	reply, err := r.do(ctx, addr, password, append(cmd, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	if n, ok := reply.(int64); ok {
+		return n, nil
+	}
+	return 0, fmt.Errorf("unexpected redis reply %v", reply)
+}
+
+// do sends a command and reads the reply. The connection is discarded on network errors and
+// re-established by the next command.
+func (r *redisConn) do(ctx context.Context, addr, password string, args ...string) (reply interface{}, err error) {
+	if r.conn == nil {
+		if err = r.dial(ctx, addr, password); err != nil {
+			return nil, err
+		}
+	}
+	return r.send(ctx, args)
+}
+
+// multi runs the commands in a MULTI/EXEC transaction, such that they are applied together. The
+// commands are pipelined, so the transaction costs a single round-trip.
+func (r *redisConn) multi(ctx context.Context, addr, password string, cmds ...[]string) (err error) {
+	if r.conn == nil {
+		if err = r.dial(ctx, addr, password); err != nil {
+			return err
+		}
+	}
+	defer r.closeOnNetworkError(&err)
+
+	buf := encodeRedisCommand([]string{"MULTI"})
+	for _, cmd := range cmds {
+		buf = append(buf, encodeRedisCommand(cmd)...)
+	}
+	buf = append(buf, encodeRedisCommand([]string{"EXEC"})...)
+	if err = r.write(ctx, buf); err != nil {
+		return err
+	}
+
+	// MULTI and every queued command are acknowledged before EXEC replies with the results. All
+	// the replies must be read to keep the connection usable, even when a command failed.
+	var failed error
+	for i := 0; i < len(cmds)+2; i++ {
+		reply, err := readRedisReply(r.reader)
+		var redisErr redisError
+		if err != nil && !errors.As(err, &redisErr) {
+			return err
+		}
+		if failed == nil {
+			failed = err
+		}
+
+		results, _ := reply.([]interface{})
+		for _, result := range results {
+			if resultErr, ok := result.(redisError); ok && failed == nil {
+				failed = resultErr
+			}
+		}
+	}
+	return failed
+}
+
+func (r *redisConn) send(ctx context.Context, args []string) (reply interface{}, err error) {
+	defer r.closeOnNetworkError(&err)
+
+	if err = r.write(ctx, encodeRedisCommand(args)); err != nil {
+		return nil, err
+	}
+	return readRedisReply(r.reader)
+}
+
+func (r *redisConn) write(ctx context.Context, data []byte) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(10 * time.Second)
+	}
+	if err := r.conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	_, err := r.conn.Write(data)
+	return err
+}
+
+// closeOnNetworkError discards the connection unless the error is a reply from Redis, as the
+// connection state is unknown after a network error.
+func (r *redisConn) closeOnNetworkError(err *error) {
+	var redisErr redisError
+	if *err != nil && !errors.As(*err, &redisErr) {
+		r.close()
+	}
+}
+
+func (r *redisConn) dial(ctx context.Context, addr, password string) (err error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	r.conn = conn
+	r.reader = bufio.NewReader(conn)
+
+	if password != "" {
+		if _, err = r.send(ctx, []string{"AUTH", password}); err != nil {
+			r.close()
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *redisConn) close() {
+	if r.conn != nil {
+		_ = r.conn.Close()
+		r.conn = nil
+	}
+}
+
+// redisPool hands out up to size connections, so commands from different goroutines do not wait on
+// each other's round-trips. Connections are dialed when first used.
+type redisPool struct {
+	once  sync.Once
+	conns chan *redisConn
+}
+
+func (p *redisPool) get(ctx context.Context, size int) (*redisConn, error) {
+	p.once.Do(func() {
+		if size <= 0 {
+			size = 8
+		}
+		p.conns = make(chan *redisConn, size)
+		for i := 0; i < size; i++ {
+			p.conns <- &redisConn{}
+		}
+	})
+
+	select {
+	case conn := <-p.conns:
+		return conn, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (p *redisPool) put(conn *redisConn) {
+	p.conns <- conn
+}
+
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func encodeRedisCommand(args []string) []byte {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, '\r', '\n')
+	for _, arg := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(arg)), 10)
+		buf = append(buf, '\r', '\n')
+		buf = append(buf, arg...)
+		buf = append(buf, '\r', '\n')
+	}
+	return buf
+}
+
+// readRedisReply reads a single reply. Simple and bulk strings are returned as strings, integers as
+// int64, arrays as []interface{} and nil replies as nil. Errors within an array are returned as
+// redisError items, such that the rest of the array is still read.
+func readRedisReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.New("malformed redis reply")
+	}
+	kind, content := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return content, nil
+	case '-':
+		return nil, redisError(content)
+	case ':':
+		return strconv.ParseInt(content, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(content)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		data := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		return string(data[:size]), nil
+	case '*':
+		size, err := strconv.Atoi(content)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+		items := make([]interface{}, size)
+		for i := range items {
+			var redisErr redisError
+			if items[i], err = readRedisReply(reader); errors.As(err, &redisErr) {
+				items[i] = redisErr
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unknown redis reply type %q", kind)
+	}
+}
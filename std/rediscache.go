@@ -0,0 +1,580 @@
+package std
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// RedisCache is a disgord.Cache backed by Redis, which caches guilds, channels, members and messages.
+// Replicas of a bot can share the cache, and a restarted bot does not have to fetch everything again.
+// Any other resource is not cached. RedisCache is safe for concurrent use, and keeps up to PoolSize
+// connections to Redis such that events from different shards are not written one at a time.
+//
+//  client := disgord.New(disgord.Config{
+//      Cache: std.NewRedisCache("localhost:6379"),
+//  })
+//
+// Entries are stored under the following keys:
+//  {prefix}:guild:{guild id}           the guild, without channels and members
+//  {prefix}:guild:{guild id}:channels  set of the channel ids of the guild
+//  {prefix}:guild:{guild id}:members   hash of the members, by user id
+//  {prefix}:channel:{channel id}       the channel
+//  {prefix}:message:{channel id}:{id}  the message, which expires after MessageTTL
+//
+// Updates that touch several keys, such as a guild with its channels and members, are written in a
+// single MULTI/EXEC transaction, so other replicas never read a half written guild.
+type RedisCache struct {
+	disgord.CacheNop
+
+	// Addr of the Redis server, eg. "localhost:6379"
+	Addr     string
+	Password string
+
+	// PoolSize is the maximum number of connections to Redis. Defaults to 8.
+	PoolSize int
+
+	// Prefix of every Redis key. Defaults to "disgord:cache".
+	Prefix string
+
+	// Marshal and Unmarshal serialize the cached entries. Defaults to JSON.
+	Marshal   func(v interface{}) ([]byte, error)
+	Unmarshal func(data []byte, v interface{}) error
+
+	// MessageTTL is how long a message is cached. Defaults to 10 minutes.
+	MessageTTL time.Duration
+
+	// OnError receives errors from updating the cache. The event is still dispatched, as the cache
+	// should not hold back events when Redis is unavailable.
+	OnError func(err error)
+
+	pool redisPool
+}
+
+var _ disgord.Cache = (*RedisCache)(nil)
+
+// NewRedisCache creates a Redis cache.
+func NewRedisCache(addr string) *RedisCache {
+	return &RedisCache{Addr: addr}
+}
+
+func (r *RedisCache) key(parts ...interface{}) string {
+	key := r.Prefix
+	if key == "" {
+		key = "disgord:cache"
+	}
+	for _, part := range parts {
+		switch p := part.(type) {
+		case string:
+			key += ":" + p
+		case disgord.Snowflake:
+			key += ":" + p.String()
+		}
+	}
+	return key
+}
+
+func (r *RedisCache) marshal(v interface{}) (string, error) {
+	marshal := r.Marshal
+	if marshal == nil {
+		marshal = json.Marshal
+	}
+	data, err := marshal(v)
+	return string(data), err
+}
+
+func (r *RedisCache) unmarshal(data string, v interface{}) error {
+	unmarshal := r.Unmarshal
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+	if err := unmarshal([]byte(data), v); err != nil {
+		return err
+	}
+	r.Patch(v)
+	return nil
+}
+
+func (r *RedisCache) do(args ...string) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := r.pool.get(ctx, r.PoolSize)
+	if err != nil {
+		return nil, err
+	}
+	defer r.pool.put(conn)
+	return conn.do(ctx, r.Addr, r.Password, args...)
+}
+
+// write runs commands that update the cache, and reports the first error to OnError. Several
+// commands are run as one transaction.
+func (r *RedisCache) write(cmds ...[]string) {
+	writes := make([][]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		if len(cmd) > 0 {
+			writes = append(writes, cmd)
+		}
+	}
+
+	var err error
+	switch len(writes) {
+	case 0:
+		return
+	case 1:
+		_, err = r.do(writes[0]...)
+	default:
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		var conn *redisConn
+		if conn, err = r.pool.get(ctx, r.PoolSize); err == nil {
+			err = conn.multi(ctx, r.Addr, r.Password, writes...)
+			r.pool.put(conn)
+		}
+	}
+	r.fail(err)
+}
+
+func (r *RedisCache) fail(err error) {
+	if err != nil && r.OnError != nil {
+		r.OnError(err)
+	}
+}
+
+// get reads the entry at the given key into v, and returns disgord.CacheMissErr when there is no entry.
+func (r *RedisCache) get(key string, v interface{}) error {
+	reply, err := r.do("GET", key)
+	if err != nil {
+		return err
+	}
+	if reply == nil {
+		return disgord.CacheMissErr
+	}
+	return r.unmarshal(reply.(string), v)
+}
+
+func (r *RedisCache) saveChannels(channels ...*disgord.Channel) {
+	set, index, err := r.channelWrites(channels)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+	r.write(set, index)
+}
+
+// channelWrites returns the commands that store the channels, and add them to the channel index of
+// their guild.
+func (r *RedisCache) channelWrites(channels []*disgord.Channel) (set, index []string, err error) {
+	if len(channels) == 0 {
+		return nil, nil, nil
+	}
+
+	set = []string{"MSET"}
+	var guildID disgord.Snowflake
+	index = []string{"SADD", ""}
+	for _, channel := range channels {
+		data, err := r.marshal(channel)
+		if err != nil {
+			return nil, nil, err
+		}
+		set = append(set, r.key("channel", channel.ID), data)
+		if !channel.GuildID.IsZero() {
+			guildID = channel.GuildID
+			index = append(index, channel.ID.String())
+		}
+	}
+
+	if guildID.IsZero() {
+		index = nil
+	} else {
+		index[1] = r.key("guild", guildID, "channels")
+	}
+	return set, index, nil
+}
+
+func (r *RedisCache) saveMembers(guildID disgord.Snowflake, members ...*disgord.Member) {
+	cmd, err := r.memberWrite(guildID, members)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+	r.write(cmd)
+}
+
+// memberWrite returns the command that stores the members of a guild.
+func (r *RedisCache) memberWrite(guildID disgord.Snowflake, members []*disgord.Member) ([]string, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	cmd := []string{"HSET", r.key("guild", guildID, "members")}
+	for _, member := range members {
+		member.GuildID = guildID
+		data, err := r.marshal(member)
+		if err != nil {
+			return nil, err
+		}
+		cmd = append(cmd, member.UserID.String(), data)
+	}
+	return cmd, nil
+}
+
+func (r *RedisCache) saveMessage(msg *disgord.Message) {
+	data, err := r.marshal(msg)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	ttl := r.MessageTTL
+	if ttl == 0 {
+		ttl = 10 * time.Minute
+	}
+	r.write([]string{"SET", r.key("message", msg.ChannelID, msg.ID), data, "PX", strconv.FormatInt(ttl.Milliseconds(), 10)})
+}
+
+func (r *RedisCache) saveGuild(guild *disgord.Guild) {
+	channels := append(guild.Channels, guild.Threads...)
+	members := guild.Members
+
+	// channels and members are stored separately
+	stripped := *guild
+	stripped.Channels, stripped.Threads, stripped.Members = nil, nil, nil
+	data, err := r.marshal(&stripped)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	for _, channel := range channels {
+		channel.GuildID = guild.ID
+	}
+	set, index, err := r.channelWrites(channels)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+	hset, err := r.memberWrite(guild.ID, members)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+	r.write([]string{"SET", r.key("guild", guild.ID), data}, set, index, hset)
+}
+
+func (r *RedisCache) deleteGuild(guildID disgord.Snowflake) {
+	channelIDs, err := r.channelIDs(guildID)
+	if err != nil {
+		r.fail(err)
+		return
+	}
+
+	cmd := []string{"DEL", r.key("guild", guildID), r.key("guild", guildID, "channels"), r.key("guild", guildID, "members")}
+	for _, id := range channelIDs {
+		cmd = append(cmd, r.key("channel", id))
+	}
+	r.write(cmd)
+}
+
+func (r *RedisCache) channelIDs(guildID disgord.Snowflake) ([]string, error) {
+	reply, err := r.do("SMEMBERS", r.key("guild", guildID, "channels"))
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := reply.([]interface{})
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.(string))
+	}
+	return ids, nil
+}
+
+func (r *RedisCache) GuildCreate(data []byte) (*disgord.GuildCreate, error) {
+	evt, err := r.CacheNop.GuildCreate(data)
+	if err != nil {
+		return nil, err
+	}
+	if !evt.Guild.Unavailable {
+		r.saveGuild(disgord.DeepCopy(evt.Guild).(*disgord.Guild))
+	}
+	return evt, nil
+}
+
+func (r *RedisCache) GuildUpdate(data []byte) (*disgord.GuildUpdate, error) {
+	evt, err := r.CacheNop.GuildUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveGuild(disgord.DeepCopy(evt.Guild).(*disgord.Guild))
+	return evt, nil
+}
+
+func (r *RedisCache) GuildDelete(data []byte) (*disgord.GuildDelete, error) {
+	evt, err := r.CacheNop.GuildDelete(data)
+	if err != nil {
+		return nil, err
+	}
+	r.deleteGuild(evt.UnavailableGuild.ID)
+	return evt, nil
+}
+
+func (r *RedisCache) ChannelCreate(data []byte) (*disgord.ChannelCreate, error) {
+	evt, err := r.CacheNop.ChannelCreate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveChannels(evt.Channel)
+	return evt, nil
+}
+
+func (r *RedisCache) ChannelUpdate(data []byte) (*disgord.ChannelUpdate, error) {
+	evt, err := r.CacheNop.ChannelUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveChannels(evt.Channel)
+	return evt, nil
+}
+
+func (r *RedisCache) ChannelDelete(data []byte) (*disgord.ChannelDelete, error) {
+	evt, err := r.CacheNop.ChannelDelete(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var index []string
+	if !evt.Channel.GuildID.IsZero() {
+		index = []string{"SREM", r.key("guild", evt.Channel.GuildID, "channels"), evt.Channel.ID.String()}
+	}
+	r.write([]string{"DEL", r.key("channel", evt.Channel.ID)}, index)
+	return evt, nil
+}
+
+func (r *RedisCache) ThreadCreate(data []byte) (*disgord.ThreadCreate, error) {
+	evt, err := r.CacheNop.ThreadCreate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveChannels(evt.Thread)
+	return evt, nil
+}
+
+func (r *RedisCache) ThreadUpdate(data []byte) (*disgord.ThreadUpdate, error) {
+	evt, err := r.CacheNop.ThreadUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveChannels(evt.Thread)
+	return evt, nil
+}
+
+func (r *RedisCache) GuildMemberAdd(data []byte) (*disgord.GuildMemberAdd, error) {
+	evt, err := r.CacheNop.GuildMemberAdd(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveMembers(evt.Member.GuildID, disgord.DeepCopy(evt.Member).(*disgord.Member))
+	return evt, nil
+}
+
+func (r *RedisCache) GuildMemberUpdate(data []byte) (*disgord.GuildMemberUpdate, error) {
+	evt, err := r.CacheNop.GuildMemberUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveMembers(evt.Member.GuildID, disgord.DeepCopy(evt.Member).(*disgord.Member))
+	return evt, nil
+}
+
+func (r *RedisCache) GuildMemberRemove(data []byte) (*disgord.GuildMemberRemove, error) {
+	evt, err := r.CacheNop.GuildMemberRemove(data)
+	if err != nil {
+		return nil, err
+	}
+	if evt.User != nil {
+		r.write([]string{"HDEL", r.key("guild", evt.GuildID, "members"), evt.User.ID.String()})
+	}
+	return evt, nil
+}
+
+func (r *RedisCache) GuildMembersChunk(data []byte) (*disgord.GuildMembersChunk, error) {
+	evt, err := r.CacheNop.GuildMembersChunk(data)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*disgord.Member, len(evt.Members))
+	for i := range evt.Members {
+		members[i] = disgord.DeepCopy(evt.Members[i]).(*disgord.Member)
+	}
+	r.saveMembers(evt.GuildID, members...)
+	return evt, nil
+}
+
+func (r *RedisCache) MessageCreate(data []byte) (*disgord.MessageCreate, error) {
+	evt, err := r.CacheNop.MessageCreate(data)
+	if err != nil {
+		return nil, err
+	}
+	r.saveMessage(evt.Message)
+	return evt, nil
+}
+
+func (r *RedisCache) MessageUpdate(data []byte) (*disgord.MessageUpdate, error) {
+	evt, err := r.CacheNop.MessageUpdate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// updates can be partial, so only update messages that are cached
+	msg := &disgord.Message{}
+	if err = r.get(r.key("message", evt.Message.ChannelID, evt.Message.ID), msg); err != nil {
+		if err != disgord.CacheMissErr {
+			r.fail(err)
+		}
+		return evt, nil
+	}
+	if err = r.unmarshal(string(data), msg); err != nil {
+		r.fail(err)
+		return evt, nil
+	}
+	r.saveMessage(msg)
+	return evt, nil
+}
+
+func (r *RedisCache) MessageDelete(data []byte) (*disgord.MessageDelete, error) {
+	evt, err := r.CacheNop.MessageDelete(data)
+	if err != nil {
+		return nil, err
+	}
+	r.write([]string{"DEL", r.key("message", evt.ChannelID, evt.MessageID)})
+	return evt, nil
+}
+
+func (r *RedisCache) MessageDeleteBulk(data []byte) (*disgord.MessageDeleteBulk, error) {
+	evt, err := r.CacheNop.MessageDeleteBulk(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(evt.MessageIDs) == 0 {
+		return evt, nil
+	}
+
+	cmd := []string{"DEL"}
+	for _, id := range evt.MessageIDs {
+		cmd = append(cmd, r.key("message", evt.ChannelID, id))
+	}
+	r.write(cmd)
+	return evt, nil
+}
+
+func (r *RedisCache) GetGuild(id disgord.Snowflake) (*disgord.Guild, error) {
+	guild := &disgord.Guild{}
+	if err := r.get(r.key("guild", id), guild); err != nil {
+		return nil, err
+	}
+
+	channels, err := r.GetGuildChannels(id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := r.members(id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.IsThread() {
+			guild.Threads = append(guild.Threads, channel)
+		} else {
+			guild.Channels = append(guild.Channels, channel)
+		}
+	}
+	guild.Members = members
+	return guild, nil
+}
+
+func (r *RedisCache) GetChannel(id disgord.Snowflake) (*disgord.Channel, error) {
+	channel := &disgord.Channel{}
+	if err := r.get(r.key("channel", id), channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (r *RedisCache) GetGuildChannels(id disgord.Snowflake) ([]*disgord.Channel, error) {
+	channelIDs, err := r.channelIDs(id)
+	if err != nil || len(channelIDs) == 0 {
+		return nil, err
+	}
+
+	cmd := []string{"MGET"}
+	for _, channelID := range channelIDs {
+		cmd = append(cmd, r.key("channel", channelID))
+	}
+	reply, err := r.do(cmd...)
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := reply.([]interface{})
+	channels := make([]*disgord.Channel, 0, len(items))
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		channel := &disgord.Channel{}
+		if err = r.unmarshal(item.(string), channel); err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+func (r *RedisCache) GetMember(guildID, userID disgord.Snowflake) (*disgord.Member, error) {
+	reply, err := r.do("HGET", r.key("guild", guildID, "members"), userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, disgord.CacheMissErr
+	}
+
+	member := &disgord.Member{}
+	if err = r.unmarshal(reply.(string), member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (r *RedisCache) members(guildID disgord.Snowflake) ([]*disgord.Member, error) {
+	reply, err := r.do("HVALS", r.key("guild", guildID, "members"))
+	if err != nil {
+		return nil, err
+	}
+
+	items, _ := reply.([]interface{})
+	members := make([]*disgord.Member, 0, len(items))
+	for _, item := range items {
+		member := &disgord.Member{}
+		if err = r.unmarshal(item.(string), member); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func (r *RedisCache) GetMessage(channelID, messageID disgord.Snowflake) (*disgord.Message, error) {
+	msg := &disgord.Message{}
+	if err := r.get(r.key("message", channelID, messageID), msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,122 @@
+// +build !integration
+
+package std
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/Vedza/disgord"
+)
+
+func TestRedisCache(t *testing.T) {
+	redis := newFakeRedis(t)
+	defer redis.listener.Close()
+
+	cache := NewRedisCache(redis.listener.Addr().String())
+	cache.OnError = func(err error) {
+		t.Error(err)
+	}
+
+	guildCreate := []byte(`{"id":"1","name":"test","channels":[{"id":"2","type":0,"name":"general"}],` +
+		`"members":[{"user":{"id":"3","username":"anders"},"roles":[]}]}`)
+	if _, err := cache.GuildCreate(guildCreate); err != nil {
+		t.Fatal(err)
+	}
+
+	guild, err := cache.GetGuild(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if guild.Name != "test" || len(guild.Channels) != 1 || len(guild.Members) != 1 {
+		t.Fatalf("unexpected guild from the cache: %+v", guild)
+	}
+	if guild.Channels[0].GuildID != 1 {
+		t.Error("expected the channel to be linked to the guild")
+	}
+	if redis.transactions != 1 {
+		t.Errorf("expected the guild to be written in one transaction. Got %d", redis.transactions)
+	}
+
+	member, err := cache.GetMember(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.UserID != 3 || member.User.Username != "anders" {
+		t.Errorf("unexpected member from the cache: %+v", member)
+	}
+
+	if _, err = cache.GuildMemberRemove([]byte(`{"guild_id":"1","user":{"id":"3"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cache.GetMember(1, 3); err != disgord.CacheMissErr {
+		t.Errorf("expected a cache miss for a removed member. Got %v", err)
+	}
+
+	if _, err = cache.MessageCreate([]byte(`{"id":"5","channel_id":"2","content":"hello"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cache.MessageUpdate([]byte(`{"id":"5","channel_id":"2","content":"edited"}`)); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := cache.GetMessage(2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Content != "edited" {
+		t.Errorf("expected the message to be updated. Got %s", msg.Content)
+	}
+
+	if _, err = cache.GuildDelete([]byte(`{"id":"1"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cache.GetChannel(2); err != disgord.CacheMissErr {
+		t.Errorf("expected the channels to be removed with the guild. Got %v", err)
+	}
+	if _, err = cache.GetGuild(1); err != disgord.CacheMissErr {
+		t.Errorf("expected a cache miss for a deleted guild. Got %v", err)
+	}
+}
+
+func TestRedisCache_Concurrent(t *testing.T) {
+	redis := newFakeRedis(t)
+	defer redis.listener.Close()
+
+	cache := NewRedisCache(redis.listener.Addr().String())
+	cache.PoolSize = 2
+	cache.OnError = func(err error) {
+		t.Error(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			guildCreate := []byte(`{"id":"` + id + `","name":"test","channels":[{"id":"` + id + `0","type":0}],` +
+				`"members":[{"user":{"id":"` + id + `"},"roles":[]}]}`)
+			if _, err := cache.GuildCreate(guildCreate); err != nil {
+				t.Error(err)
+			}
+			if _, err := cache.ChannelDelete([]byte(`{"id":"` + id + `0","guild_id":"` + id + `","type":0}`)); err != nil {
+				t.Error(err)
+			}
+		}(strconv.Itoa(i))
+	}
+	wg.Wait()
+
+	for i := 1; i <= 20; i++ {
+		id := disgord.Snowflake(i)
+		guild, err := cache.GetGuild(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(guild.Channels) != 0 || len(guild.Members) != 1 || guild.Members[0].UserID != id {
+			t.Errorf("unexpected guild from the cache: %+v", guild)
+		}
+	}
+	if redis.transactions != 40 {
+		t.Errorf("expected every guild and channel delete to be one transaction. Got %d", redis.transactions)
+	}
+}
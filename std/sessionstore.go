@@ -0,0 +1,159 @@
+package std
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// FileSessionStore is a disgord.SessionStore which keeps the sessions of every shard in a single
+// JSON file. Suitable for bots running as a single process.
+//
+//  client := disgord.New(disgord.Config{
+//      ShardConfig: disgord.ShardConfig{
+//          SessionStore: std.NewFileSessionStore("sessions.json"),
+//      },
+//  })
+type FileSessionStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+var _ disgord.SessionStore = (*FileSessionStore)(nil)
+
+// NewFileSessionStore creates a session store which writes to the given file.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{path: path}
+}
+
+func (f *FileSessionStore) read() (sessions map[uint]*disgord.GatewaySession, err error) {
+	sessions = make(map[uint]*disgord.GatewaySession)
+	data, err := ioutil.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return sessions, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (f *FileSessionStore) Load(_ context.Context, shardID uint) (*disgord.GatewaySession, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sessions, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	return sessions[shardID], nil
+}
+
+// Save writes the session to a temporary file, which replaces the session file, such that a crash
+// can not leave a partially written file behind.
+func (f *FileSessionStore) Save(_ context.Context, session *disgord.GatewaySession) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	sessions, err := f.read()
+	if err != nil {
+		return err
+	}
+	sessions[session.ShardID] = session
+
+	data, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+// RedisSessionStore is a disgord.SessionStore backed by Redis, for bots where the shards may start
+// on a different host after a restart. Sessions expire, as Discord only allows a session to be
+// resumed for a short while.
+type RedisSessionStore struct {
+	// Addr of the Redis server, eg. "localhost:6379"
+	Addr     string
+	Password string
+
+	// Prefix of every Redis key. Defaults to "disgord:sessions".
+	Prefix string
+
+	// TTL of a stored session. Defaults to 5 minutes.
+	TTL time.Duration
+
+	mu    sync.Mutex
+	redis redisConn
+}
+
+var _ disgord.SessionStore = (*RedisSessionStore)(nil)
+
+// NewRedisSessionStore creates a Redis session store.
+func NewRedisSessionStore(addr string) *RedisSessionStore {
+	return &RedisSessionStore{Addr: addr}
+}
+
+func (r *RedisSessionStore) key(shardID uint) string {
+	prefix := r.Prefix
+	if prefix == "" {
+		prefix = "disgord:sessions"
+	}
+	return prefix + ":" + strconv.FormatUint(uint64(shardID), 10)
+}
+
+func (r *RedisSessionStore) Load(ctx context.Context, shardID uint) (*disgord.GatewaySession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reply, err := r.redis.do(ctx, r.Addr, r.Password, "GET", r.key(shardID))
+	if err != nil || reply == nil {
+		return nil, err
+	}
+
+	session := &disgord.GatewaySession{}
+	if err = json.Unmarshal([]byte(reply.(string)), session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (r *RedisSessionStore) Save(ctx context.Context, session *disgord.GatewaySession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	ttl := r.TTL
+	if ttl == 0 {
+		ttl = 5 * time.Minute
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err = r.redis.do(ctx, r.Addr, r.Password, "SET", r.key(session.ShardID), string(data), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
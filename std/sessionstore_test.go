@@ -0,0 +1,61 @@
+// +build !integration
+
+package std
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Vedza/disgord"
+)
+
+func testSessionStore(t *testing.T, store disgord.SessionStore) {
+	ctx := context.Background()
+	session, err := store.Load(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session != nil {
+		t.Fatalf("expected no session. Got %+v", session)
+	}
+
+	for _, id := range []uint{0, 1} {
+		err = store.Save(ctx, &disgord.GatewaySession{
+			ShardID:    id,
+			ShardCount: 2,
+			SessionID:  "session",
+			ResumeURL:  "wss://gateway-us-east1-b.discord.gg",
+			Sequence:   uint32(10 + id),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if session, err = store.Load(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if session == nil || session.ShardID != 1 || session.Sequence != 11 || session.ResumeURL == "" {
+		t.Errorf("incorrect session. Got %+v", session)
+	}
+}
+
+func TestFileSessionStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "disgord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testSessionStore(t, NewFileSessionStore(filepath.Join(dir, "sessions.json")))
+}
+
+func TestRedisSessionStore(t *testing.T) {
+	redis := newFakeRedis(t)
+	defer redis.listener.Close()
+
+	testSessionStore(t, NewRedisSessionStore(redis.listener.Addr().String()))
+}
@@ -0,0 +1,195 @@
+package std
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Vedza/disgord"
+	"github.com/Vedza/disgord/json"
+)
+
+// the claim scripts only touch a shard key when it is free or owned by the calling instance
+const (
+	redisClaimScript = `local v = redis.call('get', KEYS[1])
+if v == false or v == ARGV[1] then
+	redis.call('set', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 1
+end
+return 0`
+	redisRenewScript = `if redis.call('get', KEYS[1]) == ARGV[1] then
+	return redis.call('pexpire', KEYS[1], ARGV[2])
+end
+return 0`
+	redisReleaseScript = `if redis.call('get', KEYS[1]) == ARGV[1] then
+	redis.call('del', KEYS[1], KEYS[2])
+	return 1
+end
+return 0`
+)
+
+// RedisShardCoordinator is a disgord.ShardCoordinator backed by Redis, for bots running as multiple
+// replicas. Every shard is claimed by writing an expiring key, which is renewed whenever the shard
+// health is reported. When a replica crashes, its shards can be claimed by another replica once the
+// keys expire.
+//
+//  client := disgord.New(disgord.Config{
+//      ShardConfig: disgord.ShardConfig{
+//          Coordinator: std.NewRedisShardCoordinator("localhost:6379", 4),
+//      },
+//  })
+type RedisShardCoordinator struct {
+	// Addr of the Redis server, eg. "localhost:6379"
+	Addr     string
+	Password string
+
+	// Prefix of every Redis key. Defaults to "disgord:shards".
+	Prefix string
+
+	// InstanceID identifies this replica and must be unique. Defaults to the hostname and process id.
+	InstanceID string
+
+	// MaxShards is the maximum number of shards claimed by this replica. Every free shard is
+	// claimed when 0.
+	MaxShards uint
+
+	// TTL is how long a claim lives without any health reports. Must be longer than the health
+	// report interval of 15 seconds. Defaults to 45 seconds.
+	TTL time.Duration
+
+	mu         sync.Mutex
+	redis      redisConn
+	shardCount uint
+}
+
+var _ disgord.ShardCoordinator = (*RedisShardCoordinator)(nil)
+
+// NewRedisShardCoordinator creates a Redis shard coordinator where every replica runs at most maxShards shards.
+func NewRedisShardCoordinator(addr string, maxShards uint) *RedisShardCoordinator {
+	return &RedisShardCoordinator{
+		Addr:      addr,
+		MaxShards: maxShards,
+	}
+}
+
+func (r *RedisShardCoordinator) prefix() string {
+	if r.Prefix == "" {
+		return "disgord:shards"
+	}
+	return r.Prefix
+}
+
+func (r *RedisShardCoordinator) instanceID() string {
+	if r.InstanceID == "" {
+		hostname, _ := os.Hostname()
+		r.InstanceID = hostname + "-" + strconv.Itoa(os.Getpid())
+	}
+	return r.InstanceID
+}
+
+func (r *RedisShardCoordinator) ttl() string {
+	ttl := r.TTL
+	if ttl == 0 {
+		ttl = 45 * time.Second
+	}
+	return strconv.FormatInt(ttl.Milliseconds(), 10)
+}
+
+// the shard count is part of the key, such that claims from before a re-shard are ignored
+func (r *RedisShardCoordinator) shardKey(shardID uint) string {
+	return fmt.Sprintf("%s:%d:%d", r.prefix(), r.shardCount, shardID)
+}
+
+func (r *RedisShardCoordinator) healthKey(shardID uint) string {
+	return r.shardKey(shardID) + ":health"
+}
+
+// Claim claims every free shard, up to MaxShards.
+func (r *RedisShardCoordinator) Claim(ctx context.Context, shardCount uint) (shardIDs []uint, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.shardCount = shardCount
+	for id := uint(0); id < shardCount; id++ {
+		if r.MaxShards > 0 && uint(len(shardIDs)) >= r.MaxShards {
+			break
+		}
+
+		var claimed int64
+		if claimed, err = r.redis.eval(ctx, r.Addr, r.Password, redisClaimScript, []string{r.shardKey(id)}, r.instanceID(), r.ttl()); err != nil {
+			return nil, err
+		}
+		if claimed == 1 {
+			shardIDs = append(shardIDs, id)
+		}
+	}
+
+	if len(shardIDs) == 0 {
+		return nil, errors.New("every shard has already been claimed")
+	}
+	return shardIDs, nil
+}
+
+// ReportHealth renews the claims and stores the health of every shard. An error is returned
+// if a claim has been lost to another replica.
+func (r *RedisShardCoordinator) ReportHealth(ctx context.Context, health []disgord.ShardHealth) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var lost []uint
+	for i := range health {
+		renewed, err := r.redis.eval(ctx, r.Addr, r.Password, redisRenewScript, []string{r.shardKey(health[i].ShardID)}, r.instanceID(), r.ttl())
+		if err != nil {
+			return err
+		}
+		if renewed == 0 {
+			lost = append(lost, health[i].ShardID)
+			continue
+		}
+
+		data, err := json.Marshal(&redisShardHealth{
+			Instance:  r.instanceID(),
+			Connected: health[i].Connected,
+			LatencyMS: health[i].Latency.Milliseconds(),
+			Reported:  time.Now().Unix(),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = r.redis.do(ctx, r.Addr, r.Password, "SET", r.healthKey(health[i].ShardID), string(data), "PX", r.ttl()); err != nil {
+			return err
+		}
+	}
+
+	if len(lost) > 0 {
+		return fmt.Errorf("shard claims were lost to another replica: %v", lost)
+	}
+	return nil
+}
+
+// Release deletes the claims, such that other replicas can claim the shards immediately.
+func (r *RedisShardCoordinator) Release(ctx context.Context, shardIDs []uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range shardIDs {
+		if _, err := r.redis.eval(ctx, r.Addr, r.Password, redisReleaseScript, []string{r.shardKey(id), r.healthKey(id)}, r.instanceID()); err != nil {
+			return err
+		}
+	}
+
+	r.redis.close()
+	return nil
+}
+
+type redisShardHealth struct {
+	Instance  string `json:"instance"`
+	Connected bool   `json:"connected"`
+	LatencyMS int64  `json:"latency_ms"`
+	Reported  int64  `json:"reported"`
+}
+
@@ -0,0 +1,258 @@
+// +build !integration
+
+package std
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/Vedza/disgord"
+)
+
+// fakeRedis understands the commands and scripts used by the Redis implementations
+type fakeRedis struct {
+	sync.Mutex
+	listener net.Listener
+	data     map[string]string
+	sets     map[string]map[string]bool
+	hashes   map[string]map[string]string
+
+	// transactions counts the executed MULTI/EXEC blocks
+	transactions int
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("unable to listen on localhost:", err)
+	}
+
+	r := &fakeRedis{
+		listener: listener,
+		data:     map[string]string{},
+		sets:     map[string]map[string]bool{},
+		hashes:   map[string]map[string]string{},
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go r.serve(conn)
+		}
+	}()
+	return r
+}
+
+func (r *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	var queued [][]string
+	var multi bool
+	for {
+		reply, err := readRedisReply(reader)
+		if err != nil {
+			return
+		}
+		items := reply.([]interface{})
+		args := make([]string, len(items))
+		for i := range items {
+			args[i] = items[i].(string)
+		}
+
+		var response string
+		switch {
+		case args[0] == "MULTI":
+			multi, queued = true, nil
+			response = "+OK\r\n"
+		case args[0] == "EXEC":
+			response = r.transaction(queued)
+			multi, queued = false, nil
+		case multi:
+			queued = append(queued, args)
+			response = "+QUEUED\r\n"
+		default:
+			response = r.exec(args)
+		}
+		if _, err = conn.Write([]byte(response)); err != nil {
+			return
+		}
+	}
+}
+
+// transaction runs the queued commands without other commands in between, like Redis does
+func (r *fakeRedis) transaction(cmds [][]string) string {
+	r.Lock()
+	defer r.Unlock()
+
+	r.transactions++
+	response := "*" + strconv.Itoa(len(cmds)) + "\r\n"
+	for _, args := range cmds {
+		response += r.run(args)
+	}
+	return response
+}
+
+func (r *fakeRedis) exec(args []string) string {
+	r.Lock()
+	defer r.Unlock()
+	return r.run(args)
+}
+
+func (r *fakeRedis) run(args []string) string {
+	switch args[0] {
+	case "GET":
+		value, ok := r.data[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	case "SET":
+		r.data[args[1]] = args[2]
+		return "+OK\r\n"
+	case "MSET":
+		for i := 1; i+1 < len(args); i += 2 {
+			r.data[args[i]] = args[i+1]
+		}
+		return "+OK\r\n"
+	case "MGET":
+		values := make([]string, 0, len(args)-1)
+		for _, key := range args[1:] {
+			if value, ok := r.data[key]; ok {
+				values = append(values, value)
+			}
+		}
+		return redisArray(values)
+	case "DEL":
+		for _, key := range args[1:] {
+			delete(r.data, key)
+			delete(r.sets, key)
+			delete(r.hashes, key)
+		}
+		return ":1\r\n"
+	case "SADD":
+		if r.sets[args[1]] == nil {
+			r.sets[args[1]] = map[string]bool{}
+		}
+		for _, member := range args[2:] {
+			r.sets[args[1]][member] = true
+		}
+		return ":1\r\n"
+	case "SREM":
+		for _, member := range args[2:] {
+			delete(r.sets[args[1]], member)
+		}
+		return ":1\r\n"
+	case "SMEMBERS":
+		members := make([]string, 0, len(r.sets[args[1]]))
+		for member := range r.sets[args[1]] {
+			members = append(members, member)
+		}
+		return redisArray(members)
+	case "HSET":
+		if r.hashes[args[1]] == nil {
+			r.hashes[args[1]] = map[string]string{}
+		}
+		for i := 2; i+1 < len(args); i += 2 {
+			r.hashes[args[1]][args[i]] = args[i+1]
+		}
+		return ":1\r\n"
+	case "HGET":
+		value, ok := r.hashes[args[1]][args[2]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	case "HDEL":
+		for _, field := range args[2:] {
+			delete(r.hashes[args[1]], field)
+		}
+		return ":1\r\n"
+	case "HVALS":
+		values := make([]string, 0, len(r.hashes[args[1]]))
+		for _, value := range r.hashes[args[1]] {
+			values = append(values, value)
+		}
+		return redisArray(values)
+	case "EVAL":
+		key, instance := args[3], args[len(args)-2]
+		if args[1] == redisReleaseScript {
+			instance = args[len(args)-1]
+		}
+		owner, exists := r.data[key]
+		switch args[1] {
+		case redisClaimScript:
+			if exists && owner != instance {
+				return ":0\r\n"
+			}
+			r.data[key] = instance
+		case redisRenewScript, redisReleaseScript:
+			if owner != instance {
+				return ":0\r\n"
+			}
+			if args[1] == redisReleaseScript {
+				delete(r.data, key)
+			}
+		}
+		return ":1\r\n"
+	}
+	return "-ERR unknown command\r\n"
+}
+
+func redisArray(values []string) string {
+	reply := "*" + strconv.Itoa(len(values)) + "\r\n"
+	for _, value := range values {
+		reply += "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	}
+	return reply
+}
+
+func TestRedisShardCoordinator(t *testing.T) {
+	redis := newFakeRedis(t)
+	defer redis.listener.Close()
+
+	ctx := context.Background()
+	a := &RedisShardCoordinator{Addr: redis.listener.Addr().String(), InstanceID: "a", MaxShards: 2}
+	b := &RedisShardCoordinator{Addr: redis.listener.Addr().String(), InstanceID: "b", MaxShards: 2}
+
+	shardsA, err := a.Claim(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shardsB, err := b.Claim(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(shardsA, []uint{0, 1}) || !reflect.DeepEqual(shardsB, []uint{2, 3}) {
+		t.Errorf("expected the shards to be divided between the replicas. Got %v and %v", shardsA, shardsB)
+	}
+
+	c := &RedisShardCoordinator{Addr: redis.listener.Addr().String(), InstanceID: "c"}
+	if _, err = c.Claim(ctx, 4); err == nil {
+		t.Error("expected an error when every shard has been claimed")
+	}
+
+	if err = a.ReportHealth(ctx, []disgord.ShardHealth{{ShardID: 0, Connected: true}}); err != nil {
+		t.Error(err)
+	}
+	if err = a.ReportHealth(ctx, []disgord.ShardHealth{{ShardID: 2}}); err == nil {
+		t.Error("expected an error when reporting a shard claimed by another replica")
+	}
+
+	if err = a.Release(ctx, shardsA); err != nil {
+		t.Fatal(err)
+	}
+	shardsC, err := c.Claim(ctx, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(shardsC, shardsA) {
+		t.Errorf("expected the released shards to be claimed. Got %v", shardsC)
+	}
+}
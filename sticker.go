@@ -0,0 +1,398 @@
+package disgord
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// StickerType https://discord.com/developers/docs/resources/sticker#sticker-object-sticker-types
+type StickerType int
+
+const (
+	_ StickerType = iota
+	StickerTypeStandard
+	StickerTypeGuild
+)
+
+// Sticker https://discord.com/developers/docs/resources/sticker#sticker-object
+type Sticker struct {
+	ID          Snowflake                `json:"id"`
+	PackID      Snowflake                `json:"pack_id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Tags        string                   `json:"tags"` // autocomplete/suggestion tags, comma separated
+	Type        StickerType              `json:"type"`
+	FormatType  MessageStickerFormatType `json:"format_type"`
+	Available   bool                     `json:"available,omitempty"`
+	GuildID     Snowflake                `json:"guild_id,omitempty"`
+	User        *User                    `json:"user,omitempty"` // the user that uploaded the guild sticker
+	SortValue   int                      `json:"sort_value,omitempty"`
+}
+
+var _ Copier = (*Sticker)(nil)
+var _ DeepCopier = (*Sticker)(nil)
+
+// StickerItem is the smallest amount of data required to render a sticker. Sent with messages.
+// https://discord.com/developers/docs/resources/sticker#sticker-item-object
+type StickerItem struct {
+	ID         Snowflake                `json:"id"`
+	Name       string                   `json:"name"`
+	FormatType MessageStickerFormatType `json:"format_type"`
+}
+
+var _ Copier = (*StickerItem)(nil)
+var _ DeepCopier = (*StickerItem)(nil)
+
+// StickerPack https://discord.com/developers/docs/resources/sticker#sticker-pack-object
+type StickerPack struct {
+	ID             Snowflake  `json:"id"`
+	Stickers       []*Sticker `json:"stickers"`
+	Name           string     `json:"name"`
+	SKUID          Snowflake  `json:"sku_id"`
+	CoverStickerID Snowflake  `json:"cover_sticker_id,omitempty"`
+	Description    string     `json:"description"`
+	BannerAssetID  Snowflake  `json:"banner_asset_id,omitempty"`
+}
+
+type stickerPacksResponse struct {
+	StickerPacks []*StickerPack `json:"sticker_packs"`
+}
+
+// CreateGuildStickerParams https://discord.com/developers/docs/resources/sticker#create-guild-sticker-form-params
+// The sticker file must be a PNG, APNG, GIF or Lottie JSON file, and at most 512 KiB.
+type CreateGuildStickerParams struct {
+	Name        string
+	Description string
+	Tags        string // autocomplete/suggestion tags, max 200 characters
+
+	FileName string
+	File     io.Reader
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string
+}
+
+func (p *CreateGuildStickerParams) FindErrors() error {
+	if len(p.Name) < 2 || len(p.Name) > 30 {
+		return errors.New("sticker name must be 2-30 characters long")
+	}
+	if p.Description != "" && (len(p.Description) < 2 || len(p.Description) > 100) {
+		return errors.New("sticker description must be empty or 2-100 characters long")
+	}
+	if p.Tags == "" || len(p.Tags) > 200 {
+		return errors.New("sticker tags must be 1-200 characters long")
+	}
+	if p.File == nil || p.FileName == "" {
+		return errors.New("sticker must have a file and a file name")
+	}
+	return nil
+}
+
+// stickerContentTypes are the content types of the sticker formats accepted by Discord, by file extension.
+// APNG files are sent as PNG.
+var stickerContentTypes = map[string]string{
+	".png":  "image/png",
+	".apng": "image/png",
+	".gif":  "image/gif",
+	".json": "application/json",
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// prepare writes the sticker as a multipart form. Unlike messages, the fields are sent as
+// form fields and not as a JSON payload.
+func (p *CreateGuildStickerParams) prepare() (postBody interface{}, contentType string, err error) {
+	buf := new(bytes.Buffer)
+	mp := multipart.NewWriter(buf)
+
+	fields := [][2]string{
+		{"name", p.Name},
+		{"description", p.Description},
+		{"tags", p.Tags},
+	}
+	for _, field := range fields {
+		if err = mp.WriteField(field[0], field[1]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Discord checks the type of the sticker file, so it can not be sent as application/octet-stream
+	file := bufio.NewReaderSize(p.File, 512)
+	fileType, ok := stickerContentTypes[strings.ToLower(filepath.Ext(p.FileName))]
+	if !ok {
+		sniff, _ := file.Peek(512)
+		fileType = http.DetectContentType(sniff)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+quoteEscaper.Replace(p.FileName)+`"`)
+	header.Set("Content-Type", fileType)
+
+	var w io.Writer
+	if w, err = mp.CreatePart(header); err != nil {
+		return nil, "", err
+	}
+	if _, err = io.Copy(w, file); err != nil {
+		return nil, "", err
+	}
+	if err = mp.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf, mp.FormDataContentType(), nil
+}
+
+// UpdateGuildStickerParams https://discord.com/developers/docs/resources/sticker#modify-guild-sticker-json-params
+type UpdateGuildStickerParams struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Tags        string  `json:"tags,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateGuildStickerParams) FindErrors() error {
+	if p.Name != "" && (len(p.Name) < 2 || len(p.Name) > 30) {
+		return errors.New("sticker name must be 2-30 characters long")
+	}
+	if p.Description != nil && *p.Description != "" && (len(*p.Description) < 2 || len(*p.Description) > 100) {
+		return errors.New("sticker description must be empty or 2-100 characters long")
+	}
+	if len(p.Tags) > 200 {
+		return errors.New("sticker tags can not be longer than 200 characters")
+	}
+	return nil
+}
+
+// GetSticker [REST] Returns a sticker object for the given sticker ID.
+//  Method                  GET
+//  Endpoint                /stickers/{sticker.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#get-sticker
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c clientQueryBuilder) GetSticker(stickerID Snowflake, flags ...RequestOption) (*Sticker, error) {
+	if stickerID.IsZero() {
+		return nil, errors.New("stickerID must be set to target the correct sticker")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.Sticker(stickerID),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Sticker{}
+	}
+
+	return getSticker(r.Execute)
+}
+
+// GetStickerPacks [REST] Returns the list of sticker packs available to Nitro subscribers.
+//  Method                  GET
+//  Endpoint                /sticker-packs
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#list-sticker-packs
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c clientQueryBuilder) GetStickerPacks(flags ...RequestOption) ([]*StickerPack, error) {
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.StickerPacks(),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &stickerPacksResponse{}
+	}
+
+	v, err := exec(r.Execute, flags...)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*stickerPacksResponse).StickerPacks, nil
+}
+
+// GetStickers [REST] Returns an array of sticker objects for the given guild. Includes user fields if the
+// bot has the MANAGE_EMOJIS_AND_STICKERS permission.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/stickers
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#list-guild-stickers
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (g guildQueryBuilder) GetStickers(flags ...RequestOption) ([]*Sticker, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildStickers(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*Sticker, 0)
+		return &tmp
+	}
+
+	return getStickers(r.Execute)
+}
+
+// CreateSticker [REST] Create a new sticker for the guild. Requires the MANAGE_EMOJIS_AND_STICKERS permission.
+// Returns the new sticker object on success. Fires a Guild Stickers Update Gateway event.
+//  Method                  POST
+//  Endpoint                /guilds/{guild.id}/stickers
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#create-guild-sticker
+//  Reviewed                2021-08-28
+//  Comment                 The sticker is uploaded as multipart/form-data. Lottie stickers can only be
+//                          uploaded by verified and partnered guilds.
+func (g guildQueryBuilder) CreateSticker(params *CreateGuildStickerParams, flags ...RequestOption) (*Sticker, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	postBody, contentType, err := params.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildStickers(g.gid),
+		Body:        postBody,
+		ContentType: contentType,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Sticker{}
+	}
+
+	return getSticker(r.Execute)
+}
+
+type GuildStickerQueryBuilder interface {
+	WithContext(ctx context.Context) GuildStickerQueryBuilder
+
+	Get(flags ...RequestOption) (*Sticker, error)
+	Update(params *UpdateGuildStickerParams, flags ...RequestOption) (*Sticker, error)
+	Delete(flags ...RequestOption) error
+}
+
+func (g guildQueryBuilder) Sticker(stickerID Snowflake) GuildStickerQueryBuilder {
+	return &guildStickerQueryBuilder{client: g.client, gid: g.gid, stickerID: stickerID}
+}
+
+type guildStickerQueryBuilder struct {
+	ctx       context.Context
+	client    *Client
+	gid       Snowflake
+	stickerID Snowflake
+}
+
+var _ GuildStickerQueryBuilder = (*guildStickerQueryBuilder)(nil)
+
+func (g guildStickerQueryBuilder) WithContext(ctx context.Context) GuildStickerQueryBuilder {
+	g.ctx = ctx
+	return &g
+}
+
+func (g guildStickerQueryBuilder) validate() error {
+	if g.gid.IsZero() {
+		return errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if g.stickerID.IsZero() {
+		return errors.New("stickerID must be set to target the correct sticker")
+	}
+	return nil
+}
+
+// Get [REST] Returns a sticker object for the given guild and sticker IDs. Includes the user field if the
+// bot has the MANAGE_EMOJIS_AND_STICKERS permission.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/stickers/{sticker.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#get-guild-sticker
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (g guildStickerQueryBuilder) Get(flags ...RequestOption) (*Sticker, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildSticker(g.gid, g.stickerID),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Sticker{}
+	}
+
+	return getSticker(r.Execute)
+}
+
+// Update [REST] Modify the given sticker. Requires the MANAGE_EMOJIS_AND_STICKERS permission.
+// Returns the updated sticker object on success. Fires a Guild Stickers Update Gateway event.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/stickers/{sticker.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#modify-guild-sticker
+//  Reviewed                2021-08-28
+//  Comment                 All parameters are optional.
+func (g guildStickerQueryBuilder) Update(params *UpdateGuildStickerParams, flags ...RequestOption) (*Sticker, error) {
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildSticker(g.gid, g.stickerID),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Sticker{}
+	}
+
+	return getSticker(r.Execute)
+}
+
+// Delete [REST] Delete the given sticker. Requires the MANAGE_EMOJIS_AND_STICKERS permission.
+// Returns 204 No Content on success. Fires a Guild Stickers Update Gateway event.
+//  Method                  DELETE
+//  Endpoint                /guilds/{guild.id}/stickers/{sticker.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/sticker#delete-guild-sticker
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (g guildStickerQueryBuilder) Delete(flags ...RequestOption) error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      g.ctx,
+		Endpoint: endpoint.GuildSticker(g.gid, g.stickerID),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
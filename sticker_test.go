@@ -0,0 +1,81 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+func TestCreateGuildStickerParams_prepare(t *testing.T) {
+	testCases := []struct {
+		name      string
+		fileName  string
+		file      string
+		wantsType string
+	}{
+		{"png", "wave.png", "\x89PNG\r\n\x1a\n", "image/png"},
+		{"apng", "wave.APNG", "\x89PNG\r\n\x1a\n", "image/png"},
+		{"lottie", "wave.json", `{"v":"5.5.2"}`, "application/json"},
+		{"sniffed", "wave", "GIF89a", "image/gif"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := &CreateGuildStickerParams{
+				Name:        "wave",
+				Description: "waving",
+				Tags:        "wave,hello",
+				FileName:    tc.fileName,
+				File:        strings.NewReader(tc.file),
+			}
+			body, contentType, err := params.prepare()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mediaType, mediaParams, err := mime.ParseMediaType(contentType)
+			if err != nil || mediaType != "multipart/form-data" {
+				t.Fatalf("expected a multipart form. Got %s", contentType)
+			}
+
+			fields := map[string]string{}
+			r := multipart.NewReader(body.(io.Reader), mediaParams["boundary"])
+			for {
+				part, err := r.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				data, _ := ioutil.ReadAll(part)
+
+				if part.FormName() != "file" {
+					fields[part.FormName()] = string(data)
+					continue
+				}
+				if part.FileName() != tc.fileName {
+					t.Errorf("unexpected file name. Got %s, wants %s", part.FileName(), tc.fileName)
+				}
+				if got := part.Header.Get("Content-Type"); got != tc.wantsType {
+					t.Errorf("unexpected file content type. Got %s, wants %s", got, tc.wantsType)
+				}
+				if !bytes.Equal(data, []byte(tc.file)) {
+					t.Errorf("unexpected file content. Got %q", data)
+				}
+			}
+
+			wants := map[string]string{"name": "wave", "description": "waving", "tags": "wave,hello"}
+			for name, value := range wants {
+				if fields[name] != value {
+					t.Errorf("unexpected form field %s. Got %q, wants %q", name, fields[name], value)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// SubscriptionStatus https://discord.com/developers/docs/resources/subscription#subscription-statuses
+type SubscriptionStatus uint
+
+const (
+	SubscriptionStatusActive SubscriptionStatus = iota
+	SubscriptionStatusEnding
+	SubscriptionStatusInactive
+)
+
+// Subscription represents a user making recurring payments for at least one SKU over an ongoing period.
+// https://discord.com/developers/docs/resources/subscription#subscription-object
+type Subscription struct {
+	ID                 Snowflake          `json:"id"`
+	UserID             Snowflake          `json:"user_id"`
+	SKUIDs             []Snowflake        `json:"sku_ids"`
+	EntitlementIDs     []Snowflake        `json:"entitlement_ids"`
+	RenewalSKUIDs      []Snowflake        `json:"renewal_sku_ids"`
+	CurrentPeriodStart Time               `json:"current_period_start"`
+	CurrentPeriodEnd   Time               `json:"current_period_end"`
+	Status             SubscriptionStatus `json:"status"`
+	CanceledAt         Time               `json:"canceled_at"`
+
+	// Country is only included when the subscription is queried with an OAuth2 token holding the private scope
+	Country string `json:"country,omitempty"`
+}
+
+var _ Copier = (*Subscription)(nil)
+var _ DeepCopier = (*Subscription)(nil)
+
+// GetSKUSubscriptionsParams https://discord.com/developers/docs/resources/subscription#query-string-params
+type GetSKUSubscriptionsParams struct {
+	Before Snowflake `urlparam:"before,omitempty"`
+	After  Snowflake `urlparam:"after,omitempty"`
+	Limit  int       `urlparam:"limit,omitempty"` // 1-100, defaults to 50
+
+	// UserID is required for bot requests, and only returns the subscriptions of this user
+	UserID Snowflake `urlparam:"user_id,omitempty"`
+}
+
+var _ URLQueryStringer = (*GetSKUSubscriptionsParams)(nil)
+
+// GetSKUSubscriptions [REST] Returns all subscriptions containing the SKU, filtered by user.
+//  Method                  GET
+//  Endpoint                /skus/{sku.id}/subscriptions
+//  Discord documentation   https://discord.com/developers/docs/resources/subscription#list-sku-subscriptions
+//  Reviewed                2024-09-05
+//  Comment                 -
+func (c clientQueryBuilder) GetSKUSubscriptions(skuID Snowflake, params *GetSKUSubscriptionsParams, flags ...RequestOption) ([]*Subscription, error) {
+	if skuID.IsZero() {
+		return nil, errors.New("skuID must be set to target the correct SKU")
+	}
+	if params == nil || params.UserID.IsZero() {
+		return nil, errors.New("a user id must be specified to list subscriptions")
+	}
+	if params.Limit < 0 || params.Limit > 100 {
+		return nil, errors.New("limit must be between 1 and 100")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.SKUSubscriptions(skuID) + params.URLQueryString(),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*Subscription, 0)
+		return &tmp
+	}
+
+	return getSubscriptions(r.Execute)
+}
+
+// GetSKUSubscription [REST] Get a subscription by its ID.
+//  Method                  GET
+//  Endpoint                /skus/{sku.id}/subscriptions/{subscription.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/subscription#get-sku-subscription
+//  Reviewed                2024-09-05
+//  Comment                 -
+func (c clientQueryBuilder) GetSKUSubscription(skuID, subscriptionID Snowflake, flags ...RequestOption) (*Subscription, error) {
+	if skuID.IsZero() {
+		return nil, errors.New("skuID must be set to target the correct SKU")
+	}
+	if subscriptionID.IsZero() {
+		return nil, errors.New("subscriptionID must be set to target the correct subscription")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.SKUSubscription(skuID, subscriptionID),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Subscription{}
+	}
+
+	return getSubscription(r.Execute)
+}
@@ -0,0 +1,66 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSKUSubscriptions(t *testing.T) {
+	var requests []string
+	var query string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/skus"):]
+			requests = append(requests, req.Method+" "+path)
+			query = req.URL.RawQuery
+			subscription := `{"id":"3","user_id":"4","sku_ids":["1"],"entitlement_ids":["5"],"status":1,` +
+				`"current_period_start":"2024-09-01T00:00:00+00:00","current_period_end":"2024-10-01T00:00:00+00:00"}`
+			if strings.HasSuffix(path, "/subscriptions") {
+				return jsonResponse(req, "["+subscription+"]"), nil
+			}
+			return jsonResponse(req, subscription), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subscriptions, err := client.GetSKUSubscriptions(1, &GetSKUSubscriptionsParams{UserID: 4, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subscriptions) != 1 {
+		t.Fatalf("expected one subscription. Got %d", len(subscriptions))
+	}
+	if query != "limit=10&user_id=4" {
+		t.Errorf("unexpected query %q", query)
+	}
+
+	subscription, err := client.GetSKUSubscription(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subscription.ID != 3 || subscription.UserID != 4 || subscription.SKUIDs[0] != 1 || subscription.EntitlementIDs[0] != 5 {
+		t.Errorf("unexpected subscription %+v", subscription)
+	}
+	if subscription.Status != SubscriptionStatusEnding || subscription.CurrentPeriodEnd.Month() != 10 {
+		t.Errorf("unexpected subscription period or status %+v", subscription)
+	}
+
+	expected := []string{
+		"GET /skus/1/subscriptions",
+		"GET /skus/1/subscriptions/3",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	if _, err = client.GetSKUSubscriptions(1, &GetSKUSubscriptionsParams{}); err == nil {
+		t.Error("expected an error when no user id is given")
+	}
+}
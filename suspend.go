@@ -0,0 +1,87 @@
+package disgord
+
+import (
+	"strconv"
+	"sync"
+)
+
+// suspension holds back events while dispatching is suspended
+type suspension struct {
+	sync.Mutex
+	suspended  bool
+	bufferSize int
+	buffer     []*dispatchJob
+	dropped    int
+}
+
+// hold buffers the event, or drops it when the buffer is full, and reports whether the dispatcher is
+// suspended. Held back events are not waited for by Client.Shutdown.
+func (d *dispatcher) hold(evtName string, guildID Snowflake, evt resource) bool {
+	s := &d.suspension
+	s.Lock()
+	defer s.Unlock()
+	if !s.suspended {
+		return false
+	}
+
+	d.pending.Dec()
+	if len(s.buffer) < s.bufferSize {
+		s.buffer = append(s.buffer, &dispatchJob{evtName: evtName, guildID: guildID, evt: evt})
+	} else {
+		s.dropped++
+		// the event never reached the handlers
+		if d.events != nil {
+			d.events.put(evt)
+		}
+	}
+	return true
+}
+
+// Suspend pauses the dispatching of events to handlers, without disconnecting from the gateway. The cache
+// is still updated. Up to Config.SuspendBufferSize events are buffered until Resume is called, and the
+// remaining events are dropped. Useful during maintenance of a database the handlers depend on.
+//
+// Note that the handlers used by disgord itself are paused as well, such that voice connections can not be
+// established while suspended.
+func (c *Client) Suspend() {
+	s := &c.dispatcher.suspension
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.suspended {
+		s.suspended = true
+		c.subsystemLog(LogGateway).Info("Event dispatching is suspended")
+	}
+}
+
+// Resume dispatches the buffered events, in the order they were received, and continues dispatching new
+// events. The number of events dropped while suspended is returned.
+func (c *Client) Resume() (dropped int) {
+	d := c.dispatcher
+	s := &d.suspension
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.suspended {
+		return 0
+	}
+
+	// new events wait for the lock, such that they are dispatched after the buffered ones
+	for _, job := range s.buffer {
+		d.pending.Inc()
+		d.enqueue(job.evtName, job.guildID, job.evt)
+	}
+	dropped = s.dropped
+	s.suspended, s.buffer, s.dropped = false, nil, 0
+
+	c.subsystemLog(LogGateway).Info("Event dispatching is resumed, " + strconv.Itoa(dropped) + " events were dropped")
+	return dropped
+}
+
+// Suspended reports whether event dispatching is suspended, see Suspend.
+func (c *Client) Suspended() bool {
+	s := &c.dispatcher.suspension
+	s.Lock()
+	defer s.Unlock()
+	return s.suspended
+}
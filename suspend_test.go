@@ -0,0 +1,71 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+)
+
+func TestClient_Suspend(t *testing.T) {
+	client, err := NewClient(context.Background(), Config{
+		BotToken:          "testing",
+		SuspendBufferSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handled := make(chan Snowflake, 3)
+	client.Gateway().MessageCreate(func(s Session, h *MessageCreate) {
+		handled <- h.Message.ID
+	})
+	go client.demultiplexer(client.dispatcher, client.eventChan)
+
+	client.Suspend()
+	if !client.Suspended() {
+		t.Fatal("expected the client to be suspended")
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		client.eventChan <- &gateway.Event{Name: EvtMessageCreate, Data: []byte(`{"id":"` + id + `","channel_id":"10"}`)}
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		s := &client.dispatcher.suspension
+		s.Lock()
+		held := len(s.buffer) + s.dropped
+		s.Unlock()
+		if held == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the events to be held back. Got %d", held)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case id := <-handled:
+		t.Fatalf("expected no events to be dispatched while suspended. Got %d", id)
+	default:
+	}
+
+	if dropped := client.Resume(); dropped != 1 {
+		t.Errorf("expected one event to be dropped. Got %d", dropped)
+	}
+	received := map[Snowflake]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case id := <-handled:
+			received[id] = true
+		case <-time.After(time.Second):
+			t.Fatal("expected the buffered events to be dispatched")
+		}
+	}
+	if !received[1] || !received[2] {
+		t.Errorf("expected the first two events. Got %v", received)
+	}
+}
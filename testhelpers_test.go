@@ -0,0 +1,27 @@
+// +build !integration
+
+package disgord
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// transportFunc replaces the http transport of a client in tests, such that REST requests can be
+// inspected and answered without a network connection.
+type transportFunc func(req *http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// jsonResponse is a successful Discord response with the given json body.
+func jsonResponse(req *http.Request, body string) *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
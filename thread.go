@@ -0,0 +1,459 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// Auto archive durations, in minutes, a thread can be configured with.
+const (
+	AutoArchiveOneHour   uint = 60
+	AutoArchiveOneDay    uint = 1440
+	AutoArchiveThreeDays uint = 4320
+	AutoArchiveOneWeek   uint = 10080
+)
+
+// ThreadMetadata https://discord.com/developers/docs/resources/channel#thread-metadata-object
+type ThreadMetadata struct {
+	Archived            bool `json:"archived"`
+	AutoArchiveDuration uint `json:"auto_archive_duration"`
+	ArchiveTimestamp    Time `json:"archive_timestamp"`
+	Locked              bool `json:"locked"`
+	Invitable           bool `json:"invitable,omitempty"`
+}
+
+var _ Copier = (*ThreadMetadata)(nil)
+var _ DeepCopier = (*ThreadMetadata)(nil)
+
+// ThreadMember https://discord.com/developers/docs/resources/channel#thread-member-object
+type ThreadMember struct {
+	ID            Snowflake `json:"id,omitempty"` // thread id
+	UserID        Snowflake `json:"user_id,omitempty"`
+	JoinTimestamp Time      `json:"join_timestamp"`
+	Flags         int       `json:"flags"`
+
+	// GuildID is only sent in the THREAD_MEMBER_UPDATE event
+	GuildID Snowflake `json:"guild_id,omitempty"`
+}
+
+var _ Copier = (*ThreadMember)(nil)
+var _ DeepCopier = (*ThreadMember)(nil)
+
+// ThreadList holds threads and the thread member objects of the current user
+// for each of the threads the current user has joined.
+type ThreadList struct {
+	Threads []*Channel      `json:"threads"`
+	Members []*ThreadMember `json:"members"`
+
+	// HasMore is set when listing archived threads and there are more threads to retrieve
+	HasMore bool `json:"has_more,omitempty"`
+}
+
+// StartThreadParams https://discord.com/developers/docs/resources/channel#start-thread-without-message-json-params
+type StartThreadParams struct {
+	Name                string `json:"name"`
+	AutoArchiveDuration uint   `json:"auto_archive_duration,omitempty"`
+	RateLimitPerUser    uint   `json:"rate_limit_per_user,omitempty"`
+
+	// Type and Invitable are ignored when the thread is started from a message
+	Type      ChannelType `json:"type,omitempty"`
+	Invitable *bool       `json:"invitable,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *StartThreadParams) FindErrors() error {
+	if p.Name == "" {
+		return errors.New("thread must have a name")
+	}
+	if len(p.Name) > 100 {
+		return errors.New("thread name can not be longer than 100 characters")
+	}
+	switch p.AutoArchiveDuration {
+	case 0, AutoArchiveOneHour, AutoArchiveOneDay, AutoArchiveThreeDays, AutoArchiveOneWeek:
+	default:
+		return errors.New("auto archive duration must be one of 60, 1440, 4320 or 10080 minutes")
+	}
+	return nil
+}
+
+// StartThreadInForumParams https://discord.com/developers/docs/resources/channel#start-thread-in-forum-or-media-channel-jsonform-params
+type StartThreadInForumParams struct {
+	Name                string      `json:"name"`
+	AutoArchiveDuration uint        `json:"auto_archive_duration,omitempty"`
+	RateLimitPerUser    uint        `json:"rate_limit_per_user,omitempty"`
+	AppliedTags         []Snowflake `json:"applied_tags,omitempty"`
+
+	// Message is the first message of the thread. Files are uploaded as part of the multipart body.
+	Message *CreateMessageParams `json:"message"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *StartThreadInForumParams) FindErrors() error {
+	thread := StartThreadParams{Name: p.Name, AutoArchiveDuration: p.AutoArchiveDuration}
+	if err := thread.FindErrors(); err != nil {
+		return err
+	}
+	if p.Message == nil {
+		return errors.New("a thread in a forum channel must have a starter message")
+	}
+	if len(p.AppliedTags) > 5 {
+		return errors.New("at most 5 tags can be applied to a thread")
+	}
+	return nil
+}
+
+func (p *StartThreadInForumParams) prepare() (postBody interface{}, contentType string, err error) {
+	if len(p.Message.Files) == 0 {
+		return p, httd.ContentTypeJSON, nil
+	}
+	return writeMultipart(p, p.Message.Files)
+}
+
+// ForumThread is a thread created in a forum or media channel, along with its starter message.
+type ForumThread struct {
+	Channel
+	Message *Message `json:"message"`
+}
+
+// GetArchivedThreadsParams https://discord.com/developers/docs/resources/channel#list-public-archived-threads-query-string-params
+type GetArchivedThreadsParams struct {
+	// Before returns threads archived before this timestamp. For the joined private archived
+	// threads, use BeforeID instead.
+	Before   Time
+	BeforeID Snowflake
+	Limit    uint
+}
+
+func (p *GetArchivedThreadsParams) URLQueryString() string {
+	params := make(urlQuery)
+	if !p.Before.IsZero() {
+		params["before"] = p.Before.String()
+	}
+	if !p.BeforeID.IsZero() {
+		params["before"] = p.BeforeID
+	}
+	if p.Limit != 0 {
+		params["limit"] = p.Limit
+	}
+	return params.URLQueryString()
+}
+
+// StartThread [REST] Creates a new thread that is not connected to an existing message. The created thread
+// defaults to a private thread. Returns a channel on success, and fires a Thread Create Gateway event.
+//  Method                  POST
+//  Endpoint                /channels/{channel.id}/threads
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#start-thread-without-message
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) StartThread(params *StartThreadParams, flags ...RequestOption) (*Channel, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+	if params.Type == 0 {
+		// the params belong to the caller
+		defaulted := *params
+		defaulted.Type = ChannelTypeGuildPrivateThread
+		params = &defaulted
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         c.ctx,
+		Endpoint:    endpoint.ChannelThreads(c.cid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Channel{}
+	}
+
+	return getChannel(r.Execute)
+}
+
+// StartThreadInForum [REST] Creates a new thread in a forum or a media channel, and sends a message within the
+// created thread. Returns a channel, with a nested message object, on success, and fires a Thread Create and
+// Message Create Gateway event. The maximum request size when sending a message is 25 MiB.
+//  Method                  POST
+//  Endpoint                /channels/{channel.id}/threads
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#start-thread-in-forum-or-media-channel
+//  Reviewed                2023-03-18
+//  Comment                 Requires the SEND_MESSAGES permission.
+func (c channelQueryBuilder) StartThreadInForum(params *StartThreadInForumParams, flags ...RequestOption) (*ForumThread, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+	if params.Message.AllowedMentions == nil && c.client.allowedMentions != nil {
+		p, msg := *params, *params.Message
+		msg.AllowedMentions = c.client.allowedMentions
+		p.Message = &msg
+		params = &p
+	}
+
+	postBody, contentType, err := params.prepare()
+	if err != nil {
+		return nil, err
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         c.ctx,
+		Endpoint:    endpoint.ChannelThreads(c.cid),
+		Body:        postBody,
+		ContentType: contentType,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ForumThread{}
+	}
+
+	v, err := exec(r.Execute, flags...)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*ForumThread), nil
+}
+
+// JoinThread [REST] Adds the current user to a thread. Also requires the thread is not archived.
+// Returns a 204 empty response on success. Fires a Thread Members Update Gateway event.
+//  Method                  PUT
+//  Endpoint                /channels/{channel.id}/thread-members/@me
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#join-thread
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) JoinThread(flags ...RequestOption) error {
+	return c.threadMemberRequest(&httd.Request{Method: httd.MethodPut, Endpoint: endpoint.ChannelThreadMemberMe(c.cid)}, flags)
+}
+
+// LeaveThread [REST] Removes the current user from a thread. Also requires the thread is not archived.
+// Returns a 204 empty response on success. Fires a Thread Members Update Gateway event.
+//  Method                  DELETE
+//  Endpoint                /channels/{channel.id}/thread-members/@me
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#leave-thread
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) LeaveThread(flags ...RequestOption) error {
+	return c.threadMemberRequest(&httd.Request{Method: httd.MethodDelete, Endpoint: endpoint.ChannelThreadMemberMe(c.cid)}, flags)
+}
+
+// AddThreadMember [REST] Adds another member to a thread. Requires the ability to send messages in the thread.
+// Also requires the thread is not archived. Returns a 204 empty response if the member is successfully added
+// or was already a member of the thread. Fires a Thread Members Update Gateway event.
+//  Method                  PUT
+//  Endpoint                /channels/{channel.id}/thread-members/{user.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#add-thread-member
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) AddThreadMember(userID Snowflake, flags ...RequestOption) error {
+	if userID.IsZero() {
+		return errors.New("userID must be set to target the specific thread member")
+	}
+	return c.threadMemberRequest(&httd.Request{Method: httd.MethodPut, Endpoint: endpoint.ChannelThreadMember(c.cid, userID)}, flags)
+}
+
+// RemoveThreadMember [REST] Removes another member from a thread. Requires the MANAGE_THREADS permission, or the
+// creator of the thread if it is a private thread. Also requires the thread is not archived. Returns a 204 empty
+// response on success. Fires a Thread Members Update Gateway event.
+//  Method                  DELETE
+//  Endpoint                /channels/{channel.id}/thread-members/{user.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#remove-thread-member
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) RemoveThreadMember(userID Snowflake, flags ...RequestOption) error {
+	if userID.IsZero() {
+		return errors.New("userID must be set to target the specific thread member")
+	}
+	return c.threadMemberRequest(&httd.Request{Method: httd.MethodDelete, Endpoint: endpoint.ChannelThreadMember(c.cid, userID)}, flags)
+}
+
+func (c channelQueryBuilder) threadMemberRequest(req *httd.Request, flags []RequestOption) error {
+	if c.cid.IsZero() {
+		return errors.New("channelID must be set to target the correct thread")
+	}
+
+	req.Ctx = c.ctx
+	r := c.client.newRESTRequest(req, flags)
+
+	_, err := r.Execute()
+	return err
+}
+
+// GetThreadMember [REST] Returns a thread member object for the specified user if they are a member of the thread.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/thread-members/{user.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#get-thread-member
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) GetThreadMember(userID Snowflake, flags ...RequestOption) (*ThreadMember, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct thread")
+	}
+	if userID.IsZero() {
+		return nil, errors.New("userID must be set to target the specific thread member")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ChannelThreadMember(c.cid, userID),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ThreadMember{}
+	}
+
+	return getThreadMember(r.Execute)
+}
+
+// GetThreadMembers [REST] Returns array of thread members objects that are members of the thread.
+// This endpoint is restricted according to whether the GUILD_MEMBERS Privileged Intent is enabled for your application.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/thread-members
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#list-thread-members
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (c channelQueryBuilder) GetThreadMembers(flags ...RequestOption) ([]*ThreadMember, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct thread")
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.ChannelThreadMembers(c.cid),
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		tmp := make([]*ThreadMember, 0)
+		return &tmp
+	}
+
+	return getThreadMembers(r.Execute)
+}
+
+// GetPublicArchivedThreads [REST] Returns archived threads in the channel that are public. When called on a
+// GUILD_TEXT channel, returns threads of type GUILD_PUBLIC_THREAD. When called on a GUILD_NEWS channel returns
+// threads of type GUILD_NEWS_THREAD. Threads are ordered by archive_timestamp, in descending order.
+// Requires the READ_MESSAGE_HISTORY permission.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/threads/archived/public
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#list-public-archived-threads
+//  Reviewed                2021-08-28
+//  Comment                 Use ThreadList.HasMore and the archive timestamp of the last thread to paginate.
+func (c channelQueryBuilder) GetPublicArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error) {
+	return c.getThreadList(endpoint.ChannelThreadsArchivedPublic(c.cid), params, flags)
+}
+
+// GetPrivateArchivedThreads [REST] Returns archived threads in the channel that are of type GUILD_PRIVATE_THREAD.
+// Threads are ordered by archive_timestamp, in descending order. Requires both the READ_MESSAGE_HISTORY and
+// MANAGE_THREADS permissions.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/threads/archived/private
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#list-private-archived-threads
+//  Reviewed                2021-08-28
+//  Comment                 Use ThreadList.HasMore and the archive timestamp of the last thread to paginate.
+func (c channelQueryBuilder) GetPrivateArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error) {
+	return c.getThreadList(endpoint.ChannelThreadsArchivedPrivate(c.cid), params, flags)
+}
+
+// GetJoinedPrivateArchivedThreads [REST] Returns archived threads in the channel that are of type
+// GUILD_PRIVATE_THREAD, and the user has joined. Threads are ordered by their id, in descending order.
+// Requires the READ_MESSAGE_HISTORY permission.
+//  Method                  GET
+//  Endpoint                /channels/{channel.id}/users/@me/threads/archived/private
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#list-joined-private-archived-threads
+//  Reviewed                2021-08-28
+//  Comment                 Use ThreadList.HasMore and GetArchivedThreadsParams.BeforeID to paginate.
+func (c channelQueryBuilder) GetJoinedPrivateArchivedThreads(params *GetArchivedThreadsParams, flags ...RequestOption) (*ThreadList, error) {
+	return c.getThreadList(endpoint.ChannelUsersMeThreadsArchivedPrivate(c.cid), params, flags)
+}
+
+func (c channelQueryBuilder) getThreadList(e string, params *GetArchivedThreadsParams, flags []RequestOption) (*ThreadList, error) {
+	if c.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if params != nil {
+		e += params.URLQueryString()
+	}
+
+	r := c.client.newRESTRequest(&httd.Request{
+		Endpoint: e,
+		Ctx:      c.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ThreadList{}
+	}
+
+	return getThreadList(r.Execute)
+}
+
+// StartThread [REST] Creates a new thread from an existing message. When called on a GUILD_TEXT channel,
+// creates a GUILD_PUBLIC_THREAD. When called on a GUILD_NEWS channel, creates a GUILD_NEWS_THREAD.
+// The id of the created thread will be the same as the id of the message. Returns a channel on success,
+// and fires a Thread Create Gateway event.
+//  Method                  POST
+//  Endpoint                /channels/{channel.id}/messages/{message.id}/threads
+//  Discord documentation   https://discord.com/developers/docs/resources/channel#start-thread-with-message
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (m messageQueryBuilder) StartThread(params *StartThreadParams, flags ...RequestOption) (*Channel, error) {
+	if m.cid.IsZero() {
+		return nil, errors.New("channelID must be set to target the correct channel")
+	}
+	if m.mid.IsZero() {
+		return nil, errors.New("messageID must be set to target the specific channel message")
+	}
+	if params == nil {
+		return nil, errors.New("params was nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := m.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPost,
+		Ctx:         m.ctx,
+		Endpoint:    endpoint.ChannelMessageThreads(m.cid, m.mid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Channel{}
+	}
+
+	return getChannel(r.Execute)
+}
+
+// GetActiveThreads [REST] Returns all active threads in the guild, including public and private threads.
+// Threads are ordered by their id, in descending order.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/threads/active
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#list-active-threads
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (g guildQueryBuilder) GetActiveThreads(flags ...RequestOption) (*ThreadList, error) {
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildThreadsActive(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &ThreadList{}
+	}
+
+	return getThreadList(r.Execute)
+}
@@ -0,0 +1,36 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_UpdateToken(t *testing.T) {
+	var authorization string
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			authorization = req.Header.Get("Authorization")
+			return jsonResponse(req, `{"id":"1"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = client.UpdateToken(""); err == nil {
+		t.Error("expected an empty token to be rejected")
+	}
+	if err = client.UpdateToken("rotated"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.Channel(1).Get(IgnoreCache); err != nil {
+		t.Fatal(err)
+	}
+	if authorization != "rotated" {
+		t.Errorf("expected the request to use the new token. Got %q", authorization)
+	}
+}
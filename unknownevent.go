@@ -0,0 +1,81 @@
+package disgord
+
+import (
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/internal/logger"
+	"github.com/Vedza/disgord/json"
+)
+
+// UnknownEventPolicy decides what happens to gateway events that disgord does not support yet, see
+// Config.UnknownEvents.
+type UnknownEventPolicy int
+
+const (
+	// UnknownEventsRaw only gives unknown events to the raw handlers, see GatewayQueryBuilder.OnRaw.
+	UnknownEventsRaw UnknownEventPolicy = iota
+
+	// UnknownEventsDrop logs the first event of every unknown event type, and drops them before they
+	// reach the raw handlers.
+	UnknownEventsDrop
+
+	// UnknownEventsDispatch dispatches unknown events as an UnknownEvent to the handlers registered with
+	// GatewayQueryBuilder.OnUnknownEvent, in addition to the raw handlers.
+	UnknownEventsDispatch
+)
+
+// UnknownEvent is a gateway event that disgord does not support yet, see UnknownEventsDispatch.
+type UnknownEvent struct {
+	Name    string
+	Payload json.RawMessage
+	ShardID uint
+}
+
+// HandlerUnknownEvent is triggered by events unknown to disgord, see GatewayQueryBuilder.OnUnknownEvent.
+type HandlerUnknownEvent = func(s Session, evt *UnknownEvent)
+
+var knownEvents = func() map[string]bool {
+	events := make(map[string]bool)
+	for _, name := range AllEvents() {
+		events[name] = true
+	}
+	return events
+}()
+
+func (d *dispatcher) addUnknownEventHandler(handler HandlerUnknownEvent) {
+	d.Lock()
+	d.unknownEventHandlers = append(d.unknownEventHandlers, handler)
+	d.Unlock()
+}
+
+func (d *dispatcher) dispatchUnknownEvent(evt *UnknownEvent) {
+	d.RLock()
+	handlers := d.unknownEventHandlers
+	d.RUnlock()
+
+	for _, handler := range handlers {
+		d.safeTriggerUnknownEvent(handler, evt)
+	}
+}
+
+func (d *dispatcher) safeTriggerUnknownEvent(handler HandlerUnknownEvent, evt *UnknownEvent) {
+	defer d.recoverHandler(evt.Name)
+	handler(d.session, evt)
+}
+
+// unknownEvent handles an event unknown to disgord according to Config.UnknownEvents.
+func (c *Client) unknownEvent(d *dispatcher, evt *gateway.Event) {
+	switch d.unknownEvents {
+	case UnknownEventsDrop:
+		d.pending.Dec()
+		if _, logged := c.unknownEventsLogged.LoadOrStore(evt.Name, true); !logged {
+			log := logger.With(c.subsystemLog(LogGateway), logger.Field{Key: "event", Value: evt.Name})
+			log.Info("Dropping events of the type " + evt.Name + ", as they are unknown to disgord")
+		}
+	case UnknownEventsDispatch:
+		d.triggerRaw(evt.Name, evt.Data)
+		d.schedule(evt.Name, 0, &UnknownEvent{Name: evt.Name, Payload: evt.Data, ShardID: evt.ShardID})
+	default:
+		d.triggerRaw(evt.Name, evt.Data)
+		d.pending.Dec()
+	}
+}
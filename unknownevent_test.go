@@ -0,0 +1,69 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Vedza/disgord/internal/gateway"
+	"github.com/Vedza/disgord/json"
+)
+
+func TestClient_UnknownEvents(t *testing.T) {
+	newClient := func(policy UnknownEventPolicy) (*Client, chan string, chan *UnknownEvent) {
+		client, err := NewClient(context.Background(), Config{
+			BotToken:      "testing",
+			UnknownEvents: policy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		raw := make(chan string, 1)
+		client.Gateway().OnRaw(func(evtName string, payload json.RawMessage) {
+			raw <- evtName
+		})
+		unknown := make(chan *UnknownEvent, 1)
+		client.Gateway().OnUnknownEvent(func(s Session, evt *UnknownEvent) {
+			unknown <- evt
+		})
+
+		client.demultiplex(client.dispatcher, &gateway.Event{Name: "BRAND_NEW_EVENT", Data: []byte(`{"id":"1"}`), ShardID: 2})
+		if err = client.Drain(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return client, raw, unknown
+	}
+
+	t.Run("raw", func(t *testing.T) {
+		_, raw, unknown := newClient(UnknownEventsRaw)
+		if len(raw) != 1 {
+			t.Error("expected the raw handler to be called")
+		}
+		if len(unknown) != 0 {
+			t.Error("expected no unknown event to be dispatched")
+		}
+	})
+	t.Run("drop", func(t *testing.T) {
+		_, raw, unknown := newClient(UnknownEventsDrop)
+		if len(raw) != 0 || len(unknown) != 0 {
+			t.Error("expected the event to be dropped")
+		}
+	})
+	t.Run("dispatch", func(t *testing.T) {
+		_, raw, unknown := newClient(UnknownEventsDispatch)
+		if len(raw) != 1 {
+			t.Error("expected the raw handler to be called")
+		}
+		select {
+		case evt := <-unknown:
+			if evt.Name != "BRAND_NEW_EVENT" || evt.ShardID != 2 || string(evt.Payload) != `{"id":"1"}` {
+				t.Errorf("unexpected event %+v", evt)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected the unknown event to be dispatched")
+		}
+	})
+}
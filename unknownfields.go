@@ -0,0 +1,164 @@
+package disgord
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Vedza/disgord/json"
+)
+
+// UnknownField is a json field sent by Discord which does not map to any struct field, such that the data is
+// discarded. See Config.OnUnknownField.
+type UnknownField struct {
+	// Source is the name of the event, or the method and endpoint of the REST request, eg. "GET /channels/123"
+	Source string
+
+	// Type is the struct missing the field, eg. "disgord.Message"
+	Type string
+
+	// Path locates the field in the payload, eg. "embeds[].footer.icon_hash"
+	Path string
+}
+
+var jsonUnmarshaler = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields compares payloads with the structs they are decoded into. Every unknown field is only
+// reported once per struct.
+type unknownFields struct {
+	report func(field *UnknownField)
+
+	mu       sync.Mutex
+	reported map[string]bool
+
+	// fields of every visited struct type, by lower case json name
+	fields sync.Map
+}
+
+func newUnknownFields(report func(field *UnknownField)) *unknownFields {
+	return &unknownFields{
+		report:   report,
+		reported: make(map[string]bool),
+	}
+}
+
+// check reports the fields of data that were not decoded into v.
+func (u *unknownFields) check(source string, data []byte, v interface{}) {
+	if v == nil {
+		return
+	}
+	u.walk(source, "", data, reflect.TypeOf(v))
+}
+
+func (u *unknownFields) walk(source, path string, data json.RawMessage, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return // eg. a Snowflake or Time
+		}
+
+		fields := u.structFields(t)
+		// events such as MessageCreate decode the payload into their only field
+		if len(fields) == 1 && reflect.PtrTo(t).Implements(jsonUnmarshaler) {
+			for _, field := range fields {
+				u.walk(source, path, data, field)
+			}
+			return
+		}
+
+		for key, value := range object {
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				u.unknown(source, t, joinFieldPath(path, key))
+				continue
+			}
+			u.walk(source, joinFieldPath(path, key), value, field)
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return
+		}
+		for _, item := range items {
+			u.walk(source, path+"[]", item, t.Elem())
+		}
+	case reflect.Map:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+		for _, value := range object {
+			u.walk(source, path+"{}", value, t.Elem())
+		}
+	}
+}
+
+func (u *unknownFields) unknown(source string, t reflect.Type, path string) {
+	field := &UnknownField{Source: source, Type: t.String(), Path: path}
+
+	key := field.Type + " " + path[strings.LastIndex(path, ".")+1:]
+	u.mu.Lock()
+	reported := u.reported[key]
+	u.reported[key] = true
+	u.mu.Unlock()
+
+	if !reported {
+		u.report(field)
+	}
+}
+
+// structFields returns the types of the decoded fields of a struct, by their lower case json name, as
+// encoding/json matches the names case insensitively.
+func (u *unknownFields) structFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := u.fields.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := make(map[string]reflect.Type)
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+				collect(fieldType)
+				continue
+			}
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			if name == "" {
+				name = field.Name
+			}
+			fields[strings.ToLower(name)] = field.Type
+		}
+	}
+	collect(t)
+
+	u.fields.Store(t, fields)
+	return fields
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,58 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+)
+
+func TestUnknownFields(t *testing.T) {
+	var fields []string
+	u := newUnknownFields(func(field *UnknownField) {
+		fields = append(fields, field.Source+" "+field.Type+" "+field.Path)
+	})
+
+	data := []byte(`{"id":"1","content":"hi","new_field":1,"embeds":[{"title":"x","brand_new":true}],"author":{"id":"2","Username":"a"}}`)
+	u.check(EvtMessageCreate, data, &MessageCreate{})
+	u.check(EvtMessageUpdate, data, &MessageUpdate{})
+
+	sort.Strings(fields)
+	expected := []string{
+		"MESSAGE_CREATE disgord.Embed embeds[].brand_new",
+		"MESSAGE_CREATE disgord.Message new_field",
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("expected each unknown field to be reported once. Got %v", fields)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("expected %q. Got %q", expected[i], fields[i])
+		}
+	}
+}
+
+func TestClient_OnUnknownField(t *testing.T) {
+	var field *UnknownField
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			return jsonResponse(req, `{"id":"1","name":"general","new_field":true}`), nil
+		})},
+		OnUnknownField: func(f *UnknownField) {
+			field = f
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.Channel(1).Get(IgnoreCache); err != nil {
+		t.Fatal(err)
+	}
+	if field == nil || field.Source != "GET /channels/1" || field.Type != "disgord.Channel" || field.Path != "new_field" {
+		t.Errorf("unexpected unknown field %+v", field)
+	}
+}
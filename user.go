@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"strconv"
 
+	"github.com/Vedza/disgord/cdn"
 	"github.com/Vedza/disgord/internal/endpoint"
 	"github.com/Vedza/disgord/internal/httd"
 )
@@ -96,7 +97,7 @@ const (
 	ActivityTypeGame ActivityType = iota
 	ActivityTypeStreaming
 	ActivityTypeListening
-	_
+	ActivityTypeWatching
 	ActivityTypeCustom
 	ActivityTypeCompeting
 )
@@ -216,21 +217,17 @@ func (u *User) Mention() string {
 	return "<@" + u.ID.String() + ">"
 }
 
-// AvatarURL returns a link to the Users avatar with the given size.
+// AvatarURL returns a link to the Users avatar with the given size. See the cdn package for other
+// formats and images.
 func (u *User) AvatarURL(size int, preferGIF bool) (url string, err error) {
 	if size > 2048 || size < 16 || (size&(size-1)) > 0 {
 		return "", errors.New("image size can be any power of two between 16 and 2048")
 	}
 
 	if u.Avatar == "" {
-		url = fmt.Sprintf("https://cdn.discordapp.com/embed/avatars/%d.png?size=%d", u.Discriminator%5, size)
-	} else if strings.HasPrefix(u.Avatar, "a_") && preferGIF {
-		url = fmt.Sprintf("https://cdn.discordapp.com/avatars/%d/%s.gif?size=%d", u.ID, u.Avatar, size)
-	} else {
-		url = fmt.Sprintf("https://cdn.discordapp.com/avatars/%d/%s.webp?size=%d", u.ID, u.Avatar, size)
+		return cdn.DefaultAvatar(u.ID, int(u.Discriminator)) + "?size=" + strconv.Itoa(size), nil
 	}
-
-	return
+	return cdn.Avatar(u.ID, u.Avatar, &cdn.Options{Size: size, Format: cdn.WebP, Animated: preferGIF})
 }
 
 // Tag formats the user to Anders#1234
@@ -309,10 +306,10 @@ type UserQueryBuilder interface {
 	WithContext(ctx context.Context) UserQueryBuilder
 
 	// GetUser Returns a user object for a given user Snowflake.
-	Get(flags ...Flag) (*User, error)
+	Get(flags ...RequestOption) (*User, error)
 
 	// CreateDM Create a new DM channel with a user. Returns a DM channel object.
-	CreateDM(flags ...Flag) (ret *Channel, err error)
+	CreateDM(flags ...RequestOption) (ret *Channel, err error)
 }
 
 // Guild is used to create a guild query builder.
@@ -338,7 +335,7 @@ func (c userQueryBuilder) WithContext(ctx context.Context) UserQueryBuilder {
 //  Discord documentation   https://discord.com/developers/docs/resources/user#get-user
 //  Reviewed                2018-06-10
 //  Comment                 -
-func (c userQueryBuilder) Get(flags ...Flag) (*User, error) {
+func (c userQueryBuilder) Get(flags ...RequestOption) (*User, error) {
 	if !ignoreCache(flags...) {
 		if usr, _ := c.client.cache.GetUser(c.uid); usr != nil {
 			return usr, nil
@@ -349,10 +346,19 @@ func (c userQueryBuilder) Get(flags ...Flag) (*User, error) {
 		Endpoint: endpoint.User(c.uid),
 		Ctx:      c.ctx,
 	}, flags)
-	r.pool = c.client.pool.user
 	r.factory = userFactory
 
-	return getUser(r.Execute)
+	v, shared, err := c.client.requests.do("user:"+c.uid.String(), func() (interface{}, error) {
+		return getUser(r.Execute)
+	})
+	if err != nil {
+		return nil, err
+	}
+	user := v.(*User)
+	if shared {
+		user = DeepCopy(user).(*User)
+	}
+	return user, nil
 }
 
 // CreateDM [REST] Create a new DM channel with a user. Returns a DM channel object.
@@ -361,7 +367,7 @@ func (c userQueryBuilder) Get(flags ...Flag) (*User, error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/user#create-dm
 //  Reviewed                2019-02-23
 //  Comment                 -
-func (c userQueryBuilder) CreateDM(flags ...Flag) (ret *Channel, err error) {
+func (c userQueryBuilder) CreateDM(flags ...RequestOption) (ret *Channel, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodPost,
 		Ctx:      c.ctx,
@@ -384,28 +390,36 @@ type CurrentUserQueryBuilder interface {
 	// GetCurrentUser Returns the user object of the requester's account. For OAuth2, this requires the identify
 	// scope, which will return the object without an email, and optionally the email scope, which returns the object
 	// with an email.
-	Get(flags ...Flag) (*User, error)
+	Get(flags ...RequestOption) (*User, error)
 
 	// UpdateCurrentUser Modify the requester's user account settings. Returns a user object on success.
-	UpdateBuilder(flags ...Flag) UpdateCurrentUserBuilder
+	UpdateBuilder(flags ...RequestOption) UpdateCurrentUserBuilder
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) UpdateCurrentUserBuilder
+	Update(flags ...RequestOption) UpdateCurrentUserBuilder
 
 	// GetCurrentUserGuilds Returns a list of partial guild objects the current user is a member of.
 	// Requires the Guilds OAuth2 scope.
-	GetGuilds(params *GetCurrentUserGuildsParams, flags ...Flag) (ret []*Guild, err error)
+	GetGuilds(params *GetCurrentUserGuildsParams, flags ...RequestOption) (ret []*Guild, err error)
 
 	// LeaveGuild Leave a guild. Returns a 204 empty response on success.
-	LeaveGuild(id Snowflake, flags ...Flag) (err error)
+	LeaveGuild(id Snowflake, flags ...RequestOption) (err error)
 
 	// CreateGroupDM Create a new group DM channel with multiple Users. Returns a DM channel object.
 	// This endpoint was intended to be used with the now-deprecated GameBridge SDK. DMs created with this
 	// endpoint will not be shown in the Discord Client
-	CreateGroupDM(params *CreateGroupDMParams, flags ...Flag) (ret *Channel, err error)
+	CreateGroupDM(params *CreateGroupDMParams, flags ...RequestOption) (ret *Channel, err error)
 
 	// GetUserConnections Returns a list of connection objects. Requires the connections OAuth2 scope.
-	GetUserConnections(flags ...Flag) (ret []*UserConnection, err error)
+	GetUserConnections(flags ...RequestOption) (ret []*UserConnection, err error)
+
+	// GetApplicationRoleConnection Returns the application role connection for the user the access token
+	// belongs to. Requires an OAuth2 access token with the role_connections.write scope.
+	GetApplicationRoleConnection(applicationID Snowflake, accessToken string, flags ...RequestOption) (*ApplicationRoleConnection, error)
+
+	// UpdateApplicationRoleConnection Updates the application role connection for the user the access token
+	// belongs to. Requires an OAuth2 access token with the role_connections.write scope.
+	UpdateApplicationRoleConnection(applicationID Snowflake, accessToken string, params *UpdateApplicationRoleConnectionParams, flags ...RequestOption) (*ApplicationRoleConnection, error)
 }
 
 // Guild is used to create a guild query builder.
@@ -434,7 +448,7 @@ func (c currentUserQueryBuilder) WithContext(ctx context.Context) CurrentUserQue
 //  Discord documentation   https://discord.com/developers/docs/resources/user#get-current-user
 //  Reviewed                2019-02-23
 //  Comment                 -
-func (c currentUserQueryBuilder) Get(flags ...Flag) (user *User, err error) {
+func (c currentUserQueryBuilder) Get(flags ...RequestOption) (user *User, err error) {
 	if !ignoreCache(flags...) {
 		if usr, err := c.client.cache.GetCurrentUser(); err != nil && usr != nil {
 			return usr, nil
@@ -457,7 +471,7 @@ func (c currentUserQueryBuilder) Get(flags ...Flag) (user *User, err error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/user#modify-current-user
 //  Reviewed                2019-02-18
 //  Comment                 -
-func (c currentUserQueryBuilder) UpdateBuilder(flags ...Flag) UpdateCurrentUserBuilder {
+func (c currentUserQueryBuilder) UpdateBuilder(flags ...RequestOption) UpdateCurrentUserBuilder {
 	builder := &updateCurrentUserBuilder{}
 	builder.r.itemFactory = userFactory // TODO: peak cached user
 	builder.r.flags = flags
@@ -490,7 +504,7 @@ var _ URLQueryStringer = (*GetCurrentUserGuildsParams)(nil)
 //  Comment                 This endpoint. returns 100 Guilds by default, which is the maximum number of
 //                          Guilds a non-bot user can join. Therefore, pagination is not needed for
 //                          integrations that need to get a list of Users' Guilds.
-func (c currentUserQueryBuilder) GetGuilds(params *GetCurrentUserGuildsParams, flags ...Flag) (ret []*Guild, err error) {
+func (c currentUserQueryBuilder) GetGuilds(params *GetCurrentUserGuildsParams, flags ...RequestOption) (ret []*Guild, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.UserMeGuilds(),
 		Ctx:      c.ctx,
@@ -527,7 +541,7 @@ type CreateGroupDMParams struct {
 //  Discord documentation   https://discord.com/developers/docs/resources/user#leave-guild
 //  Reviewed                2019-02-18
 //  Comment                 -
-func (c currentUserQueryBuilder) LeaveGuild(id Snowflake, flags ...Flag) (err error) {
+func (c currentUserQueryBuilder) LeaveGuild(id Snowflake, flags ...RequestOption) (err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Method:   httd.MethodDelete,
 		Endpoint: endpoint.UserMeGuild(id),
@@ -546,7 +560,7 @@ func (c currentUserQueryBuilder) LeaveGuild(id Snowflake, flags ...Flag) (err er
 //  Discord documentation   https://discord.com/developers/docs/resources/user#create-group-dm
 //  Reviewed                2019-02-19
 //  Comment                 -
-func (c currentUserQueryBuilder) CreateGroupDM(params *CreateGroupDMParams, flags ...Flag) (ret *Channel, err error) {
+func (c currentUserQueryBuilder) CreateGroupDM(params *CreateGroupDMParams, flags ...RequestOption) (ret *Channel, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPost,
 		Ctx:         c.ctx,
@@ -568,7 +582,7 @@ func (c currentUserQueryBuilder) CreateGroupDM(params *CreateGroupDMParams, flag
 //  Discord documentation   https://discord.com/developers/docs/resources/user#get-user-connections
 //  Reviewed                2019-02-19
 //  Comment                 -
-func (c currentUserQueryBuilder) GetUserConnections(flags ...Flag) (connections []*UserConnection, err error) {
+func (c currentUserQueryBuilder) GetUserConnections(flags ...RequestOption) (connections []*UserConnection, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.UserMeConnections(),
 		Ctx:      c.ctx,
@@ -49,3 +49,36 @@ func TestGetCurrentUserGuildsParams(t *testing.T) {
 	params.SetDefaultLimit()
 	verifyQueryString(t, params.r.urlParams, wants)
 }
+
+func TestApplicationRoleConnectionMetadata_FindErrors(t *testing.T) {
+	valid := func() *ApplicationRoleConnectionMetadata {
+		return &ApplicationRoleConnectionMetadata{
+			Type:        RoleConnectionMetadataIntegerGreaterThanOrEqual,
+			Key:         "matches_won",
+			Name:        "Matches won",
+			Description: "Number of matches won",
+		}
+	}
+
+	if err := valid().FindErrors(); err != nil {
+		t.Errorf("expected record to be valid. Got %s", err)
+	}
+
+	invalid := map[string]func(m *ApplicationRoleConnectionMetadata){
+		"unknown type":     func(m *ApplicationRoleConnectionMetadata) { m.Type = 9 },
+		"missing key":      func(m *ApplicationRoleConnectionMetadata) { m.Key = "" },
+		"uppercase key":    func(m *ApplicationRoleConnectionMetadata) { m.Key = "Matches" },
+		"key with space":   func(m *ApplicationRoleConnectionMetadata) { m.Key = "matches won" },
+		"missing name":     func(m *ApplicationRoleConnectionMetadata) { m.Name = "" },
+		"bad localization": func(m *ApplicationRoleConnectionMetadata) { m.NameLocalizations = Localizations{"xx": "won"} },
+	}
+	for name, modify := range invalid {
+		t.Run(name, func(t *testing.T) {
+			m := valid()
+			modify(m)
+			if err := m.FindErrors(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -93,13 +93,36 @@ var _ Reseter = (*VoiceRegion)(nil)
 var _ Copier = (*VoiceRegion)(nil)
 var _ DeepCopier = (*VoiceRegion)(nil)
 
+// OptimalVoiceRegion picks the region closest to the client, as marked by Discord, from the regions returned by
+// GetVoiceRegions. Deprecated regions are never picked, and custom regions only when they are marked optimal.
+// Nil is returned when no region fits.
+//  regions, err := client.Guild(guildID).GetVoiceRegions()
+//  if region := disgord.OptimalVoiceRegion(regions); region != nil {
+//      err = client.Channel(channelID).UpdateBuilder().SetRTCRegion(region.ID).Execute()
+//  }
+func OptimalVoiceRegion(regions []*VoiceRegion) *VoiceRegion {
+	var fallback *VoiceRegion
+	for _, region := range regions {
+		if region == nil || region.Deprecated {
+			continue
+		}
+		if region.Optimal {
+			return region
+		}
+		if fallback == nil && !region.Custom {
+			fallback = region
+		}
+	}
+	return fallback
+}
+
 // GetVoiceRegionsBuilder [REST] Returns an array of voice region objects that can be used when creating servers.
 //  Method                  GET
 //  Endpoint                /voice/regions
 //  Discord documentation   https://discord.com/developers/docs/resources/voice#list-voice-regions
 //  Reviewed                2018-08-21
 //  Comment                 -
-func (c clientQueryBuilder) GetVoiceRegions(flags ...Flag) (regions []*VoiceRegion, err error) {
+func (c clientQueryBuilder) GetVoiceRegions(flags ...RequestOption) (regions []*VoiceRegion, err error) {
 	r := c.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.VoiceRegions(),
 		Ctx:      c.ctx,
@@ -134,43 +157,43 @@ type VoiceChannelQueryBuilder interface {
 	WithContext(ctx context.Context) ChannelQueryBuilder
 
 	// GetChannel Get a channel by Snowflake. Returns a channel object.
-	Get(flags ...Flag) (*Channel, error)
+	Get(flags ...RequestOption) (*Channel, error)
 
 	// UpdateChannel Update a Channels settings. Requires the 'MANAGE_CHANNELS' permission for the guild. Returns
 	// a channel on success, and a 400 BAD REQUEST on invalid parameters. Fires a Channel Update Gateway event. If
 	// modifying a category, individual Channel Update events will fire for each child channel that also changes.
 	// For the PATCH method, all the JSON Params are optional.
-	UpdateBuilder(flags ...Flag) *updateChannelBuilder
+	UpdateBuilder(flags ...RequestOption) *updateChannelBuilder
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) *updateChannelBuilder
+	Update(flags ...RequestOption) *updateChannelBuilder
 
 	// DeleteChannel Delete a channel, or close a private message. Requires the 'MANAGE_CHANNELS' permission for
 	// the guild. Deleting a category does not delete its child Channels; they will have their parent_id removed and a
 	// Channel Update Gateway event will fire for each of them. Returns a channel object on success.
 	// Fires a Channel Delete Gateway event.
-	Delete(flags ...Flag) (*Channel, error)
+	Delete(flags ...RequestOption) (*Channel, error)
 
 	// EditChannelPermissions Edit the channel permission overwrites for a user or role in a channel. Only usable
 	// for guild Channels. Requires the 'MANAGE_ROLES' permission. Returns a 204 empty response on success.
 	// For more information about permissions, see permissions.
-	UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...Flag) error
+	UpdatePermissions(overwriteID Snowflake, params *UpdateChannelPermissionsParams, flags ...RequestOption) error
 
 	// GetChannelInvites Returns a list of invite objects (with invite metadata) for the channel. Only usable for
 	// guild Channels. Requires the 'MANAGE_CHANNELS' permission.
-	GetInvites(flags ...Flag) ([]*Invite, error)
+	GetInvites(flags ...RequestOption) ([]*Invite, error)
 
 	// CreateChannelInvite Create a new invite object for the channel. Only usable for guild Channels. Requires
 	// the CREATE_INSTANT_INVITE permission. All JSON parameters for this route are optional, however the request
 	// body is not. If you are not sending any fields, you still have to send an empty JSON object ({}).
 	// Returns an invite object.
-	CreateInvite(flags ...Flag) *createChannelInviteBuilder
+	CreateInvite(flags ...RequestOption) *createChannelInviteBuilder
 
 	// DeleteChannelPermission Delete a channel permission overwrite for a user or role in a channel. Only usable
 	// for guild Channels. Requires the 'MANAGE_ROLES' permission. Returns a 204 empty response on success. For more
 	// information about permissions,
 	// see permissions: https://discord.com/developers/docs/topics/permissions#permissions
-	DeletePermission(overwriteID Snowflake, flags ...Flag) error
+	DeletePermission(overwriteID Snowflake, flags ...RequestOption) error
 
 	// param{deaf} is deprecated
 	Connect(mute, deaf bool) (VoiceConnection, error)
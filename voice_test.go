@@ -0,0 +1,64 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestOptimalVoiceRegion(t *testing.T) {
+	regions := []*VoiceRegion{
+		{ID: "old", Optimal: true, Deprecated: true},
+		{ID: "event", Custom: true},
+		{ID: "us-east"},
+		{ID: "rotterdam", Optimal: true},
+	}
+	if region := OptimalVoiceRegion(regions); region == nil || region.ID != "rotterdam" {
+		t.Errorf("expected the optimal region. Got %+v", region)
+	}
+
+	regions[3].Optimal = false
+	if region := OptimalVoiceRegion(regions); region == nil || region.ID != "us-east" {
+		t.Errorf("expected the first usable region. Got %+v", region)
+	}
+
+	if region := OptimalVoiceRegion(regions[:2]); region != nil {
+		t.Errorf("expected no region. Got %+v", region)
+	}
+}
+
+func TestUpdateChannelBuilder_RTCRegion(t *testing.T) {
+	var body map[string]interface{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			_ = json.Unmarshal(data, &body)
+			return jsonResponse(req, `{"id":"1","type":2,"rtc_region":"rotterdam"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	channel, err := client.Channel(1).UpdateBuilder().SetRTCRegion("rotterdam").Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body["rtc_region"] != "rotterdam" || channel.RTCRegion != "rotterdam" {
+		t.Errorf("expected the region to be set. Got %+v, %s", body, channel.RTCRegion)
+	}
+
+	if _, err = client.Channel(1).UpdateBuilder().AutomaticRTCRegion().Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := body["rtc_region"]; !ok || v != nil {
+		t.Errorf("expected the region to be reset with null. Got %+v", body)
+	}
+}
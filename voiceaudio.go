@@ -0,0 +1,205 @@
+package disgord
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// opusFrameSamples is the number of samples per channel in a 20ms frame at 48kHz
+	opusFrameSamples = 960
+
+	// maxOpusFrameSize is the recommended max size of a single encoded opus frame
+	maxOpusFrameSize = 4000
+
+	// silenceFrames is the number of silent frames sent after audio, to avoid unintended Opus interpolation
+	silenceFrames = 5
+)
+
+var opusSilenceFrame = []byte{0xF8, 0xFF, 0xFE}
+
+// AudioSource provides opus frames to a voice connection. ReadOpusFrame is called once for every 20ms frame
+// that is sent and must return io.EOF once the source is exhausted. The returned slice must not be modified
+// after it is returned.
+type AudioSource interface {
+	ReadOpusFrame() ([]byte, error)
+}
+
+// OpusEncoder encodes raw PCM audio into opus frames. See for example github.com/hraban/opus.
+type OpusEncoder interface {
+	// Encode encodes a 20ms frame of 48kHz interleaved 16bit PCM into data, and returns the number of bytes written.
+	Encode(pcm []int16, data []byte) (int, error)
+}
+
+// NewPCMAudioSource creates an audio source of 48kHz 16bit little endian interleaved PCM audio. The audio
+// is encoded into opus frames using the given encoder, which must be configured for the same number of channels.
+func NewPCMAudioSource(r io.Reader, encoder OpusEncoder, channels int) AudioSource {
+	return &pcmAudioSource{
+		r:       r,
+		encoder: encoder,
+		pcm:     make([]int16, opusFrameSamples*channels),
+		raw:     make([]byte, opusFrameSamples*channels*2),
+	}
+}
+
+type pcmAudioSource struct {
+	r       io.Reader
+	encoder OpusEncoder
+	pcm     []int16
+	raw     []byte
+	eof     bool
+}
+
+func (s *pcmAudioSource) ReadOpusFrame() ([]byte, error) {
+	if s.eof {
+		return nil, io.EOF
+	}
+
+	n, err := io.ReadFull(s.r, s.raw)
+	if err == io.ErrUnexpectedEOF {
+		// pad the last frame with silence
+		for i := n; i < len(s.raw); i++ {
+			s.raw[i] = 0
+		}
+		s.eof = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	for i := range s.pcm {
+		s.pcm[i] = int16(binary.LittleEndian.Uint16(s.raw[i*2:]))
+	}
+
+	// the frame is handed over to the sender, so every frame needs its own buffer
+	frame := make([]byte, maxOpusFrameSize)
+	if n, err = s.encoder.Encode(s.pcm, frame); err != nil {
+		return nil, err
+	}
+	return frame[:n], nil
+}
+
+// NewOggOpusAudioSource creates an audio source from an Ogg/Opus stream, such as a .opus file or the output of
+// `ffmpeg -c:a libopus -f opus`. The stream must be encoded at 48kHz with 20ms frames.
+func NewOggOpusAudioSource(r io.Reader) AudioSource {
+	return &oggOpusAudioSource{r: r}
+}
+
+type oggOpusAudioSource struct {
+	r        io.Reader
+	segments []byte // lacing values of the current page not yet read
+	packet   []byte
+}
+
+func (s *oggOpusAudioSource) readPageHeader() error {
+	// https://tools.ietf.org/html/rfc3533#section-6
+	var header [27]byte
+	if _, err := io.ReadFull(s.r, header[:]); err != nil {
+		return err
+	}
+	if !bytes.Equal(header[:4], []byte("OggS")) {
+		return errors.New("stream is not an ogg stream, missing page capture pattern")
+	}
+
+	s.segments = make([]byte, header[26])
+	if _, err := io.ReadFull(s.r, s.segments); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *oggOpusAudioSource) ReadOpusFrame() ([]byte, error) {
+	for {
+		if len(s.segments) == 0 {
+			if err := s.readPageHeader(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		size := int(s.segments[0])
+		s.segments = s.segments[1:]
+
+		segment := make([]byte, size)
+		if _, err := io.ReadFull(s.r, segment); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		s.packet = append(s.packet, segment...)
+		if size == 255 {
+			// the packet continues in the next segment, which might be on the next page
+			continue
+		}
+
+		packet := s.packet
+		s.packet = nil
+		if len(packet) == 0 || bytes.HasPrefix(packet, []byte("OpusHead")) || bytes.HasPrefix(packet, []byte("OpusTags")) {
+			continue
+		}
+		return packet, nil
+	}
+}
+
+// NewDCAAudioSource creates an audio source from a DCA encoded stream/file.
+func NewDCAAudioSource(r io.Reader) AudioSource {
+	return &dcaAudioSource{r: r}
+}
+
+type dcaAudioSource struct {
+	r io.Reader
+}
+
+func (s *dcaAudioSource) ReadOpusFrame() ([]byte, error) {
+	var sampleSize uint16
+	if err := binary.Read(s.r, binary.LittleEndian, &sampleSize); err != nil {
+		return nil, err
+	}
+
+	frame := make([]byte, sampleSize)
+	if _, err := io.ReadFull(s.r, frame); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return frame, nil
+}
+
+// sendAudioSource sends every frame of the source, the sender loop takes care of the timing.
+func (v *voiceImpl) sendAudioSource(source AudioSource) error {
+	for {
+		frame, err := source.ReadOpusFrame()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = v.SendOpusFrame(frame); err != nil {
+			return err
+		}
+	}
+}
+
+func (v *voiceImpl) Play(source AudioSource) (err error) {
+	if err = v.StartSpeaking(); err != nil {
+		return err
+	}
+
+	err = v.sendAudioSource(source)
+	for i := 0; i < silenceFrames && v.ready.Load(); i++ {
+		_ = v.SendOpusFrame(opusSilenceFrame)
+	}
+
+	if errSpeaking := v.StopSpeaking(); err == nil {
+		err = errSpeaking
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func oggPage(packets ...[]byte) []byte {
+	var segments, body []byte
+	for _, packet := range packets {
+		size := len(packet)
+		for ; size >= 255; size -= 255 {
+			segments = append(segments, 255)
+		}
+		segments = append(segments, byte(size))
+		body = append(body, packet...)
+	}
+
+	header := make([]byte, 27)
+	copy(header, "OggS")
+	header[26] = byte(len(segments))
+	return append(append(header, segments...), body...)
+}
+
+func TestOggOpusAudioSource(t *testing.T) {
+	long := bytes.Repeat([]byte{1}, 300) // spans two segments
+	stream := append(oggPage([]byte("OpusHead...")), oggPage([]byte("OpusTags..."))...)
+	stream = append(stream, oggPage([]byte{0xF8, 0xFF, 0xFE}, long)...)
+
+	source := NewOggOpusAudioSource(bytes.NewReader(stream))
+	wants := [][]byte{{0xF8, 0xFF, 0xFE}, long}
+	for i := range wants {
+		frame, err := source.ReadOpusFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(frame, wants[i]) {
+			t.Errorf("frame %d is incorrect. Got %d bytes, wants %d bytes", i, len(frame), len(wants[i]))
+		}
+	}
+	if _, err := source.ReadOpusFrame(); err != io.EOF {
+		t.Errorf("expected io.EOF. Got %v", err)
+	}
+
+	source = NewOggOpusAudioSource(bytes.NewReader([]byte("not an ogg stream, but long enough")))
+	if _, err := source.ReadOpusFrame(); err == nil {
+		t.Error("expected an error for a non ogg stream")
+	}
+}
+
+func TestDCAAudioSource(t *testing.T) {
+	stream := &bytes.Buffer{}
+	_ = binary.Write(stream, binary.LittleEndian, uint16(3))
+	stream.Write([]byte{0xF8, 0xFF, 0xFE})
+
+	source := NewDCAAudioSource(stream)
+	if frame, err := source.ReadOpusFrame(); err != nil || len(frame) != 3 {
+		t.Errorf("unexpected frame. Got %v, %v", frame, err)
+	}
+	if _, err := source.ReadOpusFrame(); err != io.EOF {
+		t.Errorf("expected io.EOF. Got %v", err)
+	}
+}
+
+type opusEncoderMock struct {
+	pcm [][]int16
+}
+
+func (e *opusEncoderMock) Encode(pcm []int16, data []byte) (int, error) {
+	e.pcm = append(e.pcm, append([]int16{}, pcm...))
+	return copy(data, []byte{1, 2, 3}), nil
+}
+
+func TestPCMAudioSource(t *testing.T) {
+	const channels = 2
+	samples := make([]int16, opusFrameSamples*channels+2) // one and a bit frames
+	for i := range samples {
+		samples[i] = int16(i)
+	}
+	raw := &bytes.Buffer{}
+	_ = binary.Write(raw, binary.LittleEndian, samples)
+
+	encoder := &opusEncoderMock{}
+	source := NewPCMAudioSource(raw, encoder, channels)
+	for i := 0; i < 2; i++ {
+		if _, err := source.ReadOpusFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := source.ReadOpusFrame(); err != io.EOF {
+		t.Errorf("expected io.EOF. Got %v", err)
+	}
+
+	if len(encoder.pcm) != 2 {
+		t.Fatalf("expected 2 encoded frames. Got %d", len(encoder.pcm))
+	}
+	if encoder.pcm[0][5] != 5 {
+		t.Errorf("incorrect pcm sample. Got %d, wants 5", encoder.pcm[0][5])
+	}
+	last := encoder.pcm[1]
+	if last[1] != int16(opusFrameSamples*channels+1) || last[2] != 0 {
+		t.Error("the last frame was not padded with silence")
+	}
+}
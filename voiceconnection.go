@@ -14,16 +14,21 @@ import (
 
 	"github.com/Vedza/disgord/internal/gateway"
 	"github.com/Vedza/disgord/internal/gateway/cmd"
-
-	"golang.org/x/crypto/nacl/secretbox"
 )
 
+// voiceServerUpdateTimeout is how long to wait for a new voice server after Discord closed the connection with 4014,
+// before assuming the bot was disconnected from the channel
+const voiceServerUpdateTimeout = 5 * time.Second
+
 type voiceRepository struct {
 	mu sync.Mutex
 	c  *Client
 
 	pendingStates  map[Snowflake]chan *VoiceStateUpdate
 	pendingServers map[Snowflake]chan *VoiceServerUpdate
+
+	// connections are the established voice connections, which are notified when the voice server changes
+	connections map[Snowflake]*voiceImpl
 }
 
 // VoiceConnection is the interface used to interact with active voice connections.
@@ -36,12 +41,38 @@ type VoiceConnection interface {
 	StopSpeaking() error
 
 	// SendOpusFrame sends a single frame of opus data to the UDP server. Frames are sent every 20ms with 960 samples (48kHz).
+	// The connection takes care of the pacing, and a call blocks until the previous frame has been sent.
 	//
 	// if the bot has been disconnected or the channel removed, an error will be returned. The voice object must then be properly dealt with to avoid further issues.
 	SendOpusFrame(data []byte) error
 	// SendDCA reads from a Reader expecting a DCA encoded stream/file and sends them as frames.
 	SendDCA(r io.Reader) error
 
+	// Play sends every frame of the audio source, and blocks until the source is exhausted. Speaking is started
+	// before the first frame, and stopped after the trailing frames of silence. Frames are paced by the
+	// connection, so sources should provide frames as fast as they can.
+	Play(source AudioSource) error
+
+	// Receive returns a channel of opus frames sent by the given user. The channel is closed when the voice
+	// connection is closed. Frames are dropped if the channel is not drained fast enough.
+	//
+	// The bot must not be deafened to receive audio, and a user is only known once Discord has notified that
+	// the user started speaking.
+	Receive(userID Snowflake) <-chan *VoicePacket
+
+	// OnReconnect sets a handler that is called, in a new go routine, every time the connection is transparently
+	// re-established. Such as when the guild is moved to a different voice server or the voice session was lost.
+	// Audio sent while reconnecting is lost, so the handler can be used to decide whether playback should be
+	// restarted. If the connection can not be re-established, it is closed.
+	OnReconnect(handler func(conn VoiceConnection, evt *VoiceReconnect))
+
+	// Stats returns the current health metrics of the connection.
+	Stats() *VoiceStats
+
+	// OnStats sets a handler that is called with the health metrics of the connection on every interval, until
+	// the connection is closed. A nil handler stops the reporting. The interval must be at least one second.
+	OnStats(interval time.Duration, handler func(conn VoiceConnection, stats *VoiceStats)) error
+
 	// MoveTo moves from the current voice channel to the given.
 	MoveTo(channelID Snowflake) error
 
@@ -52,19 +83,85 @@ type VoiceConnection interface {
 	Close() error
 }
 
-type voiceImpl struct {
-	sync.Mutex
+// VoiceReconnectReason describes why a voice connection was re-established.
+type VoiceReconnectReason uint
 
-	ready atomic.Bool
+const (
+	// VoiceReconnectServerUpdate the guild was moved to a different voice server, for example after a region change.
+	VoiceReconnectServerUpdate VoiceReconnectReason = iota
+	// VoiceReconnectSessionInvalid the voice session was no longer valid (4006) and a new session was created.
+	VoiceReconnectSessionInvalid
+	// VoiceReconnectServerCrash the voice server crashed (4015) and the session was resumed.
+	VoiceReconnectServerCrash
+)
+
+// VoiceReconnect holds the details of a voice connection that was re-established.
+type VoiceReconnect struct {
+	GuildID Snowflake
+	Reason  VoiceReconnectReason
+
+	// Resumed is true when the voice session and UDP connection was kept. Otherwise a new session was created,
+	// and any audio sent during the reconnect was lost.
+	Resumed bool
+}
 
+// voiceTransport holds the websocket and UDP connection to a voice server.
+type voiceTransport struct {
 	ws  *gateway.VoiceClient
 	udp net.Conn
 
-	ssrc      uint32
-	secretKey [32]byte
+	ssrc         uint32
+	crypto       voiceCrypto
+	udpRoundTrip time.Duration
+}
+
+func (t *voiceTransport) close() error {
+	var errMsg string
+	if t.udp != nil {
+		if err := t.udp.Close(); err != nil {
+			errMsg += err.Error()
+		}
+	}
+	if t.ws != nil {
+		if err := t.ws.Disconnect(); err != nil {
+			errMsg += err.Error()
+		}
+	}
+
+	if errMsg != "" {
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+type voiceImpl struct {
+	sync.Mutex
+
+	ready atomic.Bool
+
+	// transport is the *voiceTransport in use, which is replaced when the connection is re-established
+	transport atomic.Value
 	send      chan []byte
 	close     chan struct{}
 
+	sessionID     atomic.String
+	server        *VoiceServerUpdate
+	serverUpdates chan *VoiceServerUpdate
+	disconnects   chan int
+	resumes       chan struct{}
+	speaking      atomic.Bool
+	onReconnect   func(VoiceConnection, *VoiceReconnect)
+
+	stats voiceStats
+
+	recvMu          sync.Mutex
+	ssrcs           map[uint32]Snowflake
+	receivers       map[Snowflake]chan *VoicePacket
+	receiversClosed bool
+
+	selfDeaf bool
+	selfMute bool
+
 	guildID Snowflake
 	c       *Client
 }
@@ -75,10 +172,15 @@ func newVoiceRepository(c *Client) (voice *voiceRepository) {
 
 		pendingStates:  make(map[Snowflake]chan *VoiceStateUpdate),
 		pendingServers: make(map[Snowflake]chan *VoiceServerUpdate),
+		connections:    make(map[Snowflake]*voiceImpl),
+	}
+	// the voice handlers should not add the voice intents, see Config.DeriveIntents
+	if err := c.dispatcher.registerInternal(EvtVoiceStateUpdate, voice.onVoiceStateUpdate); err != nil {
+		panic(err)
+	}
+	if err := c.dispatcher.registerInternal(EvtVoiceServerUpdate, voice.onVoiceServerUpdate); err != nil {
+		panic(err)
 	}
-	gt := c.Gateway()
-	gt.VoiceStateUpdate(voice.onVoiceStateUpdate)
-	gt.VoiceServerUpdate(voice.onVoiceServerUpdate)
 
 	return voice
 }
@@ -112,7 +214,7 @@ func (r *voiceRepository) voiceConnectOptions(guildID, channelID Snowflake, self
 	_, err = r.c.Gateway().Dispatch(UpdateVoiceState, &UpdateVoiceStatePayload{
 		GuildID:   guildID,
 		ChannelID: channelID,
-		SelfDeaf:  true, //selfDeaf,
+		SelfDeaf:  selfDeaf,
 		SelfMute:  selfMute,
 	})
 	if err != nil {
@@ -143,52 +245,90 @@ waiter:
 		}
 	}
 
-	voice := voiceImpl{
-		guildID: guildID,
-		c:       r.c,
-		send:    make(chan []byte),
-		close:   make(chan struct{}),
+	voice := &voiceImpl{
+		guildID:       guildID,
+		c:             r.c,
+		send:          make(chan []byte),
+		close:         make(chan struct{}),
+		server:        server,
+		serverUpdates: make(chan *VoiceServerUpdate, 1),
+		disconnects:   make(chan int, 1),
+		resumes:       make(chan struct{}, 1),
+		ssrcs:         make(map[uint32]Snowflake),
+		receivers:     make(map[Snowflake]chan *VoicePacket),
+		selfDeaf:      selfDeaf,
+		selfMute:      selfMute,
+	}
+	voice.sessionID.Store(state.SessionID)
+
+	var transport *voiceTransport
+	if transport, err = voice.dial(state.SessionID, server); err != nil {
+		return
 	}
-	// Defer a cleanup just in case
-	defer func(v *voiceImpl) {
-		if !v.ready.Load() {
-			if v.ws != nil {
-				_ = v.ws.Disconnect()
-			}
-			if v.udp != nil {
-				_ = v.udp.Close()
-			}
-			close(v.close)
+	voice.transport.Store(transport)
+	voice.ready.Store(true)
+
+	r.mu.Lock()
+	r.connections[guildID] = voice
+	r.mu.Unlock()
+
+	go voice.opusSendLoop()
+	go voice.opusReceiveLoop(transport)
+	go voice.supervise()
+
+	ret = voice
+	return
+}
+
+// dial connects to the voice server, and establishes the UDP connection used for audio.
+func (v *voiceImpl) dial(sessionID string, server *VoiceServerUpdate) (t *voiceTransport, err error) {
+	t = &voiceTransport{}
+	defer func() {
+		if err != nil {
+			_ = t.close()
+			t = nil
 		}
-	}(&voice)
+	}()
+
+	transport := t
 
 	// Connect to the websocket
-	voice.ws, err = gateway.NewVoiceClient(&gateway.VoiceConfig{
+	t.ws, err = gateway.NewVoiceClient(&gateway.VoiceConfig{
 		GuildID:        server.GuildID,
-		UserID:         r.c.botID,
-		SessionID:      state.SessionID,
+		UserID:         v.c.botID,
+		SessionID:      sessionID,
 		Token:          server.Token,
-		HTTPClient:     r.c.config.HTTPClient,
-		Endpoint:       "wss://" + strings.TrimSuffix(server.Endpoint, ":80") + "/?v=4",
-		Logger:         r.c.log,
-		SystemShutdown: r.c.shutdownChan,
+		HTTPClient:     v.c.config.HTTPClient,
+		NewWebsocket:   v.c.config.NewWebsocket,
+		Endpoint:       "wss://" + strings.TrimSuffix(server.Endpoint, ":80") + "/?v=8",
+		Logger:         v.c.subsystemLog(LogVoice),
+		SystemShutdown: v.c.shutdownChan,
+
+		OnSpeaking:         v.onSpeaking,
+		OnClientDisconnect: v.onClientDisconnect,
+		OnResumed: func() {
+			v.onResumed(transport)
+		},
+		OnDisconnect: func(code int) {
+			v.onDisconnect(transport, code)
+		},
 	})
 	if err != nil {
 		return
 	}
 
 	var ready *gateway.VoiceReady
-	if ready, err = voice.ws.Connect(); err != nil {
+	if ready, err = t.ws.Connect(); err != nil {
 		return
 	}
-	voice.ssrc = ready.SSRC
+	t.ssrc = ready.SSRC
 
 	// Connect to UDP
 	dialer := net.Dial
-	if r.c.config.Proxy != nil {
-		dialer = r.c.config.Proxy.Dial
+	if v.c.config.Proxy != nil {
+		dialer = v.c.config.Proxy.Dial
 	}
-	voice.udp, err = dialer("udp", ready.IP+":"+strconv.Itoa(ready.Port))
+	t.udp, err = dialer("udp", ready.IP+":"+strconv.Itoa(ready.Port))
 	if err != nil {
 		return
 	}
@@ -196,17 +336,19 @@ waiter:
 	// SendOpusFrame our SSRC with no further data for the IP discovery process.
 	ssrcBuffer := make([]byte, 70)
 	binary.BigEndian.PutUint32(ssrcBuffer, ready.SSRC)
-	_, err = voice.udp.Write(ssrcBuffer)
+	discoveryStart := time.Now()
+	_, err = t.udp.Write(ssrcBuffer)
 	if err != nil {
 		return
 	}
 
 	ipBuffer := make([]byte, 70)
 	var n int
-	n, err = voice.udp.Read(ipBuffer)
+	n, err = t.udp.Read(ipBuffer)
 	if err != nil {
 		return
 	}
+	t.udpRoundTrip = time.Since(discoveryStart)
 	if n < 70 {
 		err = errors.New("udp packet received from discord is not the required 70 bytes")
 		return
@@ -221,31 +363,43 @@ waiter:
 	ip := ipb[:nullPos]
 	port := binary.LittleEndian.Uint16(ipBuffer[68:70])
 
-	// Tell the websocket which encryption mode we want to use. We'll go with XSalsa20 and Poly1305 since that's what
-	// libSodium/NaCl and golang.org/x/crypto/nacl/secretbox use. If both Discord and Go both start supporting more
-	// modes "out of the box" we might want to consider implementing a "preferred mode selection" algorithm here.
+	// Tell the websocket which encryption mode we want to use, the newest supported mode is preferred.
+	var mode string
+	if mode, err = selectVoiceEncryptionMode(ready.Modes); err != nil {
+		return
+	}
+
 	var session *gateway.VoiceSessionDescription
-	session, err = voice.ws.SendUDPInfo(&gateway.VoiceSelectProtocolParams{
-		Mode:    "xsalsa20_poly1305",
+	session, err = t.ws.SendUDPInfo(&gateway.VoiceSelectProtocolParams{
+		Mode:    mode,
 		Address: ip,
 		Port:    port,
 	})
 	if err != nil {
 		return
 	}
-	if session.Mode != "xsalsa20_poly1305" {
+	if session.Mode != mode {
 		err = errors.New("discord selected mismatching encryption algorithm")
 		return
 	}
 
-	voice.secretKey = session.SecretKey
-	voice.ready.Store(true)
+	t.crypto, err = newVoiceCrypto(session.Mode, session.SecretKey)
+	return
+}
 
-	go voice.opusSendLoop()
-	go voice.watcherDiscordCloseEvt()
+// conn returns the transport currently in use, or nil while the first connection is being established.
+func (v *voiceImpl) conn() *voiceTransport {
+	t, _ := v.transport.Load().(*voiceTransport)
+	return t
+}
 
-	ret = &voice
-	return
+func (r *voiceRepository) removeConnection(v *voiceImpl) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.connections[v.guildID] == v {
+		delete(r.connections, v.guildID)
+	}
 }
 
 func (r *voiceRepository) onVoiceStateUpdate(_ Session, event *VoiceStateUpdate) {
@@ -261,6 +415,11 @@ func (r *voiceRepository) onVoiceStateUpdate(_ Session, event *VoiceStateUpdate)
 		r.mu.Unlock()
 
 		ch <- event
+	} else if voice, exists := r.connections[gid]; exists && event.SessionID != "" {
+		r.mu.Unlock()
+
+		// used when the connection must be re-established
+		voice.sessionID.Store(event.SessionID)
 	} else {
 		r.mu.Unlock()
 	}
@@ -275,11 +434,170 @@ func (r *voiceRepository) onVoiceServerUpdate(_ Session, event *VoiceServerUpdat
 		r.mu.Unlock()
 
 		ch <- event
+	} else if voice, exists := r.connections[gid]; exists {
+		r.mu.Unlock()
+
+		// the guild was moved to a different voice server
+		voice.onServerUpdate(event)
 	} else {
 		r.mu.Unlock()
 	}
 }
 
+func (v *voiceImpl) onServerUpdate(server *VoiceServerUpdate) {
+	// only the latest voice server is of interest
+	select {
+	case <-v.serverUpdates:
+	default:
+	}
+	select {
+	case v.serverUpdates <- server:
+	default:
+	}
+}
+
+func (v *voiceImpl) onDisconnect(t *voiceTransport, code int) {
+	if v.conn() != t {
+		return // the connection was already replaced
+	}
+	select {
+	case v.disconnects <- code:
+	default:
+	}
+}
+
+func (v *voiceImpl) onResumed(t *voiceTransport) {
+	if v.conn() != t {
+		return
+	}
+	select {
+	case v.resumes <- struct{}{}:
+	default:
+	}
+}
+
+// supervise re-establishes the connection when the guild is moved to another voice server or the voice session
+// is lost, and closes the voice connection when it can not be re-established.
+func (v *voiceImpl) supervise() {
+	for {
+		var (
+			server *VoiceServerUpdate
+			reason VoiceReconnectReason
+		)
+
+		select {
+		case <-v.close:
+			return
+		case <-v.conn().ws.Active():
+			v.shutdown()
+			return
+		case server = <-v.serverUpdates:
+			reason = VoiceReconnectServerUpdate
+		case <-v.resumes:
+			v.reconnected(&VoiceReconnect{
+				GuildID: v.guildID,
+				Reason:  VoiceReconnectServerCrash,
+				Resumed: true,
+			})
+			continue
+		case code := <-v.disconnects:
+			if code == 4006 {
+				server, reason = v.server, VoiceReconnectSessionInvalid
+			} else if server = v.awaitServerUpdate(); server != nil {
+				// Discord closes the connection with 4014 before moving the guild to a different voice server
+				reason = VoiceReconnectServerUpdate
+			} else {
+				// kicked from the channel or the channel was deleted
+				v.shutdown()
+				return
+			}
+		}
+
+		if server.Endpoint == "" {
+			// the voice server is being allocated, Discord sends another update once it is available
+			continue
+		}
+
+		v.c.subsystemLog(LogVoice).Debug("voice", v.guildID, "re-establishing voice connection to", server.Endpoint)
+		if err := v.reconnect(server); err != nil {
+			v.c.subsystemLog(LogVoice).Error("voice", v.guildID, "unable to re-establish voice connection:", err)
+			v.shutdown()
+			return
+		}
+
+		v.reconnected(&VoiceReconnect{
+			GuildID: v.guildID,
+			Reason:  reason,
+		})
+	}
+}
+
+// awaitServerUpdate waits for Discord to assign a new voice server. Nil is returned on timeout.
+func (v *voiceImpl) awaitServerUpdate() *VoiceServerUpdate {
+	timeout := time.After(voiceServerUpdateTimeout)
+	for {
+		select {
+		case server := <-v.serverUpdates:
+			if server.Endpoint != "" {
+				return server
+			}
+		case <-timeout:
+			return nil
+		case <-v.close:
+			return nil
+		}
+	}
+}
+
+// reconnect replaces the transport with a new connection to the given voice server. The send loop keeps going,
+// so audio continues where it left off.
+func (v *voiceImpl) reconnect(server *VoiceServerUpdate) error {
+	// Discord only allows one connection per voice session
+	_ = v.conn().close()
+
+	t, err := v.dial(v.sessionID.Load(), server)
+	if err != nil {
+		return err
+	}
+
+	v.Lock()
+	if !v.ready.Load() {
+		v.Unlock()
+		_ = t.close()
+		return errors.New("voice connection was closed while reconnecting")
+	}
+	v.transport.Store(t)
+	v.server = server
+	v.Unlock()
+
+	go v.opusReceiveLoop(t)
+
+	if v.speaking.Load() {
+		return v.speakingImpl(true)
+	}
+	return nil
+}
+
+func (v *voiceImpl) reconnected(evt *VoiceReconnect) {
+	v.c.subsystemLog(LogVoice).Info("voice", v.guildID, "voice connection was re-established")
+	v.stats.reconnects.Inc()
+
+	v.Lock()
+	handler := v.onReconnect
+	v.Unlock()
+
+	if handler != nil {
+		go handler(v, evt)
+	}
+}
+
+func (v *voiceImpl) OnReconnect(handler func(conn VoiceConnection, evt *VoiceReconnect)) {
+	v.Lock()
+	defer v.Unlock()
+
+	v.onReconnect = handler
+}
+
 func (v *voiceImpl) StartSpeaking() error {
 	return v.speakingImpl(true)
 }
@@ -295,10 +613,15 @@ func (v *voiceImpl) speakingImpl(b bool) error {
 	if !v.ready.Load() {
 		return errors.New("attempting to interact with a closed voice connection")
 	}
+	v.speaking.Store(b)
 
-	return v.ws.Emit(cmd.VoiceSpeaking, &voiceSpeakingData{
-		Speaking: b,
-		SSRC:     v.ssrc,
+	var speaking uint
+	if b {
+		speaking = 1
+	}
+	return v.conn().ws.Emit(cmd.VoiceSpeaking, &voiceSpeakingData{
+		Speaking: speaking,
+		SSRC:     v.conn().ssrc,
 	})
 }
 
@@ -315,22 +638,7 @@ func (v *voiceImpl) SendDCA(r io.Reader) error {
 		return errors.New("attempting to send to a closed voice connection")
 	}
 
-	var sampleSize uint16
-	for {
-		if err := binary.Read(r, binary.LittleEndian, &sampleSize); err != nil {
-			if err == io.EOF {
-				return nil
-			}
-			return err
-		}
-
-		buf := make([]byte, sampleSize)
-		if _, err := io.ReadFull(r, buf); err != nil {
-			panic(err)
-		}
-
-		v.send <- buf
-	}
+	return v.sendAudioSource(NewDCAAudioSource(r))
 }
 
 func (v *voiceImpl) MoveTo(channelID Snowflake) error {
@@ -348,26 +656,15 @@ func (v *voiceImpl) MoveTo(channelID Snowflake) error {
 	_, _ = v.c.Gateway().Dispatch(UpdateVoiceState, &UpdateVoiceStatePayload{
 		GuildID:   v.guildID,
 		ChannelID: channelID,
-		SelfDeaf:  true, //false,
-		SelfMute:  false,
+		SelfDeaf:  v.selfDeaf,
+		SelfMute:  v.selfMute,
 	})
 
 	return nil
 }
 
-func (v *voiceImpl) watcherDiscordCloseEvt() {
-	for {
-		var open bool
-		select {
-		case <-v.close:
-			return
-		case _, open = <-v.ws.Active():
-		}
-		if !open {
-			break
-		}
-	}
-
+// shutdown closes the voice connection after Discord closed it, or it could not be re-established.
+func (v *voiceImpl) shutdown() {
 	v.Lock()
 	defer v.Unlock()
 
@@ -383,13 +680,12 @@ func (v *voiceImpl) watcherDiscordCloseEvt() {
 	default:
 	}
 
-	_ = v.udp.Close()
-	_ = v.ws.Disconnect()
+	_ = v.conn().close()
 	close(v.send)
+	v.closeReceivers()
+	v.c.voiceRepository.removeConnection(v)
 
-	//for range v.ws.Receive() {} // drain
-
-	v.c.Logger().Info("Discord closed voice connection")
+	v.c.subsystemLog(LogVoice).Info("Discord closed voice connection")
 }
 
 func (v *voiceImpl) Close() (err error) {
@@ -399,6 +695,7 @@ func (v *voiceImpl) Close() (err error) {
 	if !v.ready.Load() {
 		return errors.New("attempting to close a closed Voice Connection")
 	}
+	v.ready.Store(false)
 
 	defer func() {
 		close(v.close)
@@ -408,12 +705,15 @@ func (v *voiceImpl) Close() (err error) {
 		default:
 		}
 		close(v.send)
+		v.closeReceivers()
+		v.c.voiceRepository.removeConnection(v)
 	}()
 
 	// if discord have already closed the connection
 	// there is no need to send out a bunch of events
-	if v.ws.IsDisconnected() {
-		return v.udp.Close()
+	t := v.conn()
+	if t.ws.IsDisconnected() {
+		return t.udp.Close()
 	}
 
 	// Tell Discord we want to disconnect from channel/guild
@@ -424,26 +724,18 @@ func (v *voiceImpl) Close() (err error) {
 		SelfMute:  true,
 	})
 
-	err1 := v.udp.Close()
-	err2 := v.ws.Disconnect()
+	return t.close()
+}
 
-	if err1 != nil || err2 != nil {
-		var errMsg string
-		if err1 != nil {
-			errMsg += err1.Error()
-		}
-		if err2 != nil {
-			errMsg += err2.Error()
-		}
+const (
+	voiceFrameDuration = 20 * time.Millisecond // 50 sends per sec, 960 samples each at 48kHz
 
-		return errors.New(errMsg)
-	}
-
-	return nil
-}
+	// voiceMaxJitter is how far behind schedule the sender can be before it stops catching up
+	voiceMaxJitter = 3 * voiceFrameDuration
+)
 
 type voiceSpeakingData struct {
-	Speaking bool   `json:"speaking"`
+	Speaking uint   `json:"speaking"` // bitwise flags, 1 is microphone audio
 	Delay    int    `json:"delay"`
 	SSRC     uint32 `json:"ssrc"`
 }
@@ -453,19 +745,18 @@ func (v *voiceImpl) opusSendLoop() {
 	header := make([]byte, 12)
 	header[0] = 0x80
 	header[1] = 0x78
-	binary.BigEndian.PutUint32(header[8:12], v.ssrc)
 
 	var (
 		sequence  uint16
 		timestamp uint32
-		nonce     [24]byte
 
 		msg  []byte
 		open bool
-	)
 
-	frequency := time.NewTicker(time.Millisecond * 20) // 50 sends per sec, 960 samples each at 48kHz
-	defer frequency.Stop()
+		// next is when the next frame is scheduled to be sent. Frames are scheduled from a fixed point in time
+		// rather than relative to the previous send, so delays in the loop does not accumulate into drift.
+		next time.Time
+	)
 
 	for {
 		select {
@@ -477,22 +768,44 @@ func (v *voiceImpl) opusSendLoop() {
 			return
 		}
 
+		now := time.Now()
+		if next.IsZero() {
+			next = now
+		} else if late := now.Sub(next); late > voiceMaxJitter {
+			// the audio was paused or the source could not keep up, start a new schedule. The skipped
+			// frames are accounted for in the timestamp so the receiving clients handles it as a gap.
+			timestamp += uint32(late/voiceFrameDuration) * opusFrameSamples
+			next = now
+
+			v.stats.gaps.Inc()
+			v.stats.gapDuration.Add(late)
+		}
+
+		// the transport is replaced when the connection is re-established
+		t := v.conn()
+		binary.BigEndian.PutUint32(header[8:12], t.ssrc)
+
 		binary.BigEndian.PutUint16(header[2:4], sequence)
 		sequence++
 
 		binary.BigEndian.PutUint32(header[4:8], timestamp)
-		timestamp += 960 // samples
-
-		copy(nonce[:], header)
+		timestamp += opusFrameSamples
 
-		toSend := secretbox.Seal(header, msg, &nonce, &v.secretKey)
-		select {
-		case <-frequency.C:
-		case <-v.close:
-			return
+		toSend := t.crypto.seal(header, msg)
+		if wait := next.Sub(now); wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-v.close:
+				return
+			}
 		}
+		// frames that are slightly late are sent right away, catching up with the schedule
+		next = next.Add(voiceFrameDuration)
 
-		_, _ = v.udp.Write(toSend)
+		if _, err := t.udp.Write(toSend); err == nil {
+			v.stats.framesSent.Inc()
+			v.stats.bytesSent.Add(uint64(len(toSend)))
+		}
 		// err on udp write? hahahahahah... hahah.. good joke.
 	}
 }
@@ -0,0 +1,66 @@
+// +build !integration
+
+package disgord
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVoiceRepository_ServerUpdate(t *testing.T) {
+	repo := &voiceRepository{
+		pendingStates:  make(map[Snowflake]chan *VoiceStateUpdate),
+		pendingServers: make(map[Snowflake]chan *VoiceServerUpdate),
+		connections:    make(map[Snowflake]*voiceImpl),
+	}
+	voice := &voiceImpl{
+		guildID:       1,
+		serverUpdates: make(chan *VoiceServerUpdate, 1),
+		disconnects:   make(chan int, 1),
+	}
+	repo.connections[voice.guildID] = voice
+
+	repo.onVoiceServerUpdate(nil, &VoiceServerUpdate{GuildID: 1, Endpoint: "old"})
+	repo.onVoiceServerUpdate(nil, &VoiceServerUpdate{GuildID: 1, Endpoint: "new"})
+	repo.onVoiceServerUpdate(nil, &VoiceServerUpdate{GuildID: 2, Endpoint: "other guild"})
+
+	select {
+	case server := <-voice.serverUpdates:
+		if server.Endpoint != "new" {
+			t.Errorf("expected the latest voice server. Got %s", server.Endpoint)
+		}
+	default:
+		t.Fatal("voice server update was not forwarded to the voice connection")
+	}
+
+	current := &voiceTransport{}
+	voice.transport.Store(current)
+	voice.onDisconnect(&voiceTransport{}, 4014)
+	if len(voice.disconnects) != 0 {
+		t.Error("close codes from a replaced transport should be ignored")
+	}
+	voice.onDisconnect(current, 4014)
+	if code := <-voice.disconnects; code != 4014 {
+		t.Errorf("expected close code 4014. Got %d", code)
+	}
+}
+
+func TestVoiceImpl_Stats(t *testing.T) {
+	voice := &voiceImpl{guildID: 1}
+	voice.transport.Store(&voiceTransport{udpRoundTrip: 40 * time.Millisecond})
+	voice.stats.framesSent.Add(50)
+	voice.stats.gaps.Inc()
+	voice.stats.gapDuration.Add(time.Second)
+
+	stats := voice.Stats()
+	if stats.GuildID != 1 || stats.FramesSent != 50 || stats.Gaps != 1 || stats.GapDuration != time.Second {
+		t.Errorf("incorrect stats. Got %+v", stats)
+	}
+	if stats.UDPRoundTrip != 40*time.Millisecond {
+		t.Errorf("expected the UDP round-trip of the transport. Got %s", stats.UDPRoundTrip)
+	}
+
+	if err := voice.OnStats(time.Millisecond, nil); err == nil {
+		t.Error("expected an error for intervals shorter than a second")
+	}
+}
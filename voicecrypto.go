@@ -0,0 +1,143 @@
+package disgord
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+// https://discord.com/developers/docs/topics/voice-connections#transport-encryption-modes
+const (
+	voiceModeXChaCha20Poly1305RTPSize = "aead_xchacha20_poly1305_rtpsize"
+
+	// Deprecated: Discord is removing the xsalsa20_poly1305 modes, it is only used when the
+	// voice server does not support any of the newer modes.
+	voiceModeXSalsa20Poly1305 = "xsalsa20_poly1305"
+)
+
+// voiceEncryptionModes are the supported encryption modes, by preference
+var voiceEncryptionModes = []string{
+	voiceModeXChaCha20Poly1305RTPSize,
+	voiceModeXSalsa20Poly1305,
+}
+
+// selectVoiceEncryptionMode picks the most preferred encryption mode that is offered by the voice server.
+func selectVoiceEncryptionMode(offered []string) (string, error) {
+	for _, mode := range voiceEncryptionModes {
+		for i := range offered {
+			if offered[i] == mode {
+				return mode, nil
+			}
+		}
+	}
+	return "", errors.New("the voice server does not offer any supported encryption modes")
+}
+
+// voiceCrypto encrypts outgoing and decrypts incoming RTP payloads.
+type voiceCrypto interface {
+	// seal encrypts the opus frame and returns the complete RTP packet. Not safe for concurrent use.
+	seal(header, opus []byte) []byte
+
+	// open decrypts the payload of the RTP packet, and returns the opus frame without any header extension.
+	open(packet []byte, headerSize int, extension bool) ([]byte, error)
+}
+
+func newVoiceCrypto(mode string, secretKey [32]byte) (voiceCrypto, error) {
+	switch mode {
+	case voiceModeXChaCha20Poly1305RTPSize:
+		aead, err := chacha20poly1305.NewX(secretKey[:])
+		if err != nil {
+			return nil, err
+		}
+		return &xChaCha20Poly1305RTPSize{aead: aead}, nil
+	case voiceModeXSalsa20Poly1305:
+		return &xSalsa20Poly1305{secretKey: secretKey}, nil
+	default:
+		return nil, errors.New("unsupported voice encryption mode " + mode)
+	}
+}
+
+// stripHeaderExtension removes the RTP header extension from a decrypted payload.
+func stripHeaderExtension(payload []byte, words int) ([]byte, error) {
+	if len(payload) < 4*words {
+		return nil, errors.New("voice packet header extension is longer than the payload")
+	}
+	return payload[4*words:], nil
+}
+
+// xChaCha20Poly1305RTPSize encrypts everything but the RTP header and the header extension profile/length,
+// which is authenticated instead. The nonce is a 32bit counter appended to the packet.
+type xChaCha20Poly1305RTPSize struct {
+	aead  cipher.AEAD
+	nonce uint32
+}
+
+func (c *xChaCha20Poly1305RTPSize) seal(header, opus []byte) []byte {
+	c.nonce++
+	var nonce [chacha20poly1305.NonceSizeX]byte
+	binary.BigEndian.PutUint32(nonce[:4], c.nonce)
+
+	packet := make([]byte, len(header), len(header)+len(opus)+c.aead.Overhead()+4)
+	copy(packet, header)
+	packet = c.aead.Seal(packet, nonce[:], opus, header)
+	return append(packet, nonce[:4]...)
+}
+
+func (c *xChaCha20Poly1305RTPSize) open(packet []byte, headerSize int, extension bool) ([]byte, error) {
+	if extension {
+		headerSize += 4 // the extension profile and length is not encrypted
+	}
+	if len(packet) < headerSize+c.aead.Overhead()+4 {
+		return nil, errors.New("voice packet is too short to hold an encrypted payload")
+	}
+
+	var nonce [chacha20poly1305.NonceSizeX]byte
+	copy(nonce[:4], packet[len(packet)-4:])
+
+	payload, err := c.aead.Open(nil, nonce[:], packet[headerSize:len(packet)-4], packet[:headerSize])
+	if err != nil {
+		return nil, errors.New("unable to decrypt voice packet")
+	}
+	if extension {
+		return stripHeaderExtension(payload, int(binary.BigEndian.Uint16(packet[headerSize-2:headerSize])))
+	}
+	return payload, nil
+}
+
+// xSalsa20Poly1305 encrypts everything after the RTP header, using the header as nonce.
+type xSalsa20Poly1305 struct {
+	secretKey [32]byte
+}
+
+func (c *xSalsa20Poly1305) seal(header, opus []byte) []byte {
+	var nonce [24]byte
+	copy(nonce[:], header)
+
+	packet := make([]byte, len(header), len(header)+len(opus)+secretbox.Overhead)
+	copy(packet, header)
+	return secretbox.Seal(packet, opus, &nonce, &c.secretKey)
+}
+
+func (c *xSalsa20Poly1305) open(packet []byte, headerSize int, extension bool) ([]byte, error) {
+	if len(packet) < headerSize+secretbox.Overhead {
+		return nil, errors.New("voice packet is too short to hold an encrypted payload")
+	}
+
+	var nonce [24]byte
+	copy(nonce[:], packet[:rtpHeaderSize])
+
+	payload, ok := secretbox.Open(nil, packet[headerSize:], &nonce, &c.secretKey)
+	if !ok {
+		return nil, errors.New("unable to decrypt voice packet")
+	}
+	if extension {
+		if len(payload) < 4 {
+			return nil, errors.New("voice packet header extension is missing")
+		}
+		return stripHeaderExtension(payload[4:], int(binary.BigEndian.Uint16(payload[2:4])))
+	}
+	return payload, nil
+}
@@ -0,0 +1,141 @@
+package disgord
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/Vedza/disgord/internal/gateway"
+)
+
+const (
+	rtpHeaderSize = 12
+	rtpVersion    = 2
+
+	// receiverBufferSize is the number of opus frames buffered per user before frames are dropped
+	receiverBufferSize = 64
+)
+
+// VoicePacket holds a single opus frame received from a user in the voice channel.
+type VoicePacket struct {
+	UserID    Snowflake
+	SSRC      uint32
+	Sequence  uint16
+	Timestamp uint32
+
+	// Opus is the decrypted opus frame. A frame holds 20ms of audio, 960 samples at 48kHz.
+	Opus []byte
+}
+
+// decodeVoicePacket decrypts a RTP packet and extracts the opus frame.
+// RTCP packets are ignored and returns nil without an error.
+func decodeVoicePacket(data []byte, crypto voiceCrypto) (*VoicePacket, error) {
+	if len(data) < rtpHeaderSize {
+		return nil, errors.New("voice packet is shorter than a RTP header")
+	}
+	if data[0]>>6 != rtpVersion {
+		return nil, errors.New("voice packet is not a RTP version 2 packet")
+	}
+	if 200 <= data[1] && data[1] <= 204 {
+		// RTCP sender/receiver reports and similar, these do not hold any audio
+		return nil, nil
+	}
+
+	headerSize := rtpHeaderSize + 4*int(data[0]&0x0f) // fixed header + CSRC identifiers
+	opus, err := crypto.open(data, headerSize, data[0]&0x10 != 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VoicePacket{
+		SSRC:      binary.BigEndian.Uint32(data[8:12]),
+		Sequence:  binary.BigEndian.Uint16(data[2:4]),
+		Timestamp: binary.BigEndian.Uint32(data[4:8]),
+		Opus:      opus,
+	}, nil
+}
+
+func (v *voiceImpl) Receive(userID Snowflake) <-chan *VoicePacket {
+	v.recvMu.Lock()
+	defer v.recvMu.Unlock()
+
+	if ch, exists := v.receivers[userID]; exists {
+		return ch
+	}
+
+	ch := make(chan *VoicePacket, receiverBufferSize)
+	if v.receiversClosed {
+		close(ch)
+		return ch
+	}
+	v.receivers[userID] = ch
+	return ch
+}
+
+func (v *voiceImpl) onSpeaking(speaking *gateway.VoiceSpeaking) {
+	if t := v.conn(); t != nil && speaking.SSRC == t.ssrc {
+		return
+	}
+
+	v.recvMu.Lock()
+	v.ssrcs[speaking.SSRC] = speaking.UserID
+	v.recvMu.Unlock()
+}
+
+func (v *voiceImpl) onClientDisconnect(disconnect *gateway.VoiceClientDisconnect) {
+	v.recvMu.Lock()
+	defer v.recvMu.Unlock()
+
+	for ssrc, userID := range v.ssrcs {
+		if userID == disconnect.UserID {
+			delete(v.ssrcs, ssrc)
+		}
+	}
+}
+
+// closeReceivers closes every receiver channel, and any later calls to Receive returns a closed channel.
+func (v *voiceImpl) closeReceivers() {
+	v.recvMu.Lock()
+	defer v.recvMu.Unlock()
+
+	v.receiversClosed = true
+	for userID, ch := range v.receivers {
+		close(ch)
+		delete(v.receivers, userID)
+	}
+}
+
+// opusReceiveLoop reads from the UDP connection of the transport, until the transport is closed.
+func (v *voiceImpl) opusReceiveLoop(t *voiceTransport) {
+	buf := make([]byte, 1500) // MTU
+	for {
+		n, err := t.udp.Read(buf)
+		if err != nil {
+			// the UDP connection is closed together with the voice connection, or when it is re-established
+			return
+		}
+
+		packet, err := decodeVoicePacket(buf[:n], t.crypto)
+		if err != nil {
+			v.c.subsystemLog(LogVoice).Debug("voice", v.guildID, err)
+			continue
+		}
+		if packet == nil {
+			continue
+		}
+
+		v.stats.framesReceived.Inc()
+
+		v.recvMu.Lock()
+		packet.UserID = v.ssrcs[packet.SSRC]
+		// unknown SSRCs are dropped until Discord sends the speaking event for them
+		if ch, exists := v.receivers[packet.UserID]; exists && !packet.UserID.IsZero() {
+			select {
+			case ch <- packet:
+			default:
+				// the receiver is not keeping up, drop the frame instead of stalling every other user
+				v.stats.framesDropped.Inc()
+			}
+		}
+		v.recvMu.Unlock()
+	}
+}
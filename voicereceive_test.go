@@ -0,0 +1,95 @@
+// +build !integration
+
+package disgord
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestDecodeVoicePacket(t *testing.T) {
+	var key [32]byte
+	copy(key[:], "a very secret key for this test!")
+
+	header := func(firstByte byte) []byte {
+		h := make([]byte, rtpHeaderSize)
+		h[0] = firstByte
+		h[1] = 0x78
+		binary.BigEndian.PutUint16(h[2:4], 7)
+		binary.BigEndian.PutUint32(h[4:8], 960)
+		binary.BigEndian.PutUint32(h[8:12], 42)
+		return h
+	}
+	opus := []byte{0xF8, 0xFF, 0xFE}
+
+	for _, mode := range voiceEncryptionModes {
+		crypto, err := newVoiceCrypto(mode, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(mode+"/plain", func(t *testing.T) {
+			packet, err := decodeVoicePacket(crypto.seal(header(0x80), opus), crypto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if packet.SSRC != 42 || packet.Sequence != 7 || packet.Timestamp != 960 {
+				t.Errorf("incorrect RTP header fields. Got %+v", packet)
+			}
+			if !bytes.Equal(packet.Opus, opus) {
+				t.Errorf("incorrect opus frame. Got %v, wants %v", packet.Opus, opus)
+			}
+		})
+
+		t.Run(mode+"/header extension", func(t *testing.T) {
+			extensionHeader := []byte{0xBE, 0xDE, 0x00, 0x01}
+			extensionBody := []byte{0x10, 0xFF, 0x90, 0x00}
+
+			var data []byte
+			if mode == voiceModeXSalsa20Poly1305 {
+				// the complete extension is encrypted
+				payload := append(append(extensionHeader, extensionBody...), opus...)
+				data = crypto.seal(header(0x90), payload)
+			} else {
+				data = crypto.seal(append(header(0x90), extensionHeader...), append(extensionBody, opus...))
+			}
+
+			packet, err := decodeVoicePacket(data, crypto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(packet.Opus, opus) {
+				t.Errorf("header extension was not stripped. Got %v, wants %v", packet.Opus, opus)
+			}
+		})
+
+		t.Run(mode+"/wrong key", func(t *testing.T) {
+			var otherKey [32]byte
+			other, _ := newVoiceCrypto(mode, otherKey)
+			if _, err := decodeVoicePacket(crypto.seal(header(0x80), opus), other); err == nil {
+				t.Error("expected decryption to fail")
+			}
+		})
+	}
+
+	t.Run("rtcp", func(t *testing.T) {
+		data := header(0x80)
+		data[1] = 201
+		packet, err := decodeVoicePacket(data, &xSalsa20Poly1305{secretKey: key})
+		if err != nil || packet != nil {
+			t.Errorf("expected RTCP packet to be ignored. Got %v, %v", packet, err)
+		}
+	})
+}
+
+func TestSelectVoiceEncryptionMode(t *testing.T) {
+	mode, err := selectVoiceEncryptionMode([]string{"xsalsa20_poly1305", "aead_xchacha20_poly1305_rtpsize", "aead_aes256_gcm_rtpsize"})
+	if err != nil || mode != voiceModeXChaCha20Poly1305RTPSize {
+		t.Errorf("expected %s to be selected. Got %s, %v", voiceModeXChaCha20Poly1305RTPSize, mode, err)
+	}
+
+	if _, err = selectVoiceEncryptionMode([]string{"xsalsa20_poly1305_lite"}); err == nil {
+		t.Error("expected an error when no supported modes are offered")
+	}
+}
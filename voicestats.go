@@ -0,0 +1,108 @@
+package disgord
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// VoiceStats holds health metrics of a voice connection. Counters are accumulated over the lifetime of the
+// voice connection, including reconnects.
+type VoiceStats struct {
+	GuildID Snowflake
+
+	// HeartbeatLatency is the time between sending a heartbeat to the voice websocket and Discord acknowledging it.
+	HeartbeatLatency time.Duration
+
+	// UDPRoundTrip is an estimate of the UDP round-trip time, measured during IP discovery when the UDP
+	// connection was established.
+	UDPRoundTrip time.Duration
+
+	FramesSent uint64
+	BytesSent  uint64
+
+	FramesReceived uint64
+	// FramesDropped is the number of received frames dropped because the receiver was not keeping up.
+	FramesDropped uint64
+
+	// Gaps is the number of times the audio was interrupted because frames were not provided in time, and
+	// GapDuration is the total length of these interruptions. Pausing the audio also counts as a gap.
+	Gaps        uint64
+	GapDuration time.Duration
+
+	Reconnects uint64
+}
+
+type voiceStats struct {
+	framesSent     atomic.Uint64
+	bytesSent      atomic.Uint64
+	framesReceived atomic.Uint64
+	framesDropped  atomic.Uint64
+	gaps           atomic.Uint64
+	gapDuration    atomic.Duration
+	reconnects     atomic.Uint64
+
+	// stopReporting stops the current periodic reporter, if any
+	stopReporting chan struct{}
+}
+
+func (v *voiceImpl) Stats() *VoiceStats {
+	stats := &VoiceStats{
+		GuildID:        v.guildID,
+		FramesSent:     v.stats.framesSent.Load(),
+		BytesSent:      v.stats.bytesSent.Load(),
+		FramesReceived: v.stats.framesReceived.Load(),
+		FramesDropped:  v.stats.framesDropped.Load(),
+		Gaps:           v.stats.gaps.Load(),
+		GapDuration:    v.stats.gapDuration.Load(),
+		Reconnects:     v.stats.reconnects.Load(),
+	}
+	if t := v.conn(); t != nil {
+		stats.UDPRoundTrip = t.udpRoundTrip
+		if t.ws != nil {
+			stats.HeartbeatLatency, _ = t.ws.HeartbeatLatency()
+		}
+	}
+	return stats
+}
+
+func (v *voiceImpl) OnStats(interval time.Duration, handler func(conn VoiceConnection, stats *VoiceStats)) error {
+	if interval < time.Second {
+		return errors.New("stats interval must be at least one second")
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	if !v.ready.Load() {
+		return errors.New("attempting to interact with a closed voice connection")
+	}
+
+	if v.stats.stopReporting != nil {
+		close(v.stats.stopReporting)
+		v.stats.stopReporting = nil
+	}
+	if handler == nil {
+		return nil
+	}
+
+	stop := make(chan struct{})
+	v.stats.stopReporting = stop
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				handler(v, v.Stats())
+			case <-stop:
+				return
+			case <-v.close:
+				return
+			}
+		}
+	}()
+	return nil
+}
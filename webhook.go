@@ -33,26 +33,26 @@ type WebhookQueryBuilder interface {
 	WithContext(ctx context.Context) WebhookQueryBuilder
 
 	// GetWebhook Returns the new webhook object for the given id.
-	Get(flags ...Flag) (*Webhook, error)
+	Get(flags ...RequestOption) (*Webhook, error)
 
 	// UpdateBuilder Modify a webhook. Requires the 'MANAGE_WEBHOOKS' permission.
 	// Returns the updated webhook object on success.
-	UpdateBuilder(flags ...Flag) *updateWebhookBuilder
+	UpdateBuilder(flags ...RequestOption) *updateWebhookBuilder
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) *updateWebhookBuilder
+	Update(flags ...RequestOption) *updateWebhookBuilder
 
 	// Delete Deletes a webhook permanently. User must be owner. Returns a 204 NO CONTENT response on success.
-	Delete(flags ...Flag) error
+	Delete(flags ...RequestOption) error
 
 	// Execute Trigger a webhook in Discord.
-	Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...Flag) (*Message, error)
+	Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...RequestOption) (*Message, error)
 
 	// ExecuteSlackWebhook Trigger a webhook in Discord from the Slack app.
-	ExecuteSlackWebhook(params *ExecuteWebhookParams, wait bool, flags ...Flag) (*Message, error)
+	ExecuteSlackWebhook(params *ExecuteWebhookParams, wait bool, flags ...RequestOption) (*Message, error)
 
 	// ExecuteGitHubWebhook Trigger a webhook in Discord from the GitHub app.
-	ExecuteGitHubWebhook(params *ExecuteWebhookParams, wait bool, flags ...Flag) (*Message, error)
+	ExecuteGitHubWebhook(params *ExecuteWebhookParams, wait bool, flags ...RequestOption) (*Message, error)
 
 	WithToken(token string) WebhookWithTokenQueryBuilder
 }
@@ -79,7 +79,7 @@ func (w webhookQueryBuilder) WithContext(ctx context.Context) WebhookQueryBuilde
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#get-webhook
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (w webhookQueryBuilder) Get(flags ...Flag) (ret *Webhook, err error) {
+func (w webhookQueryBuilder) Get(flags ...RequestOption) (ret *Webhook, err error) {
 	r := w.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.Webhook(w.webhookID),
 		Ctx:      w.ctx,
@@ -98,7 +98,7 @@ func (w webhookQueryBuilder) Get(flags ...Flag) (ret *Webhook, err error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#modify-webhook
 //  Reviewed                2018-08-14
 //  Comment                 All parameters to this endpoint.
-func (w webhookQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateWebhookBuilder) {
+func (w webhookQueryBuilder) UpdateBuilder(flags ...RequestOption) (builder *updateWebhookBuilder) {
 	builder = &updateWebhookBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Webhook{}
@@ -121,7 +121,7 @@ func (w webhookQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateWebhoo
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#delete-webhook
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (w webhookQueryBuilder) Delete(flags ...Flag) (err error) {
+func (w webhookQueryBuilder) Delete(flags ...RequestOption) (err error) {
 	return w.WithToken("").WithContext(w.ctx).Delete(flags...)
 }
 
@@ -133,16 +133,23 @@ type ExecuteWebhookParams struct {
 	TTS       bool        `json:"tts"`
 	File      interface{} `json:"file"`
 	Embeds    []*Embed    `json:"embeds"`
+
+	Components      []*MessageComponent `json:"components,omitempty"`
+	AllowedMentions *AllowedMentions    `json:"allowed_mentions,omitempty"`
+
+	// ThreadID sends the message to a thread in the channel of the webhook.
+	ThreadID Snowflake `json:"-"`
 }
 
 type execWebhookParams struct {
-	Wait bool `urlparam:"wait"`
+	Wait     bool      `urlparam:"wait"`
+	ThreadID Snowflake `urlparam:"thread_id,omitempty"`
 }
 
 var _ URLQueryStringer = (*execWebhookParams)(nil)
 
 // Execute Trigger a webhook in Discord.
-func (w webhookQueryBuilder) Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...Flag) (message *Message, err error) {
+func (w webhookQueryBuilder) Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...RequestOption) (message *Message, err error) {
 	return w.WithToken("").WithContext(w.ctx).Execute(params, wait, URLSuffix, flags...)
 }
 
@@ -153,7 +160,7 @@ func (w webhookQueryBuilder) Execute(params *ExecuteWebhookParams, wait bool, UR
 //  Reviewed                2020-05-21
 //  Comment                 Refer to Slack's documentation for more information. We do not support Slack's channel,
 //                          icon_emoji, mrkdwn, or mrkdwn_in properties.
-func (w webhookQueryBuilder) ExecuteSlackWebhook(params *ExecuteWebhookParams, wait bool, flags ...Flag) (message *Message, err error) {
+func (w webhookQueryBuilder) ExecuteSlackWebhook(params *ExecuteWebhookParams, wait bool, flags ...RequestOption) (message *Message, err error) {
 	return w.WithToken("").WithContext(w.ctx).Execute(params, wait, endpoint.Slack(), flags...)
 }
 
@@ -166,7 +173,7 @@ func (w webhookQueryBuilder) ExecuteSlackWebhook(params *ExecuteWebhookParams, w
 //                          as the "Payload URL." You can choose what events your Discord channel receives by
 //                          choosing the "Let me select individual events" option and selecting individual
 //                          events for the new webhook you're configuring.
-func (w webhookQueryBuilder) ExecuteGitHubWebhook(params *ExecuteWebhookParams, wait bool, flags ...Flag) (message *Message, err error) {
+func (w webhookQueryBuilder) ExecuteGitHubWebhook(params *ExecuteWebhookParams, wait bool, flags ...RequestOption) (message *Message, err error) {
 	return w.WithToken("").WithContext(w.ctx).Execute(params, wait, endpoint.GitHub(), flags...)
 }
 
@@ -175,19 +182,26 @@ type WebhookWithTokenQueryBuilder interface {
 
 	// Get Same as GetWebhook, except this call does not require authentication and
 	// returns no user in the webhook object.
-	Get(flags ...Flag) (*Webhook, error)
+	Get(flags ...RequestOption) (*Webhook, error)
 
 	// UpdateBuilder Same as UpdateWebhook, except this call does not require authentication,
 	// does _not_ accept a channel_id parameter in the body, and does not return a user in the webhook object.
-	UpdateBuilder(flags ...Flag) *updateWebhookBuilder
+	UpdateBuilder(flags ...RequestOption) *updateWebhookBuilder
 
 	// Deprecated: use UpdateBuilder
-	Update(flags ...Flag) *updateWebhookBuilder
+	Update(flags ...RequestOption) *updateWebhookBuilder
 
 	// Delete Same as DeleteWebhook, except this call does not require authentication.
-	Delete(flags ...Flag) error
+	Delete(flags ...RequestOption) error
+
+	Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...RequestOption) (*Message, error)
 
-	Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...Flag) (*Message, error)
+	// EditMessage edits a message previously sent by the webhook.
+	EditMessage(messageID Snowflake, params *EditWebhookMessageParams, flags ...RequestOption) (*Message, error)
+
+	// DeleteMessage deletes a message previously sent by the webhook. The thread id must be set for messages
+	// in threads.
+	DeleteMessage(messageID, threadID Snowflake, flags ...RequestOption) error
 }
 
 func (w webhookQueryBuilder) WithToken(token string) WebhookWithTokenQueryBuilder {
@@ -214,7 +228,7 @@ func (w webhookWithTokenQueryBuilder) WithContext(ctx context.Context) WebhookWi
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#get-webhook-with-token
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (w webhookWithTokenQueryBuilder) Get(flags ...Flag) (*Webhook, error) {
+func (w webhookWithTokenQueryBuilder) Get(flags ...RequestOption) (*Webhook, error) {
 	r := w.client.newRESTRequest(&httd.Request{
 		Endpoint: endpoint.WebhookToken(w.webhookID, w.token),
 		Ctx:      w.ctx,
@@ -233,7 +247,7 @@ func (w webhookWithTokenQueryBuilder) Get(flags ...Flag) (*Webhook, error) {
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#modify-webhook-with-token
 //  Reviewed                2018-08-14
 //  Comment                 All parameters to this endpoint. are optional.
-func (w webhookWithTokenQueryBuilder) UpdateBuilder(flags ...Flag) (builder *updateWebhookBuilder) {
+func (w webhookWithTokenQueryBuilder) UpdateBuilder(flags ...RequestOption) (builder *updateWebhookBuilder) {
 	builder = &updateWebhookBuilder{}
 	builder.r.itemFactory = func() interface{} {
 		return &Webhook{}
@@ -257,7 +271,7 @@ func (w webhookWithTokenQueryBuilder) UpdateBuilder(flags ...Flag) (builder *upd
 //  Discord documentation   https://discord.com/developers/docs/resources/webhook#delete-webhook-with-token
 //  Reviewed                2018-08-14
 //  Comment                 -
-func (w webhookWithTokenQueryBuilder) Delete(flags ...Flag) error {
+func (w webhookWithTokenQueryBuilder) Delete(flags ...RequestOption) error {
 	var e string
 	if w.token != "" {
 		e = endpoint.WebhookToken(w.webhookID, w.token)
@@ -288,7 +302,7 @@ func (w webhookWithTokenQueryBuilder) Delete(flags ...Flag) error {
 //  Comment#2               For the webhook embed objects, you can set every field except type (it will be
 //                          rich regardless of if you try to set it), provider, video, and any height, width,
 //                          or proxy_url values for images.
-func (w webhookWithTokenQueryBuilder) Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...Flag) (message *Message, err error) {
+func (w webhookWithTokenQueryBuilder) Execute(params *ExecuteWebhookParams, wait bool, URLSuffix string, flags ...RequestOption) (message *Message, err error) {
 	if params == nil {
 		return nil, errors.New("params can not be nil")
 	}
@@ -300,6 +314,12 @@ func (w webhookWithTokenQueryBuilder) Execute(params *ExecuteWebhookParams, wait
 		return nil, errors.New("webhook token is required")
 	}
 
+	if params.AllowedMentions == nil && w.client.allowedMentions != nil {
+		p := *params
+		p.AllowedMentions = w.client.allowedMentions
+		params = &p
+	}
+
 	var contentType string
 	if params.File == nil {
 		contentType = httd.ContentTypeJSON
@@ -307,7 +327,7 @@ func (w webhookWithTokenQueryBuilder) Execute(params *ExecuteWebhookParams, wait
 		contentType = "multipart/form-data"
 	}
 
-	urlparams := &execWebhookParams{wait}
+	urlparams := &execWebhookParams{Wait: wait, ThreadID: params.ThreadID}
 	r := w.client.newRESTRequest(&httd.Request{
 		Method:      httd.MethodPost,
 		Ctx:         w.ctx,
@@ -324,6 +344,80 @@ func (w webhookWithTokenQueryBuilder) Execute(params *ExecuteWebhookParams, wait
 	return nil, err
 }
 
+// EditWebhookMessageParams JSON params for func EditMessage. Fields left empty are not changed.
+type EditWebhookMessageParams struct {
+	Content         string              `json:"content,omitempty"`
+	Embeds          []*Embed            `json:"embeds,omitempty"`
+	Components      []*MessageComponent `json:"components,omitempty"`
+	AllowedMentions *AllowedMentions    `json:"allowed_mentions,omitempty"`
+
+	// ThreadID must be set for messages in threads.
+	ThreadID Snowflake `json:"-"`
+}
+
+type webhookMessageParams struct {
+	ThreadID Snowflake `urlparam:"thread_id,omitempty"`
+}
+
+var _ URLQueryStringer = (*webhookMessageParams)(nil)
+
+// EditWebhookMessage [REST] Edits a previously-sent webhook message from the same token.
+//  Method                  PATCH
+//  Endpoint                /webhooks/{webhook.id}/{webhook.token}/messages/{message.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/webhook#edit-webhook-message
+//  Reviewed                2022-03-18
+//  Comment                 -
+func (w webhookWithTokenQueryBuilder) EditMessage(messageID Snowflake, params *EditWebhookMessageParams, flags ...RequestOption) (*Message, error) {
+	if params == nil {
+		return nil, errors.New("params can not be nil")
+	}
+	if w.token == "" {
+		return nil, errors.New("webhook token is required")
+	}
+
+	if params.AllowedMentions == nil && w.client.allowedMentions != nil {
+		p := *params
+		p.AllowedMentions = w.client.allowedMentions
+		params = &p
+	}
+
+	urlparams := &webhookMessageParams{ThreadID: params.ThreadID}
+	r := w.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         w.ctx,
+		Endpoint:    endpoint.WebhookMessage(w.webhookID, w.token, messageID) + urlparams.URLQueryString(),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+	}, flags)
+	r.factory = func() interface{} {
+		return &Message{}
+	}
+
+	return getMessage(r.Execute)
+}
+
+// DeleteWebhookMessage [REST] Deletes a message that was created by the webhook.
+//  Method                  DELETE
+//  Endpoint                /webhooks/{webhook.id}/{webhook.token}/messages/{message.id}
+//  Discord documentation   https://discord.com/developers/docs/resources/webhook#delete-webhook-message
+//  Reviewed                2022-03-18
+//  Comment                 -
+func (w webhookWithTokenQueryBuilder) DeleteMessage(messageID, threadID Snowflake, flags ...RequestOption) error {
+	if w.token == "" {
+		return errors.New("webhook token is required")
+	}
+
+	urlparams := &webhookMessageParams{ThreadID: threadID}
+	r := w.client.newRESTRequest(&httd.Request{
+		Method:   httd.MethodDelete,
+		Ctx:      w.ctx,
+		Endpoint: endpoint.WebhookMessage(w.webhookID, w.token, messageID) + urlparams.URLQueryString(),
+	}, flags)
+
+	_, err := r.Execute()
+	return err
+}
+
 //////////////////////////////////////////////////////
 //
 // REST Builders
@@ -0,0 +1,134 @@
+package disgord
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Vedza/disgord/internal/constant"
+	"github.com/Vedza/disgord/internal/httd"
+	"github.com/Vedza/disgord/internal/logger"
+)
+
+// WebhookClientConfig configures a WebhookClient. Either the URL, or both the ID and Token, must be set.
+type WebhookClientConfig struct {
+	// URL of the webhook, as copied from the Discord client.
+	URL string
+
+	ID    Snowflake
+	Token string
+
+	HTTPClient        *http.Client
+	RESTBucketManager httd.RESTBucketManager
+	Logger            Logger
+
+	// Timeout of every request, see Config.RESTTimeout.
+	Timeout time.Duration
+
+	// ProjectName is added to the User-Agent header.
+	ProjectName string
+
+	// AllowedMentions is the default of messages without allowed mentions, see Config.AllowedMentions.
+	AllowedMentions *AllowedMentions
+}
+
+// WebhookClient executes an incoming webhook, without a bot token. Useful for lightweight notifiers, which only
+// need to post messages.
+//  hook, err := disgord.NewWebhookClient(disgord.WebhookClientConfig{URL: os.Getenv("WEBHOOK_URL")})
+//  if err != nil {
+//      panic(err)
+//  }
+//  msg, err := hook.Execute(ctx, &disgord.ExecuteWebhookParams{Content: "deployed"}, true)
+type WebhookClient struct {
+	client  *Client
+	webhook webhookWithTokenQueryBuilder
+}
+
+// ParseWebhookURL extracts the webhook id and token from a webhook URL, such as
+// https://discord.com/api/webhooks/{webhook.id}/{webhook.token}.
+func ParseWebhookURL(webhookURL string) (id Snowflake, token string, err error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return 0, "", err
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := range segments {
+		if segments[i] != "webhooks" || i+2 >= len(segments) {
+			continue
+		}
+		if id, err = GetSnowflake(segments[i+1]); err != nil {
+			return 0, "", err
+		}
+		return id, segments[i+2], nil
+	}
+	return 0, "", errors.New("not a webhook url: " + webhookURL)
+}
+
+// NewWebhookClient creates a client for executing the webhook.
+func NewWebhookClient(conf WebhookClientConfig) (*WebhookClient, error) {
+	if conf.URL != "" {
+		var err error
+		if conf.ID, conf.Token, err = ParseWebhookURL(conf.URL); err != nil {
+			return nil, err
+		}
+	}
+	if conf.ID.IsZero() || conf.Token == "" {
+		return nil, errors.New("the webhook id and token must be set")
+	}
+	if conf.HTTPClient == nil {
+		conf.HTTPClient = DefaultHttpClient
+	}
+	if conf.Logger == nil {
+		conf.Logger = logger.Empty{}
+	}
+	conf.Logger = logger.Redact(conf.Logger, conf.Token)
+	if conf.ProjectName == "" {
+		conf.ProjectName = LibraryInfo()
+	}
+
+	req, err := httd.NewClient(&httd.Config{
+		APIVersion:         constant.DiscordVersion,
+		Unauthenticated:    true,
+		HttpClient:         conf.HTTPClient,
+		RESTBucketManager:  conf.RESTBucketManager,
+		UserAgentSourceURL: constant.GitHubURL,
+		UserAgentVersion:   constant.Version,
+		UserAgentExtra:     conf.ProjectName,
+		Timeout:            restTimeout(conf.Timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{
+		req:   req,
+		cache: &CacheNop{},
+		log:   conf.Logger,
+		pool:  newPools(),
+
+		allowedMentions: conf.AllowedMentions,
+	}
+	return &WebhookClient{
+		client:  client,
+		webhook: webhookWithTokenQueryBuilder{client: client, webhookID: conf.ID, token: conf.Token},
+	}, nil
+}
+
+// Execute sends a message using the webhook. Discord only returns the message when wait is true.
+func (w *WebhookClient) Execute(ctx context.Context, params *ExecuteWebhookParams, wait bool) (*Message, error) {
+	return w.webhook.WithContext(ctx).Execute(params, wait, "")
+}
+
+// EditMessage edits a message sent by the webhook.
+func (w *WebhookClient) EditMessage(ctx context.Context, messageID Snowflake, params *EditWebhookMessageParams) (*Message, error) {
+	return w.webhook.WithContext(ctx).EditMessage(messageID, params)
+}
+
+// DeleteMessage deletes a message sent by the webhook. The thread id must be set for messages in threads.
+func (w *WebhookClient) DeleteMessage(ctx context.Context, messageID, threadID Snowflake) error {
+	return w.webhook.WithContext(ctx).DeleteMessage(messageID, threadID)
+}
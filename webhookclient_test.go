@@ -0,0 +1,63 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestParseWebhookURL(t *testing.T) {
+	id, token, err := ParseWebhookURL("https://discord.com/api/webhooks/123/abc-def")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 123 || token != "abc-def" {
+		t.Errorf("incorrect webhook. Got %d and %s", id, token)
+	}
+
+	if _, _, err = ParseWebhookURL("https://discord.com/api/channels/123"); err == nil {
+		t.Error("expected an error for urls that are not webhooks")
+	}
+}
+
+func TestWebhookClient(t *testing.T) {
+	var req *http.Request
+	hook, err := NewWebhookClient(WebhookClientConfig{
+		URL: "https://discord.com/api/webhooks/123/token",
+		HTTPClient: &http.Client{Transport: transportFunc(func(r *http.Request) (*http.Response, error) {
+			req = r
+			if r.Method == http.MethodDelete {
+				resp := jsonResponse(r, "")
+				resp.StatusCode = http.StatusNoContent
+				return resp, nil
+			}
+			return jsonResponse(r, `{"id":"7","content":"hello"}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := hook.Execute(context.Background(), &ExecuteWebhookParams{Content: "hello", ThreadID: 9}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.ID != 7 {
+		t.Errorf("expected the message to be returned. Got %+v", msg)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Error("expected no authorization header")
+	}
+	if req.URL.Path != "/api/v8/webhooks/123/token" || req.URL.Query().Get("thread_id") != "9" {
+		t.Errorf("incorrect url. Got %s", req.URL)
+	}
+
+	if err = hook.DeleteMessage(context.Background(), 7, 0); err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != http.MethodDelete || req.URL.Path != "/api/v8/webhooks/123/token/messages/7" {
+		t.Errorf("incorrect request. Got %s %s", req.Method, req.URL)
+	}
+}
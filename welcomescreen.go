@@ -0,0 +1,120 @@
+package disgord
+
+import (
+	"errors"
+
+	"github.com/Vedza/disgord/internal/endpoint"
+	"github.com/Vedza/disgord/internal/httd"
+)
+
+// WelcomeScreenChannel a channel shown on the welcome screen of a community guild.
+// https://discord.com/developers/docs/resources/guild#welcome-screen-object-welcome-screen-channel-structure
+type WelcomeScreenChannel struct {
+	ChannelID   Snowflake `json:"channel_id"`
+	Description string    `json:"description"`
+	EmojiID     Snowflake `json:"emoji_id,omitempty"`   // set for custom emojis
+	EmojiName   string    `json:"emoji_name,omitempty"` // unicode emoji or the name of the custom emoji
+}
+
+var _ Copier = (*WelcomeScreenChannel)(nil)
+var _ DeepCopier = (*WelcomeScreenChannel)(nil)
+
+// WelcomeScreen https://discord.com/developers/docs/resources/guild#welcome-screen-object
+type WelcomeScreen struct {
+	Description     string                  `json:"description"`
+	WelcomeChannels []*WelcomeScreenChannel `json:"welcome_channels"`
+}
+
+var _ Copier = (*WelcomeScreen)(nil)
+var _ DeepCopier = (*WelcomeScreen)(nil)
+
+// UpdateWelcomeScreenParams https://discord.com/developers/docs/resources/guild#modify-guild-welcome-screen-json-params
+// All fields are optional, only the fields that are set are updated. A non nil, empty, WelcomeChannels
+// slice removes all the welcome channels.
+type UpdateWelcomeScreenParams struct {
+	Enabled         *bool                    `json:"enabled,omitempty"`
+	WelcomeChannels *[]*WelcomeScreenChannel `json:"welcome_channels,omitempty"` // at most 5 channels
+	Description     *string                  `json:"description,omitempty"`
+
+	// Reason is a X-Audit-Log-Reason header field that will show up on the audit log for this action.
+	Reason string `json:"-"`
+}
+
+func (p *UpdateWelcomeScreenParams) FindErrors() error {
+	if p.Description != nil && len(*p.Description) > 140 {
+		return errors.New("welcome screen description can not be longer than 140 characters")
+	}
+	if p.WelcomeChannels == nil {
+		return nil
+	}
+
+	channels := *p.WelcomeChannels
+	if len(channels) > 5 {
+		return errors.New("the welcome screen can show at most 5 channels")
+	}
+	for _, channel := range channels {
+		if channel.ChannelID.IsZero() {
+			return errors.New("welcome screen channels must have a channel id")
+		}
+		if channel.Description == "" || len(channel.Description) > 50 {
+			return errors.New("welcome screen channel descriptions must be 1-50 characters long")
+		}
+	}
+	return nil
+}
+
+// GetWelcomeScreen [REST] Returns the welcome screen object for the guild. If the welcome screen is not
+// enabled, the MANAGE_GUILD permission is required.
+//  Method                  GET
+//  Endpoint                /guilds/{guild.id}/welcome-screen
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#get-guild-welcome-screen
+//  Reviewed                2021-08-28
+//  Comment                 -
+func (g guildQueryBuilder) GetWelcomeScreen(flags ...RequestOption) (*WelcomeScreen, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Endpoint: endpoint.GuildWelcomeScreen(g.gid),
+		Ctx:      g.ctx,
+	}, flags)
+	r.factory = func() interface{} {
+		return &WelcomeScreen{}
+	}
+
+	return getWelcomeScreen(r.Execute)
+}
+
+// UpdateWelcomeScreen [REST] Modify the guild's welcome screen. Requires the MANAGE_GUILD permission.
+// Returns the updated welcome screen object.
+//  Method                  PATCH
+//  Endpoint                /guilds/{guild.id}/welcome-screen
+//  Discord documentation   https://discord.com/developers/docs/resources/guild#modify-guild-welcome-screen
+//  Reviewed                2021-08-28
+//  Comment                 All parameters are optional.
+func (g guildQueryBuilder) UpdateWelcomeScreen(params *UpdateWelcomeScreenParams, flags ...RequestOption) (*WelcomeScreen, error) {
+	if g.gid.IsZero() {
+		return nil, errors.New("guildID must be set, was " + g.gid.String())
+	}
+	if params == nil {
+		return nil, errors.New("params object can not be nil")
+	}
+	if err := params.FindErrors(); err != nil {
+		return nil, err
+	}
+
+	r := g.client.newRESTRequest(&httd.Request{
+		Method:      httd.MethodPatch,
+		Ctx:         g.ctx,
+		Endpoint:    endpoint.GuildWelcomeScreen(g.gid),
+		Body:        params,
+		ContentType: httd.ContentTypeJSON,
+		Reason:      params.Reason,
+	}, flags)
+	r.factory = func() interface{} {
+		return &WelcomeScreen{}
+	}
+
+	return getWelcomeScreen(r.Execute)
+}
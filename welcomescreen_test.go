@@ -0,0 +1,70 @@
+// +build !integration
+
+package disgord
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Vedza/disgord/json"
+)
+
+func TestWelcomeScreen(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	client, err := NewClient(context.Background(), Config{
+		BotToken: "testing",
+		HTTPClient: &http.Client{Transport: transportFunc(func(req *http.Request) (*http.Response, error) {
+			path := req.URL.Path[strings.Index(req.URL.Path, "/guilds"):]
+			requests = append(requests, req.Method+" "+path)
+			body = nil
+			if req.Body != nil {
+				data, _ := ioutil.ReadAll(req.Body)
+				_ = json.Unmarshal(data, &body)
+			}
+			return jsonResponse(req, `{"description":"hi","welcome_channels":[{"channel_id":"2","description":"rules","emoji_name":"📜"}]}`), nil
+		})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	screen, err := client.Guild(1).GetWelcomeScreen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if screen.Description != "hi" || len(screen.WelcomeChannels) != 1 {
+		t.Fatalf("unexpected welcome screen %+v", screen)
+	}
+	if channel := screen.WelcomeChannels[0]; channel.ChannelID != 2 || channel.Description != "rules" || channel.EmojiName != "📜" {
+		t.Errorf("unexpected welcome channel %+v", channel)
+	}
+
+	enabled := true
+	removed := []*WelcomeScreenChannel{}
+	if _, err = client.Guild(1).UpdateWelcomeScreen(&UpdateWelcomeScreenParams{Enabled: &enabled, WelcomeChannels: &removed}); err != nil {
+		t.Fatal(err)
+	}
+	if channels, ok := body["welcome_channels"].([]interface{}); !ok || len(channels) != 0 || body["enabled"] != true {
+		t.Errorf("expected the channels to be removed. Got %+v", body)
+	}
+	if _, ok := body["description"]; ok {
+		t.Errorf("expected the description to be left out. Got %+v", body)
+	}
+
+	expected := []string{
+		"GET /guilds/1/welcome-screen",
+		"PATCH /guilds/1/welcome-screen",
+	}
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests\n%s", strings.Join(requests, "\n"))
+	}
+
+	tooMany := make([]*WelcomeScreenChannel, 6)
+	if _, err = client.Guild(1).UpdateWelcomeScreen(&UpdateWelcomeScreenParams{WelcomeChannels: &tooMany}); err == nil {
+		t.Error("expected an error for more than 5 welcome channels")
+	}
+}